@@ -17,9 +17,11 @@
 package ecdsa
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/internal/dudect"
 	"math/big"
 	"testing"
 
@@ -139,6 +141,41 @@ func TestNoZeros(t *testing.T) {
 	})
 }
 
+func TestConstantTimeTiming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	// class 0: fixed private key (scalar 1), class 1: random private keys
+	seed := make([]byte, fr.Bits/8+8)
+	var privKey *PrivateKey
+	var keyRand *bytes.Reader
+	prepare := func(class int) {
+		if class == 0 {
+			for i := range seed {
+				seed[i] = 0
+			}
+			seed[len(seed)-1] = 1
+		} else if _, err := rand.Read(seed); err != nil {
+			panic(err)
+		}
+		var err error
+		if privKey, err = GenerateKey(bytes.NewReader(seed)); err != nil {
+			panic(err)
+		}
+		keyRand = bytes.NewReader(seed)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { _, _ = GenerateKey(keyRand) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in GenerateKey: |t| = %.2f", tt)
+	}
+
+	msg := []byte("testing ECDSA")
+	if tt := dudect.Measure(1000, prepare, func() { _, _ = privKey.Sign(msg, nil) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in Sign: |t| = %.2f", tt)
+	}
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -23,6 +23,9 @@ package fp
 // certain errors (like fatal error: missing stackmap)
 // this ensures we test all asm path.
 var (
-	supportAdx = false
-	_          = supportAdx
+	supportAdx        = false
+	_                 = supportAdx
+	supportAvx2       = false
+	supportAvx512     = false
+	supportAvx512IFMA = false
 )
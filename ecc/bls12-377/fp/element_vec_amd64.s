@@ -0,0 +1,1628 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x8508c00000000001
+DATA q<>+8(SB)/8, $0x170b5d4430000000
+DATA q<>+16(SB)/8, $0x1ef3622fba094800
+DATA q<>+24(SB)/8, $0x1a22d9f300f5138f
+DATA q<>+32(SB)/8, $0xc63b05c06ca1493b
+DATA q<>+40(SB)/8, $0x01ae3a4617c510ea
+GLOBL q<>(SB), (RODATA+NOPTR), $48
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x8508bfffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, ra4, ra5, rb0, rb1, rb2, rb3, rb4, rb5) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	MOVQ    ra4, rb4;        \
+	SBBQ    q<>+32(SB), ra4; \
+	MOVQ    ra5, rb5;        \
+	SBBQ    q<>+40(SB), ra5; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+	CMOVQCS rb4, ra4;        \
+	CMOVQCS rb5, ra5;        \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $6
+DATA indexGatherScatter<>+16(SB)/8, $12
+DATA indexGatherScatter<>+24(SB)/8, $18
+DATA indexGatherScatter<>+32(SB)/8, $24
+DATA indexGatherScatter<>+40(SB)/8, $30
+DATA indexGatherScatter<>+48(SB)/8, $36
+DATA indexGatherScatter<>+56(SB)/8, $42
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0x8c00000000001
+DATA qLimbs52<>+8(SB)/8, $0x4430000000850
+DATA qLimbs52<>+16(SB)/8, $0xa094800170b5d
+DATA qLimbs52<>+24(SB)/8, $0x138f1ef3622fb
+DATA qLimbs52<>+32(SB)/8, $0xb1a22d9f300f5
+DATA qLimbs52<>+40(SB)/8, $0x3b05c06ca1493
+DATA qLimbs52<>+48(SB)/8, $0xa4617c510eac6
+DATA qLimbs52<>+56(SB)/8, $0x1ae3
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $64
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), $48-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// t = a[i] + b[i], the MOVQ don't change the carry flag
+	MOVQ 0(DX), SI
+	ADDQ 0(CX), SI
+	MOVQ SI, t0-48(SP)
+	MOVQ 8(DX), SI
+	ADCQ 8(CX), SI
+	MOVQ SI, t1-40(SP)
+	MOVQ 16(DX), SI
+	ADCQ 16(CX), SI
+	MOVQ SI, t2-32(SP)
+	MOVQ 24(DX), SI
+	ADCQ 24(CX), SI
+	MOVQ SI, t3-24(SP)
+	MOVQ 32(DX), SI
+	ADCQ 32(CX), SI
+	MOVQ SI, t4-16(SP)
+	MOVQ 40(DX), SI
+	ADCQ 40(CX), SI
+	MOVQ SI, t5-8(SP)
+
+	// res[i] = t - q
+	MOVQ t0-48(SP), SI
+	SUBQ q<>+0(SB), SI
+	MOVQ SI, 0(AX)
+	MOVQ t1-40(SP), SI
+	SBBQ q<>+8(SB), SI
+	MOVQ SI, 8(AX)
+	MOVQ t2-32(SP), SI
+	SBBQ q<>+16(SB), SI
+	MOVQ SI, 16(AX)
+	MOVQ t3-24(SP), SI
+	SBBQ q<>+24(SB), SI
+	MOVQ SI, 24(AX)
+	MOVQ t4-16(SP), SI
+	SBBQ q<>+32(SB), SI
+	MOVQ SI, 32(AX)
+	MOVQ t5-8(SP), SI
+	SBBQ q<>+40(SB), SI
+	MOVQ SI, 40(AX)
+
+	// res[i] = t if t < q
+	MOVQ    0(AX), SI
+	CMOVQCS t0-48(SP), SI
+	MOVQ    SI, 0(AX)
+	MOVQ    8(AX), SI
+	CMOVQCS t1-40(SP), SI
+	MOVQ    SI, 8(AX)
+	MOVQ    16(AX), SI
+	CMOVQCS t2-32(SP), SI
+	MOVQ    SI, 16(AX)
+	MOVQ    24(AX), SI
+	CMOVQCS t3-24(SP), SI
+	MOVQ    SI, 24(AX)
+	MOVQ    32(AX), SI
+	CMOVQCS t4-16(SP), SI
+	MOVQ    SI, 32(AX)
+	MOVQ    40(AX), SI
+	CMOVQCS t5-8(SP), SI
+	MOVQ    SI, 40(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000030, AX
+	ADDQ $0x0000000000000030, DX
+	ADDQ $0x0000000000000030, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), $48-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// res[i] = a[i] - b[i], the MOVQ don't change the carry flag
+	MOVQ 0(DX), SI
+	SUBQ 0(CX), SI
+	MOVQ SI, 0(AX)
+	MOVQ 8(DX), SI
+	SBBQ 8(CX), SI
+	MOVQ SI, 8(AX)
+	MOVQ 16(DX), SI
+	SBBQ 16(CX), SI
+	MOVQ SI, 16(AX)
+	MOVQ 24(DX), SI
+	SBBQ 24(CX), SI
+	MOVQ SI, 24(AX)
+	MOVQ 32(DX), SI
+	SBBQ 32(CX), SI
+	MOVQ SI, 32(AX)
+	MOVQ 40(DX), SI
+	SBBQ 40(CX), SI
+	MOVQ SI, 40(AX)
+	SBBQ DI, DI     // mask = -borrow
+
+	// res[i] += q if a[i] < b[i]
+	MOVQ q<>+0(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q0-48(SP)
+	MOVQ q<>+8(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q1-40(SP)
+	MOVQ q<>+16(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q2-32(SP)
+	MOVQ q<>+24(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q3-24(SP)
+	MOVQ q<>+32(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q4-16(SP)
+	MOVQ q<>+40(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q5-8(SP)
+	MOVQ q0-48(SP), SI
+	ADDQ SI, 0(AX)
+	MOVQ q1-40(SP), SI
+	ADCQ SI, 8(AX)
+	MOVQ q2-32(SP), SI
+	ADCQ SI, 16(AX)
+	MOVQ q3-24(SP), SI
+	ADCQ SI, 24(AX)
+	MOVQ q4-16(SP), SI
+	ADCQ SI, 32(AX)
+	MOVQ q5-8(SP), SI
+	ADCQ SI, 40(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000030, AX
+	ADDQ $0x0000000000000030, DX
+	ADDQ $0x0000000000000030, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[12]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $384-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $2, DX                  // we load 4 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+	VPXORQ       Z5, Z5, Z5
+	VPXORQ       Z6, Z6, Z6
+	VPXORQ       Z7, Z7, Z7
+	VPXORQ       Z8, Z8, Z8
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6          // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z6, Z6
+	VMOVDQU64 64(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z4, Z4
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z7, Z7
+	VMOVDQU64 128(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z5, Z5
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z8, Z8
+
+	// increment pointers to visit next elements
+	ADDQ $0x00000000000000c0, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-384(SP)
+	VMOVDQU64 Z4, acc1-320(SP)
+	VMOVDQU64 Z5, acc2-256(SP)
+	VMOVDQU64 Z6, acc3-192(SP)
+	VMOVDQU64 Z7, acc4-128(SP)
+	VMOVDQU64 Z8, acc5-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-384(SP), BX
+	ADDQ lane6-336(SP), BX
+	ADDQ lane12-288(SP), BX
+	ADDQ lane18-240(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-376(SP), BX
+	ADDQ lane7-328(SP), BX
+	ADDQ lane13-280(SP), BX
+	ADDQ lane19-232(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-368(SP), BX
+	ADDQ lane8-320(SP), BX
+	ADDQ lane14-272(SP), BX
+	ADDQ lane20-224(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-360(SP), BX
+	ADDQ lane9-312(SP), BX
+	ADDQ lane15-264(SP), BX
+	ADDQ lane21-216(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-352(SP), BX
+	ADDQ lane10-304(SP), BX
+	ADDQ lane16-256(SP), BX
+	ADDQ lane22-208(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-344(SP), BX
+	ADDQ lane11-296(SP), BX
+	ADDQ lane17-248(SP), BX
+	ADDQ lane23-200(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane24-192(SP), BX
+	ADDQ lane30-144(SP), BX
+	ADDQ lane36-96(SP), BX
+	ADDQ lane42-48(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane25-184(SP), BX
+	ADDQ lane31-136(SP), BX
+	ADDQ lane37-88(SP), BX
+	ADDQ lane43-40(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane26-176(SP), BX
+	ADDQ lane32-128(SP), BX
+	ADDQ lane38-80(SP), BX
+	ADDQ lane44-32(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane27-168(SP), BX
+	ADDQ lane33-120(SP), BX
+	ADDQ lane39-72(SP), BX
+	ADDQ lane45-24(SP), BX
+	MOVQ BX, 72(CX)
+	MOVQ lane28-160(SP), BX
+	ADDQ lane34-112(SP), BX
+	ADDQ lane40-64(SP), BX
+	ADDQ lane46-16(SP), BX
+	MOVQ BX, 80(CX)
+	MOVQ lane29-152(SP), BX
+	ADDQ lane35-104(SP), BX
+	ADDQ lane41-56(SP), BX
+	ADDQ lane47-8(SP), BX
+	MOVQ BX, 88(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[12]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $192-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $1, DX                  // we load 2 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+	VPXOR        Y5, Y5, Y5
+	VPXOR        Y6, Y6, Y6
+	VPXOR        Y7, Y7, Y7
+	VPXOR        Y8, Y8, Y8
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8          // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y6, Y6
+	VMOVDQU 32(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y4, Y4
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y7, Y7
+	VMOVDQU 64(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y5, Y5
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y8, Y8
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000060, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-192(SP)
+	VMOVDQU Y4, acc1-160(SP)
+	VMOVDQU Y5, acc2-128(SP)
+	VMOVDQU Y6, acc3-96(SP)
+	VMOVDQU Y7, acc4-64(SP)
+	VMOVDQU Y8, acc5-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-192(SP), BX
+	ADDQ lane6-144(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-184(SP), BX
+	ADDQ lane7-136(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-176(SP), BX
+	ADDQ lane8-128(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-168(SP), BX
+	ADDQ lane9-120(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-160(SP), BX
+	ADDQ lane10-112(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-152(SP), BX
+	ADDQ lane11-104(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane12-96(SP), BX
+	ADDQ lane18-48(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane13-88(SP), BX
+	ADDQ lane19-40(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane14-80(SP), BX
+	ADDQ lane20-32(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane15-72(SP), BX
+	ADDQ lane21-24(SP), BX
+	MOVQ BX, 72(CX)
+	MOVQ lane16-64(SP), BX
+	ADDQ lane22-16(SP), BX
+	MOVQ BX, 80(CX)
+	MOVQ lane17-56(SP), BX
+	ADDQ lane23-8(SP), BX
+	MOVQ BX, 88(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $1024-32
+	MOVQ         $0x0008bfffffffffff, SI
+	VPBROADCASTQ SI, Z12
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z13
+	VMOVDQU64    indexGatherScatter<>(SB), Z14
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z14*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z14*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z14*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z14*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z14*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(DX)(Z14*8), K1, Z5
+	VMOVDQU64  Z0, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a0-1024(SP)
+	VPSRLQ     $52, Z0, Z11
+	VPSLLQ     $12, Z1, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a1-960(SP)
+	VPSRLQ     $40, Z1, Z11
+	VPSLLQ     $24, Z2, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a2-896(SP)
+	VPSRLQ     $28, Z2, Z11
+	VPSLLQ     $36, Z3, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a3-832(SP)
+	VPSRLQ     $16, Z3, Z11
+	VPSLLQ     $48, Z4, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a4-768(SP)
+	VPSRLQ     $4, Z4, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a5-704(SP)
+	VPSRLQ     $56, Z4, Z11
+	VPSLLQ     $8, Z5, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a6-640(SP)
+	VPSRLQ     $44, Z5, Z11
+	VMOVDQU64  Z11, a7-576(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 32 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z14*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(CX)(Z14*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(CX)(Z14*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(CX)(Z14*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(CX)(Z14*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(CX)(Z14*8), K1, Z5
+	VPSLLQ     $32, Z0, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b0-512(SP)
+	VPSRLQ     $20, Z0, Z11
+	VPSLLQ     $44, Z1, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b1-448(SP)
+	VPSRLQ     $8, Z1, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b2-384(SP)
+	VPSRLQ     $60, Z1, Z11
+	VPSLLQ     $4, Z2, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b3-320(SP)
+	VPSRLQ     $48, Z2, Z11
+	VPSLLQ     $16, Z3, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b4-256(SP)
+	VPSRLQ     $36, Z3, Z11
+	VPSLLQ     $28, Z4, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b5-192(SP)
+	VPSRLQ     $24, Z4, Z11
+	VPSLLQ     $40, Z5, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, b6-128(SP)
+	VPSRLQ     $12, Z5, Z11
+	VMOVDQU64  Z11, b7-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+
+	// t += a * b[0]
+	VMOVDQU64   b0-512(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z0
+	VPMADD52HUQ a0-1024(SP), Z9, Z1
+	VPMADD52LUQ a1-960(SP), Z9, Z1
+	VPMADD52HUQ a1-960(SP), Z9, Z2
+	VPMADD52LUQ a2-896(SP), Z9, Z2
+	VPMADD52HUQ a2-896(SP), Z9, Z3
+	VPMADD52LUQ a3-832(SP), Z9, Z3
+	VPMADD52HUQ a3-832(SP), Z9, Z4
+	VPMADD52LUQ a4-768(SP), Z9, Z4
+	VPMADD52HUQ a4-768(SP), Z9, Z5
+	VPMADD52LUQ a5-704(SP), Z9, Z5
+	VPMADD52HUQ a5-704(SP), Z9, Z6
+	VPMADD52LUQ a6-640(SP), Z9, Z6
+	VPMADD52HUQ a6-640(SP), Z9, Z7
+	VPMADD52LUQ a7-576(SP), Z9, Z7
+	VPMADD52HUQ a7-576(SP), Z9, Z8
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z0, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z8
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z11
+	VPADDQ Z11, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-448(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z1
+	VPMADD52HUQ a0-1024(SP), Z9, Z2
+	VPMADD52LUQ a1-960(SP), Z9, Z2
+	VPMADD52HUQ a1-960(SP), Z9, Z3
+	VPMADD52LUQ a2-896(SP), Z9, Z3
+	VPMADD52HUQ a2-896(SP), Z9, Z4
+	VPMADD52LUQ a3-832(SP), Z9, Z4
+	VPMADD52HUQ a3-832(SP), Z9, Z5
+	VPMADD52LUQ a4-768(SP), Z9, Z5
+	VPMADD52HUQ a4-768(SP), Z9, Z6
+	VPMADD52LUQ a5-704(SP), Z9, Z6
+	VPMADD52HUQ a5-704(SP), Z9, Z7
+	VPMADD52LUQ a6-640(SP), Z9, Z7
+	VPMADD52HUQ a6-640(SP), Z9, Z8
+	VPMADD52LUQ a7-576(SP), Z9, Z8
+	VPMADD52HUQ a7-576(SP), Z9, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z1, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z11
+	VPADDQ Z11, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-384(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z2
+	VPMADD52HUQ a0-1024(SP), Z9, Z3
+	VPMADD52LUQ a1-960(SP), Z9, Z3
+	VPMADD52HUQ a1-960(SP), Z9, Z4
+	VPMADD52LUQ a2-896(SP), Z9, Z4
+	VPMADD52HUQ a2-896(SP), Z9, Z5
+	VPMADD52LUQ a3-832(SP), Z9, Z5
+	VPMADD52HUQ a3-832(SP), Z9, Z6
+	VPMADD52LUQ a4-768(SP), Z9, Z6
+	VPMADD52HUQ a4-768(SP), Z9, Z7
+	VPMADD52LUQ a5-704(SP), Z9, Z7
+	VPMADD52HUQ a5-704(SP), Z9, Z8
+	VPMADD52LUQ a6-640(SP), Z9, Z8
+	VPMADD52HUQ a6-640(SP), Z9, Z0
+	VPMADD52LUQ a7-576(SP), Z9, Z0
+	VPMADD52HUQ a7-576(SP), Z9, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z2, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z11
+	VPADDQ Z11, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-320(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z3
+	VPMADD52HUQ a0-1024(SP), Z9, Z4
+	VPMADD52LUQ a1-960(SP), Z9, Z4
+	VPMADD52HUQ a1-960(SP), Z9, Z5
+	VPMADD52LUQ a2-896(SP), Z9, Z5
+	VPMADD52HUQ a2-896(SP), Z9, Z6
+	VPMADD52LUQ a3-832(SP), Z9, Z6
+	VPMADD52HUQ a3-832(SP), Z9, Z7
+	VPMADD52LUQ a4-768(SP), Z9, Z7
+	VPMADD52HUQ a4-768(SP), Z9, Z8
+	VPMADD52LUQ a5-704(SP), Z9, Z8
+	VPMADD52HUQ a5-704(SP), Z9, Z0
+	VPMADD52LUQ a6-640(SP), Z9, Z0
+	VPMADD52HUQ a6-640(SP), Z9, Z1
+	VPMADD52LUQ a7-576(SP), Z9, Z1
+	VPMADD52HUQ a7-576(SP), Z9, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z3, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z11
+	VPADDQ Z11, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-256(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z4
+	VPMADD52HUQ a0-1024(SP), Z9, Z5
+	VPMADD52LUQ a1-960(SP), Z9, Z5
+	VPMADD52HUQ a1-960(SP), Z9, Z6
+	VPMADD52LUQ a2-896(SP), Z9, Z6
+	VPMADD52HUQ a2-896(SP), Z9, Z7
+	VPMADD52LUQ a3-832(SP), Z9, Z7
+	VPMADD52HUQ a3-832(SP), Z9, Z8
+	VPMADD52LUQ a4-768(SP), Z9, Z8
+	VPMADD52HUQ a4-768(SP), Z9, Z0
+	VPMADD52LUQ a5-704(SP), Z9, Z0
+	VPMADD52HUQ a5-704(SP), Z9, Z1
+	VPMADD52LUQ a6-640(SP), Z9, Z1
+	VPMADD52HUQ a6-640(SP), Z9, Z2
+	VPMADD52LUQ a7-576(SP), Z9, Z2
+	VPMADD52HUQ a7-576(SP), Z9, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z4, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z11
+	VPADDQ Z11, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-192(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z5
+	VPMADD52HUQ a0-1024(SP), Z9, Z6
+	VPMADD52LUQ a1-960(SP), Z9, Z6
+	VPMADD52HUQ a1-960(SP), Z9, Z7
+	VPMADD52LUQ a2-896(SP), Z9, Z7
+	VPMADD52HUQ a2-896(SP), Z9, Z8
+	VPMADD52LUQ a3-832(SP), Z9, Z8
+	VPMADD52HUQ a3-832(SP), Z9, Z0
+	VPMADD52LUQ a4-768(SP), Z9, Z0
+	VPMADD52HUQ a4-768(SP), Z9, Z1
+	VPMADD52LUQ a5-704(SP), Z9, Z1
+	VPMADD52HUQ a5-704(SP), Z9, Z2
+	VPMADD52LUQ a6-640(SP), Z9, Z2
+	VPMADD52HUQ a6-640(SP), Z9, Z3
+	VPMADD52LUQ a7-576(SP), Z9, Z3
+	VPMADD52HUQ a7-576(SP), Z9, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z5, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z11
+	VPADDQ Z11, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-128(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z6
+	VPMADD52HUQ a0-1024(SP), Z9, Z7
+	VPMADD52LUQ a1-960(SP), Z9, Z7
+	VPMADD52HUQ a1-960(SP), Z9, Z8
+	VPMADD52LUQ a2-896(SP), Z9, Z8
+	VPMADD52HUQ a2-896(SP), Z9, Z0
+	VPMADD52LUQ a3-832(SP), Z9, Z0
+	VPMADD52HUQ a3-832(SP), Z9, Z1
+	VPMADD52LUQ a4-768(SP), Z9, Z1
+	VPMADD52HUQ a4-768(SP), Z9, Z2
+	VPMADD52LUQ a5-704(SP), Z9, Z2
+	VPMADD52HUQ a5-704(SP), Z9, Z3
+	VPMADD52LUQ a6-640(SP), Z9, Z3
+	VPMADD52HUQ a6-640(SP), Z9, Z4
+	VPMADD52LUQ a7-576(SP), Z9, Z4
+	VPMADD52HUQ a7-576(SP), Z9, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z6, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z11
+	VPADDQ Z11, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// t += a * b[7]
+	VMOVDQU64   b7-64(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z7
+	VPMADD52HUQ a0-1024(SP), Z9, Z8
+	VPMADD52LUQ a1-960(SP), Z9, Z8
+	VPMADD52HUQ a1-960(SP), Z9, Z0
+	VPMADD52LUQ a2-896(SP), Z9, Z0
+	VPMADD52HUQ a2-896(SP), Z9, Z1
+	VPMADD52LUQ a3-832(SP), Z9, Z1
+	VPMADD52HUQ a3-832(SP), Z9, Z2
+	VPMADD52LUQ a4-768(SP), Z9, Z2
+	VPMADD52HUQ a4-768(SP), Z9, Z3
+	VPMADD52LUQ a5-704(SP), Z9, Z3
+	VPMADD52HUQ a5-704(SP), Z9, Z4
+	VPMADD52LUQ a6-640(SP), Z9, Z4
+	VPMADD52HUQ a6-640(SP), Z9, Z5
+	VPMADD52LUQ a7-576(SP), Z9, Z5
+	VPMADD52HUQ a7-576(SP), Z9, Z6
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z7, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z6
+
+	// t >>= 52
+	VPSRLQ $52, Z7, Z11
+	VPADDQ Z11, Z8, Z8
+	VPXORQ Z7, Z7, Z7
+
+	// propagate the carries
+	VPSRLQ $52, Z8, Z11
+	VPADDQ Z11, Z0, Z0
+	VPANDQ Z13, Z8, Z8
+	VPSRLQ $52, Z0, Z11
+	VPADDQ Z11, Z1, Z1
+	VPANDQ Z13, Z0, Z0
+	VPSRLQ $52, Z1, Z11
+	VPADDQ Z11, Z2, Z2
+	VPANDQ Z13, Z1, Z1
+	VPSRLQ $52, Z2, Z11
+	VPADDQ Z11, Z3, Z3
+	VPANDQ Z13, Z2, Z2
+	VPSRLQ $52, Z3, Z11
+	VPADDQ Z11, Z4, Z4
+	VPANDQ Z13, Z3, Z3
+	VPSRLQ $52, Z4, Z11
+	VPADDQ Z11, Z5, Z5
+	VPANDQ Z13, Z4, Z4
+	VPSRLQ $52, Z5, Z11
+	VPADDQ Z11, Z6, Z6
+	VPANDQ Z13, Z5, Z5
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z8, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a0-1024(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a1-960(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a2-896(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a3-832(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a4-768(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a5-704(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a6-640(SP)
+	VPSUBQ.BCST qLimbs52<>+56(SB), Z6, Z9
+	VPADDQ      Z11, Z9, Z9
+	VMOVDQU64   Z9, a7-576(SP)
+	VPMOVQ2M    Z9, K2
+	VMOVDQU64   Z8, K2, a0-1024(SP)
+	VMOVDQU64   Z0, K2, a1-960(SP)
+	VMOVDQU64   Z1, K2, a2-896(SP)
+	VMOVDQU64   Z2, K2, a3-832(SP)
+	VMOVDQU64   Z3, K2, a4-768(SP)
+	VMOVDQU64   Z4, K2, a5-704(SP)
+	VMOVDQU64   Z5, K2, a6-640(SP)
+	VMOVDQU64   Z6, K2, a7-576(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-1024(SP), Z8
+	VPSLLQ      $52, a1-960(SP), Z11
+	VPORQ       Z11, Z8, Z8
+	VPSRLQ      $12, a1-960(SP), Z0
+	VPSLLQ      $40, a2-896(SP), Z11
+	VPORQ       Z11, Z0, Z0
+	VPSRLQ      $24, a2-896(SP), Z1
+	VPSLLQ      $28, a3-832(SP), Z11
+	VPORQ       Z11, Z1, Z1
+	VPSRLQ      $36, a3-832(SP), Z2
+	VPSLLQ      $16, a4-768(SP), Z11
+	VPORQ       Z11, Z2, Z2
+	VPSRLQ      $48, a4-768(SP), Z3
+	VPSLLQ      $4, a5-704(SP), Z11
+	VPORQ       Z11, Z3, Z3
+	VPSLLQ      $56, a6-640(SP), Z11
+	VPORQ       Z11, Z3, Z3
+	VPSRLQ      $8, a6-640(SP), Z4
+	VPSLLQ      $44, a7-576(SP), Z11
+	VPORQ       Z11, Z4, Z4
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z8, K1, 0(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z4, K1, 40(AX)(Z14*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000180, AX
+	ADDQ $0x0000000000000180, DX
+	ADDQ $0x0000000000000180, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $1024-32
+	MOVQ         $0x0008bfffffffffff, SI
+	VPBROADCASTQ SI, Z12
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z13
+	VMOVDQU64    indexGatherScatter<>(SB), Z14
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPBROADCASTQ 8(CX), Z1
+	VPBROADCASTQ 16(CX), Z2
+	VPBROADCASTQ 24(CX), Z3
+	VPBROADCASTQ 32(CX), Z4
+	VPBROADCASTQ 40(CX), Z5
+	VPSLLQ       $32, Z0, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b0-512(SP)
+	VPSRLQ       $20, Z0, Z11
+	VPSLLQ       $44, Z1, Z9
+	VPORQ        Z9, Z11, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b1-448(SP)
+	VPSRLQ       $8, Z1, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b2-384(SP)
+	VPSRLQ       $60, Z1, Z11
+	VPSLLQ       $4, Z2, Z9
+	VPORQ        Z9, Z11, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b3-320(SP)
+	VPSRLQ       $48, Z2, Z11
+	VPSLLQ       $16, Z3, Z9
+	VPORQ        Z9, Z11, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b4-256(SP)
+	VPSRLQ       $36, Z3, Z11
+	VPSLLQ       $28, Z4, Z9
+	VPORQ        Z9, Z11, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b5-192(SP)
+	VPSRLQ       $24, Z4, Z11
+	VPSLLQ       $40, Z5, Z9
+	VPORQ        Z9, Z11, Z11
+	VPANDQ       Z13, Z11, Z11
+	VMOVDQU64    Z11, b6-128(SP)
+	VPSRLQ       $12, Z5, Z11
+	VMOVDQU64    Z11, b7-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z14*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z14*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z14*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z14*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z14*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(DX)(Z14*8), K1, Z5
+	VMOVDQU64  Z0, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a0-1024(SP)
+	VPSRLQ     $52, Z0, Z11
+	VPSLLQ     $12, Z1, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a1-960(SP)
+	VPSRLQ     $40, Z1, Z11
+	VPSLLQ     $24, Z2, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a2-896(SP)
+	VPSRLQ     $28, Z2, Z11
+	VPSLLQ     $36, Z3, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a3-832(SP)
+	VPSRLQ     $16, Z3, Z11
+	VPSLLQ     $48, Z4, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a4-768(SP)
+	VPSRLQ     $4, Z4, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a5-704(SP)
+	VPSRLQ     $56, Z4, Z11
+	VPSLLQ     $8, Z5, Z9
+	VPORQ      Z9, Z11, Z11
+	VPANDQ     Z13, Z11, Z11
+	VMOVDQU64  Z11, a6-640(SP)
+	VPSRLQ     $44, Z5, Z11
+	VMOVDQU64  Z11, a7-576(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+
+	// t += a * b[0]
+	VMOVDQU64   b0-512(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z0
+	VPMADD52HUQ a0-1024(SP), Z9, Z1
+	VPMADD52LUQ a1-960(SP), Z9, Z1
+	VPMADD52HUQ a1-960(SP), Z9, Z2
+	VPMADD52LUQ a2-896(SP), Z9, Z2
+	VPMADD52HUQ a2-896(SP), Z9, Z3
+	VPMADD52LUQ a3-832(SP), Z9, Z3
+	VPMADD52HUQ a3-832(SP), Z9, Z4
+	VPMADD52LUQ a4-768(SP), Z9, Z4
+	VPMADD52HUQ a4-768(SP), Z9, Z5
+	VPMADD52LUQ a5-704(SP), Z9, Z5
+	VPMADD52HUQ a5-704(SP), Z9, Z6
+	VPMADD52LUQ a6-640(SP), Z9, Z6
+	VPMADD52HUQ a6-640(SP), Z9, Z7
+	VPMADD52LUQ a7-576(SP), Z9, Z7
+	VPMADD52HUQ a7-576(SP), Z9, Z8
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z0, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z8
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z11
+	VPADDQ Z11, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-448(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z1
+	VPMADD52HUQ a0-1024(SP), Z9, Z2
+	VPMADD52LUQ a1-960(SP), Z9, Z2
+	VPMADD52HUQ a1-960(SP), Z9, Z3
+	VPMADD52LUQ a2-896(SP), Z9, Z3
+	VPMADD52HUQ a2-896(SP), Z9, Z4
+	VPMADD52LUQ a3-832(SP), Z9, Z4
+	VPMADD52HUQ a3-832(SP), Z9, Z5
+	VPMADD52LUQ a4-768(SP), Z9, Z5
+	VPMADD52HUQ a4-768(SP), Z9, Z6
+	VPMADD52LUQ a5-704(SP), Z9, Z6
+	VPMADD52HUQ a5-704(SP), Z9, Z7
+	VPMADD52LUQ a6-640(SP), Z9, Z7
+	VPMADD52HUQ a6-640(SP), Z9, Z8
+	VPMADD52LUQ a7-576(SP), Z9, Z8
+	VPMADD52HUQ a7-576(SP), Z9, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z1, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z11
+	VPADDQ Z11, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-384(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z2
+	VPMADD52HUQ a0-1024(SP), Z9, Z3
+	VPMADD52LUQ a1-960(SP), Z9, Z3
+	VPMADD52HUQ a1-960(SP), Z9, Z4
+	VPMADD52LUQ a2-896(SP), Z9, Z4
+	VPMADD52HUQ a2-896(SP), Z9, Z5
+	VPMADD52LUQ a3-832(SP), Z9, Z5
+	VPMADD52HUQ a3-832(SP), Z9, Z6
+	VPMADD52LUQ a4-768(SP), Z9, Z6
+	VPMADD52HUQ a4-768(SP), Z9, Z7
+	VPMADD52LUQ a5-704(SP), Z9, Z7
+	VPMADD52HUQ a5-704(SP), Z9, Z8
+	VPMADD52LUQ a6-640(SP), Z9, Z8
+	VPMADD52HUQ a6-640(SP), Z9, Z0
+	VPMADD52LUQ a7-576(SP), Z9, Z0
+	VPMADD52HUQ a7-576(SP), Z9, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z2, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z11
+	VPADDQ Z11, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-320(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z3
+	VPMADD52HUQ a0-1024(SP), Z9, Z4
+	VPMADD52LUQ a1-960(SP), Z9, Z4
+	VPMADD52HUQ a1-960(SP), Z9, Z5
+	VPMADD52LUQ a2-896(SP), Z9, Z5
+	VPMADD52HUQ a2-896(SP), Z9, Z6
+	VPMADD52LUQ a3-832(SP), Z9, Z6
+	VPMADD52HUQ a3-832(SP), Z9, Z7
+	VPMADD52LUQ a4-768(SP), Z9, Z7
+	VPMADD52HUQ a4-768(SP), Z9, Z8
+	VPMADD52LUQ a5-704(SP), Z9, Z8
+	VPMADD52HUQ a5-704(SP), Z9, Z0
+	VPMADD52LUQ a6-640(SP), Z9, Z0
+	VPMADD52HUQ a6-640(SP), Z9, Z1
+	VPMADD52LUQ a7-576(SP), Z9, Z1
+	VPMADD52HUQ a7-576(SP), Z9, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z3, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z11
+	VPADDQ Z11, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-256(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z4
+	VPMADD52HUQ a0-1024(SP), Z9, Z5
+	VPMADD52LUQ a1-960(SP), Z9, Z5
+	VPMADD52HUQ a1-960(SP), Z9, Z6
+	VPMADD52LUQ a2-896(SP), Z9, Z6
+	VPMADD52HUQ a2-896(SP), Z9, Z7
+	VPMADD52LUQ a3-832(SP), Z9, Z7
+	VPMADD52HUQ a3-832(SP), Z9, Z8
+	VPMADD52LUQ a4-768(SP), Z9, Z8
+	VPMADD52HUQ a4-768(SP), Z9, Z0
+	VPMADD52LUQ a5-704(SP), Z9, Z0
+	VPMADD52HUQ a5-704(SP), Z9, Z1
+	VPMADD52LUQ a6-640(SP), Z9, Z1
+	VPMADD52HUQ a6-640(SP), Z9, Z2
+	VPMADD52LUQ a7-576(SP), Z9, Z2
+	VPMADD52HUQ a7-576(SP), Z9, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z4, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z11
+	VPADDQ Z11, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-192(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z5
+	VPMADD52HUQ a0-1024(SP), Z9, Z6
+	VPMADD52LUQ a1-960(SP), Z9, Z6
+	VPMADD52HUQ a1-960(SP), Z9, Z7
+	VPMADD52LUQ a2-896(SP), Z9, Z7
+	VPMADD52HUQ a2-896(SP), Z9, Z8
+	VPMADD52LUQ a3-832(SP), Z9, Z8
+	VPMADD52HUQ a3-832(SP), Z9, Z0
+	VPMADD52LUQ a4-768(SP), Z9, Z0
+	VPMADD52HUQ a4-768(SP), Z9, Z1
+	VPMADD52LUQ a5-704(SP), Z9, Z1
+	VPMADD52HUQ a5-704(SP), Z9, Z2
+	VPMADD52LUQ a6-640(SP), Z9, Z2
+	VPMADD52HUQ a6-640(SP), Z9, Z3
+	VPMADD52LUQ a7-576(SP), Z9, Z3
+	VPMADD52HUQ a7-576(SP), Z9, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z5, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z11
+	VPADDQ Z11, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-128(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z6
+	VPMADD52HUQ a0-1024(SP), Z9, Z7
+	VPMADD52LUQ a1-960(SP), Z9, Z7
+	VPMADD52HUQ a1-960(SP), Z9, Z8
+	VPMADD52LUQ a2-896(SP), Z9, Z8
+	VPMADD52HUQ a2-896(SP), Z9, Z0
+	VPMADD52LUQ a3-832(SP), Z9, Z0
+	VPMADD52HUQ a3-832(SP), Z9, Z1
+	VPMADD52LUQ a4-768(SP), Z9, Z1
+	VPMADD52HUQ a4-768(SP), Z9, Z2
+	VPMADD52LUQ a5-704(SP), Z9, Z2
+	VPMADD52HUQ a5-704(SP), Z9, Z3
+	VPMADD52LUQ a6-640(SP), Z9, Z3
+	VPMADD52HUQ a6-640(SP), Z9, Z4
+	VPMADD52LUQ a7-576(SP), Z9, Z4
+	VPMADD52HUQ a7-576(SP), Z9, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z6, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z11
+	VPADDQ Z11, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// t += a * b[7]
+	VMOVDQU64   b7-64(SP), Z9
+	VPMADD52LUQ a0-1024(SP), Z9, Z7
+	VPMADD52HUQ a0-1024(SP), Z9, Z8
+	VPMADD52LUQ a1-960(SP), Z9, Z8
+	VPMADD52HUQ a1-960(SP), Z9, Z0
+	VPMADD52LUQ a2-896(SP), Z9, Z0
+	VPMADD52HUQ a2-896(SP), Z9, Z1
+	VPMADD52LUQ a3-832(SP), Z9, Z1
+	VPMADD52HUQ a3-832(SP), Z9, Z2
+	VPMADD52LUQ a4-768(SP), Z9, Z2
+	VPMADD52HUQ a4-768(SP), Z9, Z3
+	VPMADD52LUQ a5-704(SP), Z9, Z3
+	VPMADD52HUQ a5-704(SP), Z9, Z4
+	VPMADD52LUQ a6-640(SP), Z9, Z4
+	VPMADD52HUQ a6-640(SP), Z9, Z5
+	VPMADD52LUQ a7-576(SP), Z9, Z5
+	VPMADD52HUQ a7-576(SP), Z9, Z6
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z10, Z10, Z10
+	VPMADD52LUQ Z12, Z7, Z10
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z10, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z10, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z10, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z10, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z10, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z10, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z10, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z10, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z10, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z10, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z10, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z10, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z10, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z10, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z10, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z10, Z6
+
+	// t >>= 52
+	VPSRLQ $52, Z7, Z11
+	VPADDQ Z11, Z8, Z8
+	VPXORQ Z7, Z7, Z7
+
+	// propagate the carries
+	VPSRLQ $52, Z8, Z11
+	VPADDQ Z11, Z0, Z0
+	VPANDQ Z13, Z8, Z8
+	VPSRLQ $52, Z0, Z11
+	VPADDQ Z11, Z1, Z1
+	VPANDQ Z13, Z0, Z0
+	VPSRLQ $52, Z1, Z11
+	VPADDQ Z11, Z2, Z2
+	VPANDQ Z13, Z1, Z1
+	VPSRLQ $52, Z2, Z11
+	VPADDQ Z11, Z3, Z3
+	VPANDQ Z13, Z2, Z2
+	VPSRLQ $52, Z3, Z11
+	VPADDQ Z11, Z4, Z4
+	VPANDQ Z13, Z3, Z3
+	VPSRLQ $52, Z4, Z11
+	VPADDQ Z11, Z5, Z5
+	VPANDQ Z13, Z4, Z4
+	VPSRLQ $52, Z5, Z11
+	VPADDQ Z11, Z6, Z6
+	VPANDQ Z13, Z5, Z5
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z8, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a0-1024(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a1-960(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a2-896(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a3-832(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a4-768(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a5-704(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z9
+	VPADDQ      Z11, Z9, Z9
+	VPSRAQ      $52, Z9, Z11
+	VPANDQ      Z13, Z9, Z9
+	VMOVDQU64   Z9, a6-640(SP)
+	VPSUBQ.BCST qLimbs52<>+56(SB), Z6, Z9
+	VPADDQ      Z11, Z9, Z9
+	VMOVDQU64   Z9, a7-576(SP)
+	VPMOVQ2M    Z9, K2
+	VMOVDQU64   Z8, K2, a0-1024(SP)
+	VMOVDQU64   Z0, K2, a1-960(SP)
+	VMOVDQU64   Z1, K2, a2-896(SP)
+	VMOVDQU64   Z2, K2, a3-832(SP)
+	VMOVDQU64   Z3, K2, a4-768(SP)
+	VMOVDQU64   Z4, K2, a5-704(SP)
+	VMOVDQU64   Z5, K2, a6-640(SP)
+	VMOVDQU64   Z6, K2, a7-576(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-1024(SP), Z8
+	VPSLLQ      $52, a1-960(SP), Z11
+	VPORQ       Z11, Z8, Z8
+	VPSRLQ      $12, a1-960(SP), Z0
+	VPSLLQ      $40, a2-896(SP), Z11
+	VPORQ       Z11, Z0, Z0
+	VPSRLQ      $24, a2-896(SP), Z1
+	VPSLLQ      $28, a3-832(SP), Z11
+	VPORQ       Z11, Z1, Z1
+	VPSRLQ      $36, a3-832(SP), Z2
+	VPSLLQ      $16, a4-768(SP), Z11
+	VPORQ       Z11, Z2, Z2
+	VPSRLQ      $48, a4-768(SP), Z3
+	VPSLLQ      $4, a5-704(SP), Z11
+	VPORQ       Z11, Z3, Z3
+	VPSLLQ      $56, a6-640(SP), Z11
+	VPORQ       Z11, Z3, Z3
+	VPSRLQ      $8, a6-640(SP), Z4
+	VPSLLQ      $44, a7-576(SP), Z11
+	VPORQ       Z11, Z4, Z4
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z8, K1, 0(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z14*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z4, K1, 40(AX)(Z14*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000180, AX
+	ADDQ $0x0000000000000180, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
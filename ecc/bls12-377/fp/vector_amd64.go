@@ -0,0 +1,206 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func addVec(res, a, b *Element, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func subVec(res, a, b *Element, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAvx512IFMA {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	// the assembly processes blocks of 8 elements
+	const blockSize = 8
+	m := n / blockSize
+	if m != 0 {
+		scalarMulVec(&(*vector)[0], &a[0], b, m)
+	}
+	if r := m * blockSize; r != n {
+		scalarMulVecGeneric((*vector)[r:], a[r:], b)
+	}
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *Element, n uint64)
+
+// number of elements summed by an iteration of sumVec and sumVecAVX2,
+// such that their words fill whole registers
+const (
+	sumVecBlockSize     = 4
+	sumVecAVX2BlockSize = 2
+)
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	v := *vector
+	if !supportAvx2 {
+		sumVecGeneric(&res, v)
+		return
+	}
+	blockSize := sumVecAVX2BlockSize
+	if supportAvx512 {
+		blockSize = sumVecBlockSize
+	}
+	// sumVec accumulates the 32 bit halves of the words in 64 bit lanes;
+	// we bound the number of elements per call to ensure the lanes don't overflow.
+	const maxN = 1 << 31
+	for len(v) >= blockSize {
+		n := len(v) - len(v)%blockSize
+		if n > maxN {
+			n = maxN
+		}
+		var t [12]uint64
+		if supportAvx512 {
+			sumVec(&t, &v[0], uint64(n))
+		} else {
+			sumVecAVX2(&t, &v[0], uint64(n))
+		}
+		s := sumVecReduce(&t)
+		res.Add(&res, &s)
+		v = v[n:]
+	}
+	sumVecGeneric(&res, v)
+	return
+}
+
+//go:noescape
+func sumVec(res *[12]uint64, a *Element, n uint64)
+
+//go:noescape
+func sumVecAVX2(res *[12]uint64, a *Element, n uint64)
+
+// sumVecCoeffs[i] is the element whose internal form is 2³²ⁱ
+var sumVecCoeffs [12]Element
+
+func init() {
+	var twoTo32 Element
+	twoTo32.SetUint64(1 << 32)
+	sumVecCoeffs[0] = Element{1}
+	for i := 1; i < len(sumVecCoeffs); i++ {
+		sumVecCoeffs[i].Mul(&sumVecCoeffs[i-1], &twoTo32)
+	}
+}
+
+// sumVecReduce returns the element whose internal form is the sum accumulated by sumVec,
+// that is Σⱼ (t[j] + t[6+j] * 2³²) * 2⁶⁴ʲ mod q.
+func sumVecReduce(t *[12]uint64) (res Element) {
+	var x Element
+	for j := 0; j < 6; j++ {
+		x.SetUint64(t[j]).Mul(&x, &sumVecCoeffs[2*j])
+		res.Add(&res, &x)
+		x.SetUint64(t[6+j]).Mul(&x, &sumVecCoeffs[2*j+1])
+		res.Add(&res, &x)
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	n := len(*vector)
+	if n != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	if !supportAvx2 {
+		innerProductVecGeneric(&res, *vector, other)
+		return
+	}
+	// multiply by blocks, and sum the products
+	const blockSize = 256
+	var buf [blockSize]Element
+	for i := 0; i < n; i += blockSize {
+		m := n - i
+		if m > blockSize {
+			m = blockSize
+		}
+		b := Vector(buf[:m])
+		b.Mul((*vector)[i:i+m], other[i:i+m])
+		s := b.Sum()
+		res.Add(&res, &s)
+	}
+	return
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAvx512IFMA {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	// the assembly processes blocks of 8 elements
+	const blockSize = 8
+	m := n / blockSize
+	if m != 0 {
+		mulVec(&(*vector)[0], &a[0], &b[0], m)
+	}
+	if r := m * blockSize; r != n {
+		mulVecGeneric((*vector)[r:], a[r:], b[r:])
+	}
+}
+
+//go:noescape
+func mulVec(res, a, b *Element, n uint64)
@@ -0,0 +1,1034 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $0x0a11800000000001, R15
+	MOVD $0x59aa76fed0000001, R16
+	MOVD $0x60b44d1e5c37b001, R17
+	MOVD $0x12ab655e9a2ca556, R19
+	MOVD x+8(FP), R0
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R9
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R1, R9, R5
+	UMULH R1, R9, R11
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R10
+	MUL  R10, R5, R10
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R10, R15, R13
+	UMULH R10, R15, R12
+	ADDS  R5, R13, R13
+	ADC   ZR, R12, R12
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R2, R9, R6
+	UMULH R2, R9, R14
+	ADDS  R11, R6, R6
+	ADC   ZR, R14, R11
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R10, R16, R13
+	UMULH R10, R16, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R6, R13, R5
+	ADC   ZR, R14, R12
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R3, R9, R7
+	UMULH R3, R9, R14
+	ADDS  R11, R7, R7
+	ADC   ZR, R14, R11
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R10, R17, R13
+	UMULH R10, R17, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R7, R13, R6
+	ADC   ZR, R14, R12
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R4, R9, R8
+	UMULH R4, R9, R14
+	ADDS  R11, R8, R8
+	ADC   ZR, R14, R11
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R10, R19, R13
+	UMULH R10, R19, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R8, R13, R7
+	ADC   ZR, R14, R12
+
+	// t[3] = C + A
+	ADD  R12, R11, R8
+	MOVD 8(R0), R9
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R1, R9, R13
+	UMULH R1, R9, R14
+	ADDS  R13, R5, R5
+	ADC   ZR, R14, R11
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R10
+	MUL  R10, R5, R10
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R10, R15, R13
+	UMULH R10, R15, R12
+	ADDS  R5, R13, R13
+	ADC   ZR, R12, R12
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R2, R9, R13
+	UMULH R2, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R6, R6
+	ADC   ZR, R14, R11
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R10, R16, R13
+	UMULH R10, R16, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R6, R13, R5
+	ADC   ZR, R14, R12
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R3, R9, R13
+	UMULH R3, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R7, R7
+	ADC   ZR, R14, R11
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R10, R17, R13
+	UMULH R10, R17, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R7, R13, R6
+	ADC   ZR, R14, R12
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R4, R9, R13
+	UMULH R4, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R8, R8
+	ADC   ZR, R14, R11
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R10, R19, R13
+	UMULH R10, R19, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R8, R13, R7
+	ADC   ZR, R14, R12
+
+	// t[3] = C + A
+	ADD  R12, R11, R8
+	MOVD 16(R0), R9
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R1, R9, R13
+	UMULH R1, R9, R14
+	ADDS  R13, R5, R5
+	ADC   ZR, R14, R11
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R10
+	MUL  R10, R5, R10
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R10, R15, R13
+	UMULH R10, R15, R12
+	ADDS  R5, R13, R13
+	ADC   ZR, R12, R12
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R2, R9, R13
+	UMULH R2, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R6, R6
+	ADC   ZR, R14, R11
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R10, R16, R13
+	UMULH R10, R16, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R6, R13, R5
+	ADC   ZR, R14, R12
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R3, R9, R13
+	UMULH R3, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R7, R7
+	ADC   ZR, R14, R11
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R10, R17, R13
+	UMULH R10, R17, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R7, R13, R6
+	ADC   ZR, R14, R12
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R4, R9, R13
+	UMULH R4, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R8, R8
+	ADC   ZR, R14, R11
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R10, R19, R13
+	UMULH R10, R19, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R8, R13, R7
+	ADC   ZR, R14, R12
+
+	// t[3] = C + A
+	ADD  R12, R11, R8
+	MOVD 24(R0), R9
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R1, R9, R13
+	UMULH R1, R9, R14
+	ADDS  R13, R5, R5
+	ADC   ZR, R14, R11
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R10
+	MUL  R10, R5, R10
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R10, R15, R13
+	UMULH R10, R15, R12
+	ADDS  R5, R13, R13
+	ADC   ZR, R12, R12
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R2, R9, R13
+	UMULH R2, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R6, R6
+	ADC   ZR, R14, R11
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R10, R16, R13
+	UMULH R10, R16, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R6, R13, R5
+	ADC   ZR, R14, R12
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R3, R9, R13
+	UMULH R3, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R7, R7
+	ADC   ZR, R14, R11
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R10, R17, R13
+	UMULH R10, R17, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R7, R13, R6
+	ADC   ZR, R14, R12
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R4, R9, R13
+	UMULH R4, R9, R14
+	ADDS  R11, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R13, R8, R8
+	ADC   ZR, R14, R11
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R10, R19, R13
+	UMULH R10, R19, R14
+	ADDS  R12, R13, R13
+	ADC   ZR, R14, R14
+	ADDS  R8, R13, R7
+	ADC   ZR, R14, R12
+
+	// t[3] = C + A
+	ADD R12, R11, R8
+
+	// reduce if necessary
+	SUBS R15, R5, R1
+	SBCS R16, R6, R2
+	SBCS R17, R7, R3
+	SBCS R19, R8, R4
+	CSEL CS, R1, R5, R5
+	CSEL CS, R2, R6, R6
+	CSEL CS, R3, R7, R7
+	CSEL CS, R4, R8, R8
+	MOVD res+0(FP), R0
+	STP  (R5, R6), 0(R0)
+	STP  (R7, R8), 16(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	MOVD b+8(FP), R1
+	LDP  0(R1), (R6, R7)
+	LDP  16(R1), (R8, R9)
+	MOVD $0x0a11800000000001, R14
+	MOVD $0x59aa76fed0000001, R15
+	MOVD $0x60b44d1e5c37b001, R16
+	MOVD $0x12ab655e9a2ca556, R17
+
+	// t = a + b
+	ADDS R6, R2, R10
+	ADCS R7, R3, R11
+	ADCS R8, R4, R12
+	ADCS R9, R5, R13
+
+	// a = a - b
+	SUBS R6, R2, R2
+	SBCS R7, R3, R3
+	SBCS R8, R4, R4
+	SBCS R9, R5, R5
+
+	// b = q if a - b underflowed, 0 otherwise
+	CSEL CC, R14, ZR, R6
+	CSEL CC, R15, ZR, R7
+	CSEL CC, R16, ZR, R8
+	CSEL CC, R17, ZR, R9
+
+	// b = (a - b) mod q
+	ADDS R6, R2, R6
+	ADCS R7, R3, R7
+	ADCS R8, R4, R8
+	ADCS R9, R5, R9
+	STP  (R6, R7), 0(R1)
+	STP  (R8, R9), 16(R1)
+
+	// a = (a + b) mod q
+	SUBS R14, R10, R2
+	SBCS R15, R11, R3
+	SBCS R16, R12, R4
+	SBCS R17, R13, R5
+	CSEL CS, R2, R10, R10
+	CSEL CS, R3, R11, R11
+	CSEL CS, R4, R12, R12
+	CSEL CS, R5, R13, R13
+	STP  (R10, R11), 0(R0)
+	STP  (R12, R13), 16(R0)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVD $0x0a11800000000001, R12
+	MOVD $0x59aa76fed0000001, R13
+	MOVD $0x60b44d1e5c37b001, R14
+	MOVD $0x12ab655e9a2ca556, R15
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l1:
+	CBZ   R3, l2
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R2), (R8, R9)
+	LDP.P 16(R2), (R10, R11)
+
+	// a[i] + b[i]
+	ADDS  R8, R4, R4
+	ADCS  R9, R5, R5
+	ADCS  R10, R6, R6
+	ADCS  R11, R7, R7
+	SUBS  R12, R4, R8
+	SBCS  R13, R5, R9
+	SBCS  R14, R6, R10
+	SBCS  R15, R7, R11
+	CSEL  CS, R8, R4, R4
+	CSEL  CS, R9, R5, R5
+	CSEL  CS, R10, R6, R6
+	CSEL  CS, R11, R7, R7
+	STP.P (R4, R5), 16(R0)
+	STP.P (R6, R7), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVD $0x0a11800000000001, R12
+	MOVD $0x59aa76fed0000001, R13
+	MOVD $0x60b44d1e5c37b001, R14
+	MOVD $0x12ab655e9a2ca556, R15
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l3:
+	CBZ   R3, l4
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R2), (R8, R9)
+	LDP.P 16(R2), (R10, R11)
+
+	// a[i] - b[i]
+	SUBS R8, R4, R4
+	SBCS R9, R5, R5
+	SBCS R10, R6, R6
+	SBCS R11, R7, R7
+
+	// add q if a[i] - b[i] underflowed
+	CSEL  CC, R12, ZR, R8
+	CSEL  CC, R13, ZR, R9
+	CSEL  CC, R14, ZR, R10
+	CSEL  CC, R15, ZR, R11
+	ADDS  R8, R4, R4
+	ADCS  R9, R5, R5
+	ADCS  R10, R6, R6
+	ADCS  R11, R7, R7
+	STP.P (R4, R5), 16(R0)
+	STP.P (R6, R7), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l3
+
+l4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVD $0x0a11800000000001, R19
+	MOVD $0x59aa76fed0000001, R20
+	MOVD $0x60b44d1e5c37b001, R21
+	MOVD $0x12ab655e9a2ca556, R22
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l5:
+	CBZ   R3, l6
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	MOVD  0(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R12, R8
+	UMULH R4, R12, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R12, R9
+	UMULH R5, R12, R17
+	ADDS  R14, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R12, R10
+	UMULH R6, R12, R17
+	ADDS  R14, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R12, R11
+	UMULH R7, R12, R17
+	ADDS  R14, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 8(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 16(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 24(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD R15, R14, R11
+
+	// reduce if necessary
+	SUBS  R19, R8, R4
+	SBCS  R20, R9, R5
+	SBCS  R21, R10, R6
+	SBCS  R22, R11, R7
+	CSEL  CS, R4, R8, R8
+	CSEL  CS, R5, R9, R9
+	CSEL  CS, R6, R10, R10
+	CSEL  CS, R7, R11, R11
+	STP.P (R8, R9), 16(R0)
+	STP.P (R10, R11), 16(R0)
+	ADD   $0x0000000000000020, R2, R2
+	SUB   $1, R3, R3
+	JMP   l5
+
+l6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVD $0x0a11800000000001, R19
+	MOVD $0x59aa76fed0000001, R20
+	MOVD $0x60b44d1e5c37b001, R21
+	MOVD $0x12ab655e9a2ca556, R22
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l7:
+	CBZ   R3, l8
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	MOVD  0(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R12, R8
+	UMULH R4, R12, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R12, R9
+	UMULH R5, R12, R17
+	ADDS  R14, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R12, R10
+	UMULH R6, R12, R17
+	ADDS  R14, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R12, R11
+	UMULH R7, R12, R17
+	ADDS  R14, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 8(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 16(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD  R15, R14, R11
+	MOVD 24(R2), R12
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R12, R16
+	UMULH R4, R12, R17
+	ADDS  R16, R8, R8
+	ADC   ZR, R17, R14
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x0a117fffffffffff, R13
+	MUL  R13, R8, R13
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R13, R19, R16
+	UMULH R13, R19, R15
+	ADDS  R8, R16, R16
+	ADC   ZR, R15, R15
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R12, R16
+	UMULH R5, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R9, R9
+	ADC   ZR, R17, R14
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R13, R20, R16
+	UMULH R13, R20, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R12, R16
+	UMULH R6, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R10, R10
+	ADC   ZR, R17, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R13, R21, R16
+	UMULH R13, R21, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R12, R16
+	UMULH R7, R12, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R16, R11, R11
+	ADC   ZR, R17, R14
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R13, R22, R16
+	UMULH R13, R22, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+
+	// t[3] = C + A
+	ADD R15, R14, R11
+
+	// reduce if necessary
+	SUBS  R19, R8, R4
+	SBCS  R20, R9, R5
+	SBCS  R21, R10, R6
+	SBCS  R22, R11, R7
+	CSEL  CS, R4, R8, R8
+	CSEL  CS, R5, R9, R9
+	CSEL  CS, R6, R10, R10
+	CSEL  CS, R7, R11, R11
+	STP.P (R8, R9), 16(R0)
+	STP.P (R10, R11), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l7
+
+l8:
+	RET
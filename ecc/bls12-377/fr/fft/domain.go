@@ -21,7 +21,6 @@ import (
 	"io"
 	"math/big"
 	"math/bits"
-	"runtime"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
@@ -29,6 +28,7 @@ import (
 	curve "github.com/consensys/gnark-crypto/ecc/bls12-377"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // Domain with a power of 2 cardinality
@@ -66,15 +66,21 @@ type Domain struct {
 
 // NewDomain returns a subgroup with a power of 2 cardinality
 // cardinality >= m
+// With the WithMixedRadix option, the cardinality is the smallest 2ᵃ·3ᵇ·5ᶜ >= m the field supports.
 // shift: when specified, it's the element by which the set of root of unity is shifted.
 func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	opt := domainOptions(opts...)
 	domain := &Domain{}
 	x := ecc.NextPowerOfTwo(m)
+	if opt.mixedRadix {
+		var err error
+		if x, err = mixedRadixCardinality(m); err != nil {
+			panic(err)
+		}
+	}
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(22)
 
 	if opt.shift != nil {
@@ -83,7 +89,11 @@ func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
 
 	var err error
-	domain.Generator, err = Generator(m)
+	if domain.isMixedRadix() {
+		domain.Generator, err = rootOfUnity(x)
+	} else {
+		domain.Generator, err = Generator(m)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -143,8 +153,14 @@ func (d *Domain) CosetTableInv() ([]fr.Element, error) {
 
 func (d *Domain) preComputeTwiddles() {
 
-	// nb fft stages
+	// nb fft stages; on a mixed-radix domain, only the radix 2 stages use the twiddles
 	nbStages := uint64(bits.TrailingZeros64(d.Cardinality))
+	w, wInv := d.Generator, d.GeneratorInv
+	if d.isMixedRadix() {
+		odd := new(big.Int).SetUint64(d.Cardinality >> nbStages)
+		w.Exp(w, odd)
+		wInv.Exp(wInv, odd)
+	}
 
 	d.twiddles = make([][]fr.Element, nbStages)
 	d.twiddlesInv = make([][]fr.Element, nbStages)
@@ -160,11 +176,11 @@ func (d *Domain) preComputeTwiddles() {
 
 	wg.Add(4)
 	go func() {
-		buildTwiddles(d.twiddles, d.Generator, nbStages)
+		buildTwiddles(d.twiddles, w, nbStages)
 		wg.Done()
 	}()
 	go func() {
-		buildTwiddles(d.twiddlesInv, d.GeneratorInv, nbStages)
+		buildTwiddles(d.twiddlesInv, wInv, nbStages)
 		wg.Done()
 	}()
 	go expTable(d.FrMultiplicativeGen, d.cosetTable)
@@ -207,8 +223,8 @@ func BuildExpTable(w fr.Element, table []fr.Element) {
 
 	// see if it makes sense to parallelize exp tables pre-computation
 	interval := 0
-	if runtime.NumCPU() >= 4 {
-		interval = (n - 1) / (runtime.NumCPU() / 4)
+	if nbTasks := scheduler.Default().NbTasks(); nbTasks >= 4 {
+		interval = (n - 1) / (nbTasks / 4)
 	}
 
 	// this ratio roughly correspond to the number of multiplication one can do in place of a Exp operation
@@ -229,10 +245,10 @@ func BuildExpTable(w fr.Element, table []fr.Element) {
 			end = n
 		}
 		wg.Add(1)
-		go func() {
+		scheduler.Go(nil, func() {
 			precomputeExpTableChunk(w, uint64(start), table[start:end])
 			wg.Done()
-		}()
+		})
 	}
 	wg.Wait()
 }
@@ -17,12 +17,15 @@
 package fft
 
 import (
+	"context"
+	"errors"
 	"math/big"
 	"strconv"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
@@ -250,6 +253,154 @@ func TestFFT(t *testing.T) {
 // --------------------------------------------------------------------
 // benches
 
+func TestFFTCtx(t *testing.T) {
+	const size = 1 << 10
+	domain := NewDomain(size)
+
+	pol := make([]fr.Element, size)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	expected := make([]fr.Element, size)
+	copy(expected, pol)
+	domain.FFT(expected, DIF, OnCoset())
+	domain.FFTInverse(expected, DIT, OnCoset())
+
+	got := make([]fr.Element, size)
+	copy(got, pol)
+	if err := domain.FFTCtx(context.Background(), got, DIF, OnCoset()); err != nil {
+		t.Fatal(err)
+	}
+	if err := domain.FFTInverseCtx(context.Background(), got, DIT, OnCoset()); err != nil {
+		t.Fatal(err)
+	}
+	for i := range got {
+		if !got[i].Equal(&expected[i]) || !got[i].Equal(&pol[i]) {
+			t.Fatal("FFTCtx and FFT results differ")
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := domain.FFTCtx(ctx, got, DIF); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if err := domain.FFTInverseCtx(ctx, got, DIT); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	// a cancelled context must stop the recursion
+	done := make(chan struct{})
+	close(done)
+	copy(got, pol)
+	domain.FFT(got, DIF, withDone(done))
+	for i := range got {
+		if !got[i].Equal(&pol[i]) {
+			t.Fatal("FFT should not modify its input when done is closed")
+		}
+	}
+}
+
+func TestFFTScheduler(t *testing.T) {
+	const size = 1 << 10
+	domain := NewDomain(size)
+
+	pol := make([]fr.Element, size)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	expected := make([]fr.Element, size)
+	copy(expected, pol)
+	domain.FFT(expected, DIF, OnCoset())
+
+	for _, nbTasks := range []int{1, 3} {
+		got := make([]fr.Element, size)
+		copy(got, pol)
+		domain.FFT(got, DIF, OnCoset(), WithScheduler(scheduler.New(nbTasks)))
+		for i := range got {
+			if !got[i].Equal(&expected[i]) {
+				t.Fatalf("fft with a scheduler of %d tasks differs", nbTasks)
+			}
+		}
+		domain.FFTInverse(got, DIT, OnCoset(), WithScheduler(scheduler.New(nbTasks)))
+		for i := range got {
+			if !got[i].Equal(&pol[i]) {
+				t.Fatalf("inverse fft with a scheduler of %d tasks differs", nbTasks)
+			}
+		}
+	}
+}
+
+func TestFFTBatch(t *testing.T) {
+	const size = 1 << 9
+
+	for _, domain := range []*Domain{NewDomain(size), NewDomain(size, WithoutPrecompute()), NewDomain(3*size, WithMixedRadix())} {
+		n := int(domain.Cardinality)
+		for _, nbColumns := range []int{1, 3, 40} {
+			for _, opts := range [][]Option{nil, {OnCoset()}, {OnCoset(), WithNbTasks(1)}, {WithScheduler(scheduler.New(5))}} {
+				for _, decimation := range []Decimation{DIF, DIT} {
+					columns := make([][]fr.Element, nbColumns)
+					expected := make([][]fr.Element, nbColumns)
+					for i := range columns {
+						columns[i] = make([]fr.Element, n)
+						for j := range columns[i] {
+							columns[i][j].SetRandom()
+						}
+						expected[i] = make([]fr.Element, n)
+						copy(expected[i], columns[i])
+						domain.FFT(expected[i], decimation, opts...)
+					}
+
+					domain.FFTBatch(columns, decimation, opts...)
+					for i := range columns {
+						assertEqualVectors(t, expected[i], columns[i], "FFTBatch", n, len(opts) > 0)
+					}
+
+					inverseDecimation := DIT
+					if decimation == DIT {
+						inverseDecimation = DIF
+					}
+					for i := range expected {
+						domain.FFTInverse(expected[i], inverseDecimation, opts...)
+					}
+					domain.FFTInverseBatch(columns, inverseDecimation, opts...)
+					for i := range columns {
+						assertEqualVectors(t, expected[i], columns[i], "FFTInverseBatch", n, len(opts) > 0)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkFFTBatch(b *testing.B) {
+	const (
+		size      = 1 << 14
+		nbColumns = 32
+	)
+	domain := NewDomain(size)
+	columns := make([][]fr.Element, nbColumns)
+	for i := range columns {
+		columns[i] = make([]fr.Element, size)
+		for j := range columns[i] {
+			columns[i][j].SetRandom()
+		}
+	}
+
+	b.Run("FFT per column", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			for i := range columns {
+				domain.FFT(columns[i], DIF)
+			}
+		}
+	})
+	b.Run("FFTBatch", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.FFTBatch(columns, DIF)
+		}
+	})
+}
+
 func BenchmarkFFT(b *testing.B) {
 
 	const maxSize = 1 << 20
@@ -0,0 +1,497 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// Storage holds a vector of field elements outside of the Go heap, for the out-of-core FFTs.
+// Element i is encoded with fr.BigEndian on fr.Bytes bytes, at offset i*fr.Bytes.
+// *os.File implements Storage; see also NewByteStorage.
+type Storage interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// NewByteStorage returns a Storage backed by b, for instance a memory-mapped file.
+func NewByteStorage(b []byte) Storage {
+	return byteStorage(b)
+}
+
+type byteStorage []byte
+
+func (s byteStorage) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 || off > int64(len(s)) {
+		return 0, errors.New("fft: read out of the storage")
+	}
+	n := copy(p, s[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (s byteStorage) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 || off+int64(len(p)) > int64(len(s)) {
+		return 0, errors.New("fft: write out of the storage")
+	}
+	return copy(s[off:], p), nil
+}
+
+// FFTOutOfCore computes the FFT of the Cardinality elements of data and stores the result in data,
+// in the same order as FFT would. At most about the memory limit (see WithMemoryLimit) elements
+// are held in memory, which must be at least √(2·Cardinality); OnCoset, WithNbTasks and
+// WithScheduler apply as for FFT.
+//
+// The domain should be created WithoutPrecompute, since the out-of-core FFT only uses its
+// generators: the vector is seen as a R×C matrix, R ≤ C, and the R-point FFTs of its columns,
+// then the C-point FFTs of its rows, are computed in memory a band at a time (four-step FFT).
+func (domain *Domain) FFTOutOfCore(data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fftOutOfCore(data, decimation, false, fftOptions(opts...))
+}
+
+// FFTInverseOutOfCore computes the inverse FFT of the Cardinality elements of data and stores the
+// result in data, in the same order as FFTInverse would. See FFTOutOfCore.
+func (domain *Domain) FFTInverseOutOfCore(data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fftOutOfCore(data, decimation, true, fftOptions(opts...))
+}
+
+// FFTOutOfCoreCtx is like FFTOutOfCore but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of data is undefined.
+func (domain *Domain) FFTOutOfCoreCtx(ctx context.Context, data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fftOutOfCoreCtx(ctx, data, decimation, false, opts)
+}
+
+// FFTInverseOutOfCoreCtx is like FFTInverseOutOfCore but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of data is undefined.
+func (domain *Domain) FFTInverseOutOfCoreCtx(ctx context.Context, data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fftOutOfCoreCtx(ctx, data, decimation, true, opts)
+}
+
+func (domain *Domain) fftOutOfCoreCtx(ctx context.Context, data Storage, decimation Decimation, inverse bool, opts []Option) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], withDone(ctx.Done()))
+	if err := domain.fftOutOfCore(data, decimation, inverse, fftOptions(opts...)); err != nil && err != errCanceled {
+		return err
+	}
+	return ctx.Err()
+}
+
+// errCanceled is returned by the out-of-core FFTs when their done channel is closed;
+// the storage is then left partially transformed.
+var errCanceled = errors.New("fft: computation canceled")
+
+// BitReverseOutOfCore applies the bit-reversal permutation to the n elements of data,
+// holding at most about the memory limit (see WithMemoryLimit) elements in memory.
+// n must be a power of 2.
+func BitReverseOutOfCore(data Storage, n uint64, opts ...Option) error {
+	if bits.OnesCount64(n) != 1 {
+		return errors.New("fft: n must be a power of 2")
+	}
+	opt := fftOptions(opts...)
+	s := storageIO{data: data}
+	if n <= uint64(opt.memoryLimit) {
+		v := make([]fr.Element, n)
+		if err := s.read(v, 0); err != nil {
+			return err
+		}
+		BitReverse(v)
+		return s.write(v, 0)
+	}
+
+	// writing i = (hi, mid, lo) where hi and lo have blockLog bits, the blocks
+	// (*, mid, *) and (*, rev(mid), *) are swapped, with rev(hi, mid, lo) = (rev(lo), rev(mid), rev(hi)).
+	logN := bits.TrailingZeros64(n)
+	blockLog := logN / 2
+	for blockLog > 0 && 3<<(2*blockLog) > opt.memoryLimit {
+		blockLog--
+	}
+	if blockLog == 0 {
+		return errors.New("fft: the memory limit is too small")
+	}
+	midLog := logN - 2*blockLog
+	blockSize := 1 << blockLog
+	hiStride := uint64(1) << (blockLog + midLog)
+
+	a := make([]fr.Element, blockSize*blockSize)
+	b := make([]fr.Element, blockSize*blockSize)
+	c := make([]fr.Element, blockSize*blockSize)
+	readBlock := func(v []fr.Element, mid uint64) error {
+		for hi := 0; hi < blockSize; hi++ {
+			if err := s.read(v[hi*blockSize:(hi+1)*blockSize], uint64(hi)*hiStride+mid<<blockLog); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	writeBlock := func(v []fr.Element, mid uint64) error {
+		for hi := 0; hi < blockSize; hi++ {
+			if err := s.write(v[hi*blockSize:(hi+1)*blockSize], uint64(hi)*hiStride+mid<<blockLog); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// transpose sets dst[rev(lo)][rev(hi)] = src[hi][lo]
+	transpose := func(dst, src []fr.Element) {
+		for hi := 0; hi < blockSize; hi++ {
+			for lo := 0; lo < blockSize; lo++ {
+				dst[reverse(lo, blockLog)*blockSize+reverse(hi, blockLog)] = src[hi*blockSize+lo]
+			}
+		}
+	}
+
+	for mid := uint64(0); mid < 1<<midLog; mid++ {
+		midRev := uint64(reverse(int(mid), midLog))
+		if midRev < mid {
+			continue
+		}
+		if err := readBlock(a, mid); err != nil {
+			return err
+		}
+		if midRev == mid {
+			transpose(b, a)
+			if err := writeBlock(b, mid); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := readBlock(b, midRev); err != nil {
+			return err
+		}
+		// a and b are swapped through the buffer c
+		transpose(c, a)
+		transpose(a, b)
+		if err := writeBlock(a, mid); err != nil {
+			return err
+		}
+		if err := writeBlock(c, midRev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fftOutOfCore computes the FFT as the product of the FFTs of the columns, a diagonal of twiddles
+// and the FFTs of the rows. Writing the position of an element p = C·x + y, with
+// DIF (natural order in, bit-reversed order out):
+//
+//	column DIF FFTs, multiplication by ω^(y·rev(x)), row DIF FFTs
+//
+// and DIT, which is the transpose since the DFT matrix and the bit reversal are symmetric:
+//
+//	row DIT FFTs, multiplication by ω^(y·rev(x)), column DIT FFTs.
+func (domain *Domain) fftOutOfCore(data Storage, decimation Decimation, inverse bool, opt fftConfig) error {
+	if domain.isMixedRadix() {
+		return errors.New("fft: the out-of-core FFT needs a power of two domain")
+	}
+	if decimation != DIF && decimation != DIT {
+		return errors.New("fft: unknown decimation")
+	}
+	n := domain.Cardinality
+	s := storageIO{data: data}
+
+	if n <= uint64(opt.memoryLimit) {
+		a := make([]fr.Element, n)
+		if err := s.read(a, 0); err != nil {
+			return err
+		}
+		t := domain.fftTables(decimation, inverse, opt.coset)
+		if inverse {
+			domain.fftInverse(a, decimation, t, opt)
+		} else {
+			domain.fft(a, decimation, t, opt)
+		}
+		if isDone(opt.done) {
+			return errCanceled
+		}
+		return s.write(a, 0)
+	}
+
+	logN := bits.TrailingZeros64(n)
+	nbRows := uint64(1) << (logN / 2)
+	nbColumns := n / nbRows
+	if nbColumns > uint64(opt.memoryLimit) {
+		return errors.New("fft: the memory limit is too small for the domain")
+	}
+
+	o := outOfCore{
+		s:         s,
+		inverse:   inverse,
+		nbRows:    nbRows,
+		nbColumns: nbColumns,
+		rows:      NewDomain(nbColumns),
+		columns:   NewDomain(nbRows),
+		subOpt:    fftConfig{nbTasks: opt.nbTasks, scheduler: opt.scheduler, done: opt.done},
+		opt:       opt,
+	}
+	// the FFTs of the rows and columns must use the powers of the domain generator
+	var w fr.Element
+	w.Exp(domain.Generator, new(big.Int).SetUint64(nbRows))
+	if !w.Equal(&o.rows.Generator) {
+		return errors.New("fft: the generator of the domain is not supported")
+	}
+	o.w, o.shift = domain.Generator, domain.FrMultiplicativeGen
+	if inverse {
+		o.w, o.shift = domain.GeneratorInv, domain.FrMultiplicativeGenInv
+	}
+
+	if decimation == DIF {
+		if err := o.columnPass(DIF, opt.coset && !inverse); err != nil {
+			return err
+		}
+		return o.rowPass(DIF, opt.coset && inverse)
+	}
+	if err := o.rowPass(DIT, opt.coset && !inverse); err != nil {
+		return err
+	}
+	return o.columnPass(DIT, opt.coset && inverse)
+}
+
+type outOfCore struct {
+	s                 storageIO
+	inverse           bool
+	nbRows, nbColumns uint64
+	rows, columns     *Domain // domains of the FFTs of the rows and of the columns
+	w, shift          fr.Element
+	subOpt, opt       fftConfig
+}
+
+// columnPass computes the FFTs of the columns, a band of columns at a time. With DIF, the twiddles
+// are applied after the FFTs. If coset is set, the element at position p is multiplied by shiftᵖ,
+// before the FFTs with DIF and after them with DIT.
+func (o *outOfCore) columnPass(decimation Decimation, coset bool) error {
+	bandSize := o.nbColumns
+	for bandSize > 1 && bandSize*o.nbRows > uint64(o.opt.memoryLimit) {
+		bandSize >>= 1
+	}
+	band := make([][]fr.Element, bandSize)
+	backing := make([]fr.Element, bandSize*o.nbRows)
+	for i := range band {
+		band[i] = backing[uint64(i)*o.nbRows : uint64(i+1)*o.nbRows]
+	}
+	run := make([]fr.Element, bandSize)
+	nbRowsLog := bits.TrailingZeros64(o.nbRows)
+
+	var shiftC fr.Element
+	shiftC.Exp(o.shift, new(big.Int).SetUint64(o.nbColumns))
+
+	for y0 := uint64(0); y0 < o.nbColumns; y0 += bandSize {
+		if isDone(o.opt.done) {
+			return errCanceled
+		}
+		for x := uint64(0); x < o.nbRows; x++ {
+			if err := o.s.read(run, x*o.nbColumns+y0); err != nil {
+				return err
+			}
+			for i := range run {
+				band[i][x] = run[i]
+			}
+		}
+
+		// scaleCoset multiplies the element (x, y0+i) by shift^(C·x + y0 + i)
+		scaleCoset := func() {
+			scheduler.Execute(o.opt.scheduler, len(band), func(start, end int) {
+				var at fr.Element
+				for i := start; i < end; i++ {
+					at.Exp(o.shift, new(big.Int).SetUint64(y0+uint64(i)))
+					for x := range band[i] {
+						band[i][x].Mul(&band[i][x], &at)
+						at.Mul(&at, &shiftC)
+					}
+				}
+			}, o.opt.nbTasks)
+		}
+
+		if coset && decimation == DIF {
+			scaleCoset()
+		}
+		o.columns.fftBatch(band, decimation, o.inverse, o.subOpt)
+		if decimation == DIF {
+			// multiplication by ω^(y·rev(x))
+			scheduler.Execute(o.opt.scheduler, len(band), func(start, end int) {
+				powers := make([]fr.Element, o.nbRows)
+				var wy fr.Element
+				for i := start; i < end; i++ {
+					wy.Exp(o.w, new(big.Int).SetUint64(y0+uint64(i)))
+					powers[0].SetOne()
+					for x := 1; x < len(powers); x++ {
+						powers[x].Mul(&powers[x-1], &wy)
+					}
+					for x := range band[i] {
+						band[i][x].Mul(&band[i][x], &powers[reverse(x, nbRowsLog)])
+					}
+				}
+			}, o.opt.nbTasks)
+		}
+		if coset && decimation == DIT {
+			scaleCoset()
+		}
+		if isDone(o.opt.done) {
+			return errCanceled
+		}
+
+		for x := uint64(0); x < o.nbRows; x++ {
+			for i := range run {
+				run[i] = band[i][x]
+			}
+			if err := o.s.write(run, x*o.nbColumns+y0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rowPass computes the FFTs of the rows, a band of rows at a time. With DIT, the twiddles are
+// applied after the FFTs. If coset is set, the element at position p is multiplied by shift^rev(p),
+// before the FFTs with DIT and after them with DIF.
+func (o *outOfCore) rowPass(decimation Decimation, coset bool) error {
+	bandSize := o.nbRows
+	for bandSize > 1 && bandSize*o.nbColumns > uint64(o.opt.memoryLimit) {
+		bandSize >>= 1
+	}
+	backing := make([]fr.Element, bandSize*o.nbColumns)
+	band := make([][]fr.Element, bandSize)
+	for i := range band {
+		band[i] = backing[uint64(i)*o.nbColumns : uint64(i+1)*o.nbColumns]
+	}
+	nbRowsLog := bits.TrailingZeros64(o.nbRows)
+	nbColumnsLog := bits.TrailingZeros64(o.nbColumns)
+
+	// rev(C·x + y) = R·rev(y) + rev(x), so shift^rev(p) = (shiftᴿ)^rev(y) · shift^rev(x)
+	var cosetTable []fr.Element
+	if coset {
+		var shiftR fr.Element
+		shiftR.Exp(o.shift, new(big.Int).SetUint64(o.nbRows))
+		cosetTable = make([]fr.Element, o.nbColumns)
+		BuildExpTable(shiftR, cosetTable)
+	}
+
+	for x0 := uint64(0); x0 < o.nbRows; x0 += bandSize {
+		if isDone(o.opt.done) {
+			return errCanceled
+		}
+		if err := o.s.read(backing, x0*o.nbColumns); err != nil {
+			return err
+		}
+
+		scaleCoset := func() {
+			scheduler.Execute(o.opt.scheduler, len(band), func(start, end int) {
+				var at, tmp fr.Element
+				for i := start; i < end; i++ {
+					at.Exp(o.shift, big.NewInt(int64(reverse(int(x0)+i, nbRowsLog))))
+					for y := range band[i] {
+						tmp.Mul(&at, &cosetTable[reverse(y, nbColumnsLog)])
+						band[i][y].Mul(&band[i][y], &tmp)
+					}
+				}
+			}, o.opt.nbTasks)
+		}
+
+		if coset && decimation == DIT {
+			scaleCoset()
+		}
+		o.rows.fftBatch(band, decimation, o.inverse, o.subOpt)
+		if decimation == DIT {
+			// multiplication by ω^(y·rev(x))
+			scheduler.Execute(o.opt.scheduler, len(band), func(start, end int) {
+				var wx fr.Element
+				for i := start; i < end; i++ {
+					wx.Exp(o.w, big.NewInt(int64(reverse(int(x0)+i, nbRowsLog))))
+					var at fr.Element
+					at.SetOne()
+					for y := range band[i] {
+						band[i][y].Mul(&band[i][y], &at)
+						at.Mul(&at, &wx)
+					}
+				}
+			}, o.opt.nbTasks)
+		}
+		if coset && decimation == DIF {
+			scaleCoset()
+		}
+		if isDone(o.opt.done) {
+			return errCanceled
+		}
+
+		if err := o.s.write(backing, x0*o.nbColumns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reverse returns the reversal of the nbBits lower bits of i
+func reverse(i, nbBits int) int {
+	if nbBits == 0 {
+		return 0
+	}
+	return int(bits.Reverse64(uint64(i)) >> (64 - nbBits))
+}
+
+// storageIO reads and writes runs of consecutive elements of a Storage
+type storageIO struct {
+	data Storage
+	buf  []byte
+}
+
+func (s *storageIO) grow(n int) []byte {
+	if cap(s.buf) < n*fr.Bytes {
+		s.buf = make([]byte, n*fr.Bytes)
+	}
+	return s.buf[:n*fr.Bytes]
+}
+
+// read sets v to the len(v) elements of the storage starting at element offset
+func (s *storageIO) read(v []fr.Element, offset uint64) error {
+	buf := s.grow(len(v))
+	n, err := s.data.ReadAt(buf, int64(offset)*fr.Bytes)
+	if n != len(buf) {
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	for i := range v {
+		if v[i], err = fr.BigEndian.Element((*[fr.Bytes]byte)(buf[i*fr.Bytes : (i+1)*fr.Bytes])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write stores v in the storage, starting at element offset
+func (s *storageIO) write(v []fr.Element, offset uint64) error {
+	buf := s.grow(len(v))
+	for i := range v {
+		fr.BigEndian.PutElement((*[fr.Bytes]byte)(buf[i*fr.Bytes:(i+1)*fr.Bytes]), v[i])
+	}
+	_, err := s.data.WriteAt(buf, int64(offset)*fr.Bytes)
+	return err
+}
@@ -25,7 +25,7 @@ import (
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -33,6 +33,21 @@ var (
 	ErrNotAPowerOfTwo = errors.New("d must be a power of 2")
 )
 
+// Option defines option for altering the behavior of NewRSis.
+type Option func(*rsisConfig)
+
+type rsisConfig struct {
+	scheduler scheduler.Scheduler
+}
+
+// WithScheduler sets the scheduler spawning the go routines which generate the key.
+// If not set, scheduler.Default() is used.
+func WithScheduler(s scheduler.Scheduler) Option {
+	return func(opt *rsisConfig) {
+		opt.scheduler = s
+	}
+}
+
 // Ring-SIS instance
 type RSis struct {
 
@@ -73,7 +88,11 @@ type RSis struct {
 // logTwoBound: the bound of the vector to hash (using the infinity norm).
 // maxNbElementsToHash: maximum number of field elements the instance handles
 // used to derived n, the number of polynomials in A, and max size of instance's internal buffer.
-func NewRSis(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int) (*RSis, error) {
+func NewRSis(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int, opts ...Option) (*RSis, error) {
+	var config rsisConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
 
 	if logTwoBound > 64 {
 		return nil, errors.New("logTwoBound too large")
@@ -136,7 +155,7 @@ func NewRSis(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int) (*R
 	a := make([]fr.Element, n*r.Degree)
 	ag := make([]fr.Element, n*r.Degree)
 
-	parallel.Execute(n, func(start, end int) {
+	scheduler.Execute(config.scheduler, n, func(start, end int) {
 		var buf bytes.Buffer
 		for i := start; i < end; i++ {
 			rstart, rend := i*r.Degree, (i+1)*r.Degree
@@ -148,7 +167,7 @@ func NewRSis(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int) (*R
 
 			// fill Ag the evaluation form of the polynomials in A on the coset √(g) * <g>
 			copy(r.Ag[i], r.A[i])
-			r.Domain.FFT(r.Ag[i], fft.DIF, fft.OnCoset())
+			r.Domain.FFT(r.Ag[i], fft.DIF, fft.OnCoset(), fft.WithScheduler(config.scheduler))
 		}
 	})
 
@@ -241,9 +260,9 @@ func (r *RSis) BlockSize() int {
 // Construct a hasher generator. It takes as input the same parameters
 // as `NewRingSIS` and outputs a function which returns fresh hasher
 // everytime it is called
-func NewRingSISMaker(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int) (func() hash.Hash, error) {
+func NewRingSISMaker(seed int64, logTwoDegree, logTwoBound, maxNbElementsToHash int, opts ...Option) (func() hash.Hash, error) {
 	return func() hash.Hash {
-		h, err := NewRSis(seed, logTwoDegree, logTwoBound, maxNbElementsToHash)
+		h, err := NewRSis(seed, logTwoDegree, logTwoBound, maxNbElementsToHash, opts...)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,118 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package tensorcommitment
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+)
+
+// maxDigestSize bounds the size of a digest read by ReadFrom
+const maxDigestSize = 1 << 30
+
+// WriteTo writes the number of hashes, their size and the hashes of the digest to w.
+// All the hashes must have the same size.
+func (d Digest) WriteTo(w io.Writer) (int64, error) {
+	var header [8]byte
+	size := 0
+	if len(d) > 0 {
+		size = len(d[0])
+	}
+	binary.BigEndian.PutUint32(header[:4], uint32(len(d)))
+	binary.BigEndian.PutUint32(header[4:], uint32(size))
+	n, err := w.Write(header[:])
+	if err != nil {
+		return int64(n), err
+	}
+	for i := range d {
+		if len(d[i]) != size {
+			return int64(n), ErrInvalidDigest
+		}
+		m, err := w.Write(d[i])
+		n += m
+		if err != nil {
+			return int64(n), err
+		}
+	}
+	return int64(n), nil
+}
+
+// ReadFrom decodes a digest written by WriteTo from r.
+func (d *Digest) ReadFrom(r io.Reader) (int64, error) {
+	var header [8]byte
+	n, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return int64(n), err
+	}
+	nbHashes, size := binary.BigEndian.Uint32(header[:4]), binary.BigEndian.Uint32(header[4:])
+	if uint64(nbHashes)*uint64(size) > maxDigestSize {
+		return int64(n), ErrInvalidDigest
+	}
+	buf := make([]byte, uint64(nbHashes)*uint64(size))
+	m, err := io.ReadFull(r, buf)
+	n += m
+	if err != nil {
+		return int64(n), err
+	}
+	*d = make(Digest, nbHashes)
+	for i := range *d {
+		(*d)[i] = buf[i*int(size) : (i+1)*int(size)]
+	}
+	return int64(n), nil
+}
+
+// WriteTo writes binary encoding of a MultilinearProof
+func (proof *MultilinearProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.ClaimedValues,
+		proof.Evaluation,
+		proof.Proximity,
+		proof.Columns,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes MultilinearProof data from reader.
+func (proof *MultilinearProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.ClaimedValues,
+		&proof.Evaluation,
+		&proof.Proximity,
+		&proof.Columns,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
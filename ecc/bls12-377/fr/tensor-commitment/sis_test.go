@@ -0,0 +1,209 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package tensorcommitment
+
+import (
+	"bytes"
+	"math/bits"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/sis"
+	"github.com/stretchr/testify/require"
+)
+
+// Check the commitments are correctly formed when appending a polynomial
+func TestAppendSis(t *testing.T) {
+	if bits.UintSize == 32 {
+		t.Skip("skipping this test in 32bit.")
+	}
+	const (
+		rho          = 4
+		nbColumns    = 8
+		nbRows       = 8
+		logTwoDegree = 1
+		logTwoBound  = 4
+	)
+
+	assert := require.New(t)
+
+	// keySize := 256
+	hMaker, err := sis.NewRingSISMaker(5, logTwoDegree, logTwoBound, 8)
+	assert.NoError(err)
+
+	params, err := NewTCParams(rho, nbColumns, nbRows, hMaker)
+	assert.NoError(err)
+
+	tc := NewTensorCommitment(params)
+
+	// random polynomial (that does not fill the full matrix)
+	offset := 4
+	p := make([]fr.Element, nbRows*nbColumns-offset)
+	for i := 0; i < nbRows*nbColumns-offset; i++ {
+		p[i].SetRandom()
+	}
+
+	s, err := tc.Append(p)
+	assert.NoError(err)
+
+	assert.Equal(nbColumns, len(s))
+
+	// check the hashes of the columns
+	h := hMaker()
+	for i := 0; i < nbColumns-1; i++ {
+		h.Reset()
+		for j := 0; j < nbRows; j++ {
+			h.Write(p[i*nbRows+j].Marshal())
+		}
+		_s := h.Sum(nil)
+		assert.True(bytes.Equal(_s, s[i]), "error hash column when appending a polynomial for column", i)
+	}
+
+	// last column
+	h.Reset()
+	for i := (nbColumns - 1) * nbRows; i < nbColumns*nbRows-offset; i++ {
+		h.Write(p[i].Marshal())
+	}
+	var tmp fr.Element
+	for i := nbColumns*nbRows - offset; i < nbColumns*nbRows; i++ {
+		h.Write(tmp.Marshal())
+	}
+	_s := h.Sum(nil)
+	assert.True(bytes.Equal(_s, s[nbColumns-1]), "error hash column when appending a polynomial")
+}
+
+// Test the verification of a correct proof using SIS as hash
+func TestCommitmentSis(t *testing.T) {
+	if bits.UintSize == 32 {
+		t.Skip("skipping this test in 32bit.")
+	}
+	var rho, nbColumns, nbRows int
+	rho = 4
+	nbColumns = 8
+	nbRows = 8
+
+	logTwoDegree := 1
+	logTwoBound := 4
+	hMaker, err := sis.NewRingSISMaker(5, logTwoDegree, logTwoBound, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params, err := NewTCParams(rho, nbColumns, nbRows, hMaker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := NewTensorCommitment(params)
+
+	// random polynomial
+	p := make([]fr.Element, nbRows*nbColumns)
+	for i := 0; i < nbRows*nbColumns; i++ {
+		p[i].SetRandom()
+	}
+
+	// coefficients for the linear combination
+	l := make([]fr.Element, nbRows)
+	for i := 0; i < nbRows; i++ {
+		l[i].SetRandom()
+	}
+
+	// compute the digest...
+	_, err = tc.Append(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := tc.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// test 1: we select all the entries
+	{
+		entryList := make([]int, rho*nbColumns)
+		for i := 0; i < rho*nbColumns; i++ {
+			entryList[i] = i
+		}
+
+		// build the proof...
+		proof, err := buildProof(tc, l, entryList)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// verify that the proof is correct
+		err = Verify(proof, digest, l, hMaker())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// test 2: we select a subset of the entries
+	{
+
+		entryList := make([]int, 2)
+		entryList[0] = 1
+		entryList[1] = 4
+
+		// build the proof...
+		proof, err := buildProof(tc, l, entryList)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// verify that the proof is correct
+		err = Verify(proof, digest, l, hMaker())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// benches
+func BenchmarkTensorCommitment(b *testing.B) {
+
+	// prepare the tensor commitment
+	logTwoDegree := 4
+	logTwoBound := 4
+	rho := 4
+
+	for i := 0; i < 6; i++ {
+
+		nbColumns := (1 << (3 + i))
+		nbRows := nbColumns
+
+		h, _ := sis.NewRingSISMaker(5, logTwoDegree, logTwoBound, nbRows)
+		params, _ := NewTCParams(rho, nbColumns, nbRows, h)
+		tc := NewTensorCommitment(params)
+
+		// random polynomial
+		p := make([]fr.Element, nbRows*nbColumns)
+		for i := 0; i < nbRows*nbColumns; i++ {
+			p[i].SetRandom()
+		}
+
+		// run the benchmark
+		b.Run("size poly"+strconv.Itoa(nbRows*nbColumns), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tc.Append(p)
+				tc.Commit()
+			}
+		})
+
+	}
+
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
 	"math/big"
+	"math/bits"
 	"runtime"
+	"sync"
 )
 
 // G1Affine point in affine coordinates
@@ -74,14 +76,95 @@ func (p *G1Jac) ScalarMultiplicationAffine(a *G1Affine, s *big.Int) *G1Jac {
 }
 
 // ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a table of multiples of g, built on first use.
 func (p *G1Jac) ScalarMultiplicationBase(s *big.Int) *G1Jac {
-	return p.mulGLV(&g1Gen, s)
+	return p.mulBase(s)
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = a ⋅ s
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses of the
+// multiplication does not depend on s. However, s is first converted to an fr.Element with
+// SetBigInt, which is not constant-time; when s is secret, use ScalarMultiplicationElementConstantTime.
+// a must be in the prime-order subgroup.
+func (p *G1Affine) ScalarMultiplicationConstantTime(a *G1Affine, s *big.Int) *G1Affine {
+	var e fr.Element
+	e.SetBigInt(s)
+	return p.ScalarMultiplicationElementConstantTime(a, &e)
+}
+
+// ScalarMultiplicationElementConstantTime computes and returns p = a ⋅ s
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses does
+// not depend on s; it should be used when s is secret. a must be in the prime-order subgroup.
+func (p *G1Affine) ScalarMultiplicationElementConstantTime(a *G1Affine, s *fr.Element) *G1Affine {
+	var _p G1Jac
+	_p.FromAffine(a)
+	_p.mulConstantTime(&_p, s)
+	return p.fromJacobianConstantTime(&_p)
+}
+
+// ScalarMultiplicationBaseConstantTime computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// see ScalarMultiplicationConstantTime; when s is secret, use ScalarMultiplicationBaseElementConstantTime.
+func (p *G1Affine) ScalarMultiplicationBaseConstantTime(s *big.Int) *G1Affine {
+	var e fr.Element
+	e.SetBigInt(s)
+	return p.ScalarMultiplicationBaseElementConstantTime(&e)
+}
+
+// ScalarMultiplicationBaseElementConstantTime computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// see ScalarMultiplicationElementConstantTime
+func (p *G1Affine) ScalarMultiplicationBaseElementConstantTime(s *fr.Element) *G1Affine {
+	var _p G1Jac
+	_p.mulBaseConstantTime(s)
+	return p.fromJacobianConstantTime(&_p)
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = a ⋅ s
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses of the
+// multiplication does not depend on s. However, s is first converted to an fr.Element with
+// SetBigInt, which is not constant-time; when s is secret, use ScalarMultiplicationElementConstantTime.
+// a must be in the prime-order subgroup.
+func (p *G1Jac) ScalarMultiplicationConstantTime(a *G1Jac, s *big.Int) *G1Jac {
+	var e fr.Element
+	e.SetBigInt(s)
+	return p.mulConstantTime(a, &e)
+}
+
+// ScalarMultiplicationElementConstantTime computes and returns p = a ⋅ s
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses does
+// not depend on s; it should be used when s is secret. a must be in the prime-order subgroup.
+func (p *G1Jac) ScalarMultiplicationElementConstantTime(a *G1Jac, s *fr.Element) *G1Jac {
+	return p.mulConstantTime(a, s)
+}
+
+// ScalarMultiplicationBaseConstantTime computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// see ScalarMultiplicationConstantTime; when s is secret, use ScalarMultiplicationBaseElementConstantTime.
+func (p *G1Jac) ScalarMultiplicationBaseConstantTime(s *big.Int) *G1Jac {
+	var e fr.Element
+	e.SetBigInt(s)
+	return p.mulBaseConstantTime(&e)
+}
+
+// ScalarMultiplicationBaseElementConstantTime computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// see ScalarMultiplicationElementConstantTime
+func (p *G1Jac) ScalarMultiplicationBaseElementConstantTime(s *fr.Element) *G1Jac {
+	return p.mulBaseConstantTime(s)
 }
 
 // ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a table of multiples of g, built on first use.
 func (p *G1Affine) ScalarMultiplicationBase(s *big.Int) *G1Affine {
 	var _p G1Jac
-	_p.mulGLV(&g1Gen, s)
+	_p.mulBase(s)
 	p.FromJacobian(&_p)
 	return p
 }
@@ -149,6 +232,17 @@ func (p *G1Affine) FromJacobian(p1 *G1Jac) *G1Affine {
 	return p
 }
 
+// fromJacobianConstantTime is FromJacobian using a constant-time inversion.
+// If p1 is infinity, p is set to (0,0).
+func (p *G1Affine) fromJacobianConstantTime(p1 *G1Jac) *G1Affine {
+	var a, b fp.Element
+	a.InverseConstantTime(&p1.Z)
+	b.SquareConstantTime(&a)
+	p.X.MulConstantTime(&p1.X, &b)
+	p.Y.MulConstantTime(&p1.Y, &b).MulConstantTime(&p.Y, &a)
+	return p
+}
+
 // String returns the string representation of the point or "O" if it is infinity
 func (p *G1Affine) String() string {
 	if p.IsInfinity() {
@@ -466,6 +560,316 @@ func (p *G1Jac) mulWindowed(a *G1Jac, s *big.Int) *G1Jac {
 
 }
 
+// mulConstantTime computes p = a ⋅ s with a fixed sequence of doublings and additions.
+//
+// s is recoded into signed odd digits (see recodeScalarRegular); each digit is looked up
+// in a table of odd multiples of a by reading every entry, and added with a branchless addition.
+func (p *G1Jac) mulConstantTime(a *G1Jac, s *fr.Element) *G1Jac {
+	// a is public
+	if a.Z.IsZero() {
+		return p.Set(a)
+	}
+
+	const (
+		c        = 4                     // window size
+		nbDigits = (fr.Bits + c - 1) / c // number of signed digits
+	)
+	var digits [nbDigits]int8
+	negated, isZero := recodeScalarRegular(digits[:], s, c)
+
+	// table[i] = (2i+1) ⋅ a
+	var table [1 << (c - 1)]G1Jac
+	var a2 G1Jac
+	a2.Double(a)
+	table[0].Set(a)
+	for i := 1; i < len(table); i++ {
+		table[i].Set(&table[i-1]).AddAssign(&a2)
+	}
+
+	var res, t G1Jac
+	res.lookupConstantTime(table[:], digits[nbDigits-1])
+	for i := nbDigits - 2; i >= 0; i-- {
+		for j := 0; j < c; j++ {
+			res.doubleAssignConstantTime()
+		}
+		t.lookupConstantTime(table[:], digits[i])
+		res.addAssignConstantTime(&t)
+	}
+
+	return p.Set(res.finalizeConstantTime(negated, isZero))
+}
+
+// mulBaseConstantTime is mulBase reading all the entries of each row of the table and
+// using branchless additions, see mulConstantTime.
+func (p *G1Jac) mulBaseConstantTime(s *fr.Element) *G1Jac {
+	table := g1BaseTable()
+
+	var digits [nbBaseDigits]int8
+	negated, isZero := recodeScalarRegular(digits[:], s, baseWindow)
+
+	// the partial sums are never infinity: |Σⱼ₍ₖ dⱼ⋅2ᶜʲ| < 2ᶜᵏ ⩽ |dₖ⋅2ᶜᵏ|
+	var res, t G1Jac
+	res.lookupAffineConstantTime(table[0][:], digits[0])
+	for j := 1; j < len(digits); j++ {
+		t.lookupAffineConstantTime(table[j][:], digits[j])
+		res.addAssignConstantTime(&t)
+	}
+
+	return p.Set(res.finalizeConstantTime(negated, isZero))
+}
+
+// recodeScalarRegular recodes s into signed odd digits in [-(2ᶜ-1), 2ᶜ-1], least significant
+// first (Joye-Tunstall regular recoding), without branching on s. len(digits) must be ⌈fr.Bits / c⌉.
+//
+// Since the recoding needs an odd scalar, an even s is replaced by r - s (and negated
+// is set to 1) and s ≡ 0 by 1 (and isZero is set to 1); the caller must fix the result.
+func recodeScalarRegular(digits []int8, s *fr.Element, c uint) (negated, isZero int) {
+	// the Montgomery multiplication by 1 converts out of Montgomery form, without the
+	// conditional subtraction of Bits
+	var k, kNeg fr.Element
+	kNeg.NegConstantTime(s).MulConstantTime(&kNeg, &fr.Element{1})
+	k.MulConstantTime(s, &fr.Element{1})
+
+	var acc uint64
+	for i := range k {
+		acc |= k[i]
+	}
+	isZero = int((acc|-acc)>>63 ^ 1)
+	negated = int(1 - k[0]&1)
+	mask := -uint64(negated)
+	for i := range k {
+		k[i] ^= mask & (k[i] ^ kNeg[i])
+	}
+	k[0] |= uint64(isZero)
+
+	// k is odd; each step sets k = (k - d) / 2ᶜ with d = (k mod 2ᶜ⁺¹) - 2ᶜ odd,
+	// which keeps k odd. The last digit is the remaining k, in [1, 2ᶜ).
+	last := len(digits) - 1
+	for i := 0; i < last; i++ {
+		d := int64(k[0]&(1<<(c+1)-1)) - (1 << c)
+		digits[i] = int8(d)
+		ext := uint64(-d >> 63)
+		var carry uint64
+		k[0], carry = bits.Add64(k[0], uint64(-d), 0)
+		for j := 1; j < fr.Limbs; j++ {
+			k[j], carry = bits.Add64(k[j], ext, carry)
+		}
+		// k - d ⩾ 0 fits in 64*fr.Limbs+1 bits
+		top := ext + carry
+		for j := 0; j < fr.Limbs-1; j++ {
+			k[j] = k[j]>>c | k[j+1]<<(64-c)
+		}
+		k[fr.Limbs-1] = k[fr.Limbs-1]>>c | top<<(64-c)
+	}
+	digits[last] = int8(k[0])
+
+	return
+}
+
+// finalizeConstantTime sets p = -p if negated == 1 and p = ∞ if isZero == 1, without branching
+func (p *G1Jac) finalizeConstantTime(negated, isZero int) *G1Jac {
+	var t G1Jac
+	t.Y.NegConstantTime(&p.Y)
+	p.Y.Select(negated, &p.Y, &t.Y)
+
+	p.X.Select(isZero, &p.X, &g1Infinity.X)
+	p.Y.Select(isZero, &p.Y, &g1Infinity.Y)
+	p.Z.Select(isZero, &p.Z, &g1Infinity.Z)
+	return p
+}
+
+// lookupAffineConstantTime sets p = d ⋅ a where table[i] = (2i+1) ⋅ a and d is odd.
+// All the entries of the table are read.
+func (p *G1Jac) lookupAffineConstantTime(table []G1Affine, d int8) *G1Jac {
+	sign := d >> 7
+	idx := int(((d ^ sign) - sign) >> 1)
+
+	p.X, p.Y = table[0].X, table[0].Y
+	for i := 1; i < len(table); i++ {
+		p.X.Select(i^idx, &table[i].X, &p.X)
+		p.Y.Select(i^idx, &table[i].Y, &p.Y)
+	}
+	p.Z.SetOne()
+
+	var negY fp.Element
+	negY.NegConstantTime(&p.Y)
+	p.Y.Select(int(sign), &p.Y, &negY)
+
+	return p
+}
+
+// lookupConstantTime sets p = d ⋅ a where table[i] = (2i+1) ⋅ a and d is odd.
+// All the entries of the table are read.
+func (p *G1Jac) lookupConstantTime(table []G1Jac, d int8) *G1Jac {
+	sign := d >> 7
+	idx := int(((d ^ sign) - sign) >> 1)
+
+	p.Set(&table[0])
+	for i := 1; i < len(table); i++ {
+		p.X.Select(i^idx, &table[i].X, &p.X)
+		p.Y.Select(i^idx, &table[i].Y, &p.Y)
+		p.Z.Select(i^idx, &table[i].Z, &p.Z)
+	}
+
+	var negY fp.Element
+	negY.NegConstantTime(&p.Y)
+	p.Y.Select(int(sign), &p.Y, &negY)
+
+	return p
+}
+
+// addAssignConstantTime sets p = p + a without branching on the coordinates.
+// p and a must not be infinity; the case p == a is handled by computing both the
+// addition and the doubling, and p == -a results in Z = 0.
+func (p *G1Jac) addAssignConstantTime(a *G1Jac) *G1Jac {
+	var d G1Jac
+	d.Set(p).doubleAssignConstantTime()
+
+	var Z1Z1, Z2Z2, U1, U2, S1, S2, H, I, J, r, V fp.Element
+	Z1Z1.SquareConstantTime(&a.Z)
+	Z2Z2.SquareConstantTime(&p.Z)
+	U1.MulConstantTime(&a.X, &Z2Z2)
+	U2.MulConstantTime(&p.X, &Z1Z1)
+	S1.MulConstantTime(&a.Y, &p.Z).
+		MulConstantTime(&S1, &Z2Z2)
+	S2.MulConstantTime(&p.Y, &a.Z).
+		MulConstantTime(&S2, &Z1Z1)
+
+	neq := U1.NotEqual(&U2) | S1.NotEqual(&S2)
+	isAdd := int((neq | -neq) >> 63)
+
+	H.SubConstantTime(&U2, &U1)
+	I.DoubleConstantTime(&H).
+		SquareConstantTime(&I)
+	J.MulConstantTime(&H, &I)
+	r.SubConstantTime(&S2, &S1).DoubleConstantTime(&r)
+	V.MulConstantTime(&U1, &I)
+	p.X.SquareConstantTime(&r).
+		SubConstantTime(&p.X, &J).
+		SubConstantTime(&p.X, &V).
+		SubConstantTime(&p.X, &V)
+	p.Y.SubConstantTime(&V, &p.X).
+		MulConstantTime(&p.Y, &r)
+	S1.MulConstantTime(&S1, &J).DoubleConstantTime(&S1)
+	p.Y.SubConstantTime(&p.Y, &S1)
+	p.Z.AddConstantTime(&p.Z, &a.Z)
+	p.Z.SquareConstantTime(&p.Z).
+		SubConstantTime(&p.Z, &Z1Z1).
+		SubConstantTime(&p.Z, &Z2Z2).
+		MulConstantTime(&p.Z, &H)
+
+	p.X.Select(isAdd, &d.X, &p.X)
+	p.Y.Select(isAdd, &d.Y, &p.Y)
+	p.Z.Select(isAdd, &d.Z, &p.Z)
+
+	return p
+}
+
+// doubleAssignConstantTime is DoubleAssign using the constant-time field operations
+func (p *G1Jac) doubleAssignConstantTime() *G1Jac {
+
+	var XX, YY, YYYY, ZZ, S, M, T fp.Element
+
+	XX.SquareConstantTime(&p.X)
+	YY.SquareConstantTime(&p.Y)
+	YYYY.SquareConstantTime(&YY)
+	ZZ.SquareConstantTime(&p.Z)
+	S.AddConstantTime(&p.X, &YY)
+	S.SquareConstantTime(&S).
+		SubConstantTime(&S, &XX).
+		SubConstantTime(&S, &YYYY).
+		DoubleConstantTime(&S)
+	M.DoubleConstantTime(&XX).AddConstantTime(&M, &XX)
+	p.Z.AddConstantTime(&p.Z, &p.Y).
+		SquareConstantTime(&p.Z).
+		SubConstantTime(&p.Z, &YY).
+		SubConstantTime(&p.Z, &ZZ)
+	T.SquareConstantTime(&M)
+	p.X = T
+	T.DoubleConstantTime(&S)
+	p.X.SubConstantTime(&p.X, &T)
+	p.Y.SubConstantTime(&S, &p.X).
+		MulConstantTime(&p.Y, &M)
+	YYYY.DoubleConstantTime(&YYYY).DoubleConstantTime(&YYYY).DoubleConstantTime(&YYYY)
+	p.Y.SubConstantTime(&p.Y, &YYYY)
+
+	return p
+}
+
+const (
+	baseWindow   = 5                                       // window size of the tables of multiples of the generators
+	nbBaseDigits = (fr.Bits + baseWindow - 1) / baseWindow // number of rows of the tables
+)
+
+// mulBase computes p = s ⋅ g where g is the prime subgroup generator, using the
+// table returned by g1BaseTable; it does one mixed addition per digit and no doubling.
+func (p *G1Jac) mulBase(s *big.Int) *G1Jac {
+	table := g1BaseTable()
+
+	var e fr.Element
+	e.SetBigInt(s)
+	var digits [nbBaseDigits]int8
+	negated, isZero := recodeScalarRegular(digits[:], &e, baseWindow)
+	if isZero == 1 {
+		return p.Set(&g1Infinity)
+	}
+
+	var res G1Jac
+	var t G1Affine
+	res.Set(&g1Infinity)
+	for j, d := range digits {
+		if d > 0 {
+			res.AddMixed(&table[j][d>>1])
+		} else {
+			t.Neg(&table[j][-d>>1])
+			res.AddMixed(&t)
+		}
+	}
+	if negated == 1 {
+		res.Neg(&res)
+	}
+
+	return p.Set(&res)
+}
+
+var (
+	_g1BaseTable     [][1 << (baseWindow - 1)]G1Affine
+	_g1BaseTableOnce sync.Once
+)
+
+// g1BaseTable returns the table T[j][i] = (2i+1) ⋅ 2ᶜʲ ⋅ g, where g is the prime
+// subgroup generator and c = baseWindow. It is computed on first use.
+func g1BaseTable() [][1 << (baseWindow - 1)]G1Affine {
+	_g1BaseTableOnce.Do(func() {
+		const nbEntries = 1 << (baseWindow - 1)
+		points := make([]G1Jac, nbBaseDigits*nbEntries)
+
+		var base, base2 G1Jac
+		base.Set(&g1Gen)
+		for j := 0; j < nbBaseDigits; j++ {
+			row := points[j*nbEntries : (j+1)*nbEntries]
+			base2.Double(&base)
+			row[0].Set(&base)
+			for i := 1; i < nbEntries; i++ {
+				row[i].Set(&row[i-1]).AddAssign(&base2)
+			}
+			// base = 2ᶜ ⋅ base
+			base.Set(&base2)
+			for i := 1; i < baseWindow; i++ {
+				base.DoubleAssign()
+			}
+		}
+		affine := BatchJacobianToAffineG1(points)
+
+		table := make([][nbEntries]G1Affine, nbBaseDigits)
+		for j := range table {
+			copy(table[j][:], affine[j*nbEntries:])
+		}
+		_g1BaseTable = table
+	})
+	return _g1BaseTable
+}
+
 // ϕ assigns p to ϕ(a) where ϕ: (x,y) → (w x,y), and returns p
 // where w is a third root of unity in 𝔽p
 func (p *G1Jac) phi(a *G1Jac) *G1Jac {
@@ -1032,7 +1436,7 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 	toReturn := make([]G1Jac, len(scalars))
 
 	// partition the scalars into digits
-	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
+	digits, _ := partitionScalars(scalars, c, runtime.NumCPU(), nil)
 
 	// for each digit, take value in the base table, double it c time, voilà.
 	parallel.Execute(len(scalars), func(start, end int) {
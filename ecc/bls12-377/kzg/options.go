@@ -0,0 +1,53 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+)
+
+// Option defines option for altering the behavior of the prover methods
+// (CommitCtx, OpenCtx, BatchOpenSinglePointCtx).
+type Option func(*proverConfig)
+
+type proverConfig struct {
+	nbTasks   int
+	scheduler scheduler.Scheduler
+}
+
+// WithNbTasks sets the max number of tasks (go routines) of the multi exponentiations.
+func WithNbTasks(nbTasks int) Option {
+	return func(opt *proverConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// WithScheduler sets the scheduler spawning the go routines of the prover,
+// including the ones of the multi exponentiations. If not set, scheduler.Default() is used.
+func WithScheduler(s scheduler.Scheduler) Option {
+	return func(opt *proverConfig) {
+		opt.scheduler = s
+	}
+}
+
+func proverOptions(opts ...Option) proverConfig {
+	var opt proverConfig
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
@@ -38,7 +38,8 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	c uint64,
 	points []G1Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	done <-chan struct{}) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -176,6 +177,10 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	}
 
 	for i, digit := range digits {
+		if i%msmCancelCheckInterval == 0 && isDone(done) {
+			// the msm was cancelled, the result will be discarded.
+			break
+		}
 
 		if digit == 0 || points[i].IsInfinity() {
 			continue
@@ -366,7 +371,8 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	c uint64,
 	points []G2Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	done <-chan struct{}) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -504,6 +510,10 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	}
 
 	for i, digit := range digits {
+		if i%msmCancelCheckInterval == 0 && isDone(done) {
+			// the msm was cancelled, the result will be discarded.
+			break
+		}
 
 		if digit == 0 || points[i].IsInfinity() {
 			continue
@@ -0,0 +1,242 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+)
+
+var (
+	errInvalidProof      = errors.New("invalid proof of knowledge")
+	errUnexpectedPackage = errors.New("unexpected package")
+	errMissingPackage    = errors.New("missing package")
+)
+
+// DKGRound1Package is broadcast by a participant in the first round of the
+// distributed key generation.
+type DKGRound1Package struct {
+	ID         uint32                     // identifier of the sender
+	Commitment VSSCommitment              // commitment to the polynomial f_i of the sender
+	ProofR     twistededwards.PointAffine // Schnorr proof of knowledge of f_i(0)
+	ProofZ     big.Int
+}
+
+// DKGRound2Package is sent privately from a participant to another one in the
+// second round of the distributed key generation.
+type DKGRound2Package struct {
+	From, To uint32
+	Share    big.Int // f_From(To)
+}
+
+// DKGParticipant is the state of a participant in the distributed key generation
+// of FROST (Pedersen DKG with proofs of knowledge, cf https://eprint.iacr.org/2020/852, figure 1).
+//
+// In the first round every participant broadcasts its DKGRound1Package. In the second
+// round (Round2) it checks the packages of the others and sends each of them a share of its
+// own secret. Finalize checks the received shares and outputs the key material.
+type DKGParticipant struct {
+	id           uint32
+	minSigners   int
+	maxSigners   int
+	coefficients []big.Int
+	commitment   VSSCommitment
+	round1       map[uint32]*DKGRound1Package
+}
+
+// NewDKGParticipant samples the secret polynomial of participant id, and returns the
+// package to broadcast to the others.
+func NewDKGParticipant(r io.Reader, id uint32, minSigners, maxSigners int) (*DKGParticipant, *DKGRound1Package, error) {
+	if err := checkParameters(minSigners, maxSigners); err != nil {
+		return nil, nil, err
+	}
+	if id == 0 {
+		return nil, nil, errInvalidIdentifier
+	}
+
+	coefficients, commitment, err := randomPolynomial(r, minSigners)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// proof of knowledge of a_0: R = k*G, μ = k + a_0*c with c = H(id, a_0*G, R)
+	k, err := randomScalar(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkg := DKGRound1Package{
+		ID:         id,
+		Commitment: commitment,
+		ProofR:     scalarBaseMult(&k),
+	}
+	c, err := dkgChallenge(id, &commitment[0], &pkg.ProofR)
+	if err != nil {
+		return nil, nil, err
+	}
+	mu, a0, sk := toScalar(&c), toScalar(&coefficients[0]), toScalar(&k)
+	mu.MulConstantTime(&mu, &a0).AddConstantTime(&mu, &sk)
+	pkg.ProofZ = fromScalar(&mu)
+
+	p := DKGParticipant{
+		id:           id,
+		minSigners:   minSigners,
+		maxSigners:   maxSigners,
+		coefficients: coefficients,
+		commitment:   commitment,
+	}
+	return &p, &pkg, nil
+}
+
+// Round2 checks the packages broadcast by the other participants in the first
+// round, and returns the shares to send privately to each of them.
+func (p *DKGParticipant) Round2(round1 []DKGRound1Package) ([]DKGRound2Package, error) {
+	if len(round1) != p.maxSigners-1 {
+		return nil, errMissingPackage
+	}
+
+	p.round1 = make(map[uint32]*DKGRound1Package, len(round1))
+	for i := range round1 {
+		pkg := &round1[i]
+		if pkg.ID == 0 || pkg.ID == p.id {
+			return nil, errInvalidIdentifier
+		}
+		if _, ok := p.round1[pkg.ID]; ok {
+			return nil, errDuplicateSigner
+		}
+		if len(pkg.Commitment) != p.minSigners {
+			return nil, fmt.Errorf("%w from participant %d", errUnexpectedPackage, pkg.ID)
+		}
+		for k := range pkg.Commitment {
+			if err := validateElement(&pkg.Commitment[k]); err != nil {
+				return nil, fmt.Errorf("%w from participant %d", err, pkg.ID)
+			}
+		}
+		if err := validateElement(&pkg.ProofR); err != nil {
+			return nil, fmt.Errorf("%w from participant %d", err, pkg.ID)
+		}
+
+		// μ*G == R + c*(a_0*G)
+		c, err := dkgChallenge(pkg.ID, &pkg.Commitment[0], &pkg.ProofR)
+		if err != nil {
+			return nil, err
+		}
+		var lhs, rhs twistededwards.PointAffine
+		lhs = scalarBaseMult(&pkg.ProofZ)
+		rhs.ScalarMultiplication(&pkg.Commitment[0], &c).
+			Add(&rhs, &pkg.ProofR)
+		if !lhs.Equal(&rhs) {
+			return nil, fmt.Errorf("%w from participant %d", errInvalidProof, pkg.ID)
+		}
+
+		p.round1[pkg.ID] = pkg
+	}
+
+	round2 := make([]DKGRound2Package, 0, len(round1))
+	for i := range round1 {
+		round2 = append(round2, DKGRound2Package{
+			From:  p.id,
+			To:    round1[i].ID,
+			Share: evaluatePolynomial(p.coefficients, round1[i].ID),
+		})
+	}
+	return round2, nil
+}
+
+// Finalize checks the shares received in the second round against the commitments
+// of the first round, and returns the key material of the participant and of the group.
+func (p *DKGParticipant) Finalize(round2 []DKGRound2Package) (*KeyPackage, *PublicKeyPackage, error) {
+	if p.round1 == nil {
+		return nil, nil, errMissingPackage
+	}
+	if len(round2) != len(p.round1) {
+		return nil, nil, errMissingPackage
+	}
+
+	// s_i = ∑f_j(i)
+	kp := KeyPackage{
+		ID:         p.id,
+		MinSigners: p.minSigners,
+	}
+	kp.SigningShare = evaluatePolynomial(p.coefficients, p.id)
+	signingShare := toScalar(&kp.SigningShare)
+
+	seen := make(map[uint32]bool, len(round2))
+	for i := range round2 {
+		pkg := &round2[i]
+		round1, ok := p.round1[pkg.From]
+		if !ok || pkg.To != p.id {
+			return nil, nil, errUnexpectedPackage
+		}
+		if seen[pkg.From] {
+			return nil, nil, errDuplicateSigner
+		}
+		seen[pkg.From] = true
+		if !round1.Commitment.Verify(p.id, &pkg.Share) {
+			return nil, nil, fmt.Errorf("%w from participant %d", errInvalidShare, pkg.From)
+		}
+		share := toScalar(&pkg.Share)
+		signingShare.AddConstantTime(&signingShare, &share)
+	}
+	kp.SigningShare = fromScalar(&signingShare)
+	kp.VerifyingShare = scalarBaseMult(&kp.SigningShare)
+
+	// the commitment to the sum of the polynomials is the sum of the commitments
+	commitment := make(VSSCommitment, p.minSigners)
+	copy(commitment, p.commitment)
+	for _, pkg := range p.round1 {
+		for k := range commitment {
+			commitment[k].Add(&commitment[k], &pkg.Commitment[k])
+		}
+	}
+
+	pub := PublicKeyPackage{
+		VerifyingShares: make(map[uint32]twistededwards.PointAffine, p.maxSigners),
+		GroupPublicKey:  commitment[0],
+	}
+	pub.VerifyingShares[p.id] = commitment.evaluate(p.id)
+	for id := range p.round1 {
+		pub.VerifyingShares[id] = commitment.evaluate(id)
+	}
+	kp.GroupPublicKey = commitment[0]
+
+	if vs := pub.VerifyingShares[p.id]; !vs.Equal(&kp.VerifyingShare) {
+		return nil, nil, errInvalidShare
+	}
+
+	return &kp, &pub, nil
+}
+
+// dkgChallenge returns H(id || a_0*G || R) for the proof of knowledge of a_0.
+func dkgChallenge(id uint32, a0, R *twistededwards.PointAffine) (big.Int, error) {
+	a0Bin, err := serializeElement(a0)
+	if err != nil {
+		return big.Int{}, err
+	}
+	RBin, err := serializeElement(R)
+	if err != nil {
+		return big.Int{}, err
+	}
+	msg := make([]byte, 0, sizeScalar+2*sizeElement)
+	msg = append(msg, serializeIdentifier(id)...)
+	msg = append(msg, a0Bin...)
+	msg = append(msg, RBin...)
+	return hashToScalar(msg, "dkg")
+}
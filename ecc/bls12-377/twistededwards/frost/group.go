@@ -0,0 +1,212 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/scalar"
+	xmd "github.com/consensys/gnark-crypto/field/hash"
+)
+
+const contextString = "FROST-BLS12-377-TWISTEDEDWARDS-SHA256-v1"
+
+const (
+	sizeScalar    = fr.Bytes
+	sizeElement   = fr.Bytes
+	sizeSignature = sizeElement + sizeScalar
+)
+
+var (
+	errIdentity       = errors.New("identity element")
+	errInvalidElement = errors.New("invalid group element")
+	errInvalidScalar  = errors.New("invalid scalar encoding")
+	errHashNeeded     = errors.New("hFunc cannot be nil. We need a hash for Fiat-Shamir")
+)
+
+var curveParams = twistededwards.GetEdwardsCurve()
+
+// order of the prime subgroup
+var order = &curveParams.Order
+
+// generator of the prime subgroup
+var generator = curveParams.Base
+
+// size in bytes of the uniform strings reduced into scalars, cf RFC 9380 section 5
+var hashToScalarSize = (order.BitLen() + 128 + 7) / 8
+
+// randomScalar returns a uniform non-zero scalar read from r.
+func randomScalar(r io.Reader) (big.Int, error) {
+	var k scalar.Element
+	var res big.Int
+	b := make([]byte, hashToScalarSize)
+	for k.IsZero() {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return res, err
+		}
+		k.SetBytesConstantTime(b)
+	}
+	k.BigInt(&res)
+	return res, nil
+}
+
+// The secret scalars (shares, nonces, polynomial coefficients) are combined with
+// the constant-time arithmetic of scalar.Element rather than with big.Int.
+
+// toScalar returns s mod the group order, without branching on the value of s.
+func toScalar(s *big.Int) scalar.Element {
+	var res scalar.Element
+	res.SetBytesConstantTime(s.Bytes())
+	return res
+}
+
+// fromScalar returns s as a big.Int.
+func fromScalar(s *scalar.Element) big.Int {
+	var res big.Int
+	s.BigInt(&res)
+	return res
+}
+
+// scalarBaseMult returns s*G. The sequence of operations does not depend on s,
+// which is secret for the nonces and the shares.
+func scalarBaseMult(s *big.Int) twistededwards.PointAffine {
+	var res twistededwards.PointAffine
+	e := toScalar(s)
+	res.ScalarMultiplicationBaseElementConstantTime(&e)
+	return res
+}
+
+// validateElement checks that p, received from another participant, is an element
+// of the prime order group other than the identity, cf RFC 9591 section 4.
+func validateElement(p *twistededwards.PointAffine) error {
+	if !p.IsOnCurve() {
+		return errInvalidElement
+	}
+	if isIdentity(p) {
+		return errIdentity
+	}
+	var q twistededwards.PointAffine
+	q.ScalarMultiplication(p, order)
+	if !q.IsZero() {
+		return errInvalidElement
+	}
+	return nil
+}
+
+// isIdentity returns true if p is the neutral element of the group
+func isIdentity(p *twistededwards.PointAffine) bool {
+	return p.IsZero()
+}
+
+// serializeScalar encodes s as a big endian integer on sizeScalar bytes.
+func serializeScalar(s *big.Int) []byte {
+	var res [sizeScalar]byte
+	s.FillBytes(res[:])
+	return res[:]
+}
+
+// deserializeScalar decodes a big endian integer smaller than the group order.
+func deserializeScalar(buf []byte) (big.Int, error) {
+	var s big.Int
+	if len(buf) != sizeScalar {
+		return s, errInvalidScalar
+	}
+	s.SetBytes(buf)
+	if s.Cmp(order) >= 0 {
+		return s, errInvalidScalar
+	}
+	return s, nil
+}
+
+// serializeElement encodes p compressed as in eddsa, cf RFC 8032 section 3.1.
+// The identity cannot be serialized.
+func serializeElement(p *twistededwards.PointAffine) ([]byte, error) {
+	if isIdentity(p) {
+		return nil, errIdentity
+	}
+	res := p.Bytes()
+	return res[:], nil
+}
+
+// deserializeElement decodes a compressed point and checks it is a
+// canonical encoding of an element of the prime subgroup other than the identity.
+func deserializeElement(buf []byte) (twistededwards.PointAffine, error) {
+	var p twistededwards.PointAffine
+	if len(buf) != sizeElement {
+		return p, errInvalidElement
+	}
+	if _, err := p.SetBytes(buf); err != nil {
+		return p, err
+	}
+	if enc := p.Bytes(); string(enc[:]) != string(buf) {
+		return p, errInvalidElement
+	}
+	return p, validateElement(&p)
+}
+
+// hashToScalar hashes msg to a scalar with expand_message_xmd and SHA-256,
+// cf RFC 9380 section 5.
+func hashToScalar(msg []byte, tag string) (big.Int, error) {
+	b, err := xmd.ExpandMsgXmd(msg, []byte(contextString+tag), hashToScalarSize)
+	if err != nil {
+		return big.Int{}, err
+	}
+	var s scalar.Element
+	s.SetBytesConstantTime(b)
+	return fromScalar(&s), nil
+}
+
+// hashToBytes returns SHA-256(contextString || tag || msg)
+func hashToBytes(msg []byte, tag string) []byte {
+	h := sha256.New()
+	h.Write([]byte(contextString + tag))
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// challenge computes H(R, A, M) as in eddsa, all parameters in data are in Montgomery form.
+// The result is reduced modulo the group order.
+func challenge(R, A *twistededwards.PointAffine, message []byte, hFunc hash.Hash) (big.Int, error) {
+	var c big.Int
+	if hFunc == nil {
+		return c, errHashNeeded
+	}
+
+	hFunc.Reset()
+
+	RX := R.X.Bytes()
+	RY := R.Y.Bytes()
+	AX := A.X.Bytes()
+	AY := A.Y.Bytes()
+
+	toWrite := [][]byte{RX[:], RY[:], AX[:], AY[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return c, err
+		}
+	}
+
+	c.SetBytes(hFunc.Sum(nil))
+	c.Mod(&c, order)
+	return c, nil
+}
@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12377_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0xf6edc3ff7c7d4983e641164c140a096ee01c3d35616cc4ba4456dcf6f015e89", "0xe8c75d3ce9433b438b33a71e7d728844a960e63d986ca86bc8a07b83e886c07"},
+				Q: point{"0x12929d4987ff468e63b85d8921ad48400ba3b840beb50f68a7821fb19c3175bb", "0x8a2f7b23c81a7bfdfc20720df89891dfc5f37592a6850690590767d904c56d2"},
+				u: "0x5eb684642861ef1ea0dd73c21a064dae783b98f52836026aee9218b0f4bc93f",
+			},
+			{
+				msg: "abc", P: point{"0xe0259f4cbe7851a7fb4844c598493519fa68216a6ad68db06462cf7a1cfc236", "0xb10f38f6d025445db1e441af6db23f6074257289913246ddd6784c8a8f95bd6"},
+				Q: point{"0x419ef882a350821a33fdcbc7436207b25c92d3ab9ecfa1616bde76826db96e3", "0xb0ae2e1a4aaad2ff2761d8b9c2a30448ad11cabdb65739eac4eec4c17e1c171"},
+				u: "0x7ea09258da2bfcab0c4de7ac1a8df3f51e0019247657e41508db0ac94671c92",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0xd06ba6ea020adcc33e76f941fad2c02335950ff131c8a417d6fb1a641c6711a", "0x640c7112e768a70060c9308d2ef1bb4c6d2a7013f1b5e360202cdb7640f1e58"},
+				Q: point{"0x5a00359f7f278687a10b96c4a26ccec846507338e54139551e26f666f756044", "0x1e997bb4122dd3eb038c8ac1a20fc396d9eb8426af51b70ce6e9def93a43c4f"},
+				u: "0xaf9ad20abc90440d6541964fc4e208e9b2886051e1413f4982e3402cae2125e",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0xfc297191fcc13cce2fe9aafefcda74397be392fbcf7ce44ae6446cbb18bd4ad", "0xab2701afff95644bec173f6d67dc7bce78fd6cfceb00ca5c1bb80fd514e9f36"},
+				Q: point{"0xbfba6a1c75dc94c4c816da1aa29109322c9ab7fd1d00b97135303a2698804f5", "0xeac11754c749bd23aa08dfcf5c0ee4fac54e9290af50cdec1aadfb07f4995eb"},
+				u: "0x124ca4a1cc0501d9872cab80e25d8455933165bb24bca85f6b3a698a3a1affd6",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x3ae05e21e53fa61682ee4759c2145cc526c1a9a86ca57b56764a6037f52a5bc", "0x1130cbad7c69496e91cb562bdba2233dd0f98afe44ccdb7d3d264e7f6c7552ec"},
+				Q: point{"0x1039ff244b9d6d2a18ec630294232a3f4db02668a914a670c3287da712ba06c9", "0x226c5c25a87d4746ac75bf176acce182278fe7593b40d065baf71d0bfb544a7"},
+				u: "0xb23ed19d657622b71e203b30e776dbf0f36b6193abb31fd6ad24bdaf020b8a4",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12377_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0xb42613529393da20a3efe4a9e255a8ff29238678d1e26f32c440765f2140237", "0x4611b7903430650203dcfdab217053283f315da9203d5432a8135d6bda48bf1"},
+				Q0: point{"0x11ddbb567ff2c25ffadf3025574dd9381387bd25c1280e577b23e0be8ae92cfc", "0x455df4e0905d1ef774175408b1c793ba8d17f0d49c2c653fd7ab83460cb6f58"},
+				Q1: point{"0x5bfc4db320803a85554768a045c4ad48597698f7ab4ae7d55f3c6d13221aef0", "0x7c84df4e296ab4a4288aeac1d846115a316c13ae61da93fcc3226829e894f4b"},
+				u0: "0x9ae05a9fa40dbc6557b08f3280844c399b9aff307aff3a47899c0b97b18480a", u1: "0xf0fa8d8159ae97eb6ab9169f1cb79ad7fe9494d4c9295ff2abf1e303a4b7e8",
+			},
+			{
+				msg: "abc", P: point{"0xafdd4959f2ae61f2a237598fad60cf6af74eacbd9f9dd07421164727bd698a3", "0xa9e085a440647acb8a6dbea28e736422eb714e0a4b4bd1349f8d51de859bb32"},
+				Q0: point{"0x10bf35d2d43ae06dbcba41075ae276c4a17f33c1f90b14c5e6d2fc0925d29158", "0x6395ddd4112052e5a7bc1a6d30f137923934747f52caf1e73955b808e3a3cc5"},
+				Q1: point{"0x4422cf80c6965bed98fb8cc18bdc63bbf0861a292f35240291d482c4964bddc", "0x10e2cf8077843abd25305c8463a283a873a9e5848acdeb864ef04698cedbf48d"},
+				u0: "0x9cccc97fbe67eb3372ec2a011df6eace8ee066407ff15d8ea69e6dcccd4af1", u1: "0x834116e977f8774c43c8800ebd52914c811dce0348b9a6efa3195a1600f1d1b",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x7f81e07ec75837cf9d5f87d12d1e0958db31030869af26ae63cfd937e95b786", "0x2811073b797cdc4e6305bb1284fea15e00e11f41130d99e161b32283b0768a4"},
+				Q0: point{"0x3c58e5186c3e8086d9fbefe406a7e03e7f5d95c9b2cce51c256df8ac79e563f", "0x222cc38a36ef69a36692e47c175d64962e65661d315037664d5045470d1f11d"},
+				Q1: point{"0x8c8647c2e99d30b68a2633357e9ff55c258bd0508f02933c38226cf3df7d3fd", "0x6ca5e129cee29222e1f4845d999916e68f1c243005e3387f338dc2812107843"},
+				u0: "0x3c1f7d1636da799ec8c0b255b015f3c059ddb66fc203dd3db0120f7e9e75a56", u1: "0xe071d80633b7f1e86a9ef4bfdcfc49e3804ba484142c5e0c24cc77d1074efdb",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x45fa0f45111cbdd9b2568cea769a856c9a1675b3da43677a34b0fbd7cce4c1f", "0x5af41df136f4bfecfcc2469b42b393521e18c49e7c5572495a4758c37310811"},
+				Q0: point{"0x247441b9b5ef6dcab84e5b0594a6cf0596924c77222c42393db067427cc9aec", "0x489b97f968e2b7e3171561e15c99926b8d0fd858ab03eb74bd8cd148f853318"},
+				Q1: point{"0x119890b05d57f8ba9f7080a717ba3391bf1d1fadab06c78c83997df09ed6ad6e", "0x925d994712a60a765bb4bc28d0fbc088339df9693faa7b4dcbdf9ea6d6e4d01"},
+				u0: "0x10b9df5544f6e0d5d7d898ec2cbfe6236d27a9a616dc563e4636804f56eb11bf", u1: "0xb84a84513d8f6b8cfe65aa343308fb7993f44c632e0e142610e8766f9af691a",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x44f5eb538480f1d6fc61c167c34d39851cb8b2385b26001d0fe7a3c6a28fa0f", "0xb301f23cf091ee0de48bf5ad753ea9978cdd96bb0c4c5fabfdbbff41dd7a5e1"},
+				Q0: point{"0x4a085c6fc2daf9af360e5350917f04b8fd633a66ebe0b9d0d35b4c20157d70e", "0xf3a45e3f3bd90e6b194bdf189440f3b543c705e8be5591b4d745ccb726f5679"},
+				Q1: point{"0x104a50c62d37a17e503a822670a0c404cea318a1cd263d55afd6513183d7cd44", "0xb68c807d69e54c1f056f1652c443c1a25b6421c131f09c484cdf5b58fcbf940"},
+				u0: "0x2fe9547e7fbded39d4fcaf0b0c63984ee40a837f62874df62efc5c426701d6b", u1: "0xcade19722f53d9d220f864b83507bec80aa45961d73659ce2995d57bf291db2",
+			},
+		}}
+}
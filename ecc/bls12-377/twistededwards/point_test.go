@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/scalar"
+	"github.com/consensys/gnark-crypto/internal/dudect"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -631,6 +633,24 @@ func TestOps(t *testing.T) {
 	))
 
 	properties.Property("(mixed affine+extended) P+P=2*P", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var pExtended, p, p2 PointExtended
+			var pAffine PointAffine
+			pAffine.ScalarMultiplication(&params.Base, &s)
+			pExtended.FromAffine(&pAffine)
+
+			p.MixedAdd(&pExtended, &pAffine)
+			p2.MixedDouble(&pExtended)
+
+			return p.Equal(&p2)
+		},
+		genS1,
+	))
+
+	properties.Property("(mixed affine+extended) P+P=2*P with Z≠1", prop.ForAll(
 		func(s big.Int) bool {
 
 			params := GetEdwardsCurve()
@@ -642,7 +662,7 @@ func TestOps(t *testing.T) {
 			pAffine.ScalarMultiplication(&params.Base, &s)
 
 			p.MixedAdd(&pExtended, &pAffine)
-			p2.MixedDouble(&pExtended)
+			p2.Double(&pExtended)
 
 			return p.Equal(&p2)
 		},
@@ -709,10 +729,97 @@ func TestOps(t *testing.T) {
 		genS1,
 	))
 
+	properties.Property("(constant time) scalar multiplication should match ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var p1, p2 PointAffine
+			p1.ScalarMultiplicationConstantTime(&params.Base, &s)
+			p2.ScalarMultiplication(&params.Base, &s)
+
+			var ns big.Int
+			ns.Neg(&s)
+			var p3, p4 PointAffine
+			p3.ScalarMultiplicationConstantTime(&params.Base, &ns)
+			p4.ScalarMultiplication(&params.Base, &ns)
+
+			return p1.Equal(&p2) && p3.Equal(&p4)
+		},
+		genS1,
+	))
+
+	properties.Property("scalar multiplication with the base table should match ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var ns, ls big.Int
+			ns.Neg(&s)
+			ls.Lsh(&s, 8)
+
+			res := true
+			for _, _s := range []*big.Int{&s, &ns, &ls} {
+				var p1, p2, p3 PointAffine
+				p1.ScalarMultiplicationBase(_s)
+				p2.ScalarMultiplicationBaseConstantTime(_s)
+				p3.ScalarMultiplication(&params.Base, _s)
+				res = res && p1.Equal(&p3) && p2.Equal(&p3)
+			}
+			return res
+		},
+		genS1,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
 
+func TestScalarMultiplicationConstantTimeTiming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	params := GetEdwardsCurve()
+	var base PointExtended
+	base.FromAffine(&params.Base)
+
+	// class 0: fixed scalar, class 1: random scalars
+	var b [fr.Bytes]byte
+	var s big.Int
+	var e scalar.Element
+	var p PointExtended
+	prepare := func(class int) {
+		_, err := rand.Read(b[:]) //#nosec G404 weak rng is fine here
+		if err != nil {
+			panic(err)
+		}
+		if class == 0 {
+			s.SetUint64(1)
+		} else {
+			s.SetBytes(b[:])
+		}
+		e.SetBigInt(&s)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationConstantTime(&base, &s) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected: |t| = %.2f", tt)
+	}
+
+	baseTable()
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationBaseConstantTime(&s) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the fixed-base scalar multiplication: |t| = %.2f", tt)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationElementConstantTime(&base, &e) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the scalar multiplication by a scalar.Element: |t| = %.2f", tt)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationBaseElementConstantTime(&e) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the fixed-base scalar multiplication by a scalar.Element: |t| = %.2f", tt)
+	}
+}
+
 func TestMarshal(t *testing.T) {
 	t.Parallel()
 	initOnce.Do(initCurveParams)
@@ -810,6 +917,30 @@ func BenchmarkScalarMulExtended(b *testing.B) {
 	}
 }
 
+func BenchmarkScalarMulBase(b *testing.B) {
+	params := GetEdwardsCurve()
+	var s big.Int
+	s.SetString("52435875175126190479447705081859658376581184513", 10)
+	s.Add(&s, &params.Order)
+
+	var res PointExtended
+	baseTable()
+
+	b.Run("base table", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplicationBase(&s)
+		}
+	})
+
+	b.Run("base table constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplicationBaseConstantTime(&s)
+		}
+	})
+}
+
 func BenchmarkScalarMulProjective(b *testing.B) {
 	params := GetEdwardsCurve()
 	var a PointProj
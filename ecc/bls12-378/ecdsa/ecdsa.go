@@ -59,21 +59,17 @@ type Signature struct {
 	R, S [sizeFr]byte
 }
 
-var one = new(big.Int).SetInt64(1)
-
-// randFieldElement returns a random element of the order of the given
-// curve using the procedure given in FIPS 186-4, Appendix B.5.1.
-func randFieldElement(rand io.Reader) (k *big.Int, err error) {
+// randFieldElement returns a random non-zero element of fr, reducing fr.Bits+64
+// random bits modulo the order as in FIPS 186-4, Appendix B.5.1. The bytes are
+// reduced with SetBytesConstantTime, so that the secret never goes through math/big.
+func randFieldElement(rand io.Reader) (k fr.Element, err error) {
 	b := make([]byte, fr.Bits/8+8)
-	_, err = io.ReadFull(rand, b)
-	if err != nil {
-		return
+	for k.IsZero() {
+		if _, err = io.ReadFull(rand, b); err != nil {
+			return
+		}
+		k.SetBytesConstantTime(b)
 	}
-
-	k = new(big.Int).SetBytes(b)
-	n := new(big.Int).Sub(order, one)
-	k.Mod(k, n)
-	k.Add(k, one)
 	return
 }
 
@@ -88,8 +84,8 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 	_, _, g, _ := bls12378.Generators()
 
 	privateKey := new(PrivateKey)
-	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.scalar = k.BytesConstantTime()
+	privateKey.PublicKey.A.ScalarMultiplicationElementConstantTime(&g, &k)
 	return privateKey, nil
 }
 
@@ -193,8 +189,11 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 //
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
-	scalar, r, s, kInv := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
-	scalar.SetBytes(privKey.scalar[:sizeFr])
+	r, s := new(big.Int), new(big.Int)
+
+	// the secret scalar and the nonce only go through constant-time operations
+	var scalar, kInv, _s, _m fr.Element
+	scalar.SetBytesConstantTime(privKey.scalar[:sizeFr])
 	for {
 		for {
 			csprng, err := nonce(privKey, message)
@@ -207,8 +206,8 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			}
 
 			var P bls12378.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			P.ScalarMultiplicationBaseElementConstantTime(&k)
+			kInv.InverseConstantTime(&k)
 
 			P.X.BigInt(r)
 
@@ -217,7 +216,7 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 				break
 			}
 		}
-		s.Mul(r, scalar)
+		_s.SetBigInt(r).MulConstantTime(&_s, &scalar)
 
 		var m *big.Int
 		if hFunc != nil {
@@ -235,13 +234,14 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			m = HashToInt(message)
 		}
 
-		s.Add(m, s).
-			Mul(kInv, s).
-			Mod(s, order) // order != 0
-		if s.Sign() != 0 {
+		_m.SetBigInt(m)
+		_s.AddConstantTime(&_m, &_s).
+			MulConstantTime(&kInv, &_s)
+		if !_s.IsZero() {
 			break
 		}
 	}
+	_s.BigInt(s)
 
 	var sig Signature
 	r.FillBytes(sig.R[:sizeFr])
@@ -0,0 +1,917 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x3291440000000001
+DATA q<>+8(SB)/8, $0xeae77f3da0940001
+DATA q<>+16(SB)/8, $0x87787fb4e3dbb0ff
+DATA q<>+24(SB)/8, $0x20e7b9c8ef7b2eb1
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x329143ffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, rb0, rb1, rb2, rb3) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $4
+DATA indexGatherScatter<>+16(SB)/8, $8
+DATA indexGatherScatter<>+24(SB)/8, $12
+DATA indexGatherScatter<>+32(SB)/8, $16
+DATA indexGatherScatter<>+40(SB)/8, $20
+DATA indexGatherScatter<>+48(SB)/8, $24
+DATA indexGatherScatter<>+56(SB)/8, $28
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0x1440000000001
+DATA qLimbs52<>+8(SB)/8, $0x3da0940001329
+DATA qLimbs52<>+16(SB)/8, $0x3dbb0ffeae77f
+DATA qLimbs52<>+24(SB)/8, $0x2eb187787fb4e
+DATA qLimbs52<>+32(SB)/8, $0x20e7b9c8ef7b
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $40
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// a[i] + b[i]
+	MOVQ 0(DX), SI
+	MOVQ 8(DX), DI
+	MOVQ 16(DX), R8
+	MOVQ 24(DX), R9
+	ADDQ 0(CX), SI
+	ADCQ 8(CX), DI
+	ADCQ 16(CX), R8
+	ADCQ 24(CX), R9
+
+	// reduce element(SI,DI,R8,R9) using temp registers (R10,R11,R12,R13)
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13)
+
+	// res[i] = a[i] + b[i]
+	MOVQ SI, 0(AX)
+	MOVQ DI, 8(AX)
+	MOVQ R8, 16(AX)
+	MOVQ R9, 24(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	ADDQ $0x0000000000000020, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+	XORQ SI, SI
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// a[i] - b[i]
+	MOVQ 0(DX), DI
+	MOVQ 8(DX), R8
+	MOVQ 16(DX), R9
+	MOVQ 24(DX), R10
+	SUBQ 0(CX), DI
+	SBBQ 8(CX), R8
+	SBBQ 16(CX), R9
+	SBBQ 24(CX), R10
+
+	// reduce (a[i] - b[i])
+	MOVQ    $0x3291440000000001, R11
+	MOVQ    $0xeae77f3da0940001, R12
+	MOVQ    $0x87787fb4e3dbb0ff, R13
+	MOVQ    $0x20e7b9c8ef7b2eb1, R14
+	CMOVQCC SI, R11
+	CMOVQCC SI, R12
+	CMOVQCC SI, R13
+	CMOVQCC SI, R14
+
+	// add registers (q or 0) to t, and set to result
+	ADDQ R11, DI
+	ADCQ R12, R8
+	ADCQ R13, R9
+	ADCQ R14, R10
+
+	// res[i] = a[i] - b[i]
+	MOVQ DI, 0(AX)
+	MOVQ R8, 8(AX)
+	MOVQ R9, 16(AX)
+	MOVQ R10, 24(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	ADDQ $0x0000000000000020, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[8]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $128-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $1, DX                  // we load 2 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6          // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z4, Z4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000040, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-128(SP)
+	VMOVDQU64 Z4, acc1-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-128(SP), BX
+	ADDQ lane4-96(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-120(SP), BX
+	ADDQ lane5-88(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-112(SP), BX
+	ADDQ lane6-80(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-104(SP), BX
+	ADDQ lane7-72(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane8-64(SP), BX
+	ADDQ lane12-32(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane9-56(SP), BX
+	ADDQ lane13-24(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane10-48(SP), BX
+	ADDQ lane14-16(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane11-40(SP), BX
+	ADDQ lane15-8(SP), BX
+	MOVQ BX, 56(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[8]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $64-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8          // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y4, Y4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000020, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-64(SP)
+	VMOVDQU Y4, acc1-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-64(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-56(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-48(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-40(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-32(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-24(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane6-16(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane7-8(SP), BX
+	MOVQ BX, 56(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $640-32
+	MOVQ         $0x000143ffffffffff, SI
+	VPBROADCASTQ SI, Z9
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z10
+	VMOVDQU64    indexGatherScatter<>(SB), Z11
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z11*8), K1, Z3
+	VMOVDQU64  Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a0-640(SP)
+	VPSRLQ     $52, Z0, Z8
+	VPSLLQ     $12, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a1-576(SP)
+	VPSRLQ     $40, Z1, Z8
+	VPSLLQ     $24, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a2-512(SP)
+	VPSRLQ     $28, Z2, Z8
+	VPSLLQ     $36, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a3-448(SP)
+	VPSRLQ     $16, Z3, Z8
+	VMOVDQU64  Z8, a4-384(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 4 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(CX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(CX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(CX)(Z11*8), K1, Z3
+	VPSLLQ     $4, Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b0-320(SP)
+	VPSRLQ     $48, Z0, Z8
+	VPSLLQ     $16, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b1-256(SP)
+	VPSRLQ     $36, Z1, Z8
+	VPSLLQ     $28, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b2-192(SP)
+	VPSRLQ     $24, Z2, Z8
+	VPSLLQ     $40, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b3-128(SP)
+	VPSRLQ     $12, Z3, Z8
+	VMOVDQU64  Z8, b4-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+
+	// t += a * b[0]
+	VMOVDQU64   b0-320(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z0
+	VPMADD52HUQ a0-640(SP), Z6, Z1
+	VPMADD52LUQ a1-576(SP), Z6, Z1
+	VPMADD52HUQ a1-576(SP), Z6, Z2
+	VPMADD52LUQ a2-512(SP), Z6, Z2
+	VPMADD52HUQ a2-512(SP), Z6, Z3
+	VPMADD52LUQ a3-448(SP), Z6, Z3
+	VPMADD52HUQ a3-448(SP), Z6, Z4
+	VPMADD52LUQ a4-384(SP), Z6, Z4
+	VPMADD52HUQ a4-384(SP), Z6, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z0, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-256(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z1
+	VPMADD52HUQ a0-640(SP), Z6, Z2
+	VPMADD52LUQ a1-576(SP), Z6, Z2
+	VPMADD52HUQ a1-576(SP), Z6, Z3
+	VPMADD52LUQ a2-512(SP), Z6, Z3
+	VPMADD52HUQ a2-512(SP), Z6, Z4
+	VPMADD52LUQ a3-448(SP), Z6, Z4
+	VPMADD52HUQ a3-448(SP), Z6, Z5
+	VPMADD52LUQ a4-384(SP), Z6, Z5
+	VPMADD52HUQ a4-384(SP), Z6, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z1, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-192(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z2
+	VPMADD52HUQ a0-640(SP), Z6, Z3
+	VPMADD52LUQ a1-576(SP), Z6, Z3
+	VPMADD52HUQ a1-576(SP), Z6, Z4
+	VPMADD52LUQ a2-512(SP), Z6, Z4
+	VPMADD52HUQ a2-512(SP), Z6, Z5
+	VPMADD52LUQ a3-448(SP), Z6, Z5
+	VPMADD52HUQ a3-448(SP), Z6, Z0
+	VPMADD52LUQ a4-384(SP), Z6, Z0
+	VPMADD52HUQ a4-384(SP), Z6, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z2, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-128(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z3
+	VPMADD52HUQ a0-640(SP), Z6, Z4
+	VPMADD52LUQ a1-576(SP), Z6, Z4
+	VPMADD52HUQ a1-576(SP), Z6, Z5
+	VPMADD52LUQ a2-512(SP), Z6, Z5
+	VPMADD52HUQ a2-512(SP), Z6, Z0
+	VPMADD52LUQ a3-448(SP), Z6, Z0
+	VPMADD52HUQ a3-448(SP), Z6, Z1
+	VPMADD52LUQ a4-384(SP), Z6, Z1
+	VPMADD52HUQ a4-384(SP), Z6, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z3, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z8
+	VPADDQ Z8, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-64(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z4
+	VPMADD52HUQ a0-640(SP), Z6, Z5
+	VPMADD52LUQ a1-576(SP), Z6, Z5
+	VPMADD52HUQ a1-576(SP), Z6, Z0
+	VPMADD52LUQ a2-512(SP), Z6, Z0
+	VPMADD52HUQ a2-512(SP), Z6, Z1
+	VPMADD52LUQ a3-448(SP), Z6, Z1
+	VPMADD52HUQ a3-448(SP), Z6, Z2
+	VPMADD52LUQ a4-384(SP), Z6, Z2
+	VPMADD52HUQ a4-384(SP), Z6, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z4, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z8
+	VPADDQ Z8, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// propagate the carries
+	VPSRLQ $52, Z5, Z8
+	VPADDQ Z8, Z0, Z0
+	VPANDQ Z10, Z5, Z5
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPANDQ Z10, Z0, Z0
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPANDQ Z10, Z1, Z1
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPANDQ Z10, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z5, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a0-640(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a1-576(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a2-512(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a3-448(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z6
+	VPADDQ      Z8, Z6, Z6
+	VMOVDQU64   Z6, a4-384(SP)
+	VPMOVQ2M    Z6, K2
+	VMOVDQU64   Z5, K2, a0-640(SP)
+	VMOVDQU64   Z0, K2, a1-576(SP)
+	VMOVDQU64   Z1, K2, a2-512(SP)
+	VMOVDQU64   Z2, K2, a3-448(SP)
+	VMOVDQU64   Z3, K2, a4-384(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-640(SP), Z5
+	VPSLLQ      $52, a1-576(SP), Z8
+	VPORQ       Z8, Z5, Z5
+	VPSRLQ      $12, a1-576(SP), Z0
+	VPSLLQ      $40, a2-512(SP), Z8
+	VPORQ       Z8, Z0, Z0
+	VPSRLQ      $24, a2-512(SP), Z1
+	VPSLLQ      $28, a3-448(SP), Z8
+	VPORQ       Z8, Z1, Z1
+	VPSRLQ      $36, a3-448(SP), Z2
+	VPSLLQ      $16, a4-384(SP), Z8
+	VPORQ       Z8, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 0(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z11*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000100, AX
+	ADDQ $0x0000000000000100, DX
+	ADDQ $0x0000000000000100, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $640-32
+	MOVQ         $0x000143ffffffffff, SI
+	VPBROADCASTQ SI, Z9
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z10
+	VMOVDQU64    indexGatherScatter<>(SB), Z11
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPBROADCASTQ 8(CX), Z1
+	VPBROADCASTQ 16(CX), Z2
+	VPBROADCASTQ 24(CX), Z3
+	VPSLLQ       $4, Z0, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b0-320(SP)
+	VPSRLQ       $48, Z0, Z8
+	VPSLLQ       $16, Z1, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b1-256(SP)
+	VPSRLQ       $36, Z1, Z8
+	VPSLLQ       $28, Z2, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b2-192(SP)
+	VPSRLQ       $24, Z2, Z8
+	VPSLLQ       $40, Z3, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b3-128(SP)
+	VPSRLQ       $12, Z3, Z8
+	VMOVDQU64    Z8, b4-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z11*8), K1, Z3
+	VMOVDQU64  Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a0-640(SP)
+	VPSRLQ     $52, Z0, Z8
+	VPSLLQ     $12, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a1-576(SP)
+	VPSRLQ     $40, Z1, Z8
+	VPSLLQ     $24, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a2-512(SP)
+	VPSRLQ     $28, Z2, Z8
+	VPSLLQ     $36, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a3-448(SP)
+	VPSRLQ     $16, Z3, Z8
+	VMOVDQU64  Z8, a4-384(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+
+	// t += a * b[0]
+	VMOVDQU64   b0-320(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z0
+	VPMADD52HUQ a0-640(SP), Z6, Z1
+	VPMADD52LUQ a1-576(SP), Z6, Z1
+	VPMADD52HUQ a1-576(SP), Z6, Z2
+	VPMADD52LUQ a2-512(SP), Z6, Z2
+	VPMADD52HUQ a2-512(SP), Z6, Z3
+	VPMADD52LUQ a3-448(SP), Z6, Z3
+	VPMADD52HUQ a3-448(SP), Z6, Z4
+	VPMADD52LUQ a4-384(SP), Z6, Z4
+	VPMADD52HUQ a4-384(SP), Z6, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z0, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-256(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z1
+	VPMADD52HUQ a0-640(SP), Z6, Z2
+	VPMADD52LUQ a1-576(SP), Z6, Z2
+	VPMADD52HUQ a1-576(SP), Z6, Z3
+	VPMADD52LUQ a2-512(SP), Z6, Z3
+	VPMADD52HUQ a2-512(SP), Z6, Z4
+	VPMADD52LUQ a3-448(SP), Z6, Z4
+	VPMADD52HUQ a3-448(SP), Z6, Z5
+	VPMADD52LUQ a4-384(SP), Z6, Z5
+	VPMADD52HUQ a4-384(SP), Z6, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z1, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-192(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z2
+	VPMADD52HUQ a0-640(SP), Z6, Z3
+	VPMADD52LUQ a1-576(SP), Z6, Z3
+	VPMADD52HUQ a1-576(SP), Z6, Z4
+	VPMADD52LUQ a2-512(SP), Z6, Z4
+	VPMADD52HUQ a2-512(SP), Z6, Z5
+	VPMADD52LUQ a3-448(SP), Z6, Z5
+	VPMADD52HUQ a3-448(SP), Z6, Z0
+	VPMADD52LUQ a4-384(SP), Z6, Z0
+	VPMADD52HUQ a4-384(SP), Z6, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z2, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-128(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z3
+	VPMADD52HUQ a0-640(SP), Z6, Z4
+	VPMADD52LUQ a1-576(SP), Z6, Z4
+	VPMADD52HUQ a1-576(SP), Z6, Z5
+	VPMADD52LUQ a2-512(SP), Z6, Z5
+	VPMADD52HUQ a2-512(SP), Z6, Z0
+	VPMADD52LUQ a3-448(SP), Z6, Z0
+	VPMADD52HUQ a3-448(SP), Z6, Z1
+	VPMADD52LUQ a4-384(SP), Z6, Z1
+	VPMADD52HUQ a4-384(SP), Z6, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z3, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z8
+	VPADDQ Z8, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-64(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z4
+	VPMADD52HUQ a0-640(SP), Z6, Z5
+	VPMADD52LUQ a1-576(SP), Z6, Z5
+	VPMADD52HUQ a1-576(SP), Z6, Z0
+	VPMADD52LUQ a2-512(SP), Z6, Z0
+	VPMADD52HUQ a2-512(SP), Z6, Z1
+	VPMADD52LUQ a3-448(SP), Z6, Z1
+	VPMADD52HUQ a3-448(SP), Z6, Z2
+	VPMADD52LUQ a4-384(SP), Z6, Z2
+	VPMADD52HUQ a4-384(SP), Z6, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z4, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z8
+	VPADDQ Z8, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// propagate the carries
+	VPSRLQ $52, Z5, Z8
+	VPADDQ Z8, Z0, Z0
+	VPANDQ Z10, Z5, Z5
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPANDQ Z10, Z0, Z0
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPANDQ Z10, Z1, Z1
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPANDQ Z10, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z5, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a0-640(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a1-576(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a2-512(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a3-448(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z6
+	VPADDQ      Z8, Z6, Z6
+	VMOVDQU64   Z6, a4-384(SP)
+	VPMOVQ2M    Z6, K2
+	VMOVDQU64   Z5, K2, a0-640(SP)
+	VMOVDQU64   Z0, K2, a1-576(SP)
+	VMOVDQU64   Z1, K2, a2-512(SP)
+	VMOVDQU64   Z2, K2, a3-448(SP)
+	VMOVDQU64   Z3, K2, a4-384(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-640(SP), Z5
+	VPSLLQ      $52, a1-576(SP), Z8
+	VPORQ       Z8, Z5, Z5
+	VPSRLQ      $12, a1-576(SP), Z0
+	VPSLLQ      $40, a2-512(SP), Z8
+	VPORQ       Z8, Z0, Z0
+	VPSRLQ      $24, a2-512(SP), Z1
+	VPSLLQ      $28, a3-448(SP), Z8
+	VPORQ       Z8, Z1, Z1
+	VPSRLQ      $36, a3-448(SP), Z2
+	VPSLLQ      $16, a4-384(SP), Z8
+	VPORQ       Z8, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 0(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z11*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000100, AX
+	ADDQ $0x0000000000000100, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
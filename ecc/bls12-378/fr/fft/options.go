@@ -17,7 +17,7 @@
 package fft
 
 import (
-	"runtime"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
 )
@@ -28,8 +28,11 @@ import (
 type Option func(*fftConfig)
 
 type fftConfig struct {
-	coset   bool
-	nbTasks int
+	coset       bool
+	nbTasks     int
+	done        <-chan struct{} // if closed, the FFT stops early
+	scheduler   scheduler.Scheduler
+	memoryLimit int // number of elements the out-of-core FFTs may hold in memory
 }
 
 // OnCoset if provided, FFT(a) returns the evaluation of a on a coset.
@@ -51,16 +54,47 @@ func WithNbTasks(nbTasks int) Option {
 	}
 }
 
+// WithScheduler sets the scheduler spawning the go routines of the FFT.
+// If the number of tasks is not set, it defaults to s.NbTasks().
+func WithScheduler(s scheduler.Scheduler) Option {
+	return func(opt *fftConfig) {
+		opt.scheduler = s
+	}
+}
+
+// WithMemoryLimit sets the number of field elements the out-of-core FFTs (see FFTOutOfCore)
+// may hold in memory at once; it defaults to 2²².
+func WithMemoryLimit(nbElements int) Option {
+	return func(opt *fftConfig) {
+		opt.memoryLimit = nbElements
+	}
+}
+
+// withDone stops the FFT early when done is closed; see FFTCtx.
+func withDone(done <-chan struct{}) Option {
+	return func(opt *fftConfig) {
+		opt.done = done
+	}
+}
+
 // default options
 func fftOptions(opts ...Option) fftConfig {
 	// apply options
 	opt := fftConfig{
-		coset:   false,
-		nbTasks: runtime.NumCPU(),
+		coset: false,
 	}
 	for _, option := range opts {
 		option(&opt)
 	}
+	if opt.scheduler == nil {
+		opt.scheduler = scheduler.Default()
+	}
+	if opt.nbTasks == 0 {
+		opt.nbTasks = opt.scheduler.NbTasks()
+	}
+	if opt.memoryLimit <= 0 {
+		opt.memoryLimit = 1 << 22
+	}
 	return opt
 }
 
@@ -72,6 +106,7 @@ type DomainOption func(*domainConfig)
 type domainConfig struct {
 	shift          *fr.Element
 	withPrecompute bool
+	mixedRadix     bool
 }
 
 // WithShift sets the FrMultiplicativeGen of the domain.
@@ -90,6 +125,16 @@ func WithoutPrecompute() DomainOption {
 	}
 }
 
+// WithMixedRadix lets NewDomain pick the smallest cardinality of the form 2ᵃ·3ᵇ·5ᶜ
+// supported by the field, instead of rounding up to a power of two.
+// FFTs on such a domain run radix 3 and 5 stages; their DIF output (and DIT input)
+// is in digit-reversed order, see Domain.DigitReverse.
+func WithMixedRadix() DomainOption {
+	return func(opt *domainConfig) {
+		opt.mixedRadix = true
+	}
+}
+
 // default options
 func domainOptions(opts ...DomainOption) domainConfig {
 	// apply options
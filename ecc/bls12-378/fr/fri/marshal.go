@@ -0,0 +1,187 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// maxEncodedLength bounds the lengths read by the decoder, so that a malformed
+// encoding can't trigger an arbitrarily large allocation.
+const maxEncodedLength = 1 << 26
+
+var errEncodedLength = errors.New("encoded length is too large")
+
+// WriteTo writes the binary encoding of the proof of proximity to w.
+func (proof *ProofOfProximity) WriteTo(w io.Writer) (int64, error) {
+	enc := encoder{w: w}
+	enc.writeBytes(proof.ID)
+	enc.writeBytesSlice(proof.Roots)
+	enc.writeUint64(uint64(len(proof.FinalPolynomial)))
+	for i := range proof.FinalPolynomial {
+		enc.writeElement(&proof.FinalPolynomial[i])
+	}
+	enc.writeUint64(proof.Nonce)
+	enc.writeUint64(uint64(len(proof.Queries)))
+	for i := range proof.Queries {
+		enc.writeUint64(uint64(len(proof.Queries[i].ProofSets)))
+		for j := range proof.Queries[i].ProofSets {
+			enc.writeBytesSlice(proof.Queries[i].ProofSets[j])
+		}
+	}
+	return enc.n, enc.err
+}
+
+// ReadFrom decodes a proof of proximity written by WriteTo from r.
+func (proof *ProofOfProximity) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	proof.ID = dec.readBytes()
+	proof.Roots = dec.readBytesSlice()
+	proof.FinalPolynomial = make([]fr.Element, dec.readLength())
+	for i := 0; i < len(proof.FinalPolynomial) && dec.err == nil; i++ {
+		dec.readElement(&proof.FinalPolynomial[i])
+	}
+	proof.Nonce = dec.readUint64()
+	proof.Queries = make([]Query, dec.readLength())
+	for i := 0; i < len(proof.Queries) && dec.err == nil; i++ {
+		proof.Queries[i].ProofSets = make([][][]byte, dec.readLength())
+		for j := 0; j < len(proof.Queries[i].ProofSets) && dec.err == nil; j++ {
+			proof.Queries[i].ProofSets[j] = dec.readBytesSlice()
+		}
+	}
+	return dec.n, dec.err
+}
+
+// WriteTo writes the binary encoding of the opening proof to w.
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := encoder{w: w}
+	enc.writeBytes(proof.merkleRoot)
+	enc.writeBytesSlice(proof.ProofSet)
+	enc.writeUint64(proof.numLeaves)
+	enc.writeUint64(proof.index)
+	enc.writeElement(&proof.ClaimedValue)
+	return enc.n, enc.err
+}
+
+// ReadFrom decodes an opening proof written by WriteTo from r.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	proof.merkleRoot = dec.readBytes()
+	proof.ProofSet = dec.readBytesSlice()
+	proof.numLeaves = dec.readUint64()
+	proof.index = dec.readUint64()
+	dec.readElement(&proof.ClaimedValue)
+	return dec.n, dec.err
+}
+
+// encoder writes big-endian lengths and values to w, and keeps the first error
+type encoder struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (enc *encoder) write(b []byte) {
+	if enc.err != nil {
+		return
+	}
+	var n int
+	n, enc.err = enc.w.Write(b)
+	enc.n += int64(n)
+}
+
+func (enc *encoder) writeUint64(v uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	enc.write(buf[:])
+}
+
+func (enc *encoder) writeBytes(b []byte) {
+	enc.writeUint64(uint64(len(b)))
+	enc.write(b)
+}
+
+func (enc *encoder) writeBytesSlice(s [][]byte) {
+	enc.writeUint64(uint64(len(s)))
+	for i := range s {
+		enc.writeBytes(s[i])
+	}
+}
+
+func (enc *encoder) writeElement(e *fr.Element) {
+	b := e.Bytes()
+	enc.write(b[:])
+}
+
+// decoder reads what encoder writes from r, and keeps the first error
+type decoder struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (dec *decoder) read(b []byte) {
+	if dec.err != nil {
+		return
+	}
+	var n int
+	n, dec.err = io.ReadFull(dec.r, b)
+	dec.n += int64(n)
+}
+
+func (dec *decoder) readUint64() uint64 {
+	var buf [8]byte
+	dec.read(buf[:])
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+func (dec *decoder) readLength() int {
+	l := dec.readUint64()
+	if dec.err == nil && l > maxEncodedLength {
+		dec.err = errEncodedLength
+	}
+	if dec.err != nil {
+		return 0
+	}
+	return int(l)
+}
+
+func (dec *decoder) readBytes() []byte {
+	b := make([]byte, dec.readLength())
+	dec.read(b)
+	return b
+}
+
+func (dec *decoder) readBytesSlice() [][]byte {
+	s := make([][]byte, dec.readLength())
+	for i := 0; i < len(s) && dec.err == nil; i++ {
+		s[i] = dec.readBytes()
+	}
+	return s
+}
+
+func (dec *decoder) readElement(e *fr.Element) {
+	var buf [fr.Bytes]byte
+	dec.read(buf[:])
+	if dec.err == nil {
+		dec.err = e.SetBytesCanonical(buf[:])
+	}
+}
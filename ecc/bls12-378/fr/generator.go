@@ -30,7 +30,6 @@ func Generator(m uint64) (Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity Element
-
 	rootOfUnity.SetString("4045585818372166415418670827807793147093034396422209590578257013290761627990")
 	const maxOrderRoot uint64 = 42
 
@@ -0,0 +1,188 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package tensorcommitment
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/polynomial"
+	"github.com/stretchr/testify/require"
+)
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	m := make(polynomial.MultiLin, 1<<nbVars)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	point := make([]fr.Element, nbVars)
+	for i := range point {
+		point[i].SetRandom()
+	}
+	return point
+}
+
+// commitMultilinear appends the polynomials to a new TensorCommitment, and commits to them
+func commitMultilinear(t *testing.T, params *TcParams, polynomials ...polynomial.MultiLin) (*TensorCommitment, Digest) {
+	t.Helper()
+	tc := NewTensorCommitment(params)
+	for _, p := range polynomials {
+		if _, err := tc.Append(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	digest, err := tc.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tc, digest
+}
+
+func TestOpenMultilinear(t *testing.T) {
+	assert := require.New(t)
+
+	const (
+		rho       = 4
+		nbColumns = 16
+		nbRows    = 8
+	)
+	params, err := NewTCParams(rho, nbColumns, nbRows, sha256.New)
+	assert.NoError(err)
+
+	// from a single column to the full state
+	for nbVars := 3; nbVars <= 7; nbVars++ {
+		nbPolynomials := nbColumns * nbRows >> nbVars
+		polynomials := make([]polynomial.MultiLin, nbPolynomials)
+		for i := range polynomials {
+			polynomials[i] = randomMultiLin(nbVars)
+		}
+		point := randomPoint(nbVars)
+
+		tc, digest := commitMultilinear(t, params, polynomials...)
+		proof, err := tc.OpenMultilinear(point, digest, sha256.New(), []byte("data"))
+		assert.NoError(err)
+
+		assert.Equal(nbPolynomials, len(proof.ClaimedValues))
+		for i := range polynomials {
+			expected := polynomials[i].Evaluate(point, nil)
+			assert.True(expected.Equal(&proof.ClaimedValues[i]), "wrong claimed value")
+		}
+		assert.NoError(VerifyMultilinear(digest, &proof, point, sha256.New(), params, []byte("data")))
+
+		// wrong claimed value
+		tampered := proof
+		tampered.ClaimedValues = make([]fr.Element, len(proof.ClaimedValues))
+		copy(tampered.ClaimedValues, proof.ClaimedValues)
+		tampered.ClaimedValues[0].SetRandom()
+		assert.Error(VerifyMultilinear(digest, &tampered, point, sha256.New(), params, []byte("data")))
+
+		// wrong point
+		assert.Error(VerifyMultilinear(digest, &proof, randomPoint(nbVars), sha256.New(), params, []byte("data")))
+
+		// wrong transcript
+		assert.Error(VerifyMultilinear(digest, &proof, point, sha256.New(), params))
+
+		// wrong digest
+		_, otherDigest := commitMultilinear(t, params, randomMultiLin(nbVars))
+		assert.Error(VerifyMultilinear(otherDigest, &proof, point, sha256.New(), params, []byte("data")))
+
+		// the linear combination is not the one of the committed rows
+		tampered = proof
+		tampered.Evaluation = make([]fr.Element, len(proof.Evaluation))
+		copy(tampered.Evaluation, proof.Evaluation)
+		tampered.Evaluation[len(tampered.Evaluation)-1].SetRandom()
+		assert.Error(VerifyMultilinear(digest, &tampered, point, sha256.New(), params, []byte("data")))
+	}
+}
+
+func TestOpenMultilinearErrors(t *testing.T) {
+	assert := require.New(t)
+
+	params, err := NewTCParams(4, 8, 8, sha256.New)
+	assert.NoError(err)
+
+	tc := NewTensorCommitment(params)
+	_, err = tc.OpenMultilinear(randomPoint(3), nil, sha256.New())
+	assert.Equal(ErrCommitmentNotDone, err)
+
+	tc, digest := commitMultilinear(t, params, randomMultiLin(4))
+	_, err = tc.OpenMultilinear(randomPoint(5), digest, sha256.New())
+	assert.Equal(ErrInvalidPointSize, err)
+	_, err = tc.OpenMultilinear(randomPoint(2), digest, sha256.New())
+	assert.Equal(ErrInvalidPointSize, err)
+
+	// polynomials of different sizes can't be opened at the same point
+	tc, digest = commitMultilinear(t, params, randomMultiLin(4), randomMultiLin(3))
+	_, err = tc.OpenMultilinear(randomPoint(4), digest, sha256.New())
+	assert.Equal(ErrInvalidPointSize, err)
+
+	params, err = NewTCParams(4, 8, 6, sha256.New)
+	assert.NoError(err)
+	tc, digest = commitMultilinear(t, params, randomMultiLin(4))
+	_, err = tc.OpenMultilinear(randomPoint(4), digest, sha256.New())
+	assert.Equal(ErrNbRowsNotPowerOfTwo, err)
+}
+
+func TestMarshalMultilinear(t *testing.T) {
+	assert := require.New(t)
+
+	params, err := NewTCParams(2, 8, 4, sha256.New)
+	assert.NoError(err)
+	polynomials := []polynomial.MultiLin{randomMultiLin(4), randomMultiLin(4)}
+	point := randomPoint(4)
+	tc, digest := commitMultilinear(t, params, polynomials...)
+	proof, err := tc.OpenMultilinear(point, digest, sha256.New())
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	written, err := digest.WriteTo(&buf)
+	assert.NoError(err)
+	var digestRead Digest
+	read, err := digestRead.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(digest, digestRead)
+
+	written, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var proofRead MultilinearProof
+	read, err = proofRead.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+
+	assert.NoError(VerifyMultilinear(digestRead, &proofRead, point, sha256.New(), params))
+}
+
+func BenchmarkOpenMultilinear(b *testing.B) {
+	const nbVars = 16
+	params, _ := NewTCParams(4, 1<<(nbVars/2), 1<<(nbVars/2), sha256.New)
+	p := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	tc := NewTensorCommitment(params)
+	_, _ = tc.Append(p)
+	digest, _ := tc.Commit()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = tc.OpenMultilinear(point, digest, sha256.New())
+	}
+}
@@ -0,0 +1,37 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import "testing"
+
+// TestVectorOpsAVX2 runs the vector tests without AVX-512, to cover the AVX2 assembly
+// on the CPUs supporting both.
+func TestVectorOpsAVX2(t *testing.T) {
+	if !supportAvx2 {
+		t.Skip("AVX2 not supported")
+	}
+	defer func(avx512, ifma bool) {
+		supportAvx512, supportAvx512IFMA = avx512, ifma
+	}(supportAvx512, supportAvx512IFMA)
+	supportAvx512, supportAvx512IFMA = false, false
+
+	t.Run("Ops", TestVectorOps)
+	t.Run("SumLargeValues", TestVectorSumLargeValues)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/parallel"
 	"math/big"
 	"runtime"
+	"sync"
 )
 
 // G2Affine point in affine coordinates
@@ -71,9 +72,11 @@ func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
 }
 
 // ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a table of multiples of g, built on first use.
 func (p *G2Affine) ScalarMultiplicationBase(s *big.Int) *G2Affine {
 	var _p G2Jac
-	_p.mulGLV(&g2Gen, s)
+	_p.mulBase(s)
 	p.FromJacobian(&_p)
 	return p
 }
@@ -451,6 +454,80 @@ func (p *G2Jac) mulWindowed(a *G2Jac, s *big.Int) *G2Jac {
 
 }
 
+// mulBase computes p = s ⋅ g where g is the prime subgroup generator, using the
+// table returned by g2BaseTable; it does one mixed addition per digit and no doubling.
+func (p *G2Jac) mulBase(s *big.Int) *G2Jac {
+	table := g2BaseTable()
+
+	var e fr.Element
+	e.SetBigInt(s)
+	var digits [nbBaseDigits]int8
+	negated, isZero := recodeScalarRegular(digits[:], &e, baseWindow)
+	if isZero == 1 {
+		return p.Set(&g2Infinity)
+	}
+
+	var res G2Jac
+	var t G2Affine
+	res.Set(&g2Infinity)
+	for j, d := range digits {
+		if d > 0 {
+			res.AddMixed(&table[j][d>>1])
+		} else {
+			t.Neg(&table[j][-d>>1])
+			res.AddMixed(&t)
+		}
+	}
+	if negated == 1 {
+		res.Neg(&res)
+	}
+
+	return p.Set(&res)
+}
+
+var (
+	_g2BaseTable     [][1 << (baseWindow - 1)]G2Affine
+	_g2BaseTableOnce sync.Once
+)
+
+// g2BaseTable returns the table T[j][i] = (2i+1) ⋅ 2ᶜʲ ⋅ g, where g is the prime
+// subgroup generator and c = baseWindow. It is computed on first use.
+func g2BaseTable() [][1 << (baseWindow - 1)]G2Affine {
+	_g2BaseTableOnce.Do(func() {
+		const nbEntries = 1 << (baseWindow - 1)
+		points := make([]G2Jac, nbBaseDigits*nbEntries)
+
+		var base, base2 G2Jac
+		base.Set(&g2Gen)
+		for j := 0; j < nbBaseDigits; j++ {
+			row := points[j*nbEntries : (j+1)*nbEntries]
+			base2.Double(&base)
+			row[0].Set(&base)
+			for i := 1; i < nbEntries; i++ {
+				row[i].Set(&row[i-1]).AddAssign(&base2)
+			}
+			// base = 2ᶜ ⋅ base
+			base.Set(&base2)
+			for i := 1; i < baseWindow; i++ {
+				base.DoubleAssign()
+			}
+		}
+		affine := make([]G2Affine, len(points))
+		parallel.Execute(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				affine[i].FromJacobian(&points[i])
+			}
+		})
+
+		table := make([][nbEntries]G2Affine, nbBaseDigits)
+		for j := range table {
+			copy(table[j][:], affine[j*nbEntries:])
+		}
+		_g2BaseTable = table
+	})
+	return _g2BaseTable
+}
+
 // ψ(p) = u o π o u⁻¹ where u:E'→E iso from the twist to E
 func (p *G2Jac) psi(a *G2Jac) *G2Jac {
 	p.Set(a)
@@ -952,7 +1029,7 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 	toReturn := make([]G2Affine, len(scalars))
 
 	// partition the scalars into digits
-	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
+	digits, _ := partitionScalars(scalars, c, runtime.NumCPU(), nil)
 
 	// for each digit, take value in the base table, double it c time, voilà.
 	parallel.Execute(len(scalars), func(start, end int) {
@@ -437,6 +437,67 @@ func TestG2AffineOps(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2JacScalarMultiplicationBase(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BLS12-378] scalar multiplication with the base table and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+			var bs, nbs big.Int
+			s.BigInt(&bs)
+			nbs.Neg(&bs)
+
+			var op1, op2, op3, op4 G2Jac
+			op1.mulBase(&bs)
+			op2.ScalarMultiplication(&g2Gen, &bs)
+			op3.mulBase(&nbs)
+			op4.ScalarMultiplication(&g2Gen, &nbs)
+
+			var a1, a2 G2Affine
+			a1.ScalarMultiplicationBase(&bs)
+			a2.FromJacobian(&op2)
+
+			return op1.Equal(&op2) && op3.Equal(&op4) && a1.Equal(&a2)
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases: small scalars, scalars close to r, negative and larger than r
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(31),
+		big.NewInt(32),
+		big.NewInt(-3),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Sub(r, big.NewInt(2)),
+		new(big.Int).Set(r),
+		new(big.Int).Add(r, big.NewInt(5)),
+		new(big.Int).Lsh(big.NewInt(1), fr.Bits-1),
+	}
+	for _, s := range scalars {
+		var op1, op2 G2Jac
+		op1.mulBase(s)
+		op2.mulWindowed(&g2Gen, s)
+		if !op1.Equal(&op2) {
+			t.Fatalf("scalar multiplication with the base table by %s is incorrect", s.String())
+		}
+	}
+}
+
 func TestG2AffineCofactorCleaning(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -659,6 +720,15 @@ func BenchmarkG2JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var base G2Jac
+	g2BaseTable()
+	b.Run("base table", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			base.mulBase(&scalar)
+		}
+	})
+
 }
 
 func BenchmarkG2AffineCofactorClearing(b *testing.B) {
@@ -21,7 +21,8 @@ func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 	c uint64,
 	points []G1Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	done <-chan struct{}) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -35,6 +36,10 @@ func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 
 	// for each scalars, get the digit corresponding to the chunk we're processing.
 	for i, digit := range digits {
+		if i%msmCancelCheckInterval == 0 && isDone(done) {
+			// the msm was cancelled, the result will be discarded.
+			break
+		}
 		if digit == 0 {
 			continue
 		}
@@ -112,7 +117,8 @@ func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 	c uint64,
 	points []G2Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	done <-chan struct{}) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -126,6 +132,10 @@ func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 
 	// for each scalars, get the digit corresponding to the chunk we're processing.
 	for i, digit := range digits {
+		if i%msmCancelCheckInterval == 0 && isDone(done) {
+			// the msm was cancelled, the result will be discarded.
+			break
+		}
 		if digit == 0 {
 			continue
 		}
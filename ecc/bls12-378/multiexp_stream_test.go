@@ -0,0 +1,188 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+)
+
+func TestMultiExpStreamG1(t *testing.T) {
+	const nbSamples = 73
+	samplePoints := make([]G1Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	fillBenchScalars(sampleScalars)
+	// points at infinity must round trip through the raw encoding
+	samplePoints[5].setInfinity()
+
+	var bufPoints, bufScalars bytes.Buffer
+	if err := NewEncoder(&bufPoints, RawEncoding()).Encode(samplePoints); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewEncoder(&bufScalars).Encode(sampleScalars); err != nil {
+		t.Fatal(err)
+	}
+
+	var expected G1Affine
+	if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, chunkSize := range []int{1, 10, nbSamples, 2 * nbSamples} {
+		var got G1Affine
+		_, err := got.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), bytes.NewReader(bufScalars.Bytes()), chunkSize, ecc.MultiExpConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("streaming msm with chunk size %d differs from MultiExp", chunkSize)
+		}
+	}
+
+	t.Run("scheduler", func(t *testing.T) {
+		// with a single task, the next chunk is read on the calling go routine
+		var got G1Affine
+		config := ecc.MultiExpConfig{Scheduler: scheduler.New(1)}
+		if _, err := got.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), bytes.NewReader(bufScalars.Bytes()), 10, config); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("streaming msm with a scheduler of 1 task differs from MultiExp")
+		}
+	})
+
+	t.Run("compressed points", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf).Encode(samplePoints); err != nil {
+			t.Fatal(err)
+		}
+		var p G1Affine
+		if _, err := p.MultiExpStream(&buf, bytes.NewReader(bufScalars.Bytes()), 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on compressed points")
+		}
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf).Encode(sampleScalars[1:]); err != nil {
+			t.Fatal(err)
+		}
+		var p G1Affine
+		if _, err := p.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), &buf, 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on length mismatch")
+		}
+	})
+
+	t.Run("truncated stream", func(t *testing.T) {
+		truncated := bufPoints.Bytes()[:bufPoints.Len()-1]
+		var p G1Affine
+		if _, err := p.MultiExpStream(bytes.NewReader(truncated), bytes.NewReader(bufScalars.Bytes()), 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on a truncated stream")
+		}
+	})
+}
+
+func TestMultiExpStreamG2(t *testing.T) {
+	const nbSamples = 73
+	samplePoints := make([]G2Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	fillBenchScalars(sampleScalars)
+	// points at infinity must round trip through the raw encoding
+	samplePoints[5].setInfinity()
+
+	var bufPoints, bufScalars bytes.Buffer
+	if err := NewEncoder(&bufPoints, RawEncoding()).Encode(samplePoints); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewEncoder(&bufScalars).Encode(sampleScalars); err != nil {
+		t.Fatal(err)
+	}
+
+	var expected G2Affine
+	if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, chunkSize := range []int{1, 10, nbSamples, 2 * nbSamples} {
+		var got G2Affine
+		_, err := got.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), bytes.NewReader(bufScalars.Bytes()), chunkSize, ecc.MultiExpConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("streaming msm with chunk size %d differs from MultiExp", chunkSize)
+		}
+	}
+
+	t.Run("scheduler", func(t *testing.T) {
+		// with a single task, the next chunk is read on the calling go routine
+		var got G2Affine
+		config := ecc.MultiExpConfig{Scheduler: scheduler.New(1)}
+		if _, err := got.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), bytes.NewReader(bufScalars.Bytes()), 10, config); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("streaming msm with a scheduler of 1 task differs from MultiExp")
+		}
+	})
+
+	t.Run("compressed points", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf).Encode(samplePoints); err != nil {
+			t.Fatal(err)
+		}
+		var p G2Affine
+		if _, err := p.MultiExpStream(&buf, bytes.NewReader(bufScalars.Bytes()), 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on compressed points")
+		}
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf).Encode(sampleScalars[1:]); err != nil {
+			t.Fatal(err)
+		}
+		var p G2Affine
+		if _, err := p.MultiExpStream(bytes.NewReader(bufPoints.Bytes()), &buf, 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on length mismatch")
+		}
+	})
+
+	t.Run("truncated stream", func(t *testing.T) {
+		truncated := bufPoints.Bytes()[:bufPoints.Len()-1]
+		var p G2Affine
+		if _, err := p.MultiExpStream(bytes.NewReader(truncated), bytes.NewReader(bufScalars.Bytes()), 10, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error on a truncated stream")
+		}
+	})
+}
@@ -0,0 +1,462 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"encoding/binary"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fri"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// NewFRI returns a commitment scheme for polynomials of size at most size, built on the
+// FRI of the fri package configured with params, with h as hash function.
+//
+// A commitment is the proof of proximity of the polynomial. To open the polynomials pᵢ
+// at z, the prover folds them into f = ∑ᵢγⁱpᵢ and sends the proof of proximity of
+// q = (f-f(z))/(X-z). The verifier checks q(x)(x-z) = f(x)-f(z) on as many points x of
+// the evaluation domain as the fri queries, on which the pᵢ and q are opened. γ and the
+// points x are derived with Fiat-Shamir.
+func NewFRI(size uint64, h hash.Hash, params fri.Params) (PolynomialCommitmentScheme, error) {
+	n := ecc.NextPowerOfTwo(size)
+	iopp, err := fri.MULTIPLICATIVE_FRI.New(n, h, params)
+	if err != nil {
+		return nil, err
+	}
+	params = iopp.Params()
+	domain := fft.NewDomain(n*uint64(params.Rho), fft.WithoutPrecompute())
+	return &friScheme{
+		iopp:        iopp,
+		h:           h,
+		size:        n,
+		cardinality: domain.Cardinality,
+		generator:   domain.Generator,
+		nbQueries:   params.NbQueries,
+	}, nil
+}
+
+type friScheme struct {
+	iopp fri.Iopp
+	h    hash.Hash
+
+	// maximum size of the polynomials
+	size uint64
+
+	// size and generator of the evaluation domain
+	cardinality uint64
+	generator   fr.Element
+
+	nbQueries int
+}
+
+type friCommitment struct {
+	pp fri.ProofOfProximity
+}
+
+// friOpeningProof is both the opening proof and the batch opening proof of the fri scheme
+type friOpeningProof struct {
+	claimedValues []fr.Element
+
+	// proof of proximity of the quotient
+	quotient fri.ProofOfProximity
+
+	// openings[k][i] opens the i-th polynomial on the k-th query,
+	// and openings[k][len(claimedValues)] opens the quotient.
+	openings [][]fri.OpeningProof
+}
+
+func (s *friScheme) Commit(p []fr.Element) (Commitment, error) {
+	if uint64(len(p)) > s.size {
+		return nil, ErrInvalidPolynomialSize
+	}
+	pp, err := s.iopp.BuildProofOfProximity(p)
+	if err != nil {
+		return nil, err
+	}
+	return &friCommitment{pp: pp}, nil
+}
+
+func (s *friScheme) Open(p []fr.Element, commitment Commitment, point fr.Element) (OpeningProof, error) {
+	return s.open([][]fr.Element{p}, []Commitment{commitment}, point)
+}
+
+func (s *friScheme) Verify(commitment Commitment, proof OpeningProof, point fr.Element) error {
+	p, ok := proof.(*friOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	return s.verify([]Commitment{commitment}, p, point)
+}
+
+func (s *friScheme) BatchOpen(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	return s.open(polynomials, commitments, point, dataTranscript...)
+}
+
+func (s *friScheme) BatchVerify(commitments []Commitment, proof BatchOpeningProof, point fr.Element, dataTranscript ...[]byte) error {
+	p, ok := proof.(*friOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	return s.verify(commitments, p, point, dataTranscript...)
+}
+
+func (s *friScheme) NewCommitment() Commitment {
+	return new(friCommitment)
+}
+
+func (s *friScheme) NewOpeningProof() OpeningProof {
+	return new(friOpeningProof)
+}
+
+func (s *friScheme) NewBatchOpeningProof() BatchOpeningProof {
+	return new(friOpeningProof)
+}
+
+func (s *friScheme) open(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, dataTranscript ...[]byte) (*friOpeningProof, error) {
+	if len(polynomials) == 0 {
+		return nil, ErrZeroNbCommitments
+	}
+	if len(polynomials) != len(commitments) {
+		return nil, ErrInvalidNbCommitments
+	}
+	roots, err := friRoots(commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	var proof friOpeningProof
+	proof.claimedValues = make([]fr.Element, len(polynomials))
+	largest := 0
+	for i := range polynomials {
+		if uint64(len(polynomials[i])) > s.size {
+			return nil, ErrInvalidPolynomialSize
+		}
+		if len(polynomials[i]) > largest {
+			largest = len(polynomials[i])
+		}
+		proof.claimedValues[i] = eval(polynomials[i], point)
+	}
+
+	fs := fiatshamir.NewTranscript(s.h, "gamma", "queries")
+	gamma, err := s.deriveGamma(fs, roots, proof.claimedValues, point, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+
+	// f = ∑ᵢγⁱpᵢ, f(z) = ∑ᵢγⁱpᵢ(z)
+	f := make([]fr.Element, largest)
+	var fz, acc, tmp fr.Element
+	acc.SetOne()
+	for i := range polynomials {
+		for j := range polynomials[i] {
+			tmp.Mul(&polynomials[i][j], &acc)
+			f[j].Add(&f[j], &tmp)
+		}
+		tmp.Mul(&proof.claimedValues[i], &acc)
+		fz.Add(&fz, &tmp)
+		acc.Mul(&acc, &gamma)
+	}
+
+	// q = (f-f(z))/(X-z)
+	if len(f) > 0 {
+		f[0].Sub(&f[0], &fz)
+	}
+	q := divideByXMinusZ(f, point)
+	if proof.quotient, err = s.iopp.BuildProofOfProximity(q); err != nil {
+		return nil, err
+	}
+
+	positions, err := s.deriveQueries(fs, &proof.quotient)
+	if err != nil {
+		return nil, err
+	}
+	proof.openings = make([][]fri.OpeningProof, len(positions))
+	for k := range positions {
+		proof.openings[k] = make([]fri.OpeningProof, len(polynomials)+1)
+		for i := range polynomials {
+			if proof.openings[k][i], err = s.iopp.Open(polynomials[i], positions[k]); err != nil {
+				return nil, err
+			}
+		}
+		if proof.openings[k][len(polynomials)], err = s.iopp.Open(q, positions[k]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proof, nil
+}
+
+func (s *friScheme) verify(commitments []Commitment, proof *friOpeningProof, point fr.Element, dataTranscript ...[]byte) error {
+	if len(commitments) == 0 {
+		return ErrZeroNbCommitments
+	}
+	if len(commitments) != len(proof.claimedValues) {
+		return ErrInvalidNbCommitments
+	}
+	if len(proof.openings) != s.nbQueries {
+		return ErrInvalidProof
+	}
+	roots, err := friRoots(commitments)
+	if err != nil {
+		return err
+	}
+
+	// the committed functions are close to polynomials
+	for i := range commitments {
+		if err := s.iopp.VerifyProofOfProximity(commitments[i].(*friCommitment).pp); err != nil {
+			return err
+		}
+	}
+	if err := s.iopp.VerifyProofOfProximity(proof.quotient); err != nil {
+		return err
+	}
+
+	fs := fiatshamir.NewTranscript(s.h, "gamma", "queries")
+	gamma, err := s.deriveGamma(fs, roots, proof.claimedValues, point, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	positions, err := s.deriveQueries(fs, &proof.quotient)
+	if err != nil {
+		return err
+	}
+
+	var fz, acc, tmp fr.Element
+	acc.SetOne()
+	for i := range proof.claimedValues {
+		tmp.Mul(&proof.claimedValues[i], &acc)
+		fz.Add(&fz, &tmp)
+		acc.Mul(&acc, &gamma)
+	}
+
+	// q(x)(x-z) = f(x)-f(z) on the queried points
+	for k := range positions {
+		if len(proof.openings[k]) != len(commitments)+1 {
+			return ErrInvalidProof
+		}
+		var fx, qx, v, x fr.Element
+		acc.SetOne()
+		for i := range commitments {
+			pp := commitments[i].(*friCommitment).pp
+			if v, err = s.verifyOpening(positions[k], proof.openings[k][i], pp); err != nil {
+				return err
+			}
+			v.Mul(&v, &acc)
+			fx.Add(&fx, &v)
+			acc.Mul(&acc, &gamma)
+		}
+		if qx, err = s.verifyOpening(positions[k], proof.openings[k][len(commitments)], proof.quotient); err != nil {
+			return err
+		}
+
+		x.Exp(s.generator, new(big.Int).SetUint64(positions[k]))
+		x.Sub(&x, &point)
+		qx.Mul(&qx, &x)
+		fx.Sub(&fx, &fz)
+		if !qx.Equal(&fx) {
+			return ErrVerifyOpeningProof
+		}
+	}
+
+	return nil
+}
+
+// verifyOpening checks the opening of the function committed to in pp at position,
+// and returns the opened value
+func (s *friScheme) verifyOpening(position uint64, opening fri.OpeningProof, pp fri.ProofOfProximity) (fr.Element, error) {
+	var v fr.Element
+	if err := s.iopp.VerifyOpening(position, opening, pp); err != nil {
+		return v, err
+	}
+	return opening.ClaimedValue, nil
+}
+
+// deriveGamma binds the commitments, the claimed values, the point and dataTranscript to fs,
+// and returns the folding challenge γ
+func (s *friScheme) deriveGamma(fs *fiatshamir.Transcript, roots [][]byte, claimedValues []fr.Element, point fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	data := make([][]byte, 0, 1+len(roots)+len(claimedValues)+len(dataTranscript))
+	data = append(data, point.Marshal())
+	data = append(data, roots...)
+	for i := range claimedValues {
+		data = append(data, claimedValues[i].Marshal())
+	}
+	data = append(data, dataTranscript...)
+
+	var gamma fr.Element
+	b, err := challenge(fs, "gamma", data...)
+	if err != nil {
+		return gamma, err
+	}
+	gamma.SetBytes(b)
+	return gamma, nil
+}
+
+// deriveQueries binds the commitment to the quotient to fs, and returns the queried positions
+func (s *friScheme) deriveQueries(fs *fiatshamir.Transcript, quotient *fri.ProofOfProximity) ([]uint64, error) {
+	root, err := friRoot(quotient)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := challenge(fs, "queries", root)
+	if err != nil {
+		return nil, err
+	}
+	return deriveQueries(s.h, seed, s.nbQueries, s.cardinality), nil
+}
+
+// friRoots returns the Merkle roots of the evaluations committed to in commitments
+func friRoots(commitments []Commitment) ([][]byte, error) {
+	roots := make([][]byte, len(commitments))
+	for i := range commitments {
+		c, ok := commitments[i].(*friCommitment)
+		if !ok {
+			return nil, ErrUnexpectedType
+		}
+		root, err := friRoot(&c.pp)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = root
+	}
+	return roots, nil
+}
+
+// friRoot returns the Merkle root of the evaluations whose proximity pp proves
+func friRoot(pp *fri.ProofOfProximity) ([]byte, error) {
+	if len(pp.Roots) == 0 {
+		return nil, ErrInvalidProof
+	}
+	return pp.Roots[0], nil
+}
+
+// divideByXMinusZ returns f/(X-z), assuming f(z) = 0
+func divideByXMinusZ(f []fr.Element, z fr.Element) []fr.Element {
+	if len(f) <= 1 {
+		return []fr.Element{}
+	}
+	q := make([]fr.Element, len(f)-1)
+	q[len(q)-1] = f[len(f)-1]
+	for i := len(q) - 2; i >= 0; i-- {
+		q[i].Mul(&q[i+1], &z).Add(&q[i], &f[i+1])
+	}
+	return q
+}
+
+func (c *friCommitment) WriteTo(w io.Writer) (int64, error) {
+	return c.pp.WriteTo(w)
+}
+
+func (c *friCommitment) ReadFrom(r io.Reader) (int64, error) {
+	return c.pp.ReadFrom(r)
+}
+
+func (p *friOpeningProof) ClaimedValue() fr.Element {
+	if len(p.claimedValues) == 0 {
+		return fr.Element{}
+	}
+	return p.claimedValues[0]
+}
+
+func (p *friOpeningProof) ClaimedValues() []fr.Element {
+	return p.claimedValues
+}
+
+// WriteTo writes the claimed values, the proof of proximity of the quotient and the
+// openings to w.
+func (p *friOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	claimedValues := fr.Vector(p.claimedValues)
+	n, err := claimedValues.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	m, err := p.quotient.WriteTo(w)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	var header [8]byte
+	nbOpenings := uint32(0)
+	if len(p.openings) > 0 {
+		nbOpenings = uint32(len(p.openings[0]))
+	}
+	binary.BigEndian.PutUint32(header[:4], uint32(len(p.openings)))
+	binary.BigEndian.PutUint32(header[4:], nbOpenings)
+	k, err := w.Write(header[:])
+	n += int64(k)
+	if err != nil {
+		return n, err
+	}
+	for i := range p.openings {
+		if uint32(len(p.openings[i])) != nbOpenings {
+			return n, ErrInvalidProof
+		}
+		for j := range p.openings[i] {
+			m, err = p.openings[i][j].WriteTo(w)
+			n += m
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom decodes a proof written by WriteTo from r.
+func (p *friOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	var claimedValues fr.Vector
+	n, err := claimedValues.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	p.claimedValues = claimedValues
+	m, err := p.quotient.ReadFrom(r)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	var header [8]byte
+	k, err := io.ReadFull(r, header[:])
+	n += int64(k)
+	if err != nil {
+		return n, err
+	}
+	nbQueries, nbOpenings := binary.BigEndian.Uint32(header[:4]), binary.BigEndian.Uint32(header[4:])
+	if uint64(nbQueries)*uint64(nbOpenings) > maxNbOpenings {
+		return n, ErrInvalidProof
+	}
+	p.openings = make([][]fri.OpeningProof, nbQueries)
+	for i := range p.openings {
+		p.openings[i] = make([]fri.OpeningProof, nbOpenings)
+		for j := range p.openings[i] {
+			m, err = p.openings[i][j].ReadFrom(r)
+			n += m
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// maxNbOpenings bounds the number of openings read from a fri proof
+const maxNbOpenings = 1 << 20
@@ -0,0 +1,145 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrUnexpectedType        = errors.New("the commitment or the proof was not produced by this scheme")
+	ErrInvalidNbCommitments  = errors.New("number of commitments is not the same as the number of polynomials")
+	ErrZeroNbCommitments     = errors.New("number of commitments is zero")
+	ErrInvalidPolynomialSize = errors.New("invalid polynomial size")
+	ErrInvalidProof          = errors.New("malformed proof")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// Commitment to a polynomial.
+type Commitment interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+// OpeningProof proves the evaluation of a committed polynomial at a point.
+type OpeningProof interface {
+	io.WriterTo
+	io.ReaderFrom
+
+	// ClaimedValue returns the evaluation of the polynomial, claimed by the prover.
+	ClaimedValue() fr.Element
+}
+
+// BatchOpeningProof proves the evaluations of several committed polynomials at a point.
+type BatchOpeningProof interface {
+	io.WriterTo
+	io.ReaderFrom
+
+	// ClaimedValues returns the evaluations of the polynomials, claimed by the prover,
+	// in the order of the commitments.
+	ClaimedValues() []fr.Element
+}
+
+// PolynomialCommitmentScheme is implemented by the commitment schemes to
+// univariate polynomials in canonical basis.
+type PolynomialCommitmentScheme interface {
+
+	// Commit returns a commitment to p.
+	Commit(p []fr.Element) (Commitment, error)
+
+	// Open returns a proof that p, committed to in commitment, evaluates
+	// to the ClaimedValue of the proof at point.
+	Open(p []fr.Element, commitment Commitment, point fr.Element) (OpeningProof, error)
+
+	// Verify returns an error if proof doesn't prove the evaluation
+	// at point of the polynomial committed to in commitment.
+	Verify(commitment Commitment, proof OpeningProof, point fr.Element) error
+
+	// BatchOpen returns a proof that polynomials[i], committed to in commitments[i], evaluates
+	// to the i-th ClaimedValues of the proof at point.
+	// dataTranscript is bound to the Fiat-Shamir transcript the challenges of the proof
+	// are derived from; the verifier must provide the same data.
+	BatchOpen(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, dataTranscript ...[]byte) (BatchOpeningProof, error)
+
+	// BatchVerify returns an error if proof doesn't prove the evaluations at point
+	// of the polynomials committed to in commitments.
+	BatchVerify(commitments []Commitment, proof BatchOpeningProof, point fr.Element, dataTranscript ...[]byte) error
+
+	// NewCommitment returns an empty commitment, to decode a commitment of the scheme into.
+	NewCommitment() Commitment
+
+	// NewOpeningProof returns an empty opening proof, to decode a proof of the scheme into.
+	NewOpeningProof() OpeningProof
+
+	// NewBatchOpeningProof returns an empty batch opening proof, to decode a proof of the scheme into.
+	NewBatchOpeningProof() BatchOpeningProof
+}
+
+// Bind binds the encoding of v, typically a commitment, to the challenge challengeID of fs.
+func Bind(fs *fiatshamir.Transcript, challengeID string, v io.WriterTo) error {
+	var buf bytes.Buffer
+	if _, err := v.WriteTo(&buf); err != nil {
+		return err
+	}
+	return fs.Bind(challengeID, buf.Bytes())
+}
+
+// eval returns p(point) where p is interpreted as a polynomial
+// ∑_{i<len(p)}p[i]Xⁱ
+func eval(p []fr.Element, point fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &p[i])
+	}
+	return res
+}
+
+// deriveQueries returns nbQueries positions in [0, n) derived from seed, by hashing
+// seed ∥ i for each query i.
+func deriveQueries(h hash.Hash, seed []byte, nbQueries int, n uint64) []uint64 {
+	res := make([]uint64, nbQueries)
+	var buf [8]byte
+	var bPos, bN big.Int
+	bN.SetUint64(n)
+	for i := range res {
+		h.Reset()
+		h.Write(seed)
+		binary.BigEndian.PutUint64(buf[:], uint64(i))
+		h.Write(buf[:])
+		bPos.SetBytes(h.Sum(nil))
+		res[i] = bPos.Mod(&bPos, &bN).Uint64()
+	}
+	return res
+}
+
+// challenge binds data to the challenge challengeID of fs, and returns the challenge
+func challenge(fs *fiatshamir.Transcript, challengeID string, data ...[]byte) ([]byte, error) {
+	for i := range data {
+		if err := fs.Bind(challengeID, data[i]); err != nil {
+			return nil, err
+		}
+	}
+	return fs.ComputeChallenge(challengeID)
+}
@@ -65,3 +65,8 @@ func initCurveParams() {
 func mulByA(x *fr.Element) {
 	x.Mul(x, &curveParams.A)
 }
+
+// mulByAConstantTime is mulByA using the constant-time field operations
+func mulByAConstantTime(x *fr.Element) {
+	x.MulConstantTime(x, &curveParams.A)
+}
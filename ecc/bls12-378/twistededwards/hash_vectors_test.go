@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12378_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0xaba9a6fd46905c5797c63396862dc0c3167fb71866fda70da2a833b9839f06d", "0x132727f65074c468700dfcc77545d9e0d0c3567303604084662531a21af0c256"},
+				Q: point{"0x789b09eb4bfe130b7258556dcd5d32f2158ee08606248c6454412e56f15151", "0x1e3ef37e1efd5ac5b56f6262b9e2ab7d67a2cf11fa5c8672fed0b241b8de70dd"},
+				u: "0x137a85d8768bada803f3a4fbfeb9551e8a23299b79d55d67fe1354cf8139b695",
+			},
+			{
+				msg: "abc", P: point{"0xadccf34839d8ed168bf980d5570e93975653f60fb1f7fb0516d75a14e5cdcc7", "0x1241344f93fb091b98e29054a5ba57ad25e4f21f00a241674535ca6d57118fd6"},
+				Q: point{"0xc0142a8499143007178820d6b3cd4903c280f2e523aef69ef1da4820713acf6", "0x9aa3a86e853ef1fc39e7d81df87780745ca687f513869e6a353f50c948c1ae9"},
+				u: "0xdb39d63fab99af3d561689555807d01ccfc1403cbdcbbb59a30fef35e66559c",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x16855c88e3702a63325e880f243c1315c2881d7f9aba2782e137aebd45f39f6b", "0x43bea58f590b2cbb9d0555019fc9faae79e60e44efc061bebe50bb345787b36"},
+				Q: point{"0xa41b72eab5ac583afa7159f5ca427578786bd7220eafca219106fcd9307287c", "0x1cedaaf2fadf532f439d2aec780cd9a8e4f8664aea1954362750d26d9caea3f6"},
+				u: "0x529eff33a16e36fe51b743609e03a30bfc058b41fcf7376c4072aab14f62485",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0xd0bc3dd7c3232747f90d7bf9e83ec75e5aa012e6d1631f3be6dffcca3555e2", "0xa0d265e6c24c843a380ddc50d042cf2d2815baef06d7538185b7c4fe16ccfe4"},
+				Q: point{"0x19c877f83a273e64fa291482f2724f99c9dec106eb6cca75dc4b88ac6f4deae9", "0xf06ba9a04c00de004e8c8a419efac2e6964ec1bacefd0ef6ececf08b182fe5a"},
+				u: "0x8d2bcec5978a976c9044c8ca575307dcc9509b3eb929be9b028c908b3afce35",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x1db8143ad315b6a7b1a06e4349d80fd4049b423a9d837fe5104990d715c3f3c6", "0x1985b32d3afa834adf0e5a86aa3475999f5b14a1c86827fa3547dc207ff0f003"},
+				Q: point{"0x1226aa58e7e546c4158d16fe320fb7e08e91987696667e6cd75d51b89d191b0a", "0x11091421209beda567d06d05778f292589a0d90a842ddd2abdfba8961d336575"},
+				u: "0x1ee2055cdee2437c78c55789c94a074ab8a537c48f4e01cbffbb51f0d114eabb",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12378_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x1110cb3cb5c0f1981565a6372472ec42ed30d01da9fa2f7d8ab13087dc802a69", "0x12c6b85c399c4e63f2a7dce068a47daf03cb4311dba7a7a14f024752e6f4f3fe"},
+				Q0: point{"0x7e9b1ad94f8421632f7a3db4d8fd753e17740db37d83c96335d52888ef92b", "0x438794a0fe38a04dc78c6d5ea0b8cfc243f61b5541b8811c12f2e7d1ed794dc"},
+				Q1: point{"0x531d9f26a9277378de8338021ad55cb9cfd6656d1d7c45b3b4ddd04dbb0c8d5", "0x1e108bb10c51c7cb24f65bdc8e77b5a308f3159db2f636315706dc392858a10d"},
+				u0: "0x5ddd2439c1e601d86a0b2767c97515987c01d7833dc983f74e02a0dfb509340", u1: "0x4d0e7d99c83eabc33b4ad83e630d5c3ed77792adfc379fee5dc314141248a2b",
+			},
+			{
+				msg: "abc", P: point{"0x167daba05ea62cf7c5e434e3346a994302b7c5cdb9a1db96b62a5186224c0364", "0x1c9948c7461fb4459880649ad43fe798ad39b6f6891a5d47b432d253a644edb2"},
+				Q0: point{"0x167d8ee95a0d04244053c98648bebf9e3536746e0f856d66612b4046f4a6d2e0", "0x1dc3ae686fdabf7349afaff5ef76f5ce92cd08196a3b5f2f6c1dfa162b26dbb6"},
+				Q1: point{"0x98b96f1b2b518b07a7dd94374e831e765bf0a3a2be4b3589c612a0958bf53b7", "0x637e0efa61772d94314b16b221e6b265fffcd6d59a01c71df51c4d19ca99e57"},
+				u0: "0xd29726c1a96eabcbe583a6a6ede8ecdd6eb8a7c13b369335b719309714da5c3", u1: "0x772c52d09c06341496622d3d8c4bdc08f185cf6358135a8e2eeb46556d8394",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x10052a3e31658f14e9e654376c16ae25fde5f214eadfda20fc00061a364edbff", "0x7c2d095b397759876167b43ee5b57d3bee742d50e99f8509eee434fc6d8283f"},
+				Q0: point{"0x1075f84722f31e200e207d1c9f3fd82cf2a4e7c21a5dc58073b26b83c9eaa4f6", "0x16235c71bb593cbc77da4b0c2f4c3f9611c8e9e8c43dd499873e3c68614f2170"},
+				Q1: point{"0x48655b12ce4dd9dac63f0954591601cfd8ccd48d1cb77f394a4aceb07808e2c", "0x493bbee6c921bcb5b4ecc16081972e5ff3ea3f60a43699e7b5a884f488c1e50"},
+				u0: "0x83a987520d31cdb8a81d94c204291a6c8e0ced773e1735c7259ea6672fafc11", u1: "0x1aa302d66ddb515f1544055b9ceb1d01a58f964220b86d2c0c5402ed3a88edd0",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x19a15dca601cbe3d4a783840789ac6109520ed8f1cb00af02b1b9793747d9401", "0x90db599ab460c685e81683933f21372b6be8a6531477190dcf397977c057873"},
+				Q0: point{"0x451b5bd44c6f2bfa4467e05373647b22b40eea38742a34b1ac43df9c8649e3c", "0x171fce04964f54c69219aac68c067bb36837d15d70d5225f57b34bb2a161e338"},
+				Q1: point{"0x1756b096c2595defe75b30a57ee2d9d9e4be69aa064816163f13dccf5b5f9a5c", "0x9f03f91950106479a571f7d5b749b36215573224e62ff2c462accc4e63bced6"},
+				u0: "0x1aebe71c10795ef079924d674a33b51530b8d4996ea6ba317c3b30c5eb4f8c79", u1: "0xc391a33b176d3ff84f2b2443268067779c053308970979144baf081931a31fa",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x88b1346eb521457a2d83486ba178946b0d3bfcc56d86e88319eae64668338e9", "0x208ab0614bee514f54f04d96c5d59dd82f520b3abfda7822630b15df12ffac5"},
+				Q0: point{"0x1acece54bdc73a8e134808514e767c9c2967e166a7e16e58c0cd02a69a532a22", "0x15846b0ea0d7a4162ebf045e36a5c3725229463e44f8cffedc14628a0a06623d"},
+				Q1: point{"0x12d0f191c15c081d6c2912ee1c6299103798a98932d7538fca79c9b2e6fdd43b", "0x13aef32c86242bd1fdd811423d1fc8ed91de58bbb00b72d41bcb414168c4a764"},
+				u0: "0x2853cd87c1801c19913ed6aef5c6bc72afafdc13a0cc1e3605ecac9d1441961", u1: "0x11ebdb8e6a76c09182f238682fa8514d60c16c2f63e330d6ee871e8bd7a8577a",
+			},
+		}}
+}
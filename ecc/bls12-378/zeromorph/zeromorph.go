@@ -0,0 +1,471 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidNbDigests      = errors.New("number of digests is not the same as the number of polynomials")
+	ErrZeroNbDigests         = errors.New("number of digests is zero")
+	ErrInvalidPolynomialSize = errors.New("invalid polynomial size (larger than SRS or < 2)")
+	ErrInvalidPointSize      = errors.New("the number of coordinates of the point is not the number of variables")
+	ErrInvalidProof          = errors.New("malformed proof")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// Digest commitment of a multilinear polynomial: the kzg commitment of
+// the univariate polynomial ∑_{b∈{0,1}ⁿ}f(b)X^b, where b is read as an integer
+// as in polynomial.MultiLin.
+type Digest = kzg.Digest
+
+// VerifyingKey is the kzg verifying key of the SRS, along with the size of the SRS,
+// which bounds the degrees of the polynomials a prover can commit to.
+type VerifyingKey struct {
+	kzg.VerifyingKey
+	SRSSize uint64
+}
+
+// NewVerifyingKey returns the verifying key of srs.
+func NewVerifyingKey(srs *kzg.SRS) VerifyingKey {
+	return VerifyingKey{VerifyingKey: srs.Vk, SRSSize: uint64(len(srs.Pk.G1))}
+}
+
+// OpeningProof proof of the evaluation of a multilinear polynomial at a point.
+type OpeningProof struct {
+	// Quotients[k] commitment to the quotient qₖ, in k variables, of the
+	// decomposition f - f(u) = ∑ₖ(Xₖ-uₖ)qₖ(X₀, .., Xₖ₋₁)
+	Quotients []bls12378.G1Affine
+
+	// QHat commitment to the batched quotients, shifted to the degree of the SRS
+	QHat bls12378.G1Affine
+
+	// Pi kzg opening proof of the linearized relation
+	Pi bls12378.G1Affine
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// BatchOpeningProof proof of the evaluations of several multilinear polynomials at a point.
+type BatchOpeningProof struct {
+	// opening proof of the random linear combination of the polynomials
+	OpeningProof
+
+	// ClaimedValues purported values
+	ClaimedValues []fr.Element
+}
+
+// Commit commits to the multilinear polynomial p, of 2ⁿ evaluations on the hypercube.
+func Commit(p polynomial.MultiLin, pk kzg.ProvingKey, nbTasks ...int) (Digest, error) {
+	if len(p) < 2 || len(p)&(len(p)-1) != 0 {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	return kzg.Commit(p, pk, nbTasks...)
+}
+
+// Open computes a proof of the evaluation of p at point, using Zeromorph
+// (https://eprint.iacr.org/2023/917).
+//
+// * digest is the commitment to p, bound with the point, the claimed value and dataTranscript
+// to the Fiat-Shamir transcript deriving the challenges.
+// * the coordinates of point are ordered as in polynomial.MultiLin.Evaluate.
+//
+// The cost of the proof is linear in the size of the SRS, which should be close to len(p).
+func Open(p polynomial.MultiLin, point []fr.Element, digest Digest, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (OpeningProof, error) {
+	if err := checkSizes(len(p), point, uint64(len(pk.G1))); err != nil {
+		return OpeningProof{}, err
+	}
+	claimedValue := p.Evaluate(point, nil)
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindStatement(fs, "y", []Digest{digest}, point, []fr.Element{claimedValue}, dataTranscript); err != nil {
+		return OpeningProof{}, err
+	}
+	return open(p, claimedValue, point, fs, pk)
+}
+
+// Verify verifies a proof that the polynomial committed to in digest evaluates
+// to proof.ClaimedValue at point.
+func Verify(digest *Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindStatement(fs, "y", []Digest{*digest}, point, []fr.Element{proof.ClaimedValue}, dataTranscript); err != nil {
+		return err
+	}
+	return verify(digest, proof, point, fs, vk)
+}
+
+// BatchOpen computes a proof of the evaluations of the polynomials, of the same number
+// of variables, at point. It opens the random linear combination ∑ᵢρⁱpᵢ, where ρ is derived
+// with Fiat-Shamir from the digests, the point, the claimed values and dataTranscript.
+func BatchOpen(polynomials []polynomial.MultiLin, digests []Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	if len(polynomials) == 0 {
+		return BatchOpeningProof{}, ErrZeroNbDigests
+	}
+	if len(polynomials) != len(digests) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+
+	var res BatchOpeningProof
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	for i := range polynomials {
+		if err := checkSizes(len(polynomials[i]), point, uint64(len(pk.G1))); err != nil {
+			return BatchOpeningProof{}, err
+		}
+		res.ClaimedValues[i] = polynomials[i].Evaluate(point, nil)
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "rho", "y", "x", "z")
+	rho, err := deriveRho(fs, digests, point, res.ClaimedValues, dataTranscript)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	// f = ∑ᵢρⁱpᵢ
+	f := make(polynomial.MultiLin, len(polynomials[0]))
+	var acc, tmp fr.Element
+	acc.SetOne()
+	for i := range polynomials {
+		for j := range f {
+			tmp.Mul(&polynomials[i][j], &acc)
+			f[j].Add(&f[j], &tmp)
+		}
+		acc.Mul(&acc, &rho)
+	}
+
+	res.OpeningProof, err = open(f, combine(res.ClaimedValues, rho), point, fs, pk)
+	return res, err
+}
+
+// BatchVerify verifies a proof that the polynomials committed to in digests
+// evaluate to proof.ClaimedValues at point.
+func BatchVerify(digests []Digest, proof *BatchOpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+	if len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNbDigests
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "rho", "y", "x", "z")
+	rho, err := deriveRho(fs, digests, point, proof.ClaimedValues, dataTranscript)
+	if err != nil {
+		return err
+	}
+
+	// [f] = ∑ᵢρⁱ[pᵢ]
+	scalars := make([]fr.Element, len(digests))
+	scalars[0].SetOne()
+	for i := 1; i < len(scalars); i++ {
+		scalars[i].Mul(&scalars[i-1], &rho)
+	}
+	var digest Digest
+	if _, err := digest.MultiExp(digests, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	folded := proof.OpeningProof
+	folded.ClaimedValue = combine(proof.ClaimedValues, rho)
+	return verify(&digest, &folded, point, fs, vk)
+}
+
+// open computes the opening proof of f at point. The statement is already bound to fs,
+// whose last three challenges are "y", "x" and "z".
+//
+// With f - v = ∑ₖ(Xₖ-uₖ)qₖ, where uₖ is the coordinate of the variable of weight 2ᵏ,
+// the univariate polynomials satisfy (see the Zeromorph paper)
+//
+//	U(f) - vΦₙ(X) = ∑ₖ(X^{2ᵏ}Φₙ₋ₖ₋₁(X^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(X^{2ᵏ}))U(qₖ)
+//
+// where Φₘ(X) = ∑_{i<2ᵐ}Xⁱ. The degrees of the U(qₖ) are checked by committing to
+// q̂ = ∑ₖyᵏX^{S-2ᵏ}U(qₖ), S being the size of the SRS. Both relations are then
+// checked at a random x, combined with a random z, with a kzg opening.
+func open(f polynomial.MultiLin, claimedValue fr.Element, point []fr.Element, fs *fiatshamir.Transcript, pk kzg.ProvingKey) (OpeningProof, error) {
+	n := len(point)
+	srsSize := len(pk.G1)
+
+	var proof OpeningProof
+	proof.ClaimedValue = claimedValue
+
+	// quotients[k] = qₖ; folding the variables from the most significant one
+	quotients := make([]polynomial.MultiLin, n)
+	t := f.Clone()
+	for i := 0; i < n; i++ {
+		k := n - 1 - i
+		mid := len(t) / 2
+		quotients[k] = make(polynomial.MultiLin, mid)
+		for j := 0; j < mid; j++ {
+			quotients[k][j].Sub(&t[mid+j], &t[j])
+		}
+		t.Fold(point[i])
+	}
+
+	proof.Quotients = make([]bls12378.G1Affine, n)
+	for k := range quotients {
+		var err error
+		if proof.Quotients[k], err = kzg.Commit(quotients[k], pk); err != nil {
+			return OpeningProof{}, err
+		}
+	}
+	y, err := challenge(fs, "y", proof.Quotients...)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// q̂ = ∑ₖyᵏX^{S-2ᵏ}U(qₖ) is supported on the 2ⁿ⁻¹ highest degrees
+	qHat := make([]fr.Element, len(f)/2)
+	offset := srsSize - len(qHat)
+	var yk, tmp fr.Element
+	yk.SetOne()
+	for k := range quotients {
+		start := len(qHat) - len(quotients[k])
+		for j := range quotients[k] {
+			tmp.Mul(&quotients[k][j], &yk)
+			qHat[start+j].Add(&qHat[start+j], &tmp)
+		}
+		yk.Mul(&yk, &y)
+	}
+	if proof.QHat, err = kzg.Commit(qHat, kzg.ProvingKey{G1: pk.G1[offset:]}); err != nil {
+		return OpeningProof{}, err
+	}
+	x, err := challenge(fs, "x", proof.QHat)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	z, err := challenge(fs, "z")
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// P = ζₓ + zZₓ, where
+	// ζₓ = q̂ - ∑ₖyᵏx^{S-2ᵏ}U(qₖ)
+	// Zₓ = U(f) - vΦₙ(x) - ∑ₖcₖU(qₖ), cₖ = x^{2ᵏ}Φₙ₋ₖ₋₁(x^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(x^{2ᵏ})
+	// vanishes at x.
+	p := make([]fr.Element, srsSize)
+	copy(p[offset:], qHat)
+	for j := range f {
+		tmp.Mul(&f[j], &z)
+		p[j].Add(&p[j], &tmp)
+	}
+	coefficients, constant := linearization(point, claimedValue, x, y, z, uint64(srsSize))
+	p[0].Add(&p[0], &constant)
+	for k := range quotients {
+		for j := range quotients[k] {
+			tmp.Mul(&quotients[k][j], &coefficients[k])
+			p[j].Add(&p[j], &tmp)
+		}
+	}
+
+	// Π = P/(X-x)
+	pi := make([]fr.Element, srsSize-1)
+	pi[len(pi)-1] = p[len(p)-1]
+	for j := len(pi) - 2; j >= 0; j-- {
+		pi[j].Mul(&pi[j+1], &x).Add(&pi[j], &p[j+1])
+	}
+	if proof.Pi, err = kzg.Commit(pi, pk); err != nil {
+		return OpeningProof{}, err
+	}
+
+	return proof, nil
+}
+
+// verify verifies the opening proof of the polynomial committed to in digest at point.
+// The statement is already bound to fs, whose last three challenges are "y", "x" and "z".
+func verify(digest *Digest, proof *OpeningProof, point []fr.Element, fs *fiatshamir.Transcript, vk VerifyingKey) error {
+	n := len(point)
+	if n == 0 || n >= 64 || uint64(1)<<n > vk.SRSSize {
+		return ErrInvalidPointSize
+	}
+	if len(proof.Quotients) != n {
+		return ErrInvalidProof
+	}
+
+	y, err := challenge(fs, "y", proof.Quotients...)
+	if err != nil {
+		return err
+	}
+	x, err := challenge(fs, "x", proof.QHat)
+	if err != nil {
+		return err
+	}
+	z, err := challenge(fs, "z")
+	if err != nil {
+		return err
+	}
+
+	// [P] + x[Π] = [q̂] + z[f] + ∑ₖαₖ[qₖ] + β[1] + x[Π]
+	coefficients, constant := linearization(point, proof.ClaimedValue, x, y, z, vk.SRSSize)
+	points := make([]bls12378.G1Affine, 0, n+4)
+	scalars := make([]fr.Element, 0, n+4)
+	var one fr.Element
+	one.SetOne()
+	points = append(points, proof.QHat, *digest, vk.G1, proof.Pi)
+	scalars = append(scalars, one, z, constant, x)
+	points = append(points, proof.Quotients...)
+	scalars = append(scalars, coefficients...)
+
+	var total bls12378.G1Affine
+	if _, err := total.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	var negPi bls12378.G1Affine
+	negPi.Neg(&proof.Pi)
+
+	// e([P] + x[Π], G₂).e(-[Π], [τ]G₂) == 1
+	check, err := bls12378.PairingCheckFixedQ(
+		[]bls12378.G1Affine{total, negPi},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// linearization returns the coefficients -(yᵏx^{S-2ᵏ} + zcₖ) of the [qₖ] in [P],
+// and the constant -zvΦₙ(x)
+func linearization(point []fr.Element, claimedValue, x, y, z fr.Element, srsSize uint64) ([]fr.Element, fr.Element) {
+	n := len(point)
+
+	// x2k[k] = x^{2ᵏ}, k ≤ n
+	x2k := make([]fr.Element, n+1)
+	x2k[0] = x
+	for k := 1; k <= n; k++ {
+		x2k[k].Square(&x2k[k-1])
+	}
+
+	// xS = x^{S-2ⁿ⁻¹}; x^{S-2ᵏ} = xS·x^{2ⁿ⁻¹-2ᵏ}
+	var xS fr.Element
+	xS.Exp(x, new(big.Int).SetUint64(srsSize-uint64(1)<<(n-1)))
+
+	coefficients := make([]fr.Element, n)
+	var yk, shift, c, tmp fr.Element
+	yk.SetOne()
+	for k := 0; k < n; k++ {
+		// x^{S-2ᵏ}
+		shift.Exp(x, new(big.Int).SetUint64(uint64(1)<<(n-1)-uint64(1)<<k)).Mul(&shift, &xS)
+
+		// cₖ = x^{2ᵏ}Φₙ₋ₖ₋₁(x^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(x^{2ᵏ})
+		// the coordinates of point start with the most significant variable
+		c = phi(x2k[k+1], n-k-1)
+		c.Mul(&c, &x2k[k])
+		tmp = phi(x2k[k], n-k)
+		tmp.Mul(&tmp, &point[n-1-k])
+		c.Sub(&c, &tmp)
+
+		coefficients[k].Mul(&yk, &shift)
+		c.Mul(&c, &z)
+		coefficients[k].Add(&coefficients[k], &c).Neg(&coefficients[k])
+		yk.Mul(&yk, &y)
+	}
+
+	constant := phi(x, n)
+	constant.Mul(&constant, &claimedValue).Mul(&constant, &z).Neg(&constant)
+	return coefficients, constant
+}
+
+// phi returns Φₘ(a) = ∑_{i<2ᵐ}aⁱ = ∏_{i<m}(1 + a^{2ⁱ})
+func phi(a fr.Element, m int) fr.Element {
+	var res, one, t fr.Element
+	one.SetOne()
+	res.SetOne()
+	for i := 0; i < m; i++ {
+		t.Add(&a, &one)
+		res.Mul(&res, &t)
+		a.Square(&a)
+	}
+	return res
+}
+
+// combine returns ∑ᵢρⁱvᵢ
+func combine(values []fr.Element, rho fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(values) - 1; i >= 0; i-- {
+		res.Mul(&res, &rho).Add(&res, &values[i])
+	}
+	return res
+}
+
+// checkSizes checks that a polynomial of the given size can be opened at point with an SRS of size srsSize
+func checkSizes(size int, point []fr.Element, srsSize uint64) error {
+	if size < 2 || uint64(size) > srsSize || size&(size-1) != 0 {
+		return ErrInvalidPolynomialSize
+	}
+	if size != 1<<len(point) {
+		return ErrInvalidPointSize
+	}
+	return nil
+}
+
+// bindStatement binds the digests, the point, the claimed values and dataTranscript to the challenge challengeID
+func bindStatement(fs *fiatshamir.Transcript, challengeID string, digests []Digest, point, claimedValues []fr.Element, dataTranscript [][]byte) error {
+	for i := range digests {
+		if err := fs.Bind(challengeID, digests[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	for _, values := range [][]fr.Element{point, claimedValues} {
+		for i := range values {
+			if err := fs.Bind(challengeID, values[i].Marshal()); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind(challengeID, dataTranscript[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveRho binds the statement of a batch opening to fs, and returns the folding challenge
+func deriveRho(fs *fiatshamir.Transcript, digests []Digest, point, claimedValues []fr.Element, dataTranscript [][]byte) (fr.Element, error) {
+	if err := bindStatement(fs, "rho", digests, point, claimedValues, dataTranscript); err != nil {
+		return fr.Element{}, err
+	}
+	return challenge(fs, "rho")
+}
+
+// challenge binds the points to the challenge challengeID of fs, and returns it as a field element
+func challenge(fs *fiatshamir.Transcript, challengeID string, points ...bls12378.G1Affine) (fr.Element, error) {
+	var res fr.Element
+	for i := range points {
+		if err := fs.Bind(challengeID, points[i].Marshal()); err != nil {
+			return res, err
+		}
+	}
+	b, err := fs.ComputeChallenge(challengeID)
+	if err != nil {
+		return res, err
+	}
+	res.SetBytes(b)
+	return res, nil
+}
@@ -79,3 +79,10 @@ func mulByA(x *fr.Element) {
 	x.Neg(x)
 	fr.MulBy5(x)
 }
+
+// mulByAConstantTime is mulByA using the constant-time field operations
+func mulByAConstantTime(x *fr.Element) {
+	var t fr.Element
+	t.DoubleConstantTime(x).DoubleConstantTime(&t)
+	x.AddConstantTime(x, &t).NegConstantTime(x)
+}
@@ -0,0 +1,246 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// The curve is birationally equivalent to the Montgomery curve
+// K*t² = s³ + J*s² + s, with J = 2(a+d)/(a-d) and K = 4/(a-d),
+// itself isomorphic to the short Weierstrass curve y² = x³ + A*x + B
+// with A = (3 - J²)/(3K²) and B = (2J³ - 9J)/(27K³).
+// The Elligator 2 method does not apply since a*d is a square, so we use
+// the Shallue and van de Woestijne method on the Weierstrass model.
+// https://www.rfc-editor.org/rfc/rfc9380.html#appendix-D.1
+var (
+	hashToCurveOnce sync.Once
+	mapZ            fr.Element
+	mapA, mapB      fr.Element
+	mapC            [4]fr.Element
+	mapJDiv3, mapK  fr.Element
+)
+
+func initHashToCurveParams() {
+	initOnce.Do(initCurveParams)
+
+	var aMinusD, J, K2, tv1, tv2 fr.Element
+	aMinusD.Sub(&curveParams.A, &curveParams.D)
+
+	// K = 4/(a-d), J = 2(a+d)/(a-d)
+	mapK.Inverse(&aMinusD).Double(&mapK).Double(&mapK)
+	J.Add(&curveParams.A, &curveParams.D).Mul(&J, &mapK).Halve()
+	tv1.SetUint64(3)
+	tv1.Inverse(&tv1)
+	mapJDiv3.Mul(&J, &tv1)
+
+	// A = (3 - J²)/(3K²)
+	K2.Square(&mapK)
+	tv1.Square(&J)
+	tv2.SetUint64(3)
+	mapA.Sub(&tv2, &tv1)
+	tv1.Mul(&K2, &tv2).Inverse(&tv1)
+	mapA.Mul(&mapA, &tv1)
+
+	// B = (2J³ - 9J)/(27K³)
+	tv1.Square(&J).Double(&tv1)
+	tv2.SetUint64(9)
+	tv1.Sub(&tv1, &tv2)
+	mapB.Mul(&tv1, &J)
+	tv1.Mul(&K2, &mapK)
+	tv2.SetUint64(27)
+	tv1.Mul(&tv1, &tv2).Inverse(&tv1)
+	mapB.Mul(&mapB, &tv1)
+
+	mapZ.SetInt64(2)
+
+	// c1 = g(Z)
+	mapW(&mapC[0], &mapZ)
+	// c2 = -Z / 2
+	mapC[1].Neg(&mapZ).Halve()
+	// c3 = sqrt(-g(Z) * (3 * Z² + 4 * A))     # sgn0(c3) MUST equal 0
+	tv1.Square(&mapZ)
+	tv2.SetUint64(3)
+	tv1.Mul(&tv1, &tv2)
+	tv2.Double(&mapA).Double(&tv2)
+	tv1.Add(&tv1, &tv2)
+	mapC[2].Mul(&mapC[0], &tv1).Neg(&mapC[2])
+	mapC[2].Sqrt(&mapC[2])
+	if sgn0(&mapC[2]) == 1 {
+		mapC[2].Neg(&mapC[2])
+	}
+	// c4 = -4 * g(Z) / (3 * Z² + 4 * A)
+	tv1.Inverse(&tv1)
+	mapC[3].Double(&mapC[0]).Double(&mapC[3]).Neg(&mapC[3]).Mul(&mapC[3], &tv1)
+}
+
+// MapToCurve implements the Shallue and van de Woestijne method on the short
+// Weierstrass model of the curve, followed by the rational maps to the twisted
+// Edwards curve.
+// No cofactor clearing: the result is not necessarily in the prime subgroup.
+// https://www.rfc-editor.org/rfc/rfc9380.html#straightline-svdw
+func MapToCurve(u *fr.Element) PointAffine {
+	hashToCurveOnce.Do(initHashToCurveParams)
+
+	var tv1, tv2, tv3, tv4 fr.Element
+	var x1, x2, x3, gx1, gx2, gx, x, y fr.Element
+	var one fr.Element
+	var gx1NotSquare, gx1SquareOrGx2Not int
+
+	one.SetOne()
+
+	tv1.Square(u)                      //    1.  tv1 = u²
+	tv1.Mul(&tv1, &mapC[0])            //    2.  tv1 = tv1 * c1
+	tv2.Add(&one, &tv1)                //    3.  tv2 = 1 + tv1
+	tv1.Sub(&one, &tv1)                //    4.  tv1 = 1 - tv1
+	tv3.Mul(&tv1, &tv2)                //    5.  tv3 = tv1 * tv2
+	tv3.Inverse(&tv3)                  //    6.  tv3 = inv0(tv3)
+	tv4.Mul(u, &tv1)                   //    7.  tv4 = u * tv1
+	tv4.Mul(&tv4, &tv3)                //    8.  tv4 = tv4 * tv3
+	tv4.Mul(&tv4, &mapC[2])            //    9.  tv4 = tv4 * c3
+	x1.Sub(&mapC[1], &tv4)             //    10.  x1 = c2 - tv4
+	mapW(&gx1, &x1)                    //    11-14. gx1 = x1³ + A * x1 + B
+	gx1NotSquare = gx1.Legendre() >> 1 //    15.  e1 = is_square(gx1)
+	// gx1NotSquare = 0 if gx1 is a square, -1 otherwise
+
+	x2.Add(&mapC[1], &tv4) //    16.  x2 = c2 + tv4
+	mapW(&gx2, &x2)        //    17-20. gx2 = x2³ + A * x2 + B
+
+	{
+		gx2NotSquare := gx2.Legendre() >> 1              // gx2Square = 0 if gx2 is a square, -1 otherwise
+		gx1SquareOrGx2Not = gx2NotSquare | ^gx1NotSquare //    21.  e2 = is_square(gx2) AND NOT e1   # Avoid short-circuit logic ops
+	}
+
+	x3.Square(&tv2)       //    22.  x3 = tv2²
+	x3.Mul(&x3, &tv3)     //    23.  x3 = x3 * tv3
+	x3.Square(&x3)        //    24.  x3 = x3²
+	x3.Mul(&x3, &mapC[3]) //    25.  x3 = x3 * c4
+	x3.Add(&x3, &mapZ)    //    26.  x3 = x3 + Z
+
+	x.Select(gx1NotSquare, &x1, &x3)     //    27.   x = CMOV(x3, x1, e1)   # x = x1 if gx1 is square, else x = x3
+	x.Select(gx1SquareOrGx2Not, &x2, &x) //    28.   x = CMOV(x, x2, e2)    # x = x2 if gx2 is square and gx1 is not
+	mapW(&gx, &x)                        //    29-32. gx = x³ + A * x + B
+
+	y.Sqrt(&gx)                         //    33.   y = sqrt(gx)
+	signsNotEqual := sgn0(u) ^ sgn0(&y) //    34.  e3 = sgn0(u) == sgn0(y)
+
+	tv1.Neg(&y)
+	y.Select(int(signsNotEqual), &y, &tv1) //    35.   y = CMOV(-y, y, e3)       # Select correct sign of y
+
+	// Weierstrass to Montgomery: s = K * x - J / 3, t = K * y
+	var s, t fr.Element
+	s.Mul(&x, &mapK).Sub(&s, &mapJDiv3)
+	t.Mul(&y, &mapK)
+
+	return montgomeryToEdwards(&s, &t)
+}
+
+// mapW sets z = x³ + A * x + B
+func mapW(z, x *fr.Element) {
+	var tv fr.Element
+	tv.Square(x).Add(&tv, &mapA)
+	z.Mul(&tv, x).Add(z, &mapB)
+}
+
+// montgomeryToEdwards applies the rational map (s, t) -> (s / t, (s - 1) / (s + 1))
+// from the Montgomery curve to the twisted Edwards curve, sending the
+// exceptional cases t = 0 or s = -1 to the identity (0, 1).
+func montgomeryToEdwards(s, t *fr.Element) PointAffine {
+	var res PointAffine
+	var one, sPlusOne, den fr.Element
+	one.SetOne()
+
+	// a single inversion for 1 / (t * (s + 1))
+	sPlusOne.Add(s, &one)
+	den.Mul(t, &sPlusOne)
+	if den.IsZero() {
+		res.setInfinity()
+		return res
+	}
+	den.Inverse(&den)
+
+	res.X.Mul(s, &sPlusOne).Mul(&res.X, &den)
+	res.Y.Sub(s, &one).Mul(&res.Y, t).Mul(&res.Y, &den)
+
+	return res
+}
+
+// sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-the-sgn0-function
+// The sign of an element is not obviously related to that of its Montgomery form
+func sgn0(z *fr.Element) uint64 {
+
+	nonMont := z.Bits()
+
+	// m == 1
+	return nonMont[0] % 2
+
+}
+
+// EncodeToCurve hashes a message to a point on the curve using the
+// SVDW map, followed by cofactor clearing.
+// It is faster than HashToCurve, but the result is not uniformly distributed. Unsuitable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#roadmap
+func EncodeToCurve(msg, dst []byte) (PointAffine, error) {
+
+	var res PointAffine
+	u, err := fr.Hash(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+
+	res = MapToCurve(&u[0])
+	res.clearCofactor(&res)
+
+	return res, nil
+}
+
+// HashToCurve hashes a message to a point on the curve using the
+// SVDW map, followed by cofactor clearing.
+// Slower than EncodeToCurve, but usable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#roadmap
+func HashToCurve(msg, dst []byte) (PointAffine, error) {
+	u, err := fr.Hash(msg, dst, 2)
+	if err != nil {
+		return PointAffine{}, err
+	}
+
+	Q0 := MapToCurve(&u[0])
+	Q1 := MapToCurve(&u[1])
+
+	var res PointAffine
+	res.Add(&Q0, &Q1).
+		clearCofactor(&res)
+
+	return res, nil
+}
+
+// clearCofactor sets p to cofactor*p1, in the prime subgroup
+func (p *PointAffine) clearCofactor(p1 *PointAffine) *PointAffine {
+	initOnce.Do(initCurveParams)
+
+	var cofactor big.Int
+	curveParams.Cofactor.BigInt(&cofactor)
+
+	return p.ScalarMultiplication(p1, &cofactor)
+}
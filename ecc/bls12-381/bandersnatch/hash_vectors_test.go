@@ -0,0 +1,72 @@
+package bandersnatch
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12381_BANDERSNATCH_XMD:SHA-256_SVDW_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x4d471da1c41aadba0107ef3fe72737295038309e7e02686e55dca282479e9d88", "0x2b42bb858c74fef44be60ae0af92f18ab8d631ff24b9c86be54a4fcb78302d85"},
+				Q: point{"0x69c51b00e0d4be3a5c0e1980ba8c5095855f145df1be3bb69998d8aa171c03b7", "0x9d407b0fa1f3871c098dc0fcc9e0e844167d792e8de95b68aa93d06a956cb40"},
+				u: "0x33cb296b8a0d0d617df241dbf301f70bdc24a03f63c0ea4aefd42f1dcaed03a0",
+			},
+			{
+				msg: "abc", P: point{"0x70fd2bc9a1a2be83b9a80f9349d5778fe4ea7ec9dfc20dbeb793da5092b0e77b", "0x682624f878c151644cedca3bd64c202a77fb697d509474595ca5acd160b3253b"},
+				Q: point{"0x25971010b36500bfd2c1295ccd4ad46fe735d1233d06b9e2997c2a019e1937c3", "0x1f60bdcc7ea36088392ff3d4aa3d4b556a7734167ab8f0763be4267eb4ba3e7f"},
+				u: "0x20ba6bd93848c52151d454435d2a24e1984b86d161f6a7d8ef3ca5cb06197e5d",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x27346defabf6d60454371f6c0eb0b880043384e7603f40e84f6403c73b3ac864", "0x15df173d189b898920a597788c4cc8168eb225fd062ac7254b71df2fad260f9d"},
+				Q: point{"0x5faec469a9b1fd56d54115925aee2bd4c68f61033bfbef29044485b58c937c5d", "0x4131a8b3e9e265d2366992b1e2d3e86ddf4ca603567448bc51763d792c0d256c"},
+				u: "0x4379b8390a2d14167468d105e909fbaa1c1896549dbce09729d04cbaf732b33f",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x6b12780771a8688932d9a8a345a95d17bfa193c191bfc9e7a7f0b206537c96f4", "0x1952d647c53d68081dca4fb6b011cd585916a9f14e800a0fc9eb59a2e5ea53ef"},
+				Q: point{"0x72cdede14e3afefeefc8f5d9d933b2bdd35600f5aed52acd84af822ee243dca5", "0x651aea39d14ad4c9eab9666bb623ec40d8bdb423f832f57ead5eff54c9b06dfd"},
+				u: "0x4371b70a1364358efc7c57eb9c21ad5c0610ea7d4b02536b750a8c5583b7a89f",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x2a3998313761cc190d7c75abfa50bd632e9f01e9d87b0220f41045bd9ca2941d", "0x3e3032c5a6dcf8b7806427dc7279a94b37ac02506fb3ae887fa12e6e913a7f97"},
+				Q: point{"0x3c50c0424c18aa7289e3b4be5a98460bb9821a21717ffbcac49846e19a783c4e", "0x6c1994a339e208d7f48d361031b63c336dbcee4ff1800123253ae32113f73252"},
+				u: "0x1c98b4e1bfa8fa39c5d0b096e1453891a11c00517b7e0b77e9f5bf20e0d86d27",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12381_BANDERSNATCH_XMD:SHA-256_SVDW_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0xe723f67f3d2f8eda3ec9930bc3398182a668b000d9215231ca666f3947e0213", "0x31c9783193c097a395ab68cac88016f315f5a5468f99c278c63b4905f819ec85"},
+				Q0: point{"0x4ca356fed9f4a76f8010b6fa545f59ae1b14bd1e3d353747f1e39c43a3faca79", "0x1deca996c30e070ac40b54b76f3dfe7ee5e82cd32b4eef081f7b03067c2afee4"},
+				Q1: point{"0x10068f728a76872720538bafdeb776b6d7d79f0bfde497d0509ce16ed29fd03f", "0x177f8fa654b0c47a7dcb8ebde74adbb2d1b142964cd01802accc9faf1bc63529"},
+				u0: "0x35002d484bdb795a640c595a1e5e812a5b9e2b5a093b7453fa629bdf55e352f3", u1: "0x1e26854354bb89e1bd02dc3b07b97dc65f8a9669380fc215da1fdc3e5e062f42",
+			},
+			{
+				msg: "abc", P: point{"0x4d18bbb4176babdcb85372cffe0663d4c45b5e19eaaf1acb6aa32ac7013732ea", "0x3cf4b337acf6d9ecac4f497d02499e1717a486faca6738f04e321d73d4f63f34"},
+				Q0: point{"0x2e87f6966dee0f3d753f18950eae6a3f5efc12bb8de5f96fb291c4356ac9dab8", "0x328ac3fe99ce8e082523850a1b8ec7fdd3bc20bc12977547621c6c75151004bc"},
+				Q1: point{"0x6964227227a7478f779fa961a0acd75642229bb6f5ea8cdf86c4f7c5e62b5500", "0x5a9c6b677ae53861230ad4cfa16af972786891dc212bc0e9ed06d941c8b9af10"},
+				u0: "0x3003717f655a48c2ac3162ec048fc863af9b98d1b0f37d282e741e0148dd9f16", u1: "0x1e3bc497583d9260564d212e52351fb72b5b0fe82a3720cd4fbf0e52b0594aaf",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x45f51cc343de1a814decc3d690ebe1a0bc95ca9a20ed7ba50ebb3bb2fdc68cab", "0x2b04c29d3ac86650a50695fe41e7c2e7c6e4a73ebbaaebabc9040d71946848a"},
+				Q0: point{"0x22780e8e7f737b8f92f0d38c274584c453725db564d25e31c2517ec18427735d", "0x10783d3418ac6cb4cbb7c3b329f7f6535bab3c63c804b123c359398c79ea1f64"},
+				Q1: point{"0x3ae87819f3e4ba2fb5d2ac068f7b8474dbfecb24cbe619bf4ea0eb1ac040240e", "0x58c2e2183df055d4f44ee7ee2bb7d690d94f7c2bf951126213d82922a9fc4263"},
+				u0: "0x536cdd8ebb8d3b1148c2b5b35925b282e752a392c37a2ec58cca8fce42710881", u1: "0x6892d9e2cad84783087a01a5bfb0131ee651b0ccb1cdf97db063748e35cffb09",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x30807a537e92ecb874aaf3c5af1d78e2d7d7cc4e46054164f20514253be6f66d", "0x1f9ad865093edcc7b50e6c27201f26c6eaef675e09e091cc2a76924e25f42742"},
+				Q0: point{"0x449d00307db02b493ce9c70777188b0f7d366297343de2ff5d8bb4ba388d0144", "0x6c508bcbb92c842ea5790380a85c6e7a1ea02edb95a8d43133c25cf1c7c0f0df"},
+				Q1: point{"0x33cc9610a25f1a9d60356f68baf0f443e0105bc8f4e7e5090c9076bcb30111bc", "0x63583922c052a416516129a644d14568cd0a3465ee45b011d5d701677bfb29e1"},
+				u0: "0x66e574cd90802b973c319d2c602623dc01a13abdbddf217836e64f71e6d73796", u1: "0x493f5668fa214aa141c262339aa792a0a1e1a26607bd3fb63b9a613c6554bce8",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x69207bb0609c46de3f01dede496cd67bc3c4302ccb1815120df0be830cf61aaa", "0x634eb455473615bf0e558c20295d9c1dfea2d243bc9b09f47035e53fc29b4842"},
+				Q0: point{"0x3896e82e6828bbe57ac436107dbd65de14ac4977fe0381660705bebba84fa492", "0x3bfe80f3573c26140884881721fd2e93325f4c86d086fa264d800d1b00ce8e00"},
+				Q1: point{"0x72fe05a0ec045cf44a6958effd6fa5a8c7f74a8635273bf4e02cbcacb088409", "0x3fac2356eae3922bc44b57e212b69dc7f92a47df4ea2865ac93dd9aed1d5e2df"},
+				u0: "0x2896ea9eb968e20e515129a9880dcc455a77bfbf02104457cd6f2e0099ab2a34", u1: "0x6183ae009231585902de9803995b407c8e8cc24b1dfeff70c77692c1c1efc831",
+			},
+		}}
+}
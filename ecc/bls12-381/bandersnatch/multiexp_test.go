@@ -0,0 +1,170 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 2
+	} else {
+		parameters.MinSuccessfulTests = 5
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genS := GenBigInt()
+
+	const nbSamples = 73
+
+	// multi exp points
+	params := GetEdwardsCurve()
+	var samplePoints [nbSamples]PointAffine
+	samplePoints[0].Set(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		samplePoints[i].Add(&samplePoints[i-1], &params.Base)
+	}
+
+	properties.Property("[EXTENDED] MultiExp should match the sum of scalar multiplications", prop.ForAll(
+		func(mixer big.Int) bool {
+
+			var expected, tmp PointExtended
+			expected.setInfinity()
+
+			// mixer ensures that all the words of a scalar are set
+			var scalars [nbSamples]big.Int
+			for i := 1; i <= nbSamples; i++ {
+				scalars[i-1].SetUint64(uint64(i)).
+					Mul(&scalars[i-1], &mixer)
+
+				tmp.FromAffine(&samplePoints[i-1])
+				tmp.ScalarMultiplication(&tmp, &scalars[i-1])
+				expected.Add(&expected, &tmp)
+			}
+
+			var result PointExtended
+			if _, err := result.MultiExp(samplePoints[:], scalars[:], ecc.MultiExpConfig{}); err != nil {
+				return false
+			}
+
+			return result.Equal(&expected)
+		},
+		genS,
+	))
+
+	properties.Property("[AFFINE] MultiExp with negative and oversized scalars should match the sum of scalar multiplications", prop.ForAll(
+		func(mixer big.Int) bool {
+
+			var expected, tmp PointAffine
+			expected.setInfinity()
+
+			var scalars [nbSamples]big.Int
+			for i := 1; i <= nbSamples; i++ {
+				scalars[i-1].SetUint64(uint64(i)).
+					Mul(&scalars[i-1], &mixer)
+				if i%2 == 0 {
+					scalars[i-1].Neg(&scalars[i-1])
+				}
+				if i%3 == 0 {
+					scalars[i-1].Add(&scalars[i-1], &params.Order)
+				}
+
+				tmp.ScalarMultiplication(&samplePoints[i-1], &scalars[i-1])
+				expected.Add(&expected, &tmp)
+			}
+
+			var result PointAffine
+			if _, err := result.MultiExp(samplePoints[:], scalars[:], ecc.MultiExpConfig{NbTasks: 3}); err != nil {
+				return false
+			}
+
+			return result.Equal(&expected)
+		},
+		genS,
+	))
+
+	properties.Property("[EXTENDED] MultiExp with zero scalars or the identity should match the sum of scalar multiplications", prop.ForAll(
+		func(s big.Int) bool {
+
+			points := []PointAffine{samplePoints[0], samplePoints[1], samplePoints[2]}
+			points[1].setInfinity()
+			scalars := make([]big.Int, 3)
+			scalars[1].Set(&s)
+			scalars[2].Set(&params.Order)
+
+			var result PointExtended
+			if _, err := result.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+				return false
+			}
+
+			return result.IsZero()
+		},
+		genS,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	t.Run("mismatched sizes", func(t *testing.T) {
+		var result PointExtended
+		if _, err := result.MultiExp(samplePoints[:], make([]big.Int, nbSamples-1), ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected error for len(points) != len(scalars)")
+		}
+	})
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	const nbSamples = 1 << 14
+
+	params := GetEdwardsCurve()
+	samplePoints := make([]PointAffine, nbSamples)
+	scalars := make([]big.Int, nbSamples)
+	samplePoints[0].Set(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		samplePoints[i].Add(&samplePoints[i-1], &params.Base)
+	}
+	for i := 0; i < nbSamples; i++ {
+		s, err := rand.Int(rand.Reader, &params.Order)
+		if err != nil {
+			b.Fatal(err)
+		}
+		scalars[i].Set(s)
+	}
+
+	var testPoint PointExtended
+
+	for i := 5; i <= 14; i++ {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], scalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
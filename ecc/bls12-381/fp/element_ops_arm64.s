@@ -0,0 +1,2142 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $0xb9feffffffffaaab, R20
+	MOVD $0x1eabfffeb153ffff, R21
+	MOVD $0x6730d2a0f6b0f624, R22
+	MOVD $0x64774b84f38512bf, R23
+	MOVD $0x4b1ba7b6434bacd7, R24
+	MOVD $0x1a0111ea397fe69a, R25
+	MOVD x+8(FP), R0
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	LDP  32(R0), (R5, R6)
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R1, R13, R7
+	UMULH R1, R13, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R2, R13, R8
+	UMULH R2, R13, R19
+	ADDS  R15, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R3, R13, R9
+	UMULH R3, R13, R19
+	ADDS  R15, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R4, R13, R10
+	UMULH R4, R13, R19
+	ADDS  R15, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R5, R13, R11
+	UMULH R5, R13, R19
+	ADDS  R15, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[0] + A
+	MUL   R6, R13, R12
+	UMULH R6, R13, R19
+	ADDS  R15, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD  R16, R15, R12
+	MOVD 8(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R1, R13, R17
+	UMULH R1, R13, R19
+	ADDS  R17, R7, R7
+	ADC   ZR, R19, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R2, R13, R17
+	UMULH R2, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R3, R13, R17
+	UMULH R3, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R4, R13, R17
+	UMULH R4, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R5, R13, R17
+	UMULH R5, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[1] + A
+	MUL   R6, R13, R17
+	UMULH R6, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD  R16, R15, R12
+	MOVD 16(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R1, R13, R17
+	UMULH R1, R13, R19
+	ADDS  R17, R7, R7
+	ADC   ZR, R19, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R2, R13, R17
+	UMULH R2, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R3, R13, R17
+	UMULH R3, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R4, R13, R17
+	UMULH R4, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R5, R13, R17
+	UMULH R5, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[2] + A
+	MUL   R6, R13, R17
+	UMULH R6, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD  R16, R15, R12
+	MOVD 24(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R1, R13, R17
+	UMULH R1, R13, R19
+	ADDS  R17, R7, R7
+	ADC   ZR, R19, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R2, R13, R17
+	UMULH R2, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R3, R13, R17
+	UMULH R3, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R4, R13, R17
+	UMULH R4, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R5, R13, R17
+	UMULH R5, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[3] + A
+	MUL   R6, R13, R17
+	UMULH R6, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD  R16, R15, R12
+	MOVD 32(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R1, R13, R17
+	UMULH R1, R13, R19
+	ADDS  R17, R7, R7
+	ADC   ZR, R19, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R2, R13, R17
+	UMULH R2, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R3, R13, R17
+	UMULH R3, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R4, R13, R17
+	UMULH R4, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R5, R13, R17
+	UMULH R5, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[4] + A
+	MUL   R6, R13, R17
+	UMULH R6, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD  R16, R15, R12
+	MOVD 40(R0), R13
+
+	// (A,t[0]) := t[0] + x[0]*y[5]
+	MUL   R1, R13, R17
+	UMULH R1, R13, R19
+	ADDS  R17, R7, R7
+	ADC   ZR, R19, R15
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R14
+	MUL  R14, R7, R14
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R14, R20, R17
+	UMULH R14, R20, R16
+	ADDS  R7, R17, R17
+	ADC   ZR, R16, R16
+
+	// (A,t[1]) := t[1] + x[1]*y[5] + A
+	MUL   R2, R13, R17
+	UMULH R2, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R8, R8
+	ADC   ZR, R19, R15
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R14, R21, R17
+	UMULH R14, R21, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R8, R17, R7
+	ADC   ZR, R19, R16
+
+	// (A,t[2]) := t[2] + x[2]*y[5] + A
+	MUL   R3, R13, R17
+	UMULH R3, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R9, R9
+	ADC   ZR, R19, R15
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R14, R22, R17
+	UMULH R14, R22, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R9, R17, R8
+	ADC   ZR, R19, R16
+
+	// (A,t[3]) := t[3] + x[3]*y[5] + A
+	MUL   R4, R13, R17
+	UMULH R4, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R10, R10
+	ADC   ZR, R19, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R14, R23, R17
+	UMULH R14, R23, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R10, R17, R9
+	ADC   ZR, R19, R16
+
+	// (A,t[4]) := t[4] + x[4]*y[5] + A
+	MUL   R5, R13, R17
+	UMULH R5, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R11, R11
+	ADC   ZR, R19, R15
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R14, R24, R17
+	UMULH R14, R24, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R11, R17, R10
+	ADC   ZR, R19, R16
+
+	// (A,t[5]) := t[5] + x[5]*y[5] + A
+	MUL   R6, R13, R17
+	UMULH R6, R13, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R17, R12, R12
+	ADC   ZR, R19, R15
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MUL   R14, R25, R17
+	UMULH R14, R25, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R12, R17, R11
+	ADC   ZR, R19, R16
+
+	// t[5] = C + A
+	ADD R16, R15, R12
+
+	// reduce if necessary
+	SUBS R20, R7, R1
+	SBCS R21, R8, R2
+	SBCS R22, R9, R3
+	SBCS R23, R10, R4
+	SBCS R24, R11, R5
+	SBCS R25, R12, R6
+	CSEL CS, R1, R7, R7
+	CSEL CS, R2, R8, R8
+	CSEL CS, R3, R9, R9
+	CSEL CS, R4, R10, R10
+	CSEL CS, R5, R11, R11
+	CSEL CS, R6, R12, R12
+	MOVD res+0(FP), R0
+	STP  (R7, R8), 0(R0)
+	STP  (R9, R10), 16(R0)
+	STP  (R11, R12), 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	LDP  32(R0), (R6, R7)
+	MOVD b+8(FP), R1
+	LDP  0(R1), (R8, R9)
+	LDP  16(R1), (R10, R11)
+	LDP  32(R1), (R12, R13)
+	MOVD $0xb9feffffffffaaab, R21
+	MOVD $0x1eabfffeb153ffff, R22
+	MOVD $0x6730d2a0f6b0f624, R23
+	MOVD $0x64774b84f38512bf, R24
+	MOVD $0x4b1ba7b6434bacd7, R25
+	MOVD $0x1a0111ea397fe69a, R26
+
+	// t = a + b
+	ADDS R8, R2, R14
+	ADCS R9, R3, R15
+	ADCS R10, R4, R16
+	ADCS R11, R5, R17
+	ADCS R12, R6, R19
+	ADCS R13, R7, R20
+
+	// a = a - b
+	SUBS R8, R2, R2
+	SBCS R9, R3, R3
+	SBCS R10, R4, R4
+	SBCS R11, R5, R5
+	SBCS R12, R6, R6
+	SBCS R13, R7, R7
+
+	// b = q if a - b underflowed, 0 otherwise
+	CSEL CC, R21, ZR, R8
+	CSEL CC, R22, ZR, R9
+	CSEL CC, R23, ZR, R10
+	CSEL CC, R24, ZR, R11
+	CSEL CC, R25, ZR, R12
+	CSEL CC, R26, ZR, R13
+
+	// b = (a - b) mod q
+	ADDS R8, R2, R8
+	ADCS R9, R3, R9
+	ADCS R10, R4, R10
+	ADCS R11, R5, R11
+	ADCS R12, R6, R12
+	ADCS R13, R7, R13
+	STP  (R8, R9), 0(R1)
+	STP  (R10, R11), 16(R1)
+	STP  (R12, R13), 32(R1)
+
+	// a = (a + b) mod q
+	SUBS R21, R14, R2
+	SBCS R22, R15, R3
+	SBCS R23, R16, R4
+	SBCS R24, R17, R5
+	SBCS R25, R19, R6
+	SBCS R26, R20, R7
+	CSEL CS, R2, R14, R14
+	CSEL CS, R3, R15, R15
+	CSEL CS, R4, R16, R16
+	CSEL CS, R5, R17, R17
+	CSEL CS, R6, R19, R19
+	CSEL CS, R7, R20, R20
+	STP  (R14, R15), 0(R0)
+	STP  (R16, R17), 16(R0)
+	STP  (R19, R20), 32(R0)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVD $0xb9feffffffffaaab, R16
+	MOVD $0x1eabfffeb153ffff, R17
+	MOVD $0x6730d2a0f6b0f624, R19
+	MOVD $0x64774b84f38512bf, R20
+	MOVD $0x4b1ba7b6434bacd7, R21
+	MOVD $0x1a0111ea397fe69a, R22
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l1:
+	CBZ   R3, l2
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R1), (R8, R9)
+	LDP.P 16(R2), (R10, R11)
+	LDP.P 16(R2), (R12, R13)
+	LDP.P 16(R2), (R14, R15)
+
+	// a[i] + b[i]
+	ADDS  R10, R4, R4
+	ADCS  R11, R5, R5
+	ADCS  R12, R6, R6
+	ADCS  R13, R7, R7
+	ADCS  R14, R8, R8
+	ADCS  R15, R9, R9
+	SUBS  R16, R4, R10
+	SBCS  R17, R5, R11
+	SBCS  R19, R6, R12
+	SBCS  R20, R7, R13
+	SBCS  R21, R8, R14
+	SBCS  R22, R9, R15
+	CSEL  CS, R10, R4, R4
+	CSEL  CS, R11, R5, R5
+	CSEL  CS, R12, R6, R6
+	CSEL  CS, R13, R7, R7
+	CSEL  CS, R14, R8, R8
+	CSEL  CS, R15, R9, R9
+	STP.P (R4, R5), 16(R0)
+	STP.P (R6, R7), 16(R0)
+	STP.P (R8, R9), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVD $0xb9feffffffffaaab, R16
+	MOVD $0x1eabfffeb153ffff, R17
+	MOVD $0x6730d2a0f6b0f624, R19
+	MOVD $0x64774b84f38512bf, R20
+	MOVD $0x4b1ba7b6434bacd7, R21
+	MOVD $0x1a0111ea397fe69a, R22
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l3:
+	CBZ   R3, l4
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R1), (R8, R9)
+	LDP.P 16(R2), (R10, R11)
+	LDP.P 16(R2), (R12, R13)
+	LDP.P 16(R2), (R14, R15)
+
+	// a[i] - b[i]
+	SUBS R10, R4, R4
+	SBCS R11, R5, R5
+	SBCS R12, R6, R6
+	SBCS R13, R7, R7
+	SBCS R14, R8, R8
+	SBCS R15, R9, R9
+
+	// add q if a[i] - b[i] underflowed
+	CSEL  CC, R16, ZR, R10
+	CSEL  CC, R17, ZR, R11
+	CSEL  CC, R19, ZR, R12
+	CSEL  CC, R20, ZR, R13
+	CSEL  CC, R21, ZR, R14
+	CSEL  CC, R22, ZR, R15
+	ADDS  R10, R4, R4
+	ADCS  R11, R5, R5
+	ADCS  R12, R6, R6
+	ADCS  R13, R7, R7
+	ADCS  R14, R8, R8
+	ADCS  R15, R9, R9
+	STP.P (R4, R5), 16(R0)
+	STP.P (R6, R7), 16(R0)
+	STP.P (R8, R9), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l3
+
+l4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l5:
+	CBZ   R3, l6
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R1), (R8, R9)
+	MOVD  0(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R16, R10
+	UMULH R4, R16, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R16, R11
+	UMULH R5, R16, R22
+	ADDS  R19, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R16, R12
+	UMULH R6, R16, R22
+	ADDS  R19, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R16, R13
+	UMULH R7, R16, R22
+	ADDS  R19, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R8, R16, R14
+	UMULH R8, R16, R22
+	ADDS  R19, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[0] + A
+	MUL   R9, R16, R15
+	UMULH R9, R16, R22
+	ADDS  R19, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 8(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[1] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 16(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[2] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 24(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[3] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 32(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[4] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 40(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[5]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[5] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[5] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[5] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[5] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[5] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD R20, R19, R15
+
+	// reduce if necessary
+	MOVD  $0xb9feffffffffaaab, R4
+	MOVD  $0x1eabfffeb153ffff, R5
+	MOVD  $0x6730d2a0f6b0f624, R6
+	MOVD  $0x64774b84f38512bf, R7
+	MOVD  $0x4b1ba7b6434bacd7, R8
+	MOVD  $0x1a0111ea397fe69a, R9
+	SUBS  R4, R10, R4
+	SBCS  R5, R11, R5
+	SBCS  R6, R12, R6
+	SBCS  R7, R13, R7
+	SBCS  R8, R14, R8
+	SBCS  R9, R15, R9
+	CSEL  CS, R4, R10, R10
+	CSEL  CS, R5, R11, R11
+	CSEL  CS, R6, R12, R12
+	CSEL  CS, R7, R13, R13
+	CSEL  CS, R8, R14, R14
+	CSEL  CS, R9, R15, R15
+	STP.P (R10, R11), 16(R0)
+	STP.P (R12, R13), 16(R0)
+	STP.P (R14, R15), 16(R0)
+	ADD   $0x0000000000000030, R2, R2
+	SUB   $1, R3, R3
+	JMP   l5
+
+l6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l7:
+	CBZ   R3, l8
+	LDP.P 16(R1), (R4, R5)
+	LDP.P 16(R1), (R6, R7)
+	LDP.P 16(R1), (R8, R9)
+	MOVD  0(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R16, R10
+	UMULH R4, R16, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R16, R11
+	UMULH R5, R16, R22
+	ADDS  R19, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R16, R12
+	UMULH R6, R16, R22
+	ADDS  R19, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R16, R13
+	UMULH R7, R16, R22
+	ADDS  R19, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R8, R16, R14
+	UMULH R8, R16, R22
+	ADDS  R19, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[0] + A
+	MUL   R9, R16, R15
+	UMULH R9, R16, R22
+	ADDS  R19, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 8(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[1] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 16(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[2] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 24(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[3] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 32(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[4] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD  R20, R19, R15
+	MOVD 40(R2), R16
+
+	// (A,t[0]) := t[0] + x[0]*y[5]
+	MUL   R4, R16, R21
+	UMULH R4, R16, R22
+	ADDS  R21, R10, R10
+	ADC   ZR, R22, R19
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x89f3fffcfffcfffd, R17
+	MUL  R17, R10, R17
+
+	// C,_ := t[0] + m*q[0]
+	MOVD  $0xb9feffffffffaaab, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R20
+	ADDS  R10, R21, R21
+	ADC   ZR, R20, R20
+
+	// (A,t[1]) := t[1] + x[1]*y[5] + A
+	MUL   R5, R16, R21
+	UMULH R5, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R11, R11
+	ADC   ZR, R22, R19
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MOVD  $0x1eabfffeb153ffff, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R11, R21, R10
+	ADC   ZR, R22, R20
+
+	// (A,t[2]) := t[2] + x[2]*y[5] + A
+	MUL   R6, R16, R21
+	UMULH R6, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R12, R12
+	ADC   ZR, R22, R19
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MOVD  $0x6730d2a0f6b0f624, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R12, R21, R11
+	ADC   ZR, R22, R20
+
+	// (A,t[3]) := t[3] + x[3]*y[5] + A
+	MUL   R7, R16, R21
+	UMULH R7, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R13, R13
+	ADC   ZR, R22, R19
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MOVD  $0x64774b84f38512bf, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R13, R21, R12
+	ADC   ZR, R22, R20
+
+	// (A,t[4]) := t[4] + x[4]*y[5] + A
+	MUL   R8, R16, R21
+	UMULH R8, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R14, R14
+	ADC   ZR, R22, R19
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MOVD  $0x4b1ba7b6434bacd7, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R14, R21, R13
+	ADC   ZR, R22, R20
+
+	// (A,t[5]) := t[5] + x[5]*y[5] + A
+	MUL   R9, R16, R21
+	UMULH R9, R16, R22
+	ADDS  R19, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R21, R15, R15
+	ADC   ZR, R22, R19
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	MOVD  $0x1a0111ea397fe69a, R22
+	MUL   R17, R22, R21
+	UMULH R17, R22, R22
+	ADDS  R20, R21, R21
+	ADC   ZR, R22, R22
+	ADDS  R15, R21, R14
+	ADC   ZR, R22, R20
+
+	// t[5] = C + A
+	ADD R20, R19, R15
+
+	// reduce if necessary
+	MOVD  $0xb9feffffffffaaab, R4
+	MOVD  $0x1eabfffeb153ffff, R5
+	MOVD  $0x6730d2a0f6b0f624, R6
+	MOVD  $0x64774b84f38512bf, R7
+	MOVD  $0x4b1ba7b6434bacd7, R8
+	MOVD  $0x1a0111ea397fe69a, R9
+	SUBS  R4, R10, R4
+	SBCS  R5, R11, R5
+	SBCS  R6, R12, R6
+	SBCS  R7, R13, R7
+	SBCS  R8, R14, R8
+	SBCS  R9, R15, R9
+	CSEL  CS, R4, R10, R10
+	CSEL  CS, R5, R11, R11
+	CSEL  CS, R6, R12, R12
+	CSEL  CS, R7, R13, R13
+	CSEL  CS, R8, R14, R14
+	CSEL  CS, R9, R15, R15
+	STP.P (R10, R11), 16(R0)
+	STP.P (R12, R13), 16(R0)
+	STP.P (R14, R15), 16(R0)
+	SUB   $1, R3, R3
+	JMP   l7
+
+l8:
+	RET
@@ -0,0 +1,354 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package tensorcommitment
+
+import (
+	"hash"
+	"math/big"
+	"math/bits"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+type DummyHash uint
+
+func (d DummyHash) Write(p []byte) (n int, err error) {
+	return 0, nil
+}
+
+func (d DummyHash) Sum(b []byte) []byte {
+	return b
+}
+
+func (d DummyHash) Reset() {}
+
+func (d DummyHash) Size() int {
+	return 0
+}
+
+func (d DummyHash) BlockSize() int {
+	return 0
+}
+
+func DummyHashMaker() hash.Hash {
+	var res DummyHash
+	return &res
+}
+
+// buildProof builds a proof of the linear combination of the rows of tc with the
+// coefficients l, opening the columns in entryList
+func buildProof(tc *TensorCommitment, l []fr.Element, entryList []int) (Proof, error) {
+	linComb, err := tc.ProverComputeLinComb(l)
+	if err != nil {
+		return Proof{}, err
+	}
+
+	openedColumns, err := tc.ProverOpenColumns(entryList)
+	if err != nil {
+		return Proof{}, err
+	}
+
+	return BuildProof(tc.params, linComb, entryList, openedColumns), nil
+}
+
+func TestAppend(t *testing.T) {
+	if bits.UintSize == 32 {
+		t.Skip("skipping this test in 32bit.")
+	}
+
+	assert := require.New(t)
+
+	// tensor commitment
+	const (
+		rho       = 4
+		nbRows    = 10
+		nbColumns = 16
+	)
+	params, err := NewTCParams(rho, nbColumns, nbRows, DummyHashMaker)
+	assert.NoError(err)
+
+	tc := NewTensorCommitment(params)
+
+	{
+		// random Polynomial of size nbRows
+		p := make([]fr.Element, nbRows)
+		for i := 0; i < nbRows; i++ {
+			p[i].SetRandom()
+		}
+		_, err := tc.Append(p)
+		assert.NoError(err)
+
+		// check if p corresponds to the first column of the state
+		for i := 0; i < nbRows; i++ {
+			assert.True(tc.State[i][0].Equal(&p[i]), "a column is not filled correctly")
+		}
+
+	}
+
+	// after a first polynomial has been filled
+	{
+		// random Polynomial of size nbRows
+		p := make([]fr.Element, nbRows)
+		for i := 0; i < nbRows; i++ {
+			p[i].SetRandom()
+		}
+		_, err := tc.Append(p)
+		assert.NoError(err)
+
+		// check if p corresponds to the second column of the state
+		for i := 0; i < nbRows; i++ {
+			assert.True(tc.State[i][1].Equal(&p[i]), "a column is not filled correctly")
+		}
+	}
+
+	// polynomial whose size is not a multiple of nbRows
+	{
+		// random Polynomial of size nbRows
+		offset := 4
+		p := make([]fr.Element, nbRows+offset)
+		for i := 0; i < nbRows+offset; i++ {
+			p[i].SetRandom()
+		}
+		_, err := tc.Append(p)
+		assert.NoError(err)
+
+		// check if p corresponds to the first column of the state
+		for i := 0; i < nbRows; i++ {
+			assert.True(tc.State[i][2].Equal(&p[i]), "a column is not filled correctly")
+		}
+		for i := 0; i < offset; i++ {
+			assert.True(tc.State[i][3].Equal(&p[i+nbRows]), "a column is not filled correctly")
+		}
+	}
+
+	// same to see if the last column was correctly offset
+	{
+		// random Polynomial of size nbRows
+		offset := 4
+		p := make([]fr.Element, nbRows+offset)
+		for i := 0; i < nbRows+offset; i++ {
+			p[i].SetRandom()
+		}
+		_, err := tc.Append(p)
+		assert.NoError(err)
+
+		// check if p corresponds to the first column of the state
+		for i := 0; i < nbRows; i++ {
+			assert.True(tc.State[i][4].Equal(&p[i]), "a column is not filled correctly")
+		}
+		for i := 0; i < offset; i++ {
+			assert.True(tc.State[i][5].Equal(&p[i+nbRows]), "a column is not filled correctly")
+		}
+	}
+
+}
+
+func TestLinearCombination(t *testing.T) {
+
+	rho := 4
+	nbRows := 8
+	nbColumns := 8
+	params, err := NewTCParams(rho, nbColumns, nbRows, DummyHashMaker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := NewTensorCommitment(params)
+
+	// build a random polynomial
+	p := make([]fr.Element, nbRows*nbColumns)
+	for i := 0; i < 64; i++ {
+		p[i].SetRandom()
+	}
+
+	// we select all the entries for the test
+	entryList := make([]int, rho*nbColumns)
+	for i := 0; i < rho*nbColumns; i++ {
+		entryList[i] = i
+	}
+
+	// append p and commit (otherwise the proof cannot be built)
+	tc.Append(p)
+	_, err = tc.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// at each trial, it's the i-th line which is selected
+	for i := 0; i < nbRows; i++ {
+
+		// used for the random linear combination.
+		// it will act as a selector for the test: it selects the i-th
+		// row of p, when p is written as a matrix M_ij, where M_ij=p[i*m+j].
+		// The i-th entry of l is 1, the others are 0.
+		l := make([]fr.Element, nbRows)
+		l[i].SetInt64(1)
+
+		proof, err := buildProof(tc, l, entryList)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the i-th line of p is the one that is supposed to be selected
+		// (corresponding to the linear combination)
+		expected := make([]fr.Element, nbColumns)
+		for j := 0; j < nbColumns; j++ {
+			expected[j].Set(&p[j*nbRows+i])
+		}
+
+		for j := 0; j < nbColumns; j++ {
+			if !expected[j].Equal(&proof.LinearCombination[j]) {
+				t.Fatal("expected linear combination is incorrect")
+			}
+		}
+
+	}
+}
+
+// Test the verification of a correct proof using a mock hash
+func TestCommitmentDummyHash(t *testing.T) {
+
+	var rho, nbColumns, nbRows int
+	rho = 4
+	nbColumns = 8
+	nbRows = 8
+
+	var h DummyHash
+	params, err := NewTCParams(rho, nbColumns, nbRows, DummyHashMaker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := NewTensorCommitment(params)
+
+	// random polynomial
+	p := make([]fr.Element, nbRows*nbColumns)
+	for i := 0; i < nbRows*nbColumns; i++ {
+		p[i].SetRandom()
+	}
+
+	// coefficients for the linear combination
+	l := make([]fr.Element, nbRows)
+	for i := 0; i < nbRows; i++ {
+		l[i].SetRandom()
+	}
+
+	// we select all the entries for the test
+	entryList := make([]int, rho*nbColumns)
+	for i := 0; i < rho*nbColumns; i++ {
+		entryList[i] = i
+	}
+
+	// compute the digest...
+	_, err = tc.Append(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := tc.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// build the proof...
+	proof, err := buildProof(tc, l, entryList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// verify that the proof is correct
+	err = Verify(proof, digest, l, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+// Test the opening using a dummy hash
+func TestOpeningDummyHash(t *testing.T) {
+
+	var rho, nbColumns, nbRows int
+	rho = 4
+	nbColumns = 8
+	nbRows = 8
+
+	params, err := NewTCParams(rho, nbColumns, nbRows, DummyHashMaker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := NewTensorCommitment(params)
+
+	// random polynomial
+	p := make([]fr.Element, nbColumns*nbRows)
+	for i := 0; i < nbColumns*nbRows; i++ {
+		p[i].SetRandom()
+	}
+
+	// the coefficients are (1,x,x^2,..,x^{n-1}) where x is the point
+	// at which the opening is done
+	var xm, x fr.Element
+	x.SetRandom()
+	hi := make([]fr.Element, nbColumns) // stores [1,x^{nbRows},..,x^{nbRows*nbColumns^-1}]
+	lo := make([]fr.Element, nbRows)    // stores [1,x,..,x^{nbRows-1}]
+	lo[0].SetInt64(1)
+	hi[0].SetInt64(1)
+	xm.Exp(x, big.NewInt(int64(nbRows)))
+	for i := 1; i < nbColumns; i++ {
+		lo[i].Mul(&lo[i-1], &x)
+		hi[i].Mul(&hi[i-1], &xm)
+	}
+
+	// create the digest before computing the proof
+	_, err = tc.Append(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tc.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// build the proof
+	entryList := make([]int, rho*nbColumns)
+	for i := 0; i < rho*nbColumns; i++ {
+		entryList[i] = i
+	}
+	proof, err := buildProof(tc, lo, entryList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// finish the evaluation by computing
+	// [linearCombination] * [hi]^t
+	var eval, tmp fr.Element
+	for i := 0; i < nbColumns; i++ {
+		tmp.Mul(&proof.LinearCombination[i], &hi[i])
+		eval.Add(&eval, &tmp)
+	}
+
+	// compute the real evaluation of p at x manually
+	var expectedEval fr.Element
+	for i := 0; i < nbRows*nbColumns; i++ {
+		expectedEval.Mul(&expectedEval, &x)
+		expectedEval.Add(&expectedEval, &p[len(p)-i-1])
+	}
+
+	// the results coincide
+	if !expectedEval.Equal(&eval) {
+		t.Fatal("p(x) != [ lo ] x M x [ hi ]^t")
+	}
+
+}
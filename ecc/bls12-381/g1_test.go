@@ -25,6 +25,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/dudect"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -445,6 +446,188 @@ func TestG1AffineOps(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1JacScalarMultiplicationBase(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BLS12-381] scalar multiplication with the base table and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+			var bs, nbs big.Int
+			s.BigInt(&bs)
+			nbs.Neg(&bs)
+
+			var op1, op2, op3, op4 G1Jac
+			op1.mulBase(&bs)
+			op2.ScalarMultiplication(&g1Gen, &bs)
+			op3.mulBase(&nbs)
+			op4.ScalarMultiplication(&g1Gen, &nbs)
+
+			var a1, a2 G1Affine
+			a1.ScalarMultiplicationBase(&bs)
+			a2.FromJacobian(&op2)
+
+			return op1.Equal(&op2) && op3.Equal(&op4) && a1.Equal(&a2)
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases: small scalars, scalars close to r, negative and larger than r
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(31),
+		big.NewInt(32),
+		big.NewInt(-3),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Sub(r, big.NewInt(2)),
+		new(big.Int).Set(r),
+		new(big.Int).Add(r, big.NewInt(5)),
+		new(big.Int).Lsh(big.NewInt(1), fr.Bits-1),
+	}
+	for _, s := range scalars {
+		var op1, op2 G1Jac
+		op1.mulBase(s)
+		op2.mulWindowed(&g1Gen, s)
+		if !op1.Equal(&op2) {
+			t.Fatalf("scalar multiplication with the base table by %s is incorrect", s.String())
+		}
+	}
+}
+
+func TestG1JacScalarMultiplicationConstantTime(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BLS12-381] constant-time scalar multiplication and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s, x fr.Element) bool {
+			var a, op1, op2 G1Jac
+			a.ScalarMultiplication(&g1Gen, x.BigInt(new(big.Int)))
+
+			var bs big.Int
+			s.BigInt(&bs)
+			op1.ScalarMultiplicationConstantTime(&a, &bs)
+			op2.ScalarMultiplication(&a, &bs)
+			return op1.Equal(&op2)
+		},
+		genScalar,
+		genScalar,
+	))
+
+	properties.Property("[BLS12-381] constant-time scalar multiplication (affine) and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G1Affine
+			var bs big.Int
+			s.BigInt(&bs)
+			op1.ScalarMultiplicationBaseConstantTime(&bs)
+			op2.ScalarMultiplication(&g1GenAff, &bs)
+			return op1.Equal(&op2)
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases: small scalars, scalars close to r, negative and larger than r
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(15),
+		big.NewInt(16),
+		big.NewInt(-3),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Sub(r, big.NewInt(2)),
+		new(big.Int).Sub(r, big.NewInt(30)),
+		new(big.Int).Set(r),
+		new(big.Int).Add(r, big.NewInt(5)),
+		new(big.Int).Lsh(big.NewInt(1), fr.Bits-1),
+	}
+	for _, s := range scalars {
+		var op1, op2 G1Jac
+		op1.ScalarMultiplicationConstantTime(&g1Gen, s)
+		op2.mulWindowed(&g1Gen, s)
+		if !op1.Equal(&op2) {
+			t.Fatalf("constant-time scalar multiplication by %s is incorrect", s.String())
+		}
+		op1.ScalarMultiplicationBaseConstantTime(s)
+		if !op1.Equal(&op2) {
+			t.Fatalf("constant-time scalar multiplication of the generator by %s is incorrect", s.String())
+		}
+		var a1, a2 G1Affine
+		a1.ScalarMultiplicationBaseConstantTime(s)
+		a2.FromJacobian(&op2)
+		if !a1.Equal(&a2) {
+			t.Fatalf("constant-time scalar multiplication (affine) by %s is incorrect", s.String())
+		}
+	}
+
+	var inf G1Jac
+	inf.ScalarMultiplicationConstantTime(&g1Infinity, big.NewInt(42))
+	if !inf.Z.IsZero() {
+		t.Fatal("constant-time scalar multiplication of infinity should be infinity")
+	}
+}
+
+func TestG1JacScalarMultiplicationConstantTimeTiming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	// class 0: fixed scalar, class 1: random scalars
+	var fixed, e fr.Element
+	fixed.SetOne()
+	var s big.Int
+	var p G1Jac
+	prepare := func(class int) {
+		if class == 0 {
+			e.Set(&fixed)
+		} else {
+			e.SetRandom()
+		}
+		e.BigInt(&s)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationConstantTime(&g1Gen, &s) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected: |t| = %.2f", tt)
+	}
+
+	g1BaseTable()
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationBaseConstantTime(&s) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the fixed-base scalar multiplication: |t| = %.2f", tt)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationElementConstantTime(&g1Gen, &e) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the scalar multiplication by an fr.Element: |t| = %.2f", tt)
+	}
+
+	if tt := dudect.Measure(1000, prepare, func() { p.ScalarMultiplicationBaseElementConstantTime(&e) }); tt > dudect.Threshold {
+		t.Fatalf("timing leak detected in the fixed-base scalar multiplication by an fr.Element: |t| = %.2f", tt)
+	}
+}
+
 func TestG1AffineCofactorCleaning(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -670,6 +853,31 @@ func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var base G1Jac
+	g1BaseTable()
+	b.Run("base table", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			base.mulBase(&scalar)
+		}
+	})
+	var ct G1Jac
+	var e fr.Element
+	e.SetBigInt(&scalar)
+	b.Run("constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.mulConstantTime(&g1Gen, &e)
+		}
+	})
+
+	b.Run("base table constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.mulBaseConstantTime(&e)
+		}
+	})
+
 }
 
 func BenchmarkG1AffineCofactorClearing(b *testing.B) {
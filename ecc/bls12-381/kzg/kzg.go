@@ -17,6 +17,7 @@
 package kzg
 
 import (
+	"context"
 	"errors"
 	"hash"
 	"math/big"
@@ -28,6 +29,7 @@ import (
 	"github.com/consensys/gnark-crypto/fiat-shamir"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 var (
@@ -168,6 +170,16 @@ type BatchOpeningProof struct {
 // Commit commits to a polynomial using a multi exponentiation with the SRS.
 // It is assumed that the polynomial is in canonical form, in Montgomery form.
 func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	var opts []Option
+	if len(nbTasks) > 0 {
+		opts = append(opts, WithNbTasks(nbTasks[0]))
+	}
+	return CommitCtx(context.Background(), p, pk, opts...)
+}
+
+// CommitCtx is like Commit but stops the multi exponentiation when ctx is done,
+// in which case ctx.Err() is returned.
+func CommitCtx(ctx context.Context, p []fr.Element, pk ProvingKey, opts ...Option) (Digest, error) {
 
 	if len(p) == 0 || len(p) > len(pk.G1) {
 		return Digest{}, ErrInvalidPolynomialSize
@@ -175,11 +187,9 @@ func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 
 	var res bls12381.G1Affine
 
-	config := ecc.MultiExpConfig{}
-	if len(nbTasks) > 0 {
-		config.NbTasks = nbTasks[0]
-	}
-	if _, err := res.MultiExp(pk.G1[:len(p)], p, config); err != nil {
+	opt := proverOptions(opts...)
+	config := ecc.MultiExpConfig{NbTasks: opt.nbTasks, Scheduler: opt.scheduler}
+	if _, err := res.MultiExpCtx(ctx, pk.G1[:len(p)], p, config); err != nil {
 		return Digest{}, err
 	}
 
@@ -189,6 +199,12 @@ func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 // Open computes an opening proof of polynomial p at given point.
 // fft.Domain Cardinality must be larger than p.Degree()
 func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error) {
+	return OpenCtx(context.Background(), p, point, pk)
+}
+
+// OpenCtx is like Open but stops the computation when ctx is done,
+// in which case ctx.Err() is returned.
+func OpenCtx(ctx context.Context, p []fr.Element, point fr.Element, pk ProvingKey, opts ...Option) (OpeningProof, error) {
 	if len(p) == 0 || len(p) > len(pk.G1) {
 		return OpeningProof{}, ErrInvalidPolynomialSize
 	}
@@ -205,7 +221,7 @@ func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error)
 	h := dividePolyByXminusA(_p, res.ClaimedValue, point)
 
 	// commit to H
-	hCommit, err := Commit(h, pk)
+	hCommit, err := CommitCtx(ctx, h, pk, opts...)
 	if err != nil {
 		return OpeningProof{}, err
 	}
@@ -263,6 +279,13 @@ func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk Verify
 // * polynomials is the list of polynomials to open, they are supposed to be of the same size.
 // * dataTranscript extra data that might be needed to derive the challenge used for folding
 func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	return BatchOpenSinglePointCtx(context.Background(), polynomials, digests, point, hf, pk, dataTranscript)
+}
+
+// BatchOpenSinglePointCtx is like BatchOpenSinglePoint but stops the computation when ctx is done,
+// in which case ctx.Err() is returned. The extra data to derive the challenge are given in dataTranscript.
+func BatchOpenSinglePointCtx(ctx context.Context, polynomials [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript [][]byte, opts ...Option) (BatchOpeningProof, error) {
+	opt := proverOptions(opts...)
 
 	// check for invalid sizes
 	nbDigests := len(digests)
@@ -288,10 +311,11 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 	var wg sync.WaitGroup
 	wg.Add(len(polynomials))
 	for i := 0; i < len(polynomials); i++ {
-		go func(_i int) {
+		_i := i
+		scheduler.Go(opt.scheduler, func() {
 			res.ClaimedValues[_i] = eval(polynomials[_i], point)
 			wg.Done()
-		}(i)
+		})
 	}
 
 	// wait for polynomial evaluations to be completed (res.ClaimedValues)
@@ -306,14 +330,14 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 	// ∑ᵢγⁱf(a)
 	var foldedEvaluations fr.Element
 	chSumGammai := make(chan struct{}, 1)
-	go func() {
+	scheduler.Go(opt.scheduler, func() {
 		foldedEvaluations = res.ClaimedValues[nbDigests-1]
 		for i := nbDigests - 2; i >= 0; i-- {
 			foldedEvaluations.Mul(&foldedEvaluations, &gamma).
 				Add(&foldedEvaluations, &res.ClaimedValues[i])
 		}
 		close(chSumGammai)
-	}()
+	})
 
 	// compute ∑ᵢγⁱfᵢ
 	// note: if we are willing to parallelize that, we could clone the poly and scale them by
@@ -328,7 +352,7 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 
 	for i := 1; i < len(polynomials); i++ {
 		i := i
-		parallel.Execute(len(polynomials[i]), func(start, end int) {
+		scheduler.Execute(opt.scheduler, len(polynomials[i]), func(start, end int) {
 			var pj fr.Element
 			for j := start; j < end; j++ {
 				pj.Mul(&polynomials[i][j], &gammas[i-1])
@@ -342,7 +366,7 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 	h := dividePolyByXminusA(foldedPolynomials, foldedEvaluations, point)
 	foldedPolynomials = nil // same memory as h
 
-	res.H, err = Commit(h, pk)
+	res.H, err = CommitCtx(ctx, h, pk, opts...)
 	if err != nil {
 		return BatchOpeningProof{}, err
 	}
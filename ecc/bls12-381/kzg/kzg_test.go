@@ -17,6 +17,7 @@
 package kzg
 
 import (
+	"context"
 	"crypto/sha256"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
 
 	"github.com/consensys/gnark-crypto/utils"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // Test SRS re-used across tests of the KZG scheme
@@ -196,6 +198,60 @@ func TestCommit(t *testing.T) {
 
 }
 
+func TestCommitCtx(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+
+	expected, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+	got, err := CommitCtx(context.Background(), f, testSrs.Pk)
+	assert.NoError(err)
+	assert.True(expected.Equal(&got), "CommitCtx and Commit results differ")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = CommitCtx(ctx, f, testSrs.Pk)
+	assert.ErrorIs(err, context.Canceled)
+
+	var point fr.Element
+	point.SetString("4321")
+	_, err = OpenCtx(ctx, f, point, testSrs.Pk)
+	assert.ErrorIs(err, context.Canceled)
+}
+
+func TestWithScheduler(t *testing.T) {
+	assert := require.New(t)
+
+	f := [][]fr.Element{randomPolynomial(60), randomPolynomial(40), randomPolynomial(60)}
+	var point fr.Element
+	point.SetString("4321")
+	s := scheduler.New(2)
+
+	expected, err := Commit(f[0], testSrs.Pk)
+	assert.NoError(err)
+	got, err := CommitCtx(context.Background(), f[0], testSrs.Pk, WithScheduler(s), WithNbTasks(2))
+	assert.NoError(err)
+	assert.True(expected.Equal(&got), "the commitment should not depend on the scheduler")
+
+	expectedProof, err := Open(f[0], point, testSrs.Pk)
+	assert.NoError(err)
+	proof, err := OpenCtx(context.Background(), f[0], point, testSrs.Pk, WithScheduler(s))
+	assert.NoError(err)
+	assert.Equal(expectedProof, proof, "the opening proof should not depend on the scheduler")
+
+	digests := make([]Digest, len(f))
+	for i := range f {
+		digests[i], err = Commit(f[i], testSrs.Pk)
+		assert.NoError(err)
+	}
+	expectedBatchProof, err := BatchOpenSinglePoint(f, digests, point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	batchProof, err := BatchOpenSinglePointCtx(context.Background(), f, digests, point, sha256.New(), testSrs.Pk, nil, WithScheduler(s))
+	assert.NoError(err)
+	assert.Equal(expectedBatchProof, batchProof, "the batch opening proof should not depend on the scheduler")
+}
+
 func TestVerifySinglePoint(t *testing.T) {
 
 	// create a polynomial
@@ -17,10 +17,11 @@
 package bls12381
 
 import (
+	"context"
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"math"
 	"runtime"
 )
@@ -29,8 +30,15 @@ import (
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G1Affine) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	return p.MultiExpCtx(context.Background(), points, scalars, config)
+}
+
+// MultiExpCtx is like MultiExp but stops the computation when ctx is done.
+//
+// In that case, the worker go routines are stopped and ctx.Err() is returned; p is left unchanged.
+func (p *G1Affine) MultiExpCtx(ctx context.Context, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
 	var _p G1Jac
-	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+	if _, err := _p.MultiExpCtx(ctx, points, scalars, config); err != nil {
 		return nil, err
 	}
 	p.FromJacobian(&_p)
@@ -41,6 +49,13 @@ func (p *G1Affine) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	return p.MultiExpCtx(context.Background(), points, scalars, config)
+}
+
+// MultiExpCtx is like MultiExp but stops the computation when ctx is done.
+//
+// In that case, the worker go routines are stopped and ctx.Err() is returned; the value of p is undefined.
+func (p *G1Jac) MultiExpCtx(ctx context.Context, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
 	// note:
 	// each of the msmCX method is the same, except for the c constant it declares
@@ -81,6 +96,10 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
@@ -140,25 +159,37 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		config.NbTasks = int(math.Ceil(float64(config.NbTasks) / 2.0))
 		var _p G1Jac
 		chDone := make(chan struct{}, 1)
-		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+		scheduler.Go(config.Scheduler, func() {
+			_p.MultiExpCtx(ctx, points[:nbPoints/2], scalars[:nbPoints/2], config)
 			close(chDone)
-		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		})
+		_, err := p.MultiExpCtx(ctx, points[nbPoints/2:], scalars[nbPoints/2:], config)
 		<-chDone
+		if err != nil {
+			return nil, err
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		p.AddAssign(&_p)
 		return p, nil
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG1(p, C, points, scalars, config)
+	_innerMsmG1(p, C, points, scalars, config, ctx.Done())
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
 
-func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
+// _innerMsmG1 computes the msm with window size c.
+// If done is closed, the chunk processors stop early and the value of p is undefined.
+func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig, done <-chan struct{}) *G1Jac {
 	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks, config.Scheduler)
 
 	nbChunks := computeNbChunks(c)
 
@@ -187,8 +218,11 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 	}
 
 	// the last chunk may be processed with a different method than the rest, as it could be smaller.
+	// the go routines are spawned through the scheduler; if it has no room left, the chunk is
+	// processed by the current go routine.
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
+		j := j
 		processChunk := getChunkProcessorG1(c, chunkStats[j])
 		if j == int(nbChunks-1) {
 			processChunk = getChunkProcessorG1(lastC(c), chunkStats[j])
@@ -202,8 +236,12 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 			if sem != nil {
 				sem <- struct{}{} // add another token to the semaphore, since we split in two.
 			}
-			go processChunk(uint64(j), chSplit, c, points[:split], digits[j*n:(j*n)+split], sem)
-			go processChunk(uint64(j), chSplit, c, points[split:], digits[(j*n)+split:(j+1)*n], sem)
+			scheduler.Go(config.Scheduler, func() {
+				processChunk(uint64(j), chSplit, c, points[:split], digits[j*n:(j*n)+split], sem, done)
+			})
+			scheduler.Go(config.Scheduler, func() {
+				processChunk(uint64(j), chSplit, c, points[split:], digits[(j*n)+split:(j+1)*n], sem, done)
+			})
 			go func(chunkID int) {
 				s1 := <-chSplit
 				s2 := <-chSplit
@@ -213,7 +251,9 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 			}(j)
 			continue
 		}
-		go processChunk(uint64(j), chChunks[j], c, points, digits[j*n:(j+1)*n], sem)
+		scheduler.Go(config.Scheduler, func() {
+			processChunk(uint64(j), chChunks[j], c, points, digits[j*n:(j+1)*n], sem, done)
+		})
 	}
 
 	return msmReduceChunkG1Affine(p, int(c), chChunks[:])
@@ -221,7 +261,7 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 
 // getChunkProcessorG1 decides, depending on c window size and statistics for the chunk
 // to return the best algorithm to process the chunk.
-func getChunkProcessorG1(c uint64, stat chunkStat) func(chunkID uint64, chRes chan<- g1JacExtended, c uint64, points []G1Affine, digits []uint16, sem chan struct{}) {
+func getChunkProcessorG1(c uint64, stat chunkStat) func(chunkID uint64, chRes chan<- g1JacExtended, c uint64, points []G1Affine, digits []uint16, sem chan struct{}, done <-chan struct{}) {
 	switch c {
 
 	case 3:
@@ -327,8 +367,15 @@ func msmReduceChunkG1Affine(p *G1Jac, c int, chChunks []chan g1JacExtended) *G1J
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G2Affine) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	return p.MultiExpCtx(context.Background(), points, scalars, config)
+}
+
+// MultiExpCtx is like MultiExp but stops the computation when ctx is done.
+//
+// In that case, the worker go routines are stopped and ctx.Err() is returned; p is left unchanged.
+func (p *G2Affine) MultiExpCtx(ctx context.Context, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
 	var _p G2Jac
-	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+	if _, err := _p.MultiExpCtx(ctx, points, scalars, config); err != nil {
 		return nil, err
 	}
 	p.FromJacobian(&_p)
@@ -339,6 +386,13 @@ func (p *G2Affine) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	return p.MultiExpCtx(context.Background(), points, scalars, config)
+}
+
+// MultiExpCtx is like MultiExp but stops the computation when ctx is done.
+//
+// In that case, the worker go routines are stopped and ctx.Err() is returned; the value of p is undefined.
+func (p *G2Jac) MultiExpCtx(ctx context.Context, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
 	// note:
 	// each of the msmCX method is the same, except for the c constant it declares
@@ -379,6 +433,10 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
@@ -438,25 +496,37 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		config.NbTasks = int(math.Ceil(float64(config.NbTasks) / 2.0))
 		var _p G2Jac
 		chDone := make(chan struct{}, 1)
-		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+		scheduler.Go(config.Scheduler, func() {
+			_p.MultiExpCtx(ctx, points[:nbPoints/2], scalars[:nbPoints/2], config)
 			close(chDone)
-		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		})
+		_, err := p.MultiExpCtx(ctx, points[nbPoints/2:], scalars[nbPoints/2:], config)
 		<-chDone
+		if err != nil {
+			return nil, err
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		p.AddAssign(&_p)
 		return p, nil
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG2(p, C, points, scalars, config)
+	_innerMsmG2(p, C, points, scalars, config, ctx.Done())
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
 
-func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
+// _innerMsmG2 computes the msm with window size c.
+// If done is closed, the chunk processors stop early and the value of p is undefined.
+func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig, done <-chan struct{}) *G2Jac {
 	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks, config.Scheduler)
 
 	nbChunks := computeNbChunks(c)
 
@@ -485,8 +555,11 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 	}
 
 	// the last chunk may be processed with a different method than the rest, as it could be smaller.
+	// the go routines are spawned through the scheduler; if it has no room left, the chunk is
+	// processed by the current go routine.
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
+		j := j
 		processChunk := getChunkProcessorG2(c, chunkStats[j])
 		if j == int(nbChunks-1) {
 			processChunk = getChunkProcessorG2(lastC(c), chunkStats[j])
@@ -500,8 +573,12 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 			if sem != nil {
 				sem <- struct{}{} // add another token to the semaphore, since we split in two.
 			}
-			go processChunk(uint64(j), chSplit, c, points[:split], digits[j*n:(j*n)+split], sem)
-			go processChunk(uint64(j), chSplit, c, points[split:], digits[(j*n)+split:(j+1)*n], sem)
+			scheduler.Go(config.Scheduler, func() {
+				processChunk(uint64(j), chSplit, c, points[:split], digits[j*n:(j*n)+split], sem, done)
+			})
+			scheduler.Go(config.Scheduler, func() {
+				processChunk(uint64(j), chSplit, c, points[split:], digits[(j*n)+split:(j+1)*n], sem, done)
+			})
 			go func(chunkID int) {
 				s1 := <-chSplit
 				s2 := <-chSplit
@@ -511,7 +588,9 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 			}(j)
 			continue
 		}
-		go processChunk(uint64(j), chChunks[j], c, points, digits[j*n:(j+1)*n], sem)
+		scheduler.Go(config.Scheduler, func() {
+			processChunk(uint64(j), chChunks[j], c, points, digits[j*n:(j+1)*n], sem, done)
+		})
 	}
 
 	return msmReduceChunkG2Affine(p, int(c), chChunks[:])
@@ -519,7 +598,7 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 
 // getChunkProcessorG2 decides, depending on c window size and statistics for the chunk
 // to return the best algorithm to process the chunk.
-func getChunkProcessorG2(c uint64, stat chunkStat) func(chunkID uint64, chRes chan<- g2JacExtended, c uint64, points []G2Affine, digits []uint16, sem chan struct{}) {
+func getChunkProcessorG2(c uint64, stat chunkStat) func(chunkID uint64, chRes chan<- g2JacExtended, c uint64, points []G2Affine, digits []uint16, sem chan struct{}, done <-chan struct{}) {
 	switch c {
 
 	case 3:
@@ -621,6 +700,20 @@ func msmReduceChunkG2Affine(p *G2Jac, c int, chChunks []chan g2JacExtended) *G2J
 	return p.unsafeFromJacExtended(&_p)
 }
 
+// msmCancelCheckInterval is the number of digits a chunk processor handles
+// between two checks for cancellation.
+const msmCancelCheckInterval = 1 << 10
+
+// isDone returns true if the done channel is closed; a nil channel is never done.
+func isDone(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
 // selector stores the index, mask and shifts needed to select bits from a scalar
 // it is used during the multiExp algorithm or the batch scalar multiplication
 type selector struct {
@@ -663,7 +756,7 @@ type chunkStat struct {
 // 2^{c} to the current digit, making it negative.
 // negative digits can be processed in a later step as adding -G into the bucket instead of G
 // (computing -G is cheap, and this saves us half of the buckets in the MultiExp or BatchScalarMultiplication)
-func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []chunkStat) {
+func partitionScalars(scalars []fr.Element, c uint64, nbTasks int, s scheduler.Scheduler) ([]uint16, []chunkStat) {
 	// no benefit here to have more tasks than CPUs
 	if nbTasks > runtime.NumCPU() {
 		nbTasks = runtime.NumCPU()
@@ -695,7 +788,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 		selectors[chunk] = d
 	}
 
-	parallel.Execute(len(scalars), func(start, end int) {
+	scheduler.Execute(s, len(scalars), func(start, end int) {
 		for i := start; i < end; i++ {
 			if scalars[i].IsZero() {
 				// everything is 0, no need to process this scalar
@@ -765,7 +858,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 		// no need to compute stats for small window sizes
 		return digits, chunkStats
 	}
-	parallel.Execute(len(chunkStats), func(start, end int) {
+	scheduler.Execute(s, len(chunkStats), func(start, end int) {
 		// for each chunk compute the statistics
 		for chunkID := start; chunkID < end; chunkID++ {
 			// indicates if a bucket is hit.
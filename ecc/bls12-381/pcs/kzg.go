@@ -0,0 +1,169 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"hash"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+)
+
+// NewKZG returns the KZG commitment scheme using pk to commit and open, and vk to verify.
+// A verifier may leave pk empty.
+// hf is used to derive the folding challenge of batch openings.
+func NewKZG(pk kzg.ProvingKey, vk kzg.VerifyingKey, hf hash.Hash) PolynomialCommitmentScheme {
+	return &kzgScheme{pk: pk, vk: vk, hf: hf}
+}
+
+type kzgScheme struct {
+	pk kzg.ProvingKey
+	vk kzg.VerifyingKey
+	hf hash.Hash
+}
+
+type kzgCommitment struct {
+	digest kzg.Digest
+}
+
+type kzgOpeningProof struct {
+	proof kzg.OpeningProof
+}
+
+type kzgBatchOpeningProof struct {
+	proof kzg.BatchOpeningProof
+}
+
+func (s *kzgScheme) Commit(p []fr.Element) (Commitment, error) {
+	digest, err := kzg.Commit(p, s.pk)
+	if err != nil {
+		return nil, err
+	}
+	return &kzgCommitment{digest: digest}, nil
+}
+
+func (s *kzgScheme) Open(p []fr.Element, commitment Commitment, point fr.Element) (OpeningProof, error) {
+	if _, ok := commitment.(*kzgCommitment); !ok {
+		return nil, ErrUnexpectedType
+	}
+	proof, err := kzg.Open(p, point, s.pk)
+	if err != nil {
+		return nil, err
+	}
+	return &kzgOpeningProof{proof: proof}, nil
+}
+
+func (s *kzgScheme) Verify(commitment Commitment, proof OpeningProof, point fr.Element) error {
+	c, ok := commitment.(*kzgCommitment)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	p, ok := proof.(*kzgOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	return kzg.Verify(&c.digest, &p.proof, point, s.vk)
+}
+
+func (s *kzgScheme) BatchOpen(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	digests, err := kzgDigests(commitments)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := kzg.BatchOpenSinglePoint(polynomials, digests, point, s.hf, s.pk, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+	return &kzgBatchOpeningProof{proof: proof}, nil
+}
+
+func (s *kzgScheme) BatchVerify(commitments []Commitment, proof BatchOpeningProof, point fr.Element, dataTranscript ...[]byte) error {
+	digests, err := kzgDigests(commitments)
+	if err != nil {
+		return err
+	}
+	p, ok := proof.(*kzgBatchOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	return kzg.BatchVerifySinglePoint(digests, &p.proof, point, s.hf, s.vk, dataTranscript...)
+}
+
+func (s *kzgScheme) NewCommitment() Commitment {
+	return new(kzgCommitment)
+}
+
+func (s *kzgScheme) NewOpeningProof() OpeningProof {
+	return new(kzgOpeningProof)
+}
+
+func (s *kzgScheme) NewBatchOpeningProof() BatchOpeningProof {
+	return new(kzgBatchOpeningProof)
+}
+
+// kzgDigests returns the kzg digests of the commitments
+func kzgDigests(commitments []Commitment) ([]kzg.Digest, error) {
+	digests := make([]kzg.Digest, len(commitments))
+	for i := range commitments {
+		c, ok := commitments[i].(*kzgCommitment)
+		if !ok {
+			return nil, ErrUnexpectedType
+		}
+		digests[i] = c.digest
+	}
+	return digests, nil
+}
+
+// WriteTo writes the compressed encoding of the digest to w.
+func (c *kzgCommitment) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12381.NewEncoder(w)
+	err := enc.Encode(&c.digest)
+	return enc.BytesWritten(), err
+}
+
+// ReadFrom decodes a digest written by WriteTo from r.
+func (c *kzgCommitment) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12381.NewDecoder(r)
+	err := dec.Decode(&c.digest)
+	return dec.BytesRead(), err
+}
+
+func (p *kzgOpeningProof) ClaimedValue() fr.Element {
+	return p.proof.ClaimedValue
+}
+
+func (p *kzgOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	return p.proof.WriteTo(w)
+}
+
+func (p *kzgOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	return p.proof.ReadFrom(r)
+}
+
+func (p *kzgBatchOpeningProof) ClaimedValues() []fr.Element {
+	return p.proof.ClaimedValues
+}
+
+func (p *kzgBatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	return p.proof.WriteTo(w)
+}
+
+func (p *kzgBatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	return p.proof.ReadFrom(r)
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fri"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+)
+
+func TestKZG(t *testing.T) {
+	const size = 64
+	srs, err := kzg.NewSRS(size, big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testScheme(t, NewKZG(srs.Pk, srs.Vk, sha256.New()), size)
+}
+
+func TestFRI(t *testing.T) {
+	const size = 64
+	for _, params := range []fri.Params{
+		fri.DefaultParams(),
+		{FoldingArity: 4, Rho: 4, NbQueries: 8, GrindingBits: 4, FinalDegree: 3},
+	} {
+		scheme, err := NewFRI(size, sha256.New(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testScheme(t, scheme, size)
+	}
+}
+
+// testScheme runs the checks every PolynomialCommitmentScheme must pass, on polynomials of size
+// at most size
+func testScheme(t *testing.T, scheme PolynomialCommitmentScheme, size int) {
+	t.Helper()
+
+	polynomials := [][]fr.Element{randomPolynomial(size), randomPolynomial(size - 3), randomPolynomial(1)}
+	commitments := make([]Commitment, len(polynomials))
+	for i := range polynomials {
+		var err error
+		if commitments[i], err = scheme.Commit(polynomials[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var point fr.Element
+	point.SetRandom()
+
+	// single opening
+	proof, err := scheme.Open(polynomials[0], commitments[0], point)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := eval(polynomials[0], point)
+	if claimed := proof.ClaimedValue(); !claimed.Equal(&expected) {
+		t.Fatal("wrong claimed value")
+	}
+	if err := scheme.Verify(commitments[0], proof, point); err != nil {
+		t.Fatal(err)
+	}
+	var otherPoint fr.Element
+	otherPoint.SetRandom()
+	if err := scheme.Verify(commitments[0], proof, otherPoint); err == nil {
+		t.Fatal("verifying an opening at another point should fail")
+	}
+	if err := scheme.Verify(commitments[1], proof, point); err == nil {
+		t.Fatal("verifying an opening against another commitment should fail")
+	}
+
+	// serialization
+	commitment := scheme.NewCommitment()
+	roundTrip(t, commitments[0], commitment)
+	proofRead := scheme.NewOpeningProof()
+	roundTrip(t, proof, proofRead)
+	if err := scheme.Verify(commitment, proofRead, point); err != nil {
+		t.Fatal(err)
+	}
+
+	// batch opening
+	batchProof, err := scheme.BatchOpen(polynomials, commitments, point, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimedValues := batchProof.ClaimedValues()
+	for i := range polynomials {
+		expected := eval(polynomials[i], point)
+		if !claimedValues[i].Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+	}
+	if err := scheme.BatchVerify(commitments, batchProof, point, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := scheme.BatchVerify(commitments, batchProof, point, []byte("other data")); err == nil {
+		t.Fatal("verifying a batch opening with another transcript should fail")
+	}
+	commitments[0], commitments[1] = commitments[1], commitments[0]
+	if err := scheme.BatchVerify(commitments, batchProof, point, []byte("data")); err == nil {
+		t.Fatal("verifying a batch opening against permuted commitments should fail")
+	}
+	commitments[0], commitments[1] = commitments[1], commitments[0]
+
+	batchProofRead := scheme.NewBatchOpeningProof()
+	roundTrip(t, batchProof, batchProofRead)
+	if err := scheme.BatchVerify(commitments, batchProofRead, point, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// roundTrip writes from to a buffer and reads it back into to
+func roundTrip(t *testing.T, from io.WriterTo, to io.ReaderFrom) {
+	t.Helper()
+	var buf bytes.Buffer
+	written, err := from.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := to.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read != written {
+		t.Fatalf("read %d bytes, wrote %d", read, written)
+	}
+}
+
+func randomPolynomial(size int) []fr.Element {
+	p := make([]fr.Element, size)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
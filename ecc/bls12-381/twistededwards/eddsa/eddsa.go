@@ -17,20 +17,24 @@
 package eddsa
 
 import (
+	"crypto/rand"
 	"crypto/subtle"
 	"errors"
 	"hash"
 	"io"
 	"math/big"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/scalar"
 	"github.com/consensys/gnark-crypto/signature"
 	"golang.org/x/crypto/blake2b"
 )
 
 var errNotOnCurve = errors.New("point not on curve")
 var errHashNeeded = errors.New("hFunc cannot be nil. We need a hash for Fiat-Shamir")
+var errBatchSize = errors.New("public keys, signatures and messages must have the same length")
 
 const (
 	sizeFr         = fr.Bytes
@@ -61,8 +65,6 @@ type Signature struct {
 
 // GenerateKey generates a public and private key pair.
 func GenerateKey(r io.Reader) (*PrivateKey, error) {
-	c := twistededwards.GetEdwardsCurve()
-
 	var pub PublicKey
 	var priv PrivateKey
 	// hash(h) = private_key || random_source, on 32 bytes each
@@ -88,9 +90,9 @@ func GenerateKey(r io.Reader) (*PrivateKey, error) {
 		priv.scalar[i] = h[j]
 	}
 
-	var bScalar big.Int
-	bScalar.SetBytes(priv.scalar[:])
-	pub.A.ScalarMultiplication(&c.Base, &bScalar)
+	var a scalar.Element
+	a.SetBytesConstantTime(priv.scalar[:])
+	pub.A.ScalarMultiplicationBaseElementConstantTime(&a)
 
 	priv.PublicKey = pub
 
@@ -126,14 +128,12 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 		return nil, errHashNeeded
 	}
 
-	curveParams := twistededwards.GetEdwardsCurve()
-
 	var res Signature
 
 	// blinding factor for the private key
-	// blindingFactorBigInt must be the same size as the private key,
-	// blindingFactorBigInt = h(randomness_source||message)[:sizeFr]
-	var blindingFactorBigInt big.Int
+	// r must be the same size as the private key,
+	// r = h(randomness_source||message)[:sizeFr] (mod the order of the subgroup)
+	var r scalar.Element
 
 	// randSrc = privKey.randSrc || msg (-> message = MSB message .. LSB message)
 	randSrc := make([]byte, 32+len(message))
@@ -142,10 +142,10 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 
 	// randBytes = H(randSrc)
 	blindingFactorBytes := blake2b.Sum512(randSrc[:]) // TODO ensures that the hash used to build the key and the one used here is the same
-	blindingFactorBigInt.SetBytes(blindingFactorBytes[:sizeFr])
+	r.SetBytesConstantTime(blindingFactorBytes[:sizeFr])
 
 	// compute R = randScalar*Base
-	res.R.ScalarMultiplication(&curveParams.Base, &blindingFactorBigInt)
+	res.R.ScalarMultiplicationBaseElementConstantTime(&r)
 	if !res.R.IsOnCurve() {
 		return nil, errNotOnCurve
 	}
@@ -164,23 +164,17 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 		}
 	}
 
-	var hramInt big.Int
 	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
 
-	// Compute s = randScalarInt + H(R,A,M)*S
-	// going with big int to do ops mod curve order
-	var bscalar, bs big.Int
-	bscalar.SetBytes(privKey.scalar[:])
-	bs.Mul(&hramInt, &bscalar).
-		Add(&bs, &blindingFactorBigInt).
-		Mod(&bs, &curveParams.Order)
-	sb := bs.Bytes()
-	if len(sb) < sizeFr {
-		offset := make([]byte, sizeFr-len(sb))
-		sb = append(offset, sb...)
-	}
-	copy(res.S[:], sb[:])
+	// Compute s = randScalar + H(R,A,M)*S mod the order of the subgroup,
+	// without branching on the secrets randScalar and S
+	var s, a scalar.Element
+	a.SetBytesConstantTime(privKey.scalar[:])
+	s.SetBytesConstantTime(hramBin).
+		MulConstantTime(&s, &a).
+		AddConstantTime(&s, &r)
+	sb := s.Bytes()
+	copy(res.S[sizeFr-len(sb):], sb[:])
 
 	return res.Bytes(), nil
 }
@@ -208,31 +202,17 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 	}
 
 	// compute H(R, A, M), all parameters in data are in Montgomery form
-
-	hFunc.Reset()
-
-	sigRX := sig.R.X.Bytes()
-	sigRY := sig.R.Y.Bytes()
-	sigAX := pub.A.X.Bytes()
-	sigAY := pub.A.Y.Bytes()
-
-	toWrite := [][]byte{sigRX[:], sigRY[:], sigAX[:], sigAY[:], message}
-	for _, bytes := range toWrite {
-		if _, err := hFunc.Write(bytes); err != nil {
-			return false, err
-		}
+	hramInt, err := hashRAM(&sig.R, &pub.A, message, hFunc)
+	if err != nil {
+		return false, err
 	}
 
-	var hramInt big.Int
-	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
-
 	// lhs = cofactor*S*Base
 	var lhs twistededwards.PointAffine
 	var bCofactor, bs big.Int
 	curveParams.Cofactor.BigInt(&bCofactor)
 	bs.SetBytes(sig.S[:])
-	lhs.ScalarMultiplication(&curveParams.Base, &bs).
+	lhs.ScalarMultiplicationBase(&bs).
 		ScalarMultiplication(&lhs, &bCofactor)
 
 	if !lhs.IsOnCurve() {
@@ -255,3 +235,131 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 
 	return true, nil
 }
+
+// BatchVerify verifies a batch of eddsa signatures.
+//
+// Instead of checking cofactor*S_i*Base = cofactor*(R_i + H(R_i,A_i,M_i)*A_i)
+// for each signature, the equations are combined with random 128-bit
+// coefficients z_i into a single multi-scalar multiplication
+//
+//	cofactor*((∑z_i*S_i)*Base - ∑z_i*R_i - ∑(z_i*H(R_i,A_i,M_i))*A_i) = 0
+//
+// It returns true if all signatures are valid. Otherwise each signature is
+// verified on its own and the indices of the invalid ones are returned.
+func BatchVerify(publicKeys []PublicKey, sigsBin, messages [][]byte, hFunc hash.Hash) (bool, []int, error) {
+
+	// hFunc cannot be nil.
+	// We need a hash function for the Fiat-Shamir.
+	if hFunc == nil {
+		return false, nil, errHashNeeded
+	}
+	if len(publicKeys) != len(sigsBin) || len(publicKeys) != len(messages) {
+		return false, nil, errBatchSize
+	}
+	n := len(publicKeys)
+	if n == 0 {
+		return true, nil, nil
+	}
+
+	ok, err := batchVerify(publicKeys, sigsBin, messages, hFunc)
+	if err != nil {
+		return false, nil, err
+	}
+	if ok {
+		return true, nil, nil
+	}
+
+	// the batch is invalid, find the culprits
+	var invalid []int
+	for i := 0; i < n; i++ {
+		valid, err := publicKeys[i].Verify(sigsBin[i], messages[i], hFunc)
+		if err != nil || !valid {
+			invalid = append(invalid, i)
+		}
+	}
+
+	return false, invalid, nil
+}
+
+// batchVerify checks the randomized combination of the verification equations.
+// A malformed signature or public key makes the batch invalid.
+func batchVerify(publicKeys []PublicKey, sigsBin, messages [][]byte, hFunc hash.Hash) (bool, error) {
+	curveParams := twistededwards.GetEdwardsCurve()
+	n := len(publicKeys)
+
+	// points = [Base, -R_0, -A_0, ..., -R_{n-1}, -A_{n-1}]
+	points := make([]twistededwards.PointAffine, 2*n+1)
+	scalars := make([]big.Int, 2*n+1)
+	points[0].Set(&curveParams.Base)
+
+	var sig Signature
+	var z, s big.Int
+	var zBytes [16]byte
+	for i := 0; i < n; i++ {
+		if !publicKeys[i].A.IsOnCurve() {
+			return false, nil
+		}
+		if _, err := sig.SetBytes(sigsBin[i]); err != nil {
+			return false, nil
+		}
+
+		hramInt, err := hashRAM(&sig.R, &publicKeys[i].A, messages[i], hFunc)
+		if err != nil {
+			return false, err
+		}
+
+		// z_i must be unpredictable to the signers
+		if _, err := rand.Read(zBytes[:]); err != nil {
+			return false, err
+		}
+		z.SetBytes(zBytes[:])
+
+		// ∑z_i*S_i
+		s.SetBytes(sig.S[:])
+		s.Mul(&s, &z)
+		scalars[0].Add(&scalars[0], &s)
+
+		points[2*i+1].Neg(&sig.R)
+		scalars[2*i+1].Set(&z)
+
+		points[2*i+2].Neg(&publicKeys[i].A)
+		scalars[2*i+2].Mul(&hramInt, &z)
+	}
+
+	// the scalars are reduced modulo the subgroup order in MultiExp,
+	// which is sound since the result is multiplied by the cofactor
+	var res twistededwards.PointExtended
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+
+	var bCofactor big.Int
+	curveParams.Cofactor.BigInt(&bCofactor)
+	res.ScalarMultiplication(&res, &bCofactor)
+
+	return res.IsZero(), nil
+}
+
+// hashRAM computes H(R, A, M), all parameters in data are in Montgomery form
+func hashRAM(R, A *twistededwards.PointAffine, message []byte, hFunc hash.Hash) (big.Int, error) {
+	var hramInt big.Int
+
+	hFunc.Reset()
+
+	RX := R.X.Bytes()
+	RY := R.Y.Bytes()
+	AX := A.X.Bytes()
+	AY := A.Y.Bytes()
+
+	toWrite := [][]byte{RX[:], RY[:], AX[:], AY[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return hramInt, err
+		}
+	}
+
+	hramBin := hFunc.Sum(nil)
+	hramInt.SetBytes(hramBin)
+
+	return hramInt, nil
+}
@@ -264,6 +264,91 @@ func TestEddsaSHA256(t *testing.T) {
 
 }
 
+func TestBatchVerify(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	hFunc := hash.MIMC_BLS12_381.New()
+
+	const n = 10
+	publicKeys := make([]PublicKey, n)
+	signatures := make([][]byte, n)
+	messages := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys[i] = privKey.PublicKey
+
+		var frMsg fr.Element
+		frMsg.SetRandom()
+		msgBin := frMsg.Bytes()
+		messages[i] = msgBin[:]
+
+		signatures[i], err = privKey.Sign(messages[i], hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// all signatures are valid
+	valid, invalid, err := BatchVerify(publicKeys, signatures, messages, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid || len(invalid) != 0 {
+		t.Fatal("batch of valid signatures should verify")
+	}
+
+	// empty batch
+	valid, _, err = BatchVerify(nil, nil, nil, hFunc)
+	if err != nil || !valid {
+		t.Fatal("empty batch should verify")
+	}
+
+	// inconsistent sizes
+	if _, _, err = BatchVerify(publicKeys, signatures[1:], messages, hFunc); err != errBatchSize {
+		t.Fatal("expected error for inconsistent sizes")
+	}
+
+	// a wrong message and a swapped public key
+	var frMsg fr.Element
+	frMsg.SetRandom()
+	wrongMsg := frMsg.Bytes()
+	msg3 := messages[3]
+	messages[3] = wrongMsg[:]
+	publicKeys[7], publicKeys[8] = publicKeys[8], publicKeys[7]
+
+	valid, invalid, err = BatchVerify(publicKeys, signatures, messages, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("batch with invalid signatures should not verify")
+	}
+	if len(invalid) != 3 || invalid[0] != 3 || invalid[1] != 7 || invalid[2] != 8 {
+		t.Fatalf("wrong invalid signatures, got %v, expected [3 7 8]", invalid)
+	}
+
+	// a malformed signature
+	messages[3] = msg3
+	publicKeys[7], publicKeys[8] = publicKeys[8], publicKeys[7]
+	signatures[5] = signatures[5][:sizeFr]
+
+	valid, invalid, err = BatchVerify(publicKeys, signatures, messages, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("batch with a malformed signature should not verify")
+	}
+	if len(invalid) != 1 || invalid[0] != 5 {
+		t.Fatalf("wrong invalid signatures, got %v, expected [5]", invalid)
+	}
+}
+
 // benchmarks
 
 func BenchmarkVerify(b *testing.B) {
@@ -289,3 +374,33 @@ func BenchmarkVerify(b *testing.B) {
 		pubKey.Verify(signature, msgBin[:], hFunc)
 	}
 }
+
+func BenchmarkBatchVerify(b *testing.B) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	hFunc := hash.MIMC_BLS12_381.New()
+
+	const n = 64
+	publicKeys := make([]PublicKey, n)
+	signatures := make([][]byte, n)
+	messages := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(r)
+		if err != nil {
+			b.Fatal(err)
+		}
+		publicKeys[i] = privKey.PublicKey
+		var frMsg fr.Element
+		frMsg.SetRandom()
+		msgBin := frMsg.Bytes()
+		messages[i] = msgBin[:]
+		signatures[i], _ = privKey.Sign(messages[i], hFunc)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchVerify(publicKeys, signatures, messages, hFunc)
+	}
+}
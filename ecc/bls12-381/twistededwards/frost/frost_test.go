@@ -0,0 +1,463 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/eddsa"
+)
+
+var hFunc = sha256.New()
+
+func TestTrustedDealerKeyGen(t *testing.T) {
+	const minSigners, maxSigners = 3, 5
+
+	shares, pub, err := TrustedDealerKeyGen(rand.Reader, minSigners, maxSigners)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != maxSigners || len(pub.VerifyingShares) != maxSigners {
+		t.Fatal("wrong number of shares")
+	}
+
+	keys := keyPackages(t, shares)
+	for _, kp := range keys {
+		if !kp.GroupPublicKey.Equal(&pub.GroupPublicKey) {
+			t.Fatal("group public key mismatch")
+		}
+		vs := pub.VerifyingShares[kp.ID]
+		if !kp.VerifyingShare.Equal(&vs) || kp.MinSigners != minSigners {
+			t.Fatal("key package does not match the public key package")
+		}
+	}
+
+	// any minSigners shares interpolate the signing key
+	ctx := signingContext{ids: []uint32{1, 3, 4}}
+	var s big.Int
+	for _, id := range ctx.ids {
+		lambda := ctx.lagrangeCoefficient(id)
+		s.Add(&s, lambda.Mul(&lambda, &keys[id-1].SigningShare))
+	}
+	s.Mod(&s, order)
+	if pk := scalarBaseMult(&s); !pk.Equal(&pub.GroupPublicKey) {
+		t.Fatal("shares do not interpolate the signing key")
+	}
+
+	// tampered share
+	shares[0].Value.Add(&shares[0].Value, big.NewInt(1))
+	if _, err := shares[0].KeyPackage(); err != errInvalidShare {
+		t.Fatal("tampered share should be rejected")
+	}
+
+	for _, p := range [][2]int{{1, 3}, {3, 2}, {0, 0}} {
+		if _, _, err := TrustedDealerKeyGen(rand.Reader, p[0], p[1]); err != errInvalidParameters {
+			t.Fatal("invalid parameters should be rejected")
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	shares, pub, err := TrustedDealerKeyGen(rand.Reader, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := keyPackages(t, shares)
+	message := []byte("testing FROST")
+
+	for _, signers := range [][]int{{0, 1, 2}, {4, 2, 0}, {0, 1, 2, 3, 4}} {
+		var participants []*KeyPackage
+		for _, i := range signers {
+			participants = append(participants, keys[i])
+		}
+		commitments, sigShares := signingSession(t, participants, message)
+
+		for i := range sigShares {
+			if err := VerifySignatureShare(pub, &sigShares[i], message, commitments, hFunc); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		sig, err := Aggregate(pub, message, commitments, sigShares, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sig) != sizeSignature {
+			t.Fatal("wrong signature size")
+		}
+
+		if ok, err := Verify(&pub.GroupPublicKey, sig, message, hFunc); err != nil || !ok {
+			t.Fatal("signature should be valid", err)
+		}
+		if ok, _ := Verify(&pub.GroupPublicKey, sig, []byte("wrong message"), hFunc); ok {
+			t.Fatal("signature on a different message should be invalid")
+		}
+
+		// the single-party verifier accepts the signature
+		publicKey := eddsa.PublicKey{A: pub.GroupPublicKey}
+		if ok, err := publicKey.Verify(sig, message, hFunc); err != nil || !ok {
+			t.Fatal("eddsa should accept the signature", err)
+		}
+	}
+}
+
+func TestSignErrors(t *testing.T) {
+	shares, pub, err := TrustedDealerKeyGen(rand.Reader, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := keyPackages(t, shares)
+	message := []byte("testing FROST")
+
+	t.Run("not_enough_signers", func(t *testing.T) {
+		nonces, err := Commit(rand.Reader, keys[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		other, err := Commit(rand.Reader, keys[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments := []SigningCommitments{nonces.Commitments, other.Commitments}
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); err != errNotEnoughSigners {
+			t.Fatal("expected errNotEnoughSigners")
+		}
+	})
+
+	t.Run("nonce_reuse", func(t *testing.T) {
+		commitments, _ := signingSession(t, keys[:3], message)
+		nonces, err := Commit(rand.Reader, keys[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments[0] = nonces.Commitments
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); err != errNoncesUsed {
+			t.Fatal("nonces should not be usable twice")
+		}
+	})
+
+	t.Run("commitment_mismatch", func(t *testing.T) {
+		commitments, _ := signingSession(t, keys[:3], message)
+		nonces, err := Commit(rand.Reader, keys[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); err != errCommitmentMismatch {
+			t.Fatal("expected errCommitmentMismatch")
+		}
+	})
+
+	t.Run("duplicate_signer", func(t *testing.T) {
+		commitments, _ := signingSession(t, keys[:3], message)
+		commitments[2] = commitments[1]
+		if err := VerifySignatureShare(pub, &SignatureShare{ID: 1}, message, commitments, hFunc); err != errDuplicateSigner {
+			t.Fatal("expected errDuplicateSigner")
+		}
+	})
+
+	t.Run("invalid_commitment", func(t *testing.T) {
+		commitments, sigShares := signingSession(t, keys[:3], message)
+		nonces, err := Commit(rand.Reader, keys[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments[0] = nonces.Commitments
+		commitments[1].Binding = invalidElement()
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); !errors.Is(err, errInvalidElement) {
+			t.Fatal("expected errInvalidElement", err)
+		}
+
+		commitments[1].Binding, commitments[2].Hiding = commitments[2].Hiding, invalidElement()
+		if err := VerifySignatureShare(pub, &sigShares[0], message, commitments, hFunc); !errors.Is(err, errInvalidElement) {
+			t.Fatal("expected errInvalidElement", err)
+		}
+		if _, err := Aggregate(pub, message, commitments, sigShares, hFunc); !errors.Is(err, errInvalidElement) {
+			t.Fatal("expected errInvalidElement", err)
+		}
+	})
+
+	t.Run("invalid_share", func(t *testing.T) {
+		commitments, sigShares := signingSession(t, keys[1:], message)
+		sigShares[1].Z.Add(&sigShares[1].Z, big.NewInt(1))
+
+		err := VerifySignatureShare(pub, &sigShares[1], message, commitments, hFunc)
+		if !errors.Is(err, errInvalidSignatureShare) {
+			t.Fatal("expected errInvalidSignatureShare")
+		}
+		_, err = Aggregate(pub, message, commitments, sigShares, hFunc)
+		if !errors.Is(err, errInvalidSignatureShare) || err.Error() != "invalid signature share from participant 3" {
+			t.Fatal("the culprit should be identified", err)
+		}
+	})
+}
+
+func TestDKG(t *testing.T) {
+	const minSigners, maxSigners = 3, 4
+
+	participants := make([]*DKGParticipant, maxSigners)
+	round1 := make([]DKGRound1Package, maxSigners)
+	for i := range participants {
+		p, pkg, err := NewDKGParticipant(rand.Reader, uint32(i+1), minSigners, maxSigners)
+		if err != nil {
+			t.Fatal(err)
+		}
+		participants[i], round1[i] = p, *pkg
+	}
+
+	// round 1 packages are broadcast, round 2 packages are sent to their recipient
+	round2 := make([][]DKGRound2Package, maxSigners)
+	for i, p := range participants {
+		others := make([]DKGRound1Package, 0, maxSigners-1)
+		others = append(others, round1[:i]...)
+		others = append(others, round1[i+1:]...)
+		pkgs, err := p.Round2(others)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, pkg := range pkgs {
+			round2[pkg.To-1] = append(round2[pkg.To-1], pkg)
+		}
+	}
+
+	keys := make([]*KeyPackage, maxSigners)
+	var pub *PublicKeyPackage
+	for i, p := range participants {
+		kp, pkp, err := p.Finalize(round2[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pub != nil && !pub.GroupPublicKey.Equal(&pkp.GroupPublicKey) {
+			t.Fatal("participants disagree on the group public key")
+		}
+		keys[i], pub = kp, pkp
+	}
+
+	message := []byte("testing FROST DKG")
+	commitments, sigShares := signingSession(t, []*KeyPackage{keys[3], keys[0], keys[2]}, message)
+	sig, err := Aggregate(pub, message, commitments, sigShares, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := Verify(&pub.GroupPublicKey, sig, message, hFunc); err != nil || !ok {
+		t.Fatal("signature should be valid", err)
+	}
+
+	t.Run("invalid_proof", func(t *testing.T) {
+		p, _, err := NewDKGParticipant(rand.Reader, 1, minSigners, maxSigners)
+		if err != nil {
+			t.Fatal(err)
+		}
+		others := make([]DKGRound1Package, maxSigners-1)
+		copy(others, round1[1:])
+		others[0].ProofZ = *new(big.Int).Add(&others[0].ProofZ, big.NewInt(1)) // don't alias round1[1].ProofZ
+		if _, err := p.Round2(others); !errors.Is(err, errInvalidProof) {
+			t.Fatal("expected errInvalidProof")
+		}
+	})
+
+	t.Run("invalid_element", func(t *testing.T) {
+		p, _, err := NewDKGParticipant(rand.Reader, 1, minSigners, maxSigners)
+		if err != nil {
+			t.Fatal(err)
+		}
+		others := make([]DKGRound1Package, maxSigners-1)
+		copy(others, round1[1:])
+		others[1].Commitment = append(VSSCommitment{}, others[1].Commitment...)
+		others[1].Commitment[1] = invalidElement()
+		if _, err := p.Round2(others); !errors.Is(err, errInvalidElement) {
+			t.Fatal("expected errInvalidElement", err)
+		}
+
+		copy(others, round1[1:])
+		others[2].ProofR = invalidElement()
+		if _, err := p.Round2(others); !errors.Is(err, errInvalidElement) {
+			t.Fatal("expected errInvalidElement", err)
+		}
+	})
+
+	t.Run("invalid_share", func(t *testing.T) {
+		p, pkg, err := NewDKGParticipant(rand.Reader, 1, minSigners, maxSigners)
+		if err != nil {
+			t.Fatal(err)
+		}
+		others := []DKGParticipant{}
+		all := []DKGRound1Package{*pkg}
+		for i := 2; i <= maxSigners; i++ {
+			q, pkg, err := NewDKGParticipant(rand.Reader, uint32(i), minSigners, maxSigners)
+			if err != nil {
+				t.Fatal(err)
+			}
+			others = append(others, *q)
+			all = append(all, *pkg)
+		}
+		if _, err := p.Round2(all[1:]); err != nil {
+			t.Fatal(err)
+		}
+		var toP []DKGRound2Package
+		for i := range others {
+			r1 := make([]DKGRound1Package, 0, maxSigners-1)
+			r1 = append(r1, all[:i+1]...)
+			r1 = append(r1, all[i+2:]...)
+			pkgs, err := others[i].Round2(r1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			toP = append(toP, pkgs[0])
+		}
+		toP[1].Share.Add(&toP[1].Share, big.NewInt(1))
+		if _, _, err := p.Finalize(toP); !errors.Is(err, errInvalidShare) {
+			t.Fatal("expected errInvalidShare")
+		}
+	})
+}
+
+func TestSerialization(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		s, err := randomScalar(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := scalarBaseMult(&s)
+		b, err := serializeElement(&p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err := deserializeElement(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&q) {
+			t.Fatal("element round trip failed")
+		}
+
+		r, err := deserializeScalar(serializeScalar(&s))
+		if err != nil || r.Cmp(&s) != 0 {
+			t.Fatal("scalar round trip failed")
+		}
+	}
+
+	var identity twistededwards.PointAffine
+	identity.Y.SetOne()
+	if _, err := serializeElement(&identity); err != errIdentity {
+		t.Fatal("identity should not be serializable")
+	}
+
+	if _, err := deserializeScalar(serializeScalar(order)); err != errInvalidScalar {
+		t.Fatal("scalar >= order should be rejected")
+	}
+	if _, err := deserializeElement(bytes.Repeat([]byte{0xff}, sizeElement)); err == nil {
+		t.Fatal("invalid encoding should be rejected")
+	}
+	if err := validateElement(&identity); err != errIdentity {
+		t.Fatal("identity should be rejected")
+	}
+	bad := invalidElement()
+	if err := validateElement(&bad); err != errInvalidElement {
+		t.Fatal("invalid element should be rejected")
+	}
+}
+
+func BenchmarkSign(b *testing.B) {
+	shares, _, err := TrustedDealerKeyGen(rand.Reader, 3, 5)
+	if err != nil {
+		b.Fatal(err)
+	}
+	keys := keyPackages(b, shares)
+	message := []byte("testing FROST")
+	commitments, _ := signingSession(b, keys[:3], message)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		nonces, err := Commit(rand.Reader, keys[0])
+		if err != nil {
+			b.Fatal(err)
+		}
+		commitments[0] = nonces.Commitments
+		if _, err := Sign(keys[0], nonces, message, commitments, hFunc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAggregate(b *testing.B) {
+	shares, pub, err := TrustedDealerKeyGen(rand.Reader, 3, 5)
+	if err != nil {
+		b.Fatal(err)
+	}
+	keys := keyPackages(b, shares)
+	message := []byte("testing FROST")
+	commitments, sigShares := signingSession(b, keys[:3], message)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Aggregate(pub, message, commitments, sigShares, hFunc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// invalidElement returns (0, -1), a point of order 2 which lies on every
+// twisted Edwards curve but not in the prime order subgroup.
+func invalidElement() twistededwards.PointAffine {
+	var p twistededwards.PointAffine
+	p.Y.SetOne()
+	p.Y.Neg(&p.Y)
+	return p
+}
+
+func keyPackages(tb testing.TB, shares []SecretShare) []*KeyPackage {
+	keys := make([]*KeyPackage, len(shares))
+	for i := range shares {
+		var err error
+		if keys[i], err = shares[i].KeyPackage(); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return keys
+}
+
+// signingSession runs the two rounds of the signing protocol.
+func signingSession(tb testing.TB, signers []*KeyPackage, message []byte) ([]SigningCommitments, []SignatureShare) {
+	nonces := make([]*SigningNonces, len(signers))
+	commitments := make([]SigningCommitments, len(signers))
+	for i, kp := range signers {
+		var err error
+		if nonces[i], err = Commit(rand.Reader, kp); err != nil {
+			tb.Fatal(err)
+		}
+		commitments[i] = nonces[i].Commitments
+	}
+
+	sigShares := make([]SignatureShare, len(signers))
+	for i, kp := range signers {
+		share, err := Sign(kp, nonces[i], message, commitments, hFunc)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		sigShares[i] = *share
+	}
+	return commitments, sigShares
+}
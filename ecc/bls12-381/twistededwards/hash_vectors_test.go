@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12381_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x34cbceb7f5ce20f3c202e90b3dfc8c15cdd81e1029a0eefe6914b87b8b6c1be0", "0x47b34d92cbaa64361bc8a4f44efe2db3c42d592982fea2a005339f9b9e19c8cc"},
+				Q: point{"0x2904ef8550c842a57b9d0b310336abcb2f67923c5c430b2c41f00da6189b6896", "0x3e5923bb2f363dc6e04581d93d4169d2adeb2fcc8a97401d6e9654bc09237bc4"},
+				u: "0x703c0e22a493050399b8b4823b6f84e13c1d82adf33feb9ee62f861e11f61b3c",
+			},
+			{
+				msg: "abc", P: point{"0x187969b586310400877bab66988f0283318c9b38c987bd75c1a35464c50f46cf", "0x3b23418f2c3cc0a2534f277456fddeeb1885b83aba6770fdf7765161e42aa3f1"},
+				Q: point{"0x674ecc8da21c26a34f3e8ef6f7db7b6b511c9baa86040d68e9b39e7c48d10ea0", "0x1bacc6734896f970ff16fdc932bf5585319a531a874ee221a3628d6f34efb676"},
+				u: "0x124bc186ba667a7eaba4b9978b27d66ed58544c9830f4137b4e472d85ab4180c",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x27553d99f40abbc54a26ac343593dbdbbb0c7ed858deed04a8917f3cfcaa2f39", "0x3a8fe493df4fe15cc55f818c0fd8085e1a18393711251dd0dcff83bcc01a746b"},
+				Q: point{"0x3e434d5126f85d9b3ad17ddbc769a723c66bafd9e163f4a398e79797ef8ec0f9", "0x4836826a5c6d528c4a7f6ea6e8bb7b8fb91e6e2552f35e255c751ebf6d1862ce"},
+				u: "0x6484d2441c6340cc9c85df6dd764acc3ef37432333cf3c5686d284ee1bd068d5",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x7454b9ea55db5027b5e786b37ed3a657f54ca1b754216b3e13b5efb2a292359", "0x145ee0fd2936a09940e1f855f236456862e7a3268ae75c6abd940d23a9ded1af"},
+				Q: point{"0x71ec889106ccc4f98999da64dfaab39feb5238e5622614c1514bb36e299d2624", "0x42446bb733adcb2215b2b80d0efd30ca546d1aad0362a9c7f1f915dbf48b4dcc"},
+				u: "0x1457672068f6c88ea8d1bd394e9be536ab77adf403398ff7e37923bd4f145ef9",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x73cfa2a9986852d3a4e43b21b6ecf0e23ec9d23806b8174f03226f6f89e187b9", "0x42473274ef7e7554bcc7629a97e2952b930dc6777a931577d49fa24fbd8588e9"},
+				Q: point{"0xd9527897a33b38d20edd37e65235984da5ebe7f53a77635ee20deedb34b8cf1", "0x57fa64306ec1c456ece8adee91a39ad5fddb22236fb84c89bfda9b6ed928fdd4"},
+				u: "0x3882c500fdb49e788eb3a55769b4786a8f0d658b644594c57775d6745e3bf6bb",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS12381_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x47730a67f65c82523cd1c92826a61a88fbfc14d567dc7c27f38f49336d53e967", "0x5d5782dcc6cccc249fe0bf6682ca67ec6e0992300bc4006b9a1f7584fafea378"},
+				Q0: point{"0x3fad8dfeb7b08e9811b37e0fa506baa08192a8d1c2113abfde6ebb5aa91f91d", "0x1e84389076ba932848bdc5fdbda4d187f149ca68e3cf001b9e4ee10402360bba"},
+				Q1: point{"0x54aad41f1feca34a0bceffd1b415227db2d73915752c8c1df9574a32fa65845b", "0x12abae652b6350764267dfbf4ee0f9f6a8627c90ef3f7a2964520216a163cb67"},
+				u0: "0x57518b22b0269e5ad14073634e7e8daa5f7a8cf62b651cfdec808cbbf3b6d37a", u1: "0xcb660a45e1952ee3e0288275b4801ddda0bc6b5905c807ebb17aed05e034cc2",
+			},
+			{
+				msg: "abc", P: point{"0x5a031accc1c9f2dae21f3e91f37aec6dac62901d3836c68d747683b73d105660", "0x69c9a8941ae7e2f11abde84ee5600030fea0c5c206719ec8ebbf114e1f39292c"},
+				Q0: point{"0x64c4d31234565465118571763279eae8bfff6c469ad77f4d7edc3288b2c1cd40", "0x731f46061782fe72b2b689093decedb2b64a735b02548fee8d3c54ad325a13da"},
+				Q1: point{"0x4f9d46b123a56db7ca35faa5fecbd5ab3df0227381726e6b1e384ffed6a57d24", "0x3572e84776762e54914f5cdf64f68cd754d5af3f583c3dff27d9b4213e41b92"},
+				u0: "0x56238f49895fe85dac19f3e2f7969d0ff106d202b41635a05af908d1c2b92100", u1: "0x4f1da168f469c29c2fd90bfe5d47a5a629444ab92be069270d9449664c6918b5",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x36a0933705d4f48f05d8c3651a3e2bf4ff7f4e1fd9e09b88b2687d0f8ea5372e", "0x2a5c5ab9ec3e71e153b52ddccc350e6e40865250f9a6beebfbc2b029f1b515b8"},
+				Q0: point{"0x4d7148e118b06dcd4e04e13ee4a8660fd0bd8939ccbd2eefe1fefe86b72d18f3", "0x601c07ece299cc76206f769f25b7ff836f6d91fd50a0b9d7bab4f80e7b558688"},
+				Q1: point{"0x1108aa56a2915dd2d9a3dfd302bef9ed28910ff14da814620db99cba05b1ec0e", "0x56cd6744a5d91d7788a8292535a064105569f5cbd0d18042c8f1ae51caae8dbb"},
+				u0: "0x7299114150da918e3396bf95077a83cbbe4766133a2ba027f22b12e156bd05fb", u1: "0x6bcbea515c698a12a17dfe9d26aa44c8e40f9b196702d18b2fd1898aa8ebcbd1",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x57cac8e9d3d9e3f8adaf0139d501093a26f861a6b2c454e3d6c7882208b4070", "0x51e7d353af67f56accba742074c3ae96683662b9397bc9ea1975f495a2485a40"},
+				Q0: point{"0x3da7f6c2d03a18ade8b3f7bdcbb0173dada7de758d7c548f6c7b06d3ff9c4edc", "0x16b2bd5f675db29e9e4caeafa703c4b04b3f604ce8b6312124805377260f714c"},
+				Q1: point{"0x1e9d973dee311dd740208a3adaad079781d52bed39f7191b029a4d5bb7515150", "0x6d351be9f49490b40198493325a4e4efe7ad0ee44d24720e2d13b4484515754e"},
+				u0: "0x189701a83d02e5586d11ee55af4dcbe5c989e78ba324dd913f60b1b0cfc1ee3b", u1: "0x60c41afad42263238dd0425e9762e1685bc8c593cb26e61ee054efaeff87e67e",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x6708b76f3eeac8d427f264ca7eecaa659562cd4387f7fb87065b6648c74c88ab", "0xf5df892675d359f2daa8428867cef8c01c590cb404e42de681aa2e69d80a25d"},
+				Q0: point{"0x6246bb6adcdacf603876b212f63d13b361255067336651008e096c59697d97e8", "0x1d30d95030719936e1297a5b7ef7afc3b0a5372995000464d158b7db729983f5"},
+				Q1: point{"0x6854382e60a56a08f2d55442d234a4256affe8277adbde14349d47c96dd29f1e", "0x21f6ebf19919dfb931e7398342f656c3a1afd1a859d8967c3988a6aef6ff8ca1"},
+				u0: "0x62e8b285fe106492f3d6026ae766647d8dd76495c6b79896287cbd8722a87238", u1: "0x2718ed0cb5b09081367ae6407e83ac96ad14d7890576608060acf692bfe6b1cf",
+			},
+		}}
+}
@@ -0,0 +1,238 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package vss
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// dkgAdversary describes the misbehaviour of some dealers
+type dkgAdversary struct {
+	corruptShares  map[uint32][]uint32 // dealer -> recipients of an invalid share
+	noJustify      map[uint32]bool     // dealers which do not answer the complaints
+	corruptFeldman map[uint32]bool     // dealers which publish a wrong Feldman commitment
+	peerComplaints map[uint32]bool     // participants given only the complaints of the others
+}
+
+// runDKG runs the DKG between n participants, all the messages being delivered.
+func runDKG(t *testing.T, threshold, n int, adv dkgAdversary) []*KeyShare {
+	parties := make([]*DKG, n)
+	for i := range parties {
+		var err error
+		if parties[i], err = NewDKG(uint32(i+1), threshold, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// deals
+	var deals []DealMessage
+	var shares []ShareMessage
+	for _, p := range parties {
+		deal, s, err := p.Deal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range s {
+			for _, r := range adv.corruptShares[deal.Dealer] {
+				if s[i].Recipient == r {
+					s[i].Share.Add(&s[i].Share, &s[i].Blinding)
+				}
+			}
+		}
+		deals = append(deals, *deal)
+		shares = append(shares, s...)
+	}
+
+	// complaints
+	var complaints []Complaint
+	for _, p := range parties {
+		c, err := p.ProcessDeals(deals, shares)
+		if err != nil {
+			t.Fatal(err)
+		}
+		complaints = append(complaints, c...)
+	}
+
+	// justifications
+	var justifications []Justification
+	for i, p := range parties {
+		j, err := p.Justify(complaints)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !adv.noJustify[uint32(i+1)] {
+			justifications = append(justifications, j...)
+		}
+	}
+	for i, p := range parties {
+		c := complaints
+		if adv.peerComplaints[uint32(i+1)] {
+			c = nil
+			for _, complaint := range complaints {
+				if complaint.Accuser != uint32(i+1) {
+					c = append(c, complaint)
+				}
+			}
+		}
+		if err := p.ProcessJustifications(c, justifications); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// extraction
+	var feldman []FeldmanMessage
+	for i, p := range parties {
+		msg, err := p.Extract()
+		if err == errNotQualified {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if adv.corruptFeldman[uint32(i+1)] {
+			msg.Commitment = append(FeldmanCommitment(nil), msg.Commitment...)
+			msg.Commitment[1] = msg.Commitment[0]
+		}
+		feldman = append(feldman, *msg)
+	}
+	var extractionComplaints []ExtractionComplaint
+	for _, p := range parties {
+		c, err := p.ProcessExtraction(feldman)
+		if err != nil {
+			t.Fatal(err)
+		}
+		extractionComplaints = append(extractionComplaints, c...)
+	}
+	var reveals []RevealMessage
+	for _, p := range parties {
+		r, err := p.Reveal(extractionComplaints)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reveals = append(reveals, r...)
+	}
+
+	keys := make([]*KeyShare, n)
+	for i, p := range parties {
+		var err error
+		if keys[i], err = p.Finalize(reveals); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+// checkKeys checks that all the participants agree on the key and that their shares
+// are consistent with it.
+func checkKeys(t *testing.T, keys []*KeyShare, threshold int, qualified []uint32) {
+	pk := keys[0].PublicKey()
+	for _, k := range keys {
+		if len(k.Qualified) != len(qualified) {
+			t.Fatalf("participant %d: wrong set of qualified dealers %v", k.Index, k.Qualified)
+		}
+		for i := range qualified {
+			if k.Qualified[i] != qualified[i] {
+				t.Fatalf("participant %d: wrong set of qualified dealers %v", k.Index, k.Qualified)
+			}
+		}
+		if kpk := k.PublicKey(); !kpk.Equal(&pk) {
+			t.Fatal("participants disagree on the public key")
+		}
+		for _, other := range keys {
+			if !k.Commitment.Verify(&Share{Index: other.Index, Value: other.Secret}) {
+				t.Fatalf("share of participant %d does not match the commitment", other.Index)
+			}
+		}
+	}
+
+	shares := make([]Share, len(keys))
+	for i, k := range keys {
+		shares[i] = Share{Index: k.Index, Value: k.Secret}
+	}
+	secret, err := Reconstruct(shares[len(shares)-threshold:], threshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Polynomial([]fr.Element{secret}).FeldmanCommit()
+	if !expected[0].Equal(&pk) {
+		t.Fatal("shares do not reconstruct the secret key")
+	}
+}
+
+func TestDKG(t *testing.T) {
+	const threshold, n = 3, 5
+
+	t.Run("honest", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{})
+		checkKeys(t, keys, threshold, []uint32{1, 2, 3, 4, 5})
+	})
+
+	t.Run("justified_complaint", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{
+			corruptShares: map[uint32][]uint32{2: {3, 5}},
+		})
+		checkKeys(t, keys, threshold, []uint32{1, 2, 3, 4, 5})
+	})
+
+	t.Run("own_complaint_not_passed", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{
+			corruptShares:  map[uint32][]uint32{2: {3}},
+			peerComplaints: map[uint32]bool{3: true},
+		})
+		checkKeys(t, keys, threshold, []uint32{1, 2, 3, 4, 5})
+	})
+
+	t.Run("unjustified_complaint", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{
+			corruptShares: map[uint32][]uint32{2: {3}},
+			noJustify:     map[uint32]bool{2: true},
+		})
+		checkKeys(t, keys, threshold, []uint32{1, 3, 4, 5})
+	})
+
+	t.Run("too_many_complaints", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{
+			corruptShares: map[uint32][]uint32{4: {1, 2, 3}},
+		})
+		checkKeys(t, keys, threshold, []uint32{1, 2, 3, 5})
+	})
+
+	t.Run("invalid_feldman_commitment", func(t *testing.T) {
+		keys := runDKG(t, threshold, n, dkgAdversary{
+			corruptFeldman: map[uint32]bool{5: true},
+		})
+		checkKeys(t, keys, threshold, []uint32{1, 2, 3, 4, 5})
+	})
+}
+
+func TestDKGPhases(t *testing.T) {
+	d, err := NewDKG(1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Extract(); err != errWrongPhase {
+		t.Fatal("expected errWrongPhase")
+	}
+	if _, err := NewDKG(4, 2, 3); err != errInvalidIndex {
+		t.Fatal("expected errInvalidIndex")
+	}
+	if _, err := NewDKG(1, 4, 3); err != errInvalidThreshold {
+		t.Fatal("expected errInvalidThreshold")
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package vss
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+var (
+	errInvalidThreshold = errors.New("threshold must be in [1, n]")
+	errInvalidIndex     = errors.New("share index must be non zero")
+	errDuplicateIndex   = errors.New("duplicate share index")
+	errNotEnoughShares  = errors.New("not enough shares")
+	errDegreeMismatch   = errors.New("polynomials must have the same degree")
+)
+
+var (
+	initOnce sync.Once
+	g, h     curve.G1Affine
+)
+
+// Generators returns the generators (G, H) of G1 used in the commitments. The discrete
+// logarithm of H in base G is unknown: H is obtained by hashing to the curve.
+func Generators() (curve.G1Affine, curve.G1Affine) {
+	initOnce.Do(initGenerators)
+	return g, h
+}
+
+func initGenerators() {
+	_, _, g, _ = curve.Generators()
+	var err error
+	if h, err = curve.HashToG1([]byte("H"), []byte("VSS-BLS12-381-PEDERSEN-GENERATOR")); err != nil {
+		panic(err)
+	}
+}
+
+// Share is the evaluation f(Index) of a sharing polynomial f.
+type Share struct {
+	Index uint32 // non zero
+	Value fr.Element
+}
+
+// Polynomial is a sharing polynomial f(x) = ∑a_k*x^k, the secret being f(0) = a_0.
+type Polynomial []fr.Element
+
+// NewPolynomial returns a random polynomial of degree threshold-1 such that f(0) = secret.
+func NewPolynomial(secret *fr.Element, threshold int) (Polynomial, error) {
+	if threshold < 1 {
+		return nil, errInvalidThreshold
+	}
+	f := make(Polynomial, threshold)
+	f[0].Set(secret)
+	for k := 1; k < threshold; k++ {
+		if _, err := f[k].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Split shares secret into n shares, any threshold of which reconstruct it.
+// The shares are f(1), ..., f(n).
+func Split(secret *fr.Element, threshold, n int) ([]Share, Polynomial, error) {
+	if threshold < 1 || threshold > n {
+		return nil, nil, errInvalidThreshold
+	}
+	f, err := NewPolynomial(secret, threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.Shares(n), f, nil
+}
+
+// Eval returns f(x)
+func (f Polynomial) Eval(x *fr.Element) fr.Element {
+	var res fr.Element
+	for k := len(f) - 1; k >= 0; k-- {
+		res.Mul(&res, x).Add(&res, &f[k])
+	}
+	return res
+}
+
+// Share returns the share f(index)
+func (f Polynomial) Share(index uint32) Share {
+	var x fr.Element
+	x.SetUint64(uint64(index))
+	return Share{Index: index, Value: f.Eval(&x)}
+}
+
+// Shares returns the shares f(1), ..., f(n)
+func (f Polynomial) Shares(n int) []Share {
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = f.Share(uint32(i + 1))
+	}
+	return shares
+}
+
+// Reconstruct returns the secret f(0) from at least deg(f)+1 shares. Only the
+// first shares are used when more are given.
+func Reconstruct(shares []Share, threshold int) (fr.Element, error) {
+	var secret fr.Element
+	if len(shares) < threshold || threshold < 1 {
+		return secret, errNotEnoughShares
+	}
+	shares = shares[:threshold]
+	lambda, err := LagrangeCoefficients(indices(shares))
+	if err != nil {
+		return secret, err
+	}
+	var tmp fr.Element
+	for i := range shares {
+		tmp.Mul(&lambda[i], &shares[i].Value)
+		secret.Add(&secret, &tmp)
+	}
+	return secret, nil
+}
+
+// LagrangeCoefficients returns the coefficients λ_i = ∏_{j≠i} x_j/(x_j-x_i) such that
+// f(0) = ∑λ_i*f(x_i) for any polynomial f of degree smaller than len(indices).
+func LagrangeCoefficients(indices []uint32) ([]fr.Element, error) {
+	if err := checkIndices(indices); err != nil {
+		return nil, err
+	}
+
+	x := make([]fr.Element, len(indices))
+	for i := range x {
+		x[i].SetUint64(uint64(indices[i]))
+	}
+
+	num := make([]fr.Element, len(x))
+	den := make([]fr.Element, len(x))
+	var tmp fr.Element
+	for i := range x {
+		num[i].SetOne()
+		den[i].SetOne()
+		for j := range x {
+			if j == i {
+				continue
+			}
+			num[i].Mul(&num[i], &x[j])
+			tmp.Sub(&x[j], &x[i])
+			den[i].Mul(&den[i], &tmp)
+		}
+	}
+	den = fr.BatchInvert(den)
+	for i := range num {
+		num[i].Mul(&num[i], &den[i])
+	}
+	return num, nil
+}
+
+// PublicShare is the image f(Index)*P of a share in G1.
+type PublicShare struct {
+	Index uint32 // non zero
+	Value curve.G1Affine
+}
+
+// ReconstructInExponent returns f(0)*P from threshold public shares f(x_i)*P,
+// using the Lagrange coefficients. Only the first shares are used when more are given.
+func ReconstructInExponent(shares []PublicShare, threshold int) (curve.G1Affine, error) {
+	var res curve.G1Affine
+	if len(shares) < threshold || threshold < 1 {
+		return res, errNotEnoughShares
+	}
+	shares = shares[:threshold]
+	ids := make([]uint32, len(shares))
+	for i := range shares {
+		ids[i] = shares[i].Index
+	}
+	lambda, err := LagrangeCoefficients(ids)
+	if err != nil {
+		return res, err
+	}
+
+	var acc, tmp curve.G1Jac
+	var b big.Int
+	for i := range shares {
+		tmp.FromAffine(&shares[i].Value)
+		tmp.ScalarMultiplication(&tmp, lambda[i].BigInt(&b))
+		acc.AddAssign(&tmp)
+	}
+	res.FromJacobian(&acc)
+	return res, nil
+}
+
+// FeldmanCommitment is the commitment [a_0*G, ..., a_{t-1}*G] to a polynomial.
+type FeldmanCommitment []curve.G1Affine
+
+// FeldmanCommit returns the Feldman commitment to f.
+func (f Polynomial) FeldmanCommit() FeldmanCommitment {
+	initOnce.Do(initGenerators)
+	c := make(FeldmanCommitment, len(f))
+	var b big.Int
+	for k := range f {
+		c[k].ScalarMultiplication(&g, f[k].BigInt(&b))
+	}
+	return c
+}
+
+// Evaluate returns f(index)*G
+func (c FeldmanCommitment) Evaluate(index uint32) curve.G1Affine {
+	return evaluateInExponent(c, index)
+}
+
+// Verify checks that share.Value*G = f(share.Index)*G
+func (c FeldmanCommitment) Verify(share *Share) bool {
+	if len(c) == 0 || share.Index == 0 {
+		return false
+	}
+	initOnce.Do(initGenerators)
+	var lhs curve.G1Affine
+	var b big.Int
+	lhs.ScalarMultiplication(&g, share.Value.BigInt(&b))
+	rhs := c.Evaluate(share.Index)
+	return lhs.Equal(&rhs)
+}
+
+// PedersenCommitment is the commitment [a_0*G + b_0*H, ..., a_{t-1}*G + b_{t-1}*H]
+// to a polynomial f = ∑a_k*x^k, with a blinding polynomial ∑b_k*x^k.
+type PedersenCommitment []curve.G1Affine
+
+// PedersenCommit returns the Pedersen commitment to f blinded by blinding.
+func (f Polynomial) PedersenCommit(blinding Polynomial) (PedersenCommitment, error) {
+	if len(f) != len(blinding) {
+		return nil, errDegreeMismatch
+	}
+	initOnce.Do(initGenerators)
+	c := make(PedersenCommitment, len(f))
+	for k := range f {
+		c[k] = pedersenCommit(&f[k], &blinding[k])
+	}
+	return c, nil
+}
+
+// Evaluate returns f(index)*G + f'(index)*H where f' is the blinding polynomial.
+func (c PedersenCommitment) Evaluate(index uint32) curve.G1Affine {
+	return evaluateInExponent(c, index)
+}
+
+// Verify checks share and blinding, the evaluations of f and of the blinding polynomial
+// at the same index, against the commitment.
+func (c PedersenCommitment) Verify(share, blinding *Share) bool {
+	if len(c) == 0 || share.Index == 0 || share.Index != blinding.Index {
+		return false
+	}
+	lhs := pedersenCommit(&share.Value, &blinding.Value)
+	rhs := c.Evaluate(share.Index)
+	return lhs.Equal(&rhs)
+}
+
+// pedersenCommit returns a*G + b*H
+func pedersenCommit(a, b *fr.Element) curve.G1Affine {
+	initOnce.Do(initGenerators)
+	var res, tmp curve.G1Jac
+	var bi big.Int
+	res.ScalarMultiplicationAffine(&g, a.BigInt(&bi))
+	tmp.ScalarMultiplicationAffine(&h, b.BigInt(&bi))
+	res.AddAssign(&tmp)
+	var resAff curve.G1Affine
+	resAff.FromJacobian(&res)
+	return resAff
+}
+
+// evaluateInExponent returns ∑x^k*c_k with Horner's rule.
+func evaluateInExponent(c []curve.G1Affine, index uint32) curve.G1Affine {
+	var acc curve.G1Jac
+	x := new(big.Int).SetUint64(uint64(index))
+	for k := len(c) - 1; k >= 0; k-- {
+		acc.ScalarMultiplication(&acc, x)
+		acc.AddMixed(&c[k])
+	}
+	var res curve.G1Affine
+	res.FromJacobian(&acc)
+	return res
+}
+
+// interpolate returns the polynomial of degree smaller than len(shares)
+// through the shares.
+func interpolate(shares []Share) (Polynomial, error) {
+	if err := checkIndices(indices(shares)); err != nil {
+		return nil, err
+	}
+	n := len(shares)
+	x := make([]fr.Element, n)
+	for i := range x {
+		x[i].SetUint64(uint64(shares[i].Index))
+	}
+
+	// p = ∏(X - x_j)
+	var tmp fr.Element
+	p := make(Polynomial, n+1)
+	p[0].SetOne()
+	for j := range x {
+		for k := j + 1; k > 0; k-- {
+			tmp.Mul(&p[k], &x[j])
+			p[k].Sub(&p[k-1], &tmp)
+		}
+		p[0].Mul(&p[0], &x[j]).Neg(&p[0])
+	}
+
+	res := make(Polynomial, n)
+	q := make(Polynomial, n)
+	den := make([]fr.Element, n)
+	for i := range x {
+		// ∏_{j≠i} (x_i - x_j)
+		den[i].SetOne()
+		for j := range x {
+			if j != i {
+				tmp.Sub(&x[i], &x[j])
+				den[i].Mul(&den[i], &tmp)
+			}
+		}
+	}
+	den = fr.BatchInvert(den)
+	for i := range x {
+		// q = p / (X - x_i), by synthetic division
+		q[n-1].Set(&p[n])
+		for k := n - 1; k > 0; k-- {
+			tmp.Mul(&q[k], &x[i])
+			q[k-1].Add(&p[k], &tmp)
+		}
+		tmp.Mul(&shares[i].Value, &den[i])
+		for k := range q {
+			var c fr.Element
+			c.Mul(&q[k], &tmp)
+			res[k].Add(&res[k], &c)
+		}
+	}
+	return res, nil
+}
+
+func indices(shares []Share) []uint32 {
+	ids := make([]uint32, len(shares))
+	for i := range shares {
+		ids[i] = shares[i].Index
+	}
+	return ids
+}
+
+func checkIndices(ids []uint32) error {
+	seen := make(map[uint32]bool, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			return errInvalidIndex
+		}
+		if seen[id] {
+			return errDuplicateIndex
+		}
+		seen[id] = true
+	}
+	return nil
+}
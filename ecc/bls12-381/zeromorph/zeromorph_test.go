@@ -0,0 +1,182 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+)
+
+// testSrs is larger than the polynomials, to exercise the degree shifts
+var testSrs *kzg.SRS
+
+func init() {
+	testSrs, _ = kzg.NewSRS(100, big.NewInt(42))
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	m := make(polynomial.MultiLin, 1<<nbVars)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	point := make([]fr.Element, nbVars)
+	for i := range point {
+		point[i].SetRandom()
+	}
+	return point
+}
+
+func TestOpen(t *testing.T) {
+	vk := NewVerifyingKey(testSrs)
+	for nbVars := 1; nbVars <= 6; nbVars++ {
+		f := randomMultiLin(nbVars)
+		point := randomPoint(nbVars)
+
+		digest, err := Commit(f, testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := Open(f, point, digest, sha256.New(), testSrs.Pk, []byte("data"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := f.Evaluate(point, nil)
+		if !proof.ClaimedValue.Equal(&expected) {
+			t.Fatalf("%d variables: wrong claimed value", nbVars)
+		}
+		if err := Verify(&digest, &proof, point, sha256.New(), vk, []byte("data")); err != nil {
+			t.Fatalf("%d variables: %v", nbVars, err)
+		}
+
+		// wrong claimed value
+		tampered := proof
+		tampered.ClaimedValue.SetRandom()
+		if err := Verify(&digest, &tampered, point, sha256.New(), vk, []byte("data")); err == nil {
+			t.Fatalf("%d variables: verifying a wrong claimed value should fail", nbVars)
+		}
+
+		// wrong point
+		otherPoint := randomPoint(nbVars)
+		if err := Verify(&digest, &proof, otherPoint, sha256.New(), vk, []byte("data")); err == nil {
+			t.Fatalf("%d variables: verifying at another point should fail", nbVars)
+		}
+
+		// wrong transcript
+		if err := Verify(&digest, &proof, point, sha256.New(), vk); err == nil {
+			t.Fatalf("%d variables: verifying with another transcript should fail", nbVars)
+		}
+	}
+}
+
+func TestOpenErrors(t *testing.T) {
+	f := randomMultiLin(7)
+	if _, err := Commit(f, testSrs.Pk); err == nil {
+		t.Fatal("committing to a polynomial larger than the SRS should fail")
+	}
+	f = randomMultiLin(3)
+	digest, _ := Commit(f, testSrs.Pk)
+	if _, err := Open(f, randomPoint(2), digest, sha256.New(), testSrs.Pk); err != ErrInvalidPointSize {
+		t.Fatal("opening at a point with the wrong number of coordinates should fail")
+	}
+}
+
+func TestBatchOpen(t *testing.T) {
+	const nbVars = 5
+	vk := NewVerifyingKey(testSrs)
+	polynomials := make([]polynomial.MultiLin, 4)
+	digests := make([]Digest, len(polynomials))
+	for i := range polynomials {
+		polynomials[i] = randomMultiLin(nbVars)
+		var err error
+		if digests[i], err = Commit(polynomials[i], testSrs.Pk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	point := randomPoint(nbVars)
+
+	proof, err := BatchOpen(polynomials, digests, point, sha256.New(), testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range polynomials {
+		expected := polynomials[i].Evaluate(point, nil)
+		if !proof.ClaimedValues[i].Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+	}
+	if err := BatchVerify(digests, &proof, point, sha256.New(), vk); err != nil {
+		t.Fatal(err)
+	}
+
+	proof.ClaimedValues[1].SetRandom()
+	if err := BatchVerify(digests, &proof, point, sha256.New(), vk); err == nil {
+		t.Fatal("verifying a wrong claimed value should fail")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	const nbVars = 4
+	f := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	digest, _ := Commit(f, testSrs.Pk)
+	proof, err := BatchOpen([]polynomial.MultiLin{f}, []Digest{digest}, point, sha256.New(), testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var read BatchOpeningProof
+	n, err := read.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != written {
+		t.Fatalf("read %d bytes, wrote %d", n, written)
+	}
+	if err := BatchVerify([]Digest{digest}, &read, point, sha256.New(), NewVerifyingKey(testSrs)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const nbVars = 16
+	srs, err := kzg.NewSRS(1<<nbVars, big.NewInt(-1))
+	if err != nil {
+		b.Fatal(err)
+	}
+	f := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	digest, _ := Commit(f, srs.Pk)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(f, point, digest, sha256.New(), srs.Pk)
+	}
+}
@@ -21,11 +21,12 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
-	"runtime"
+	"math/bits"
 	"strings"
-	"sync"
 	"sync/atomic"
 	"unsafe"
+
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // Vector represents a slice of Element.
@@ -37,6 +38,13 @@ import (
 //   - encoding.BinaryMarshaler
 //   - encoding.BinaryUnmarshaler
 //   - sort.Interface
+//
+// The arithmetic methods (Add, Sub, ScalarMul, Mul, Sum, InnerProduct) use assembly where it
+// is available, and the pure Go implementation otherwise:
+//   - amd64: Add and Sub in assembly; Mul, ScalarMul and InnerProduct with AVX-512 IFMA
+//     and Sum with AVX-512 or AVX2, if the CPU supports them. Without IFMA, Mul and ScalarMul
+//     use the scalar assembly multiplication, which is faster than the AVX2 one.
+//   - arm64: Add, Sub, ScalarMul and Mul in assembly.
 type Vector []Element
 
 // MarshalBinary implements encoding.BinaryMarshaler
@@ -111,7 +119,7 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 	go func() {
 		var cptErrors uint64
 		// process the elements in parallel
-		execute(int(sliceLen), func(start, end int) {
+		scheduler.Execute(nil, int(sliceLen), func(start, end int) {
 
 			var z Element
 			for i := start; i < end; i++ {
@@ -200,55 +208,78 @@ func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
 
-// TODO @gbotrel make a public package out of that.
-// execute executes the work function in parallel.
-// this is copy paste from internal/parallel/parallel.go
-// as we don't want to generate code importing internal/
-func execute(nbIterations int, work func(int, int), maxCpus ...int) {
-
-	nbTasks := runtime.NumCPU()
-	if len(maxCpus) == 1 {
-		nbTasks = maxCpus[0]
-		if nbTasks < 1 {
-			nbTasks = 1
-		} else if nbTasks > 512 {
-			nbTasks = 512
+// Exp sets vector[i] = a[i]ᵏ for all i.
+// If k is negative, the elements are inverted first (the inverse of 0 being 0).
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Exp(a Vector, k int64) {
+	N := len(a)
+	if N != len(*vector) {
+		panic("vector.Exp: vectors don't have the same length")
+	}
+	if N == 0 {
+		return
+	}
+	if k == 0 {
+		for i := range *vector {
+			(*vector)[i].SetOne()
 		}
+		return
 	}
 
-	if nbTasks == 1 {
-		// no go routines
-		work(0, nbIterations)
-		return
+	e := uint64(k)
+	var base Vector
+	if k < 0 {
+		e = -e
+		base = BatchInvert(a)
+	} else {
+		base = make(Vector, N)
+		copy(base, a)
+	}
+
+	// left to right square and multiply
+	copy(*vector, base)
+	for i := bits.Len64(e) - 2; i >= 0; i-- {
+		vector.Mul(*vector, *vector)
+		if (e>>uint(i))&1 == 1 {
+			vector.Mul(*vector, base)
+		}
 	}
+}
 
-	nbIterationsPerCpus := nbIterations / nbTasks
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
 
-	// more CPUs than tasks: a CPU will work on exactly one iteration
-	if nbIterationsPerCpus < 1 {
-		nbIterationsPerCpus = 1
-		nbTasks = nbIterations
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
 	}
+}
 
-	var wg sync.WaitGroup
+func scalarMulVecGeneric(res, a Vector, b *Element) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
 
-	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
-	extraTasksOffset := 0
+func sumVecGeneric(res *Element, a Vector) {
+	for i := 0; i < len(a); i++ {
+		res.Add(res, &a[i])
+	}
+}
 
-	for i := 0; i < nbTasks; i++ {
-		wg.Add(1)
-		_start := i*nbIterationsPerCpus + extraTasksOffset
-		_end := _start + nbIterationsPerCpus
-		if extraTasks > 0 {
-			_end++
-			extraTasks--
-			extraTasksOffset++
-		}
-		go func() {
-			work(_start, _end)
-			wg.Done()
-		}()
+func innerProductVecGeneric(res *Element, a, b Vector) {
+	var tmp Element
+	for i := 0; i < len(a); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(res, &tmp)
 	}
+}
 
-	wg.Wait()
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
 }
@@ -17,8 +17,9 @@
 package fft
 
 import (
+	"context"
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"math/big"
 	"math/bits"
 
@@ -39,10 +40,21 @@ const butterflyThreshold = 16
 // FFT computes (recursively) the discrete Fourier transform of a and stores the result in a
 // if decimation == DIT (decimation in time), the input must be in bit-reversed order
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// on a mixed-radix domain, the bit-reversed order is replaced by the digit-reversed order (see DigitReverse)
 func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option) {
 
 	opt := fftOptions(opts...)
 
+	if domain.isMixedRadix() {
+		domain.mixedRadixFFT(a, decimation, false, opt)
+		return
+	}
+
+	domain.fft(a, decimation, domain.fftTables(decimation, false, opt.coset), opt)
+}
+
+// fft is FFT on a power of two domain, with the tables returned by fftTables
+func (domain *Domain) fft(a []fr.Element, decimation Decimation, t fftTables, opt fftConfig) {
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
@@ -54,13 +66,8 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 	if opt.coset {
 		if decimation == DIT {
 			// scale by coset table (in bit reversed order)
-			cosetTable := domain.cosetTable
-			if !domain.withPrecompute {
-				// we need to build the full table or do a bit reverse dance.
-				cosetTable = make([]fr.Element, len(a))
-				BuildExpTable(domain.FrMultiplicativeGen, cosetTable)
-			}
-			parallel.Execute(len(a), func(start, end int) {
+			cosetTable := t.cosetTable
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 				n := uint64(len(a))
 				nn := uint64(64 - bits.TrailingZeros64(n))
 				for i := start; i < end; i++ {
@@ -70,14 +77,14 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 			}, opt.nbTasks)
 		} else {
 			if domain.withPrecompute {
-				parallel.Execute(len(a), func(start, end int) {
+				scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 					for i := start; i < end; i++ {
 						a[i].Mul(&a[i], &domain.cosetTable[i])
 					}
 				}, opt.nbTasks)
 			} else {
 				c := domain.FrMultiplicativeGen
-				parallel.Execute(len(a), func(start, end int) {
+				scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 					var at fr.Element
 					at.Exp(c, big.NewInt(int64(start)))
 					for i := start; i < end; i++ {
@@ -90,22 +97,11 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 		}
 	}
 
-	twiddles := domain.twiddles
-	twiddlesStartStage := 0
-	if !domain.withPrecompute {
-		twiddlesStartStage = 3
-		nbStages := int(bits.TrailingZeros64(domain.Cardinality))
-		twiddles = make([][]fr.Element, nbStages-twiddlesStartStage)
-		w := domain.Generator
-		w.Exp(w, big.NewInt(int64(1<<twiddlesStartStage)))
-		buildTwiddles(twiddles, w, uint64(nbStages-twiddlesStartStage))
-	}
-
 	switch decimation {
 	case DIF:
-		difFFT(a, domain.Generator, twiddles, twiddlesStartStage, 0, maxSplits, nil, opt.nbTasks)
+		difFFT(a, domain.Generator, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
 	case DIT:
-		ditFFT(a, domain.Generator, twiddles, twiddlesStartStage, 0, maxSplits, nil, opt.nbTasks)
+		ditFFT(a, domain.Generator, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
 	default:
 		panic("not implemented")
 	}
@@ -116,9 +112,20 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
 // coset sets the shift of the fft (0 = no shift, standard fft)
 // len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+// on a mixed-radix domain, the bit-reversed order is replaced by the digit-reversed order (see DigitReverse)
 func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...Option) {
 	opt := fftOptions(opts...)
 
+	if domain.isMixedRadix() {
+		domain.mixedRadixFFT(a, decimation, true, opt)
+		return
+	}
+
+	domain.fftInverse(a, decimation, domain.fftTables(decimation, true, opt.coset), opt)
+}
+
+// fftInverse is FFTInverse on a power of two domain, with the tables returned by fftTables
+func (domain *Domain) fftInverse(a []fr.Element, decimation Decimation, t fftTables, opt fftConfig) {
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
@@ -126,29 +133,22 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 		maxSplits = -1
 	}
 
-	twiddlesInv := domain.twiddlesInv
-	twiddlesStartStage := 0
-	if !domain.withPrecompute {
-		twiddlesStartStage = 3
-		nbStages := int(bits.TrailingZeros64(domain.Cardinality))
-		twiddlesInv = make([][]fr.Element, nbStages-twiddlesStartStage)
-		w := domain.GeneratorInv
-		w.Exp(w, big.NewInt(int64(1<<twiddlesStartStage)))
-		buildTwiddles(twiddlesInv, w, uint64(nbStages-twiddlesStartStage))
-	}
-
 	switch decimation {
 	case DIF:
-		difFFT(a, domain.GeneratorInv, twiddlesInv, twiddlesStartStage, 0, maxSplits, nil, opt.nbTasks)
+		difFFT(a, domain.GeneratorInv, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
 	case DIT:
-		ditFFT(a, domain.GeneratorInv, twiddlesInv, twiddlesStartStage, 0, maxSplits, nil, opt.nbTasks)
+		ditFFT(a, domain.GeneratorInv, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
 	default:
 		panic("not implemented")
 	}
 
+	if isDone(opt.done) {
+		return
+	}
+
 	// scale by CardinalityInv
 	if !opt.coset {
-		parallel.Execute(len(a), func(start, end int) {
+		scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 			for i := start; i < end; i++ {
 				a[i].Mul(&a[i], &domain.CardinalityInv)
 			}
@@ -158,7 +158,7 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 
 	if decimation == DIT {
 		if domain.withPrecompute {
-			parallel.Execute(len(a), func(start, end int) {
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 				for i := start; i < end; i++ {
 					a[i].Mul(&a[i], &domain.cosetTableInv[i]).
 						Mul(&a[i], &domain.CardinalityInv)
@@ -166,7 +166,7 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 			}, opt.nbTasks)
 		} else {
 			c := domain.FrMultiplicativeGenInv
-			parallel.Execute(len(a), func(start, end int) {
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 				var at fr.Element
 				at.Exp(c, big.NewInt(int64(start)))
 				at.Mul(&at, &domain.CardinalityInv)
@@ -180,13 +180,8 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 	}
 
 	// decimation == DIF, need to access coset table in bit reversed order.
-	cosetTableInv := domain.cosetTableInv
-	if !domain.withPrecompute {
-		// we need to build the full table or do a bit reverse dance.
-		cosetTableInv = make([]fr.Element, len(a))
-		BuildExpTable(domain.FrMultiplicativeGenInv, cosetTableInv)
-	}
-	parallel.Execute(len(a), func(start, end int) {
+	cosetTableInv := t.cosetTable
+	scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
 		n := uint64(len(a))
 		nn := uint64(64 - bits.TrailingZeros64(n))
 		for i := start; i < end; i++ {
@@ -198,10 +193,132 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 
 }
 
-func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+// fftTables holds the twiddles, and the coset table accessed in bit reversed order, of an FFT;
+// they are built once for all the columns of FFTBatch when the domain has no precomputed tables.
+type fftTables struct {
+	twiddles           [][]fr.Element
+	twiddlesStartStage int
+	cosetTable         []fr.Element
+}
+
+func (domain *Domain) fftTables(decimation Decimation, inverse, coset bool) fftTables {
+	if domain.withPrecompute {
+		if inverse {
+			return fftTables{twiddles: domain.twiddlesInv, cosetTable: domain.cosetTableInv}
+		}
+		return fftTables{twiddles: domain.twiddles, cosetTable: domain.cosetTable}
+	}
+
+	var t fftTables
+	w, shift := domain.Generator, domain.FrMultiplicativeGen
+	if inverse {
+		w, shift = domain.GeneratorInv, domain.FrMultiplicativeGenInv
+	}
+	t.twiddlesStartStage = 3
+	nbStages := int(bits.TrailingZeros64(domain.Cardinality))
+	t.twiddles = make([][]fr.Element, nbStages-t.twiddlesStartStage)
+	w.Exp(w, big.NewInt(int64(1<<t.twiddlesStartStage)))
+	buildTwiddles(t.twiddles, w, uint64(nbStages-t.twiddlesStartStage))
+
+	// the coset table is only needed when accessed in bit reversed order;
+	// we need to build the full table or do a bit reverse dance.
+	if coset && (decimation == DIT) != inverse {
+		t.cosetTable = make([]fr.Element, domain.Cardinality)
+		BuildExpTable(shift, t.cosetTable)
+	}
+	return t
+}
+
+// FFTBatch computes the FFT of each column, as FFT would, and stores the results in place.
+// The columns must all have the domain's cardinality; the options apply to all of them.
+//
+// The twiddles are shared by all the columns. When there are at least as many columns as tasks,
+// each column is transformed by a single go routine, which keeps it in the cache of one core
+// instead of splitting its stages across cores; otherwise the tasks are divided between the columns.
+func (domain *Domain) FFTBatch(columns [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(columns, decimation, false, fftOptions(opts...))
+}
+
+// FFTInverseBatch computes the inverse FFT of each column, as FFTInverse would, and stores the
+// results in place. See FFTBatch.
+func (domain *Domain) FFTInverseBatch(columns [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(columns, decimation, true, fftOptions(opts...))
+}
+
+func (domain *Domain) fftBatch(columns [][]fr.Element, decimation Decimation, inverse bool, opt fftConfig) {
+	if len(columns) == 0 {
+		return
+	}
+	for i := range columns {
+		if uint64(len(columns[i])) != domain.Cardinality {
+			panic("fft: all the columns must have the cardinality of the domain")
+		}
+	}
+
+	var t fftTables
+	if !domain.isMixedRadix() {
+		t = domain.fftTables(decimation, inverse, opt.coset)
+	}
+
+	columnOpt := opt
+	columnOpt.nbTasks = opt.nbTasks / len(columns)
+	if columnOpt.nbTasks < 1 {
+		columnOpt.nbTasks = 1
+	}
+
+	scheduler.Execute(opt.scheduler, len(columns), func(start, end int) {
+		for i := start; i < end && !isDone(opt.done); i++ {
+			switch {
+			case domain.isMixedRadix():
+				domain.mixedRadixFFT(columns[i], decimation, inverse, columnOpt)
+			case inverse:
+				domain.fftInverse(columns[i], decimation, t, columnOpt)
+			default:
+				domain.fft(columns[i], decimation, t, columnOpt)
+			}
+		}
+	}, opt.nbTasks)
+}
+
+// FFTCtx is like FFT but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of a is undefined.
+func (domain *Domain) FFTCtx(ctx context.Context, a []fr.Element, decimation Decimation, opts ...Option) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], withDone(ctx.Done()))
+	domain.FFT(a, decimation, opts...)
+	return ctx.Err()
+}
+
+// FFTInverseCtx is like FFTInverse but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of a is undefined.
+func (domain *Domain) FFTInverseCtx(ctx context.Context, a []fr.Element, decimation Decimation, opts ...Option) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], withDone(ctx.Done()))
+	domain.FFTInverse(a, decimation, opts...)
+	return ctx.Err()
+}
+
+// isDone returns true if the done channel is closed; a nil channel is never done.
+func isDone(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
 	if chDone != nil {
 		defer close(chDone)
 	}
+	if isDone(opt.done) {
+		return
+	}
 
 	n := len(a)
 	if n == 1 {
@@ -217,7 +334,7 @@ func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 	if stage < twiddlesStartStage {
 		if parallelButterfly {
 			w := w
-			parallel.Execute(m, func(start, end int) {
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
 				if start == 0 {
 					fr.Butterfly(&a[0], &a[m])
 					start++
@@ -225,7 +342,7 @@ func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 				var at fr.Element
 				at.Exp(w, big.NewInt(int64(start)))
 				innerDIFWithoutTwiddles(a, at, w, start, end, m)
-			}, nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
+			}, opt.nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
 		} else {
 			innerDIFWithoutTwiddles(a, w, w, 0, m, m)
 		}
@@ -233,9 +350,9 @@ func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 		w.Square(&w)
 	} else {
 		if parallelButterfly {
-			parallel.Execute(m, func(start, end int) {
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
 				innerDIFWithTwiddles(a, twiddles[stage-twiddlesStartStage], start, end, m)
-			}, nbTasks/(1<<(stage)))
+			}, opt.nbTasks/(1<<(stage)))
 		} else {
 			innerDIFWithTwiddles(a, twiddles[stage-twiddlesStartStage], 0, m, m)
 		}
@@ -248,12 +365,14 @@ func difFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 	nextStage := stage + 1
 	if stage < maxSplits {
 		chDone := make(chan struct{}, 1)
-		go difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, nbTasks)
-		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
+		scheduler.Go(opt.scheduler, func() {
+			difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, opt)
+		})
+		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
 		<-chDone
 	} else {
-		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
-		difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
+		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
 	}
 
 }
@@ -281,10 +400,13 @@ func innerDIFWithoutTwiddles(a []fr.Element, at, w fr.Element, start, end, m int
 	}
 }
 
-func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
 	if chDone != nil {
 		defer close(chDone)
 	}
+	if isDone(opt.done) {
+		return
+	}
 	n := len(a)
 	if n == 1 {
 		return
@@ -301,12 +423,17 @@ func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 	if stage < maxSplits {
 		// that's the only time we fire go routines
 		chDone := make(chan struct{}, 1)
-		go ditFFT(a[m:], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, nbTasks)
-		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
+		scheduler.Go(opt.scheduler, func() {
+			ditFFT(a[m:], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, opt)
+		})
+		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
 		<-chDone
 	} else {
-		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
-		ditFFT(a[m:n], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, nbTasks)
+		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		ditFFT(a[m:n], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+	}
+	if isDone(opt.done) {
+		return
 	}
 
 	parallelButterfly := (m > butterflyThreshold) && (stage < maxSplits)
@@ -315,7 +442,7 @@ func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 		// we need to compute the twiddles for this stage on the fly.
 		if parallelButterfly {
 			w := w
-			parallel.Execute(m, func(start, end int) {
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
 				if start == 0 {
 					fr.Butterfly(&a[0], &a[m])
 					start++
@@ -323,7 +450,7 @@ func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 				var at fr.Element
 				at.Exp(w, big.NewInt(int64(start)))
 				innerDITWithoutTwiddles(a, at, w, start, end, m)
-			}, nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
+			}, opt.nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
 
 		} else {
 			innerDITWithoutTwiddles(a, w, w, 0, m, m)
@@ -331,9 +458,9 @@ func ditFFT(a []fr.Element, w fr.Element, twiddles [][]fr.Element, twiddlesStart
 		return
 	}
 	if parallelButterfly {
-		parallel.Execute(m, func(start, end int) {
+		scheduler.Execute(opt.scheduler, m, func(start, end int) {
 			innerDITWithTwiddles(a, twiddles[stage-twiddlesStartStage], start, end, m)
-		}, nbTasks/(1<<(stage)))
+		}, opt.nbTasks/(1<<(stage)))
 	} else {
 		innerDITWithTwiddles(a, twiddles[stage-twiddlesStartStage], 0, m, m)
 	}
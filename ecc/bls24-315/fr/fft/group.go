@@ -0,0 +1,249 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+)
+
+// FFTG1 computes the discrete Fourier transform of a vector of G1 points and stores the result in a,
+// with the same conventions and options as FFT:
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// (see BitReverseG1).
+// Each butterfly costs a scalar multiplication, which FFTG1 runs in parallel.
+func (domain *Domain) FFTG1(a []curve.G1Jac, decimation Decimation, opts ...Option) {
+	groupFFT(domain, a, decimation, false, fftOptions(opts...))
+}
+
+// FFTInverseG1 computes the inverse discrete Fourier transform of a vector of G1 points
+// and stores the result in a. See FFTG1.
+func (domain *Domain) FFTInverseG1(a []curve.G1Jac, decimation Decimation, opts ...Option) {
+	groupFFT(domain, a, decimation, true, fftOptions(opts...))
+}
+
+// FFTG2 computes the discrete Fourier transform of a vector of G2 points and stores the result in a.
+// See FFTG1.
+func (domain *Domain) FFTG2(a []curve.G2Jac, decimation Decimation, opts ...Option) {
+	groupFFT(domain, a, decimation, false, fftOptions(opts...))
+}
+
+// FFTInverseG2 computes the inverse discrete Fourier transform of a vector of G2 points
+// and stores the result in a. See FFTG1.
+func (domain *Domain) FFTInverseG2(a []curve.G2Jac, decimation Decimation, opts ...Option) {
+	groupFFT(domain, a, decimation, true, fftOptions(opts...))
+}
+
+// BitReverseG1 applies the bit-reversal permutation to a.
+// len(a) must be a power of 2
+func BitReverseG1(a []curve.G1Jac) {
+	bitReverseGroup(a)
+}
+
+// BitReverseG2 applies the bit-reversal permutation to a.
+// len(a) must be a power of 2
+func BitReverseG2(a []curve.G2Jac) {
+	bitReverseGroup(a)
+}
+
+// groupElement is implemented by the points of G1 and G2 in Jacobian coordinates
+type groupElement[T any] interface {
+	*T
+	Set(*T) *T
+	AddAssign(*T) *T
+	SubAssign(*T) *T
+	ScalarMultiplication(*T, *big.Int) *T
+}
+
+func groupFFT[T any, PT groupElement[T]](domain *Domain, a []T, decimation Decimation, inverse bool, opt fftConfig) {
+	if uint64(len(a)) != domain.Cardinality || domain.isMixedRadix() {
+		panic("fft: the group FFT needs a vector of the size of a power of two domain")
+	}
+	if len(a) == 1 && !opt.coset {
+		return
+	}
+
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
+	if opt.nbTasks == 1 {
+		maxSplits = -1
+	}
+
+	w, shift := domain.Generator, domain.FrMultiplicativeGen
+	if inverse {
+		w, shift = domain.GeneratorInv, domain.FrMultiplicativeGenInv
+	}
+
+	// twiddles[j] = wʲ, for j < n/2; the stage s uses the twiddles of index multiple of 2ˢ
+	twiddles := make([]big.Int, len(a)/2)
+	scheduler.Execute(opt.scheduler, len(twiddles), func(start, end int) {
+		var wj fr.Element
+		wj.Exp(w, big.NewInt(int64(start)))
+		for j := start; j < end; j++ {
+			wj.BigInt(&twiddles[j])
+			wj.Mul(&wj, &w)
+		}
+	}, opt.nbTasks)
+
+	// scale multiplies a[i] by c·shift^i, or c·shift^rev(i) if bitReversed is set
+	scale := func(c fr.Element, bitReversed bool) {
+		nn := uint64(64 - bits.TrailingZeros64(uint64(len(a))))
+		scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+			var s fr.Element
+			var b big.Int
+			for i := start; i < end; i++ {
+				e := uint64(i)
+				if bitReversed {
+					e = bits.Reverse64(e) >> nn
+				}
+				s.Exp(shift, new(big.Int).SetUint64(e)).Mul(&s, &c)
+				PT(&a[i]).ScalarMultiplication(&a[i], s.BigInt(&b))
+			}
+		}, opt.nbTasks)
+	}
+
+	var one fr.Element
+	one.SetOne()
+	if opt.coset && !inverse {
+		scale(one, decimation == DIT)
+	}
+
+	switch decimation {
+	case DIF:
+		difFFTGroup[T, PT](a, twiddles, 0, maxSplits, nil, opt)
+	case DIT:
+		ditFFTGroup[T, PT](a, twiddles, 0, maxSplits, nil, opt)
+	default:
+		panic("not implemented")
+	}
+
+	if !inverse || isDone(opt.done) {
+		return
+	}
+	if opt.coset {
+		scale(domain.CardinalityInv, decimation == DIF)
+		return
+	}
+	var cardinalityInv big.Int
+	domain.CardinalityInv.BigInt(&cardinalityInv)
+	scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			PT(&a[i]).ScalarMultiplication(&a[i], &cardinalityInv)
+		}
+	}, opt.nbTasks)
+}
+
+func butterflyGroup[T any, PT groupElement[T]](a, b *T) {
+	var t T
+	PT(&t).Set(a)
+	PT(a).AddAssign(b)
+	PT(&t).SubAssign(b)
+	PT(b).Set(&t)
+}
+
+func difFFTGroup[T any, PT groupElement[T]](a []T, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	n := len(a)
+	if n == 1 || isDone(opt.done) {
+		return
+	}
+	m := n >> 1
+	stride := 1 << stage
+
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			butterflyGroup[T, PT](&a[i], &a[i+m])
+			if i != 0 {
+				PT(&a[i+m]).ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+		}
+	}
+	// 1 << stage == number of concurrent calls at this stage
+	scheduler.Execute(opt.scheduler, m, butterflies, opt.nbTasks>>stage)
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		scheduler.Go(opt.scheduler, func() {
+			difFFTGroup[T, PT](a[m:n], twiddles, nextStage, maxSplits, chDone, opt)
+		})
+		difFFTGroup[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil, opt)
+		<-chDone
+	} else {
+		difFFTGroup[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil, opt)
+		difFFTGroup[T, PT](a[m:n], twiddles, nextStage, maxSplits, nil, opt)
+	}
+}
+
+func ditFFTGroup[T any, PT groupElement[T]](a []T, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	n := len(a)
+	if n == 1 || isDone(opt.done) {
+		return
+	}
+	m := n >> 1
+	stride := 1 << stage
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		scheduler.Go(opt.scheduler, func() {
+			ditFFTGroup[T, PT](a[m:n], twiddles, nextStage, maxSplits, chDone, opt)
+		})
+		ditFFTGroup[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil, opt)
+		<-chDone
+	} else {
+		ditFFTGroup[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil, opt)
+		ditFFTGroup[T, PT](a[m:n], twiddles, nextStage, maxSplits, nil, opt)
+	}
+
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			if i != 0 {
+				PT(&a[i+m]).ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+			butterflyGroup[T, PT](&a[i], &a[i+m])
+		}
+	}
+	scheduler.Execute(opt.scheduler, m, butterflies, opt.nbTasks>>stage)
+}
+
+func bitReverseGroup[T any](a []T) {
+	n := uint64(len(a))
+	if bits.OnesCount64(n) != 1 {
+		panic("len(a) must be a power of 2")
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+)
+
+func TestFFTG1G2(t *testing.T) {
+	const size = 1 << 5
+	g1, g2, _, _ := curve.Generators()
+
+	for _, precompute := range []bool{true, false} {
+		domain := NewDomain(size)
+		if !precompute {
+			domain = NewDomain(size, WithoutPrecompute())
+		}
+		for _, opts := range [][]Option{nil, {OnCoset()}, {WithNbTasks(1)}, {OnCoset(), WithNbTasks(3)}} {
+			for _, decimation := range []Decimation{DIF, DIT} {
+				// [FFT(s)]g = FFT([s]g)
+				scalars := make([]fr.Element, size)
+				p1 := make([]curve.G1Jac, size)
+				p2 := make([]curve.G2Jac, size)
+				var b big.Int
+				for i := range scalars {
+					scalars[i].SetRandom()
+					scalars[i].BigInt(&b)
+					p1[i].ScalarMultiplication(&g1, &b)
+					p2[i].ScalarMultiplication(&g2, &b)
+				}
+
+				check := func(name string) {
+					t.Helper()
+					for i := range scalars {
+						var e1 curve.G1Jac
+						var e2 curve.G2Jac
+						scalars[i].BigInt(&b)
+						e1.ScalarMultiplication(&g1, &b)
+						e2.ScalarMultiplication(&g2, &b)
+						if !e1.Equal(&p1[i]) || !e2.Equal(&p2[i]) {
+							t.Fatalf("%s (decimation %d, %d options): mismatch at index %d", name, decimation, len(opts), i)
+						}
+					}
+				}
+
+				domain.FFT(scalars, decimation, opts...)
+				domain.FFTG1(p1, decimation, opts...)
+				domain.FFTG2(p2, decimation, opts...)
+				check("FFT")
+
+				domain.FFTInverse(scalars, decimation, opts...)
+				domain.FFTInverseG1(p1, decimation, opts...)
+				domain.FFTInverseG2(p2, decimation, opts...)
+				check("FFTInverse")
+
+				BitReverse(scalars)
+				BitReverseG1(p1)
+				BitReverseG2(p2)
+				check("BitReverse")
+			}
+		}
+	}
+}
+
+func BenchmarkFFTG1(b *testing.B) {
+	const size = 1 << 10
+	g1, _, _, _ := curve.Generators()
+	domain := NewDomain(size)
+	points := make([]curve.G1Jac, size)
+	for i := range points {
+		points[i] = g1
+	}
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		domain.FFTG1(points, DIF)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+func TestFFTOutOfCore(t *testing.T) {
+	for _, logN := range []int{10, 11} {
+		n := 1 << logN
+		for _, precompute := range []bool{true, false} {
+			var domain *Domain
+			if precompute {
+				domain = NewDomain(uint64(n))
+			} else {
+				domain = NewDomain(uint64(n), WithoutPrecompute())
+			}
+			pol := randomVector(n)
+
+			for _, coset := range []bool{false, true} {
+				opts := []Option{WithMemoryLimit(1 << 7)}
+				if coset {
+					opts = append(opts, OnCoset())
+				}
+				for _, decimation := range []Decimation{DIF, DIT} {
+					expected := make([]fr.Element, n)
+					copy(expected, pol)
+					domain.FFT(expected, decimation, opts...)
+
+					storage := newTestStorage(pol)
+					if err := domain.FFTOutOfCore(storage, decimation, opts...); err != nil {
+						t.Fatal(err)
+					}
+					assertEqualVectors(t, expected, readTestStorage(t, storage, n), "FFTOutOfCore", n, coset)
+
+					domain.FFTInverse(expected, decimation, opts...)
+					if err := domain.FFTInverseOutOfCore(storage, decimation, opts...); err != nil {
+						t.Fatal(err)
+					}
+					assertEqualVectors(t, expected, readTestStorage(t, storage, n), "FFTInverseOutOfCore", n, coset)
+				}
+			}
+		}
+	}
+}
+
+func TestFFTOutOfCoreFile(t *testing.T) {
+	const n = 1 << 9
+	domain := NewDomain(n, WithoutPrecompute())
+	pol := randomVector(n)
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "fft"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.Write(newTestStorage(pol)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := domain.FFTOutOfCore(file, DIF, OnCoset(), WithMemoryLimit(1<<6)); err != nil {
+		t.Fatal(err)
+	}
+	if err := BitReverseOutOfCore(file, n, WithMemoryLimit(1<<6)); err != nil {
+		t.Fatal(err)
+	}
+	expected := make([]fr.Element, n)
+	copy(expected, pol)
+	domain.FFT(expected, DIF, OnCoset())
+	BitReverse(expected)
+	assertEqualVectors(t, expected, readTestStorage(t, file, n), "FFTOutOfCore on a file", n, true)
+}
+
+func TestFFTOutOfCoreErrors(t *testing.T) {
+	const n = 1 << 10
+	storage := newTestStorage(randomVector(n))
+	if err := NewDomain(n).FFTOutOfCore(storage, DIF, WithMemoryLimit(16)); err == nil {
+		t.Fatal("expected an error for a memory limit smaller than a row")
+	}
+	if err := NewDomain(3*n/4, WithMixedRadix()).FFTOutOfCore(storage, DIF); err == nil {
+		t.Fatal("expected an error for a mixed-radix domain")
+	}
+	if err := NewDomain(2*n).FFTOutOfCore(storage, DIF, WithMemoryLimit(64)); err == nil {
+		t.Fatal("expected an error for a storage too small")
+	}
+}
+
+// cancelingStorage cancels a context on the first write
+type cancelingStorage struct {
+	byteStorage
+	cancel context.CancelFunc
+}
+
+func (s cancelingStorage) WriteAt(p []byte, off int64) (int, error) {
+	s.cancel()
+	return s.byteStorage.WriteAt(p, off)
+}
+
+func TestFFTOutOfCoreCtx(t *testing.T) {
+	const n = 1 << 10
+	domain := NewDomain(n, WithoutPrecompute())
+	pol := randomVector(n)
+	expected := make([]fr.Element, n)
+	copy(expected, pol)
+	domain.FFT(expected, DIF)
+
+	storage := newTestStorage(pol)
+	if err := domain.FFTOutOfCoreCtx(context.Background(), storage, DIF, WithMemoryLimit(1<<7)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualVectors(t, expected, readTestStorage(t, storage, n), "FFTOutOfCoreCtx", n, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := domain.FFTInverseOutOfCoreCtx(ctx, storage, DIT); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	// the cancellation after the first band of the first pass must be reported
+	ctx, cancel = context.WithCancel(context.Background())
+	s := cancelingStorage{byteStorage: newTestStorage(pol), cancel: cancel}
+	if err := domain.FFTOutOfCoreCtx(ctx, s, DIF, WithMemoryLimit(1<<7)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if err := domain.fftOutOfCore(newTestStorage(pol), DIT, false, fftOptions(WithMemoryLimit(1<<7), withDone(ctx.Done()))); err != errCanceled {
+		t.Fatalf("expected errCanceled, got %v", err)
+	}
+}
+
+func TestBitReverseOutOfCore(t *testing.T) {
+	for _, logN := range []int{1, 6, 9, 12} {
+		n := 1 << logN
+		v := randomVector(n)
+		storage := newTestStorage(v)
+		if err := BitReverseOutOfCore(storage, uint64(n), WithMemoryLimit(1<<5)); err != nil {
+			t.Fatal(err)
+		}
+		BitReverse(v)
+		assertEqualVectors(t, v, readTestStorage(t, storage, n), "BitReverseOutOfCore", n, false)
+	}
+}
+
+func BenchmarkFFTOutOfCore(b *testing.B) {
+	const n = 1 << 18
+	domain := NewDomain(n, WithoutPrecompute())
+	storage := newTestStorage(randomVector(n))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := domain.FFTOutOfCore(storage, DIF, WithMemoryLimit(1<<12)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func randomVector(n int) []fr.Element {
+	v := make([]fr.Element, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+// newTestStorage returns the encoding of v expected by the out-of-core FFTs
+func newTestStorage(v []fr.Element) byteStorage {
+	res := make(byteStorage, len(v)*fr.Bytes)
+	for i := range v {
+		fr.BigEndian.PutElement((*[fr.Bytes]byte)(res[i*fr.Bytes:]), v[i])
+	}
+	return res
+}
+
+func readTestStorage(t *testing.T, s Storage, n int) []fr.Element {
+	t.Helper()
+	v := make([]fr.Element, n)
+	r := storageIO{data: s}
+	if err := r.read(v, 0); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomPolynomial(size int) Polynomial {
+	p := make(Polynomial, size)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func randomPoints(n int) []fr.Element {
+	points := make([]fr.Element, n)
+	for i := range points {
+		points[i].SetRandom()
+	}
+	return points
+}
+
+// naiveMul is the schoolbook product, used as a reference
+func naiveMul(p1, p2 Polynomial) Polynomial {
+	res := make(Polynomial, len(p1)+len(p2)-1)
+	var tmp fr.Element
+	for i := range p1 {
+		for j := range p2 {
+			tmp.Mul(&p1[i], &p2[j])
+			res[i+j].Add(&res[i+j], &tmp)
+		}
+	}
+	return res
+}
+
+func TestPolynomialMul(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, sizes := range [][2]int{{1, 1}, {3, 17}, {33, 40}, {100, 257}, {300, 300}} {
+		p1, p2 := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		var p Polynomial
+		p.Mul(p1, p2)
+		assert.True(p.Equal(naiveMul(p1, p2)), "sizes %v", sizes)
+
+		// aliasing
+		p1.Mul(p1, p2)
+		assert.True(p1.Equal(p), "sizes %v", sizes)
+	}
+}
+
+func TestPolynomialDivMod(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, sizes := range [][2]int{{1, 1}, {5, 1}, {5, 8}, {20, 7}, {300, 100}, {500, 200}, {1000, 10}} {
+		a, b := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		var q, r Polynomial
+		q.Div(a, b)
+		r.Mod(a, b)
+		assert.True(len(r) < len(b) || (len(r) == 1 && r[0].IsZero()), "sizes %v: deg(r) >= deg(b)", sizes)
+
+		// a = q*b + r
+		var res Polynomial
+		res.Mul(q, b)
+		res.Add(res, r)
+		res = trim(res)
+		assert.True(res.Equal(a), "sizes %v", sizes)
+	}
+
+	assert.Panics(func() {
+		var q Polynomial
+		q.Div(randomPolynomial(10), make(Polynomial, 3))
+	})
+}
+
+func TestPolynomialVanishing(t *testing.T) {
+	assert := assert.New(t)
+
+	points := randomPoints(77)
+	z := Vanishing(points)
+	assert.Equal(len(points)+1, len(z))
+	assert.True(z[len(z)-1].IsOne())
+	for i := range points {
+		v := z.Eval(&points[i])
+		assert.True(v.IsZero())
+	}
+
+	// (z * f) / z = f
+	f := randomPolynomial(150)
+	var zf, q Polynomial
+	zf.Mul(z, f)
+	q.DivByVanishing(zf, points)
+	assert.True(q.Equal(f))
+}
+
+func TestPolynomialEvalMany(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, sizes := range [][2]int{{10, 3}, {100, 65}, {64, 300}, {513, 200}} {
+		p := randomPolynomial(sizes[0])
+		points := randomPoints(sizes[1])
+		evals := p.EvalMany(points)
+		for i := range points {
+			expected := p.Eval(&points[i])
+			assert.True(expected.Equal(&evals[i]), "sizes %v, point %d", sizes, i)
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, n := range []int{1, 2, 33, 200} {
+		xs, ys := randomPoints(n), randomPoints(n)
+		p, err := Interpolate(xs, ys)
+		assert.NoError(err)
+		assert.LessOrEqual(len(p), n)
+		evals := p.EvalMany(xs)
+		for i := range ys {
+			assert.True(ys[i].Equal(&evals[i]), "n=%d, point %d", n, i)
+		}
+	}
+
+	xs, ys := randomPoints(10), randomPoints(10)
+	xs[7] = xs[2]
+	_, err := Interpolate(xs, ys)
+	assert.ErrorIs(err, ErrDuplicatePoints)
+}
+
+func BenchmarkPolynomialMul(b *testing.B) {
+	const size = 1 << 14
+	p1, p2 := randomPolynomial(size), randomPolynomial(size)
+	var p Polynomial
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Mul(p1, p2)
+	}
+}
+
+func BenchmarkInterpolate(b *testing.B) {
+	const size = 1 << 12
+	xs, ys := randomPoints(size), randomPoints(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Interpolate(xs, ys)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package frost provides FROST threshold Schnorr signatures on bls24-315's twisted edwards curve.
+//
+// A group of n participants holds Shamir shares of a signing key, any t of them
+// can jointly sign in two rounds:
+//   - each signer publishes commitments to a pair of single-use nonces (Commit),
+//   - each signer computes its signature share (Sign), which the coordinator
+//     checks (VerifySignatureShare) and combines into a signature (Aggregate).
+//
+// Key shares are produced either by a trusted dealer (TrustedDealerKeyGen) or
+// by a distributed key generation without dealer (NewDKGParticipant).
+//
+// The aggregated signatures have the same format and verification equation
+// as the eddsa package: the challenge H(R, A, M) is computed with the
+// caller's hash function. The other hashes of the protocol are instantiated
+// with SHA-256 as in RFC 9591.
+//
+// # See also
+//
+// https://www.rfc-editor.org/rfc/rfc9591
+//
+// https://eprint.iacr.org/2020/852
+package frost
@@ -0,0 +1,521 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/twistededwards/scalar"
+)
+
+var (
+	errInvalidParameters     = errors.New("invalid threshold parameters")
+	errInvalidIdentifier     = errors.New("invalid participant identifier")
+	errInvalidShare          = errors.New("secret share does not match the commitment")
+	errNotEnoughSigners      = errors.New("not enough signers")
+	errDuplicateSigner       = errors.New("duplicate signer")
+	errMissingCommitment     = errors.New("no commitment for the signer")
+	errCommitmentMismatch    = errors.New("commitment does not match the nonces")
+	errNoncesUsed            = errors.New("nonces have already been used")
+	errUnknownSigner         = errors.New("signer not in the public key package")
+	errInvalidSignature      = errors.New("invalid signature")
+	errInvalidSignatureShare = errors.New("invalid signature share")
+)
+
+// VSSCommitment is a Feldman commitment [a_0*G, ..., a_{t-1}*G] to
+// the polynomial f(x) = ∑a_k*x^k sharing the secret a_0.
+type VSSCommitment []twistededwards.PointAffine
+
+// evaluate returns f(x)*G = ∑(x^k)*(a_k*G)
+func (c VSSCommitment) evaluate(x uint32) twistededwards.PointAffine {
+	// Horner, from the highest degree coefficient
+	var res, tmp twistededwards.PointAffine
+	res.X.SetZero()
+	res.Y.SetOne()
+	bx := new(big.Int).SetUint64(uint64(x))
+	for k := len(c) - 1; k >= 0; k-- {
+		tmp.ScalarMultiplication(&res, bx)
+		res.Add(&tmp, &c[k])
+	}
+	return res
+}
+
+// Verify checks that share is f(id), cf RFC 9591 section C.2.
+func (c VSSCommitment) Verify(id uint32, share *big.Int) bool {
+	if len(c) == 0 || id == 0 {
+		return false
+	}
+	lhs := scalarBaseMult(share)
+	rhs := c.evaluate(id)
+	return lhs.Equal(&rhs)
+}
+
+// SecretShare is the share of the signing key sent by a trusted dealer to a participant.
+type SecretShare struct {
+	ID         uint32        // identifier of the participant, non zero
+	Value      big.Int       // f(ID)
+	Commitment VSSCommitment // commitment to f
+}
+
+// KeyPackage holds the key material a participant needs to sign.
+type KeyPackage struct {
+	ID             uint32                     // identifier of the participant, non zero
+	SigningShare   big.Int                    // s_i, Shamir share of the signing key
+	VerifyingShare twistededwards.PointAffine // s_i*G
+	GroupPublicKey twistededwards.PointAffine // s*G
+	MinSigners     int                        // threshold t
+}
+
+// PublicKeyPackage holds the public key material of the group, needed to
+// verify signature shares and signatures.
+type PublicKeyPackage struct {
+	VerifyingShares map[uint32]twistededwards.PointAffine // s_i*G for each participant
+	GroupPublicKey  twistededwards.PointAffine            // s*G
+}
+
+// TrustedDealerKeyGen samples a signing key and splits it into maxSigners shares,
+// any minSigners of which can sign, cf RFC 9591 appendix C.
+// Participants are numbered 1 to maxSigners.
+func TrustedDealerKeyGen(r io.Reader, minSigners, maxSigners int) ([]SecretShare, *PublicKeyPackage, error) {
+	if err := checkParameters(minSigners, maxSigners); err != nil {
+		return nil, nil, err
+	}
+
+	coefficients, commitment, err := randomPolynomial(r, minSigners)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shares := make([]SecretShare, maxSigners)
+	pub := PublicKeyPackage{
+		VerifyingShares: make(map[uint32]twistededwards.PointAffine, maxSigners),
+		GroupPublicKey:  commitment[0],
+	}
+	for i := range shares {
+		id := uint32(i + 1)
+		shares[i].ID = id
+		shares[i].Value = evaluatePolynomial(coefficients, id)
+		shares[i].Commitment = commitment
+		pub.VerifyingShares[id] = scalarBaseMult(&shares[i].Value)
+	}
+
+	return shares, &pub, nil
+}
+
+// KeyPackage verifies the share against the dealer's commitment and returns
+// the key material of the participant.
+func (s *SecretShare) KeyPackage() (*KeyPackage, error) {
+	if !s.Commitment.Verify(s.ID, &s.Value) {
+		return nil, errInvalidShare
+	}
+	kp := KeyPackage{
+		ID:             s.ID,
+		VerifyingShare: scalarBaseMult(&s.Value),
+		GroupPublicKey: s.Commitment[0],
+		MinSigners:     len(s.Commitment),
+	}
+	kp.SigningShare.Set(&s.Value)
+	return &kp, nil
+}
+
+// SigningCommitments are the commitments (D_i, E_i) to the nonces of a signer,
+// published in the first round.
+type SigningCommitments struct {
+	ID      uint32                     // identifier of the signer
+	Hiding  twistededwards.PointAffine // D_i = d_i*G
+	Binding twistededwards.PointAffine // E_i = e_i*G
+}
+
+// SigningNonces are the secret nonces (d_i, e_i) of a signer. They must be
+// used for a single signature and never be shared.
+type SigningNonces struct {
+	hiding, binding big.Int
+	Commitments     SigningCommitments
+}
+
+// SignatureShare is the contribution z_i of a signer to a signature.
+type SignatureShare struct {
+	ID uint32  // identifier of the signer
+	Z  big.Int // z_i = d_i + e_i*ρ_i + λ_i*s_i*c
+}
+
+// Commit runs the first round of the signing protocol: it samples the nonces
+// of the participant and returns them with their commitments, cf RFC 9591 section 5.1.
+func Commit(r io.Reader, kp *KeyPackage) (*SigningNonces, error) {
+	var nonces SigningNonces
+	var err error
+	if nonces.hiding, err = generateNonce(r, &kp.SigningShare); err != nil {
+		return nil, err
+	}
+	if nonces.binding, err = generateNonce(r, &kp.SigningShare); err != nil {
+		return nil, err
+	}
+	nonces.Commitments = SigningCommitments{
+		ID:      kp.ID,
+		Hiding:  scalarBaseMult(&nonces.hiding),
+		Binding: scalarBaseMult(&nonces.binding),
+	}
+	return &nonces, nil
+}
+
+// Sign runs the second round of the signing protocol: it returns the signature
+// share of the participant on message, given the commitments of all the signers,
+// cf RFC 9591 section 5.2.
+//
+// The nonces are erased so that they cannot be used twice.
+func Sign(kp *KeyPackage, nonces *SigningNonces, message []byte, commitments []SigningCommitments, hFunc hash.Hash) (*SignatureShare, error) {
+	if nonces.hiding.Sign() == 0 || nonces.binding.Sign() == 0 {
+		return nil, errNoncesUsed
+	}
+	if len(commitments) < kp.MinSigners {
+		return nil, errNotEnoughSigners
+	}
+
+	ctx, err := newSigningContext(&kp.GroupPublicKey, message, commitments, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := ctx.index(kp.ID)
+	if idx < 0 {
+		return nil, errMissingCommitment
+	}
+	if c := &ctx.commitments[idx]; !c.Hiding.Equal(&nonces.Commitments.Hiding) || !c.Binding.Equal(&nonces.Commitments.Binding) {
+		return nil, errCommitmentMismatch
+	}
+
+	lambda := ctx.lagrangeCoefficient(kp.ID)
+
+	// z_i = d_i + e_i*ρ_i + λ_i*s_i*c, without branching on the secrets d_i, e_i and s_i
+	z, signingShare, c := toScalar(&lambda), toScalar(&kp.SigningShare), toScalar(&ctx.challenge)
+	hiding, binding, rho := toScalar(&nonces.hiding), toScalar(&nonces.binding), toScalar(&ctx.bindingFactors[idx])
+	z.MulConstantTime(&z, &signingShare).
+		MulConstantTime(&z, &c).
+		AddConstantTime(&z, binding.MulConstantTime(&binding, &rho)).
+		AddConstantTime(&z, &hiding)
+	share := SignatureShare{ID: kp.ID, Z: fromScalar(&z)}
+
+	nonces.hiding.SetUint64(0)
+	nonces.binding.SetUint64(0)
+
+	return &share, nil
+}
+
+// VerifySignatureShare checks the signature share of a signer, cf RFC 9591 section 5.4.
+func VerifySignatureShare(pub *PublicKeyPackage, share *SignatureShare, message []byte, commitments []SigningCommitments, hFunc hash.Hash) error {
+	ctx, err := newSigningContext(&pub.GroupPublicKey, message, commitments, hFunc)
+	if err != nil {
+		return err
+	}
+	return ctx.verifyShare(pub, share)
+}
+
+// Aggregate combines the signature shares into a signature on message
+// under the group public key, cf RFC 9591 section 5.3.
+// If the signature is invalid, the first invalid share is reported.
+func Aggregate(pub *PublicKeyPackage, message []byte, commitments []SigningCommitments, shares []SignatureShare, hFunc hash.Hash) ([]byte, error) {
+	ctx, err := newSigningContext(&pub.GroupPublicKey, message, commitments, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) != len(ctx.commitments) {
+		return nil, errNotEnoughSigners
+	}
+
+	var z big.Int
+	seen := make(map[uint32]bool, len(shares))
+	for i := range shares {
+		if ctx.index(shares[i].ID) < 0 {
+			return nil, errMissingCommitment
+		}
+		if seen[shares[i].ID] {
+			return nil, errDuplicateSigner
+		}
+		seen[shares[i].ID] = true
+		z.Add(&z, &shares[i].Z)
+	}
+	z.Mod(&z, order)
+
+	// z*G == R + c*Y
+	var lhs, rhs twistededwards.PointAffine
+	lhs = scalarBaseMult(&z)
+	rhs.ScalarMultiplication(&pub.GroupPublicKey, &ctx.challenge).
+		Add(&rhs, &ctx.groupCommitment)
+	if !lhs.Equal(&rhs) {
+		for i := range shares {
+			if err := ctx.verifyShare(pub, &shares[i]); err != nil {
+				return nil, err
+			}
+		}
+		return nil, errInvalidSignature
+	}
+
+	sig, err := serializeElement(&ctx.groupCommitment)
+	if err != nil {
+		return nil, err
+	}
+	return append(sig, serializeScalar(&z)...), nil
+}
+
+// Verify verifies a signature produced by Aggregate. It is equivalent to
+// the verification of the eddsa package, that is cofactor*S*G = cofactor*(R + H(R,A,M)*A).
+func Verify(groupPublicKey *twistededwards.PointAffine, sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	if len(sigBin) != sizeSignature {
+		return false, errInvalidSignature
+	}
+	R, err := deserializeElement(sigBin[:sizeElement])
+	if err != nil {
+		return false, err
+	}
+	z, err := deserializeScalar(sigBin[sizeElement:])
+	if err != nil {
+		return false, err
+	}
+	c, err := challenge(&R, groupPublicKey, message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	var lhs, rhs twistededwards.PointAffine
+	lhs = scalarBaseMult(&z)
+	rhs.ScalarMultiplication(groupPublicKey, &c).
+		Add(&rhs, &R)
+	var bCofactor big.Int
+	curveParams.Cofactor.BigInt(&bCofactor)
+	lhs.ScalarMultiplication(&lhs, &bCofactor)
+	rhs.ScalarMultiplication(&rhs, &bCofactor)
+
+	return lhs.Equal(&rhs), nil
+}
+
+// signingContext holds the values common to all the signers of a message.
+type signingContext struct {
+	commitments     []SigningCommitments // sorted by identifier
+	ids             []uint32
+	bindingFactors  []big.Int                  // ρ_i
+	groupCommitment twistededwards.PointAffine // R = ∑D_i + ρ_i*E_i
+	challenge       big.Int                    // c = H(R, Y, m)
+}
+
+func newSigningContext(groupPublicKey *twistededwards.PointAffine, message []byte, commitments []SigningCommitments, hFunc hash.Hash) (*signingContext, error) {
+	if len(commitments) == 0 {
+		return nil, errNotEnoughSigners
+	}
+
+	var ctx signingContext
+	ctx.commitments = make([]SigningCommitments, len(commitments))
+	copy(ctx.commitments, commitments)
+	sort.Slice(ctx.commitments, func(i, j int) bool {
+		return ctx.commitments[i].ID < ctx.commitments[j].ID
+	})
+
+	ctx.ids = make([]uint32, len(ctx.commitments))
+	for i := range ctx.commitments {
+		ctx.ids[i] = ctx.commitments[i].ID
+		if ctx.ids[i] == 0 {
+			return nil, errInvalidIdentifier
+		}
+		if i > 0 && ctx.ids[i] == ctx.ids[i-1] {
+			return nil, errDuplicateSigner
+		}
+		if err := validateElement(&ctx.commitments[i].Hiding); err != nil {
+			return nil, fmt.Errorf("hiding commitment of participant %d: %w", ctx.ids[i], err)
+		}
+		if err := validateElement(&ctx.commitments[i].Binding); err != nil {
+			return nil, fmt.Errorf("binding commitment of participant %d: %w", ctx.ids[i], err)
+		}
+	}
+
+	if err := ctx.computeBindingFactors(groupPublicKey, message); err != nil {
+		return nil, err
+	}
+
+	// R = ∑D_i + ρ_i*E_i
+	var tmp twistededwards.PointAffine
+	ctx.groupCommitment.X.SetZero()
+	ctx.groupCommitment.Y.SetOne()
+	for i := range ctx.commitments {
+		tmp.ScalarMultiplication(&ctx.commitments[i].Binding, &ctx.bindingFactors[i]).
+			Add(&tmp, &ctx.commitments[i].Hiding)
+		ctx.groupCommitment.Add(&ctx.groupCommitment, &tmp)
+	}
+	if isIdentity(&ctx.groupCommitment) {
+		return nil, errIdentity
+	}
+
+	var err error
+	ctx.challenge, err = challenge(&ctx.groupCommitment, groupPublicKey, message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctx, nil
+}
+
+// computeBindingFactors computes ρ_i = H1(Y || H4(m) || H5(commitments) || i), cf RFC 9591 section 4.4.
+func (ctx *signingContext) computeBindingFactors(groupPublicKey *twistededwards.PointAffine, message []byte) error {
+	groupPublicKeyBin, err := serializeElement(groupPublicKey)
+	if err != nil {
+		return err
+	}
+
+	// encode_group_commitment_list
+	encodedCommitments := make([]byte, 0, len(ctx.commitments)*(sizeScalar+2*sizeElement))
+	for i := range ctx.commitments {
+		hidingBin, err := serializeElement(&ctx.commitments[i].Hiding)
+		if err != nil {
+			return err
+		}
+		bindingBin, err := serializeElement(&ctx.commitments[i].Binding)
+		if err != nil {
+			return err
+		}
+		encodedCommitments = append(encodedCommitments, serializeIdentifier(ctx.ids[i])...)
+		encodedCommitments = append(encodedCommitments, hidingBin...)
+		encodedCommitments = append(encodedCommitments, bindingBin...)
+	}
+
+	prefix := make([]byte, 0, len(groupPublicKeyBin)+2*32+sizeScalar)
+	prefix = append(prefix, groupPublicKeyBin...)
+	prefix = append(prefix, hashToBytes(message, "msg")...)
+	prefix = append(prefix, hashToBytes(encodedCommitments, "com")...)
+
+	ctx.bindingFactors = make([]big.Int, len(ctx.commitments))
+	for i := range ctx.commitments {
+		rhoInput := append(prefix[:len(prefix):len(prefix)], serializeIdentifier(ctx.ids[i])...)
+		if ctx.bindingFactors[i], err = hashToScalar(rhoInput, "rho"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// index returns the position of the signer id in the sorted commitments, or -1.
+func (ctx *signingContext) index(id uint32) int {
+	i := sort.Search(len(ctx.ids), func(i int) bool { return ctx.ids[i] >= id })
+	if i < len(ctx.ids) && ctx.ids[i] == id {
+		return i
+	}
+	return -1
+}
+
+// lagrangeCoefficient returns λ_i = ∏_{j≠i} x_j/(x_j-x_i) over the signers,
+// cf RFC 9591 section 4.2. id must be a signer.
+func (ctx *signingContext) lagrangeCoefficient(id uint32) big.Int {
+	var num, den, tmp big.Int
+	num.SetUint64(1)
+	den.SetUint64(1)
+	xi := new(big.Int).SetUint64(uint64(id))
+	for _, j := range ctx.ids {
+		if j == id {
+			continue
+		}
+		tmp.SetUint64(uint64(j))
+		num.Mul(&num, &tmp)
+		tmp.Sub(&tmp, xi)
+		den.Mul(&den, &tmp)
+	}
+	den.Mod(&den, order).ModInverse(&den, order)
+	num.Mul(&num, &den).Mod(&num, order)
+	return num
+}
+
+// verifyShare checks z_i*G == D_i + ρ_i*E_i + (c*λ_i)*Y_i
+func (ctx *signingContext) verifyShare(pub *PublicKeyPackage, share *SignatureShare) error {
+	idx := ctx.index(share.ID)
+	if idx < 0 {
+		return errMissingCommitment
+	}
+	verifyingShare, ok := pub.VerifyingShares[share.ID]
+	if !ok {
+		return errUnknownSigner
+	}
+
+	lambda := ctx.lagrangeCoefficient(share.ID)
+	lambda.Mul(&lambda, &ctx.challenge)
+
+	var lhs, rhs, tmp twistededwards.PointAffine
+	lhs = scalarBaseMult(&share.Z)
+	rhs.ScalarMultiplication(&ctx.commitments[idx].Binding, &ctx.bindingFactors[idx]).
+		Add(&rhs, &ctx.commitments[idx].Hiding)
+	tmp.ScalarMultiplication(&verifyingShare, &lambda)
+	rhs.Add(&rhs, &tmp)
+
+	if !lhs.Equal(&rhs) {
+		return fmt.Errorf("%w from participant %d", errInvalidSignatureShare, share.ID)
+	}
+	return nil
+}
+
+// generateNonce returns H3(random_bytes(32) || secret), cf RFC 9591 section 4.1.
+func generateNonce(r io.Reader, secret *big.Int) (big.Int, error) {
+	buf := make([]byte, 32, 32+sizeScalar)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return big.Int{}, err
+	}
+	buf = append(buf, serializeScalar(secret)...)
+	return hashToScalar(buf, "nonce")
+}
+
+// serializeIdentifier encodes the identifier as a scalar.
+func serializeIdentifier(id uint32) []byte {
+	var res [sizeScalar]byte
+	binary.BigEndian.PutUint32(res[sizeScalar-4:], id)
+	return res[:]
+}
+
+// checkParameters checks that 2 ≤ minSigners ≤ maxSigners.
+func checkParameters(minSigners, maxSigners int) error {
+	if minSigners < 2 || minSigners > maxSigners || uint64(maxSigners) >= 1<<32 {
+		return errInvalidParameters
+	}
+	return nil
+}
+
+// randomPolynomial samples the coefficients of a polynomial of degree
+// t-1 and commits to them.
+func randomPolynomial(r io.Reader, t int) ([]big.Int, VSSCommitment, error) {
+	coefficients := make([]big.Int, t)
+	commitment := make(VSSCommitment, t)
+	for k := range coefficients {
+		var err error
+		if coefficients[k], err = randomScalar(r); err != nil {
+			return nil, nil, err
+		}
+		commitment[k] = scalarBaseMult(&coefficients[k])
+	}
+	return coefficients, commitment, nil
+}
+
+// evaluatePolynomial returns ∑coefficients[k]*x^k mod order, without branching
+// on the coefficients.
+func evaluatePolynomial(coefficients []big.Int, x uint32) big.Int {
+	var res, sx scalar.Element
+	sx.SetUint64(uint64(x))
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		c := toScalar(&coefficients[k])
+		res.MulConstantTime(&res, &sx).AddConstantTime(&res, &c)
+	}
+	return fromScalar(&res)
+}
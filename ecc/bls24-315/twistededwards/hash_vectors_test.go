@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS24315_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0xfcd8539ece6b2590c817ab78a834a6d985cf86e3e1f8ff2798fd0792a71b35", "0x17b8e6f25f3c41e68cef8d4f21aee9ac7d523387a38a38bd054aca602f520dce"},
+				Q: point{"0xe7188a448cdf5522d896c55500c33062ecddde9e1d63ea391f3c66f46d2dbc0", "0xb6cced0323aa380661911c3452982bd3f4f1fce9cb06ca100fde4d50f001844"},
+				u: "0x192e439de15527bc20698fcec9cf44f8bf91575d58f0f7527c959547e84d2ce9",
+			},
+			{
+				msg: "abc", P: point{"0x1079779ef012e3d01bd559a292547d074877d7d4fe4c260109b6b71c575ecfa3", "0xef98f9d36d7ea44621a8cd3a30b4106f85102d08ffe4a0b6a40ab1a937430a"},
+				Q: point{"0x54ae0b962ea43e3ceed50798d9f0efb006b9d2a854f0de90c9d5d0ca24b4e54", "0x16dbe402ca10f956aff621f1f634611fd5b76db7d7632df9da73ac5da21b2c88"},
+				u: "0xc891957e7774c680ba11c60a549dfb9eda57b628bb0f33260c618b0e4bb230f",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x123df89453aefa0d1c4ff7b59035f5fd830fd7b54d145555671ed9e9e26a8789", "0x106076d86a973c7d6a8f467b1f7f08e0ea637a8c0dca2c104319bcc5220dd3d8"},
+				Q: point{"0x170b1ecb9dadb722b04c9c51e52a5d1ec6d0f92a0c0b8f149e195485e81b3ff0", "0xbad2f35ddcd4cfce97552a45ad397fee68f0fb93abbc77c7dd6ba71bb97d0e4"},
+				u: "0x2659db0bafa008d903b1102f4074bf35044a56060e0909e0ec166095c2be224",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x11b065328d07a5395ae94c6f3b147f9107cbebf8889a20f7096c311c346bb6c4", "0x64c713bbf3b95d1dc4ae24c3b5c78e7aa897fb5aa19de764bda091e1aa1d9d"},
+				Q: point{"0x120c242e48c3085a87334d6f803bfeac854cfbb441f2258e1e120d7110f90832", "0xe55bbb4788aa9a7ca1566e0d5a0d914eedb20adc2f9ea15bc6020847c322e62"},
+				u: "0xc06a6caef46a653bf9df1375cfcb0b0eba46d13c8b87acd60c303df964cc9a6",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x14fc818a89e873f48244f08c906974e20a142d34bf4f25f538219f5a0eae456c", "0xb740f82521fe15bfcb96b13847af9e3661ad1e645bfc2125f9cd22fee9f0bf4"},
+				Q: point{"0x9fb140f3a50f1aaf0387e093429d02ffa6a7d0d48af860080f91fe03e176a27", "0xb5cb9c215c40066ab060e1d183af72f79f381a179325334c8904739d3ea90c8"},
+				u: "0xa409cb335a0c0b3b32e060951be7220e485cb98c19e341e12f16d32013e29cd",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS24315_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x23521ca739c7d43573789824a5ec422609233dae5053f327b550c1a8095a8e0", "0xc76c4bd7ff192e29f9935724438f745f63940ab47bae74db0722e5764453ab2"},
+				Q0: point{"0xd2254817cc4003a89ec3d72fbbcef0fd6b13e8282ab60417cc288bc124ea0a4", "0xc930d1575b8bb21c840662f8fbc89f5057c46d26bb123b3e3605640ac255b92"},
+				Q1: point{"0x82b1214a5fc09f2d9f9a2b48f3d478598ed30388938bc80d070e6f1806e2b7b", "0x268d40e57168e5705e8aabf3e15cfe0b1655f4005b5d65443e2f6e89bf36dff"},
+				u0: "0x84081d8cf9ea01b80e04f4694d4f7fdf8e1b7fdee1e5d53c84ed656b65e0197", u1: "0x17681d3b2541390dc2406886ed54b7b5083dc56715dfe8d11add0f1c0faa4397",
+			},
+			{
+				msg: "abc", P: point{"0x1791dc331e1c58d1b717823e1214daf97a566f4dcb9ff90de75cbc4a047acd2a", "0x8e4105df41ca792fa4c635330e2412333f108ceaa349528edc00a1b059f9717"},
+				Q0: point{"0xd6e608974cae507b317f1be16280604d6947134679befeb8795a246c857f64", "0xceee25f45e40cd6c00dd3bf253cc351f5c83c25125480cc3aed41b2375d4c4b"},
+				Q1: point{"0x39aa0f0231ac60fe4da2cfd27d2a868f3327a6377193c98b73f3a5d81c0fb2b", "0x587ec3111868e691a1a8181ebaa7b5e83cf4e2a651f6d352da755344e399649"},
+				u0: "0xb67a30cc0b4502efc43bd04b4607a358246173e380a4c0b9819c6ffeae626d1", u1: "0x150498ec0073900612ba4eab59943a0ffda294e0df2a73672bd5f54af4ba623d",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x5b2b29dfa86690c080367d4a3f1e51148884f956d18f35662b4c2ff0e9227c0", "0xdb403c5d9670dfac3255738e9dc73d6317f401275d4cb5a445eea615b4f9719"},
+				Q0: point{"0x1916b47b07609afe20900507a2541e7d64e8d36b0f3710a47de65b6c298dcc1c", "0xcbfa4b1e3d8305064c093f7a22554747ad9578e040ae54a8f49b15c3d7c79cd"},
+				Q1: point{"0x14c5871f22af6a3f4280f9142ea6c2a6c0ac9fe3206c77263ecaf46b39d57ed", "0x134a8e657f945a2b130a39a5d4262f504b0e62c98413855ab803df9e9183c4d5"},
+				u0: "0xb3cb02eb79d2af4fed7c45295c552ce9562e553905f8d03a5a4fc166bbb9c08", u1: "0xce9a99b2218d103db5874120012a3d3550965742dc6843d65986ff4888a62de",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x17c79c19518937075b67d179c9ffeb8af737616147067193c71c8f7024c38655", "0x1065ee668cd8a8c68dfe554877efc8762bbb871a0cd1657c228bc1986c0f5f48"},
+				Q0: point{"0xcde7be32daa73d6b3ac480b795ae8871218a2a63c2b96dca9f5f2cbded84050", "0x486f23b1a7d7e6e9cc1772096709f90380ee2c46e9ea66d9c2cf51a7e5442f6"},
+				Q1: point{"0x16dc2e8210583b107d963f1f77daea0439fd858b8893b8e4c35dec4ef8fbf01e", "0xf6bc850615897df57a4351d32e91d94618b1762c5be08188274c8c044310ec0"},
+				u0: "0x9989551b676d101f955f142a36a0ea28ab09634ba8354fe4a7f072537d41d54", u1: "0xc751ecae44adb08cd3dadf1f24fe27e2c72767481e370d8c74fe73c75affb01",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x1379db699d36506f377d5a46941256c19083c49fa34ab7e168780ba0cff4261d", "0x112a47eb3c37526da15136c752529e3547d270bb19b35d9b1b6993de07e6428b"},
+				Q0: point{"0x13f0b73a6cbb22eb44369fb937b4f5c5e879bafa5d94369291d1b661fdb71043", "0x79d1d17340c040dddb8799cd30442fd4f08fe103eafa8bdb60e413e38b85d84"},
+				Q1: point{"0x1664ad0afe7a679da84ef4a20c821eb1ed8c624ec3986e26e72c598d00b0434", "0x54398bb4987e283de075cda23d3795815ee049073e7af936af659bd792b49b2"},
+				u0: "0x3c02085dd0d40790c9992f084f6af73a1b8f8cc98ab1b3fe8b84471283f8ba0", u1: "0x67a2244e205ebc5ab3e8f2ed46146c88acb58a9a7de2ccf41ad55f0705665cc",
+			},
+		}}
+}
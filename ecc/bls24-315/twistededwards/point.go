@@ -21,8 +21,10 @@ import (
 	"io"
 	"math/big"
 	"math/bits"
+	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/twistededwards/scalar"
 )
 
 // PointAffine point on a twisted Edwards curve
@@ -266,6 +268,73 @@ func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *Po
 	return p
 }
 
+// ScalarMultiplicationConstantTime scalar multiplication of a point
+// p1 in affine coordinates with a scalar in big.Int
+//
+// see PointExtended.ScalarMultiplicationConstantTime
+func (p *PointAffine) ScalarMultiplicationConstantTime(p1 *PointAffine, scalar *big.Int) *PointAffine {
+
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	resExtended.ScalarMultiplicationConstantTime(&p1Extended, scalar)
+	p.fromExtendedConstantTime(&resExtended)
+
+	return p
+}
+
+// ScalarMultiplicationElementConstantTime scalar multiplication of a point
+// p1 in affine coordinates with a scalar modulo the order of the prime subgroup
+//
+// see PointExtended.ScalarMultiplicationElementConstantTime
+func (p *PointAffine) ScalarMultiplicationElementConstantTime(p1 *PointAffine, s *scalar.Element) *PointAffine {
+
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	resExtended.ScalarMultiplicationElementConstantTime(&p1Extended, s)
+	p.fromExtendedConstantTime(&resExtended)
+
+	return p
+}
+
+// ScalarMultiplicationBase computes and returns p = scalar ⋅ Base
+//
+// It uses a table of multiples of Base, built on first use.
+func (p *PointAffine) ScalarMultiplicationBase(scalar *big.Int) *PointAffine {
+	var resExtended PointExtended
+	resExtended.ScalarMultiplicationBase(scalar)
+	p.FromExtended(&resExtended)
+	return p
+}
+
+// ScalarMultiplicationBaseConstantTime computes and returns p = scalar ⋅ Base
+//
+// see PointExtended.ScalarMultiplicationBaseConstantTime
+func (p *PointAffine) ScalarMultiplicationBaseConstantTime(scalar *big.Int) *PointAffine {
+	var resExtended PointExtended
+	resExtended.ScalarMultiplicationBaseConstantTime(scalar)
+	p.fromExtendedConstantTime(&resExtended)
+	return p
+}
+
+// ScalarMultiplicationBaseElementConstantTime computes and returns p = s ⋅ Base
+//
+// see PointExtended.ScalarMultiplicationBaseElementConstantTime
+func (p *PointAffine) ScalarMultiplicationBaseElementConstantTime(s *scalar.Element) *PointAffine {
+	var resExtended PointExtended
+	resExtended.ScalarMultiplicationBaseElementConstantTime(s)
+	p.fromExtendedConstantTime(&resExtended)
+	return p
+}
+
+// fromExtendedConstantTime is FromExtended using a constant-time inversion
+func (p *PointAffine) fromExtendedConstantTime(p1 *PointExtended) *PointAffine {
+	var I fr.Element
+	I.InverseConstantTime(&p1.Z)
+	p.X.MulConstantTime(&p1.X, &I)
+	p.Y.MulConstantTime(&p1.Y, &I)
+	return p
+}
+
 // setInfinity sets p to O (0:1)
 func (p *PointAffine) setInfinity() *PointAffine {
 	p.X.SetZero()
@@ -532,7 +601,8 @@ func (p *PointExtended) MixedAdd(p1 *PointExtended, p2 *PointAffine) *PointExten
 	B.Mul(&p2.Y, &p1.Z)
 
 	if p1.X.Equal(&A) && p1.Y.Equal(&B) {
-		p.MixedDouble(p1)
+		// MixedDouble assumes p1.Z = 1
+		p.Double(p1)
 		return p
 	}
 
@@ -628,6 +698,239 @@ func (p *PointExtended) setInfinity() *PointExtended {
 	return p
 }
 
+// ScalarMultiplicationConstantTime scalar multiplication of a point
+// p1 in extended coordinates with a scalar in big.Int
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses does
+// not depend on the value of the scalar, up to its sign and its length if it is larger
+// than fr.Bytes. The big.Int is still handled by math/big, which does not guarantee
+// constant-time operations; when the scalar is secret, use ScalarMultiplicationElementConstantTime.
+func (p *PointExtended) ScalarMultiplicationConstantTime(p1 *PointExtended, scalar *big.Int) *PointExtended {
+	var _p1 PointExtended
+	_p1.Set(p1)
+	if scalar.Sign() == -1 {
+		_p1.Neg(&_p1)
+	}
+
+	// fixed-length big-endian encoding of |scalar|
+	nbBytes := fr.Bytes
+	if l := (scalar.BitLen() + 7) / 8; l > nbBytes {
+		nbBytes = l
+	}
+	var _scalar big.Int
+	b := _scalar.Abs(scalar).FillBytes(make([]byte, nbBytes))
+
+	return p.mulBytesConstantTime(&_p1, b)
+}
+
+// ScalarMultiplicationElementConstantTime scalar multiplication of a point
+// p1 in extended coordinates with a scalar modulo the order of the prime subgroup
+//
+// Unlike ScalarMultiplication, the sequence of operations and memory accesses does
+// not depend on s; it should be used when s is secret. p1 must be in the prime subgroup.
+func (p *PointExtended) ScalarMultiplicationElementConstantTime(p1 *PointExtended, s *scalar.Element) *PointExtended {
+	return p.mulBytesConstantTime(p1, elementBytesConstantTime(s))
+}
+
+// elementBytesConstantTime returns the big-endian encoding of s on fr.Bytes bytes,
+// without branching on s.
+func elementBytesConstantTime(s *scalar.Element) []byte {
+	// the order of the subgroup is smaller than the modulus of fr
+	b := make([]byte, fr.Bytes)
+	e := s.BytesConstantTime()
+	copy(b[fr.Bytes-scalar.Bytes:], e[:])
+	return b
+}
+
+// mulBytesConstantTime sets p = b ⋅ p1, where b is a big-endian integer, with a
+// sequence of operations and memory accesses depending only on len(b).
+func (p *PointExtended) mulBytesConstantTime(p1 *PointExtended, b []byte) *PointExtended {
+	const c = 4 // window size
+
+	// table[i] = i ⋅ p1
+	var table [1 << c]PointExtended
+	table[0].setInfinity()
+	table[1].Set(p1)
+	for i := 2; i < len(table); i++ {
+		table[i].Add(&table[i-1], p1)
+	}
+
+	var res, t PointExtended
+	res.setInfinity()
+	for i := range b {
+		for _, w := range [2]byte{b[i] >> c, b[i] & (1<<c - 1)} {
+			for j := 0; j < c; j++ {
+				res.doubleConstantTime(&res)
+			}
+			t.lookupConstantTime(table[:], int(w))
+			res.addConstantTime(&res, &t)
+		}
+	}
+
+	return p.Set(&res)
+}
+
+// addConstantTime is Add using the constant-time field operations
+func (p *PointExtended) addConstantTime(p1, p2 *PointExtended) *PointExtended {
+	var A, B, C, D, E, F, G, H, tmp fr.Element
+	A.MulConstantTime(&p1.X, &p2.X)
+	B.MulConstantTime(&p1.Y, &p2.Y)
+	C.MulConstantTime(&p1.T, &p2.T).MulConstantTime(&C, &curveParams.D)
+	D.MulConstantTime(&p1.Z, &p2.Z)
+	tmp.AddConstantTime(&p1.X, &p1.Y)
+	E.AddConstantTime(&p2.X, &p2.Y).
+		MulConstantTime(&E, &tmp).
+		SubConstantTime(&E, &A).
+		SubConstantTime(&E, &B)
+	F.SubConstantTime(&D, &C)
+	G.AddConstantTime(&D, &C)
+	H.Set(&A)
+	mulByAConstantTime(&H)
+	H.SubConstantTime(&B, &H)
+
+	p.X.MulConstantTime(&E, &F)
+	p.Y.MulConstantTime(&G, &H)
+	p.T.MulConstantTime(&E, &H)
+	p.Z.MulConstantTime(&F, &G)
+
+	return p
+}
+
+// doubleConstantTime is Double using the constant-time field operations
+func (p *PointExtended) doubleConstantTime(p1 *PointExtended) *PointExtended {
+
+	var A, B, C, D, E, F, G, H fr.Element
+
+	A.SquareConstantTime(&p1.X)
+	B.SquareConstantTime(&p1.Y)
+	C.SquareConstantTime(&p1.Z).
+		DoubleConstantTime(&C)
+	D.Set(&A)
+	mulByAConstantTime(&D)
+	E.AddConstantTime(&p1.X, &p1.Y).
+		SquareConstantTime(&E).
+		SubConstantTime(&E, &A).
+		SubConstantTime(&E, &B)
+	G.AddConstantTime(&D, &B)
+	F.SubConstantTime(&G, &C)
+	H.SubConstantTime(&D, &B)
+
+	p.X.MulConstantTime(&E, &F)
+	p.Y.MulConstantTime(&G, &H)
+	p.T.MulConstantTime(&H, &E)
+	p.Z.MulConstantTime(&F, &G)
+
+	return p
+}
+
+// lookupConstantTime sets p = table[idx], reading all the entries of the table
+func (p *PointExtended) lookupConstantTime(table []PointExtended, idx int) *PointExtended {
+	p.Set(&table[0])
+	for i := 1; i < len(table); i++ {
+		p.X.Select(i^idx, &table[i].X, &p.X)
+		p.Y.Select(i^idx, &table[i].Y, &p.Y)
+		p.Z.Select(i^idx, &table[i].Z, &p.Z)
+		p.T.Select(i^idx, &table[i].T, &p.T)
+	}
+	return p
+}
+
+// ScalarMultiplicationBase computes and returns p = scalar ⋅ Base
+//
+// It uses a table of multiples of Base, built on first use, and does no doubling.
+func (p *PointExtended) ScalarMultiplicationBase(scalar *big.Int) *PointExtended {
+	table := baseTable()
+	b := baseScalarBytes(scalar)
+
+	var res PointExtended
+	res.setInfinity()
+	for i := range b {
+		j := 2 * (len(b) - 1 - i)
+		if w := b[i] & 0xf; w != 0 {
+			res.Add(&res, &table[j][w])
+		}
+		if w := b[i] >> 4; w != 0 {
+			res.Add(&res, &table[j+1][w])
+		}
+	}
+
+	return p.Set(&res)
+}
+
+// ScalarMultiplicationBaseConstantTime computes and returns p = scalar ⋅ Base
+//
+// Unlike ScalarMultiplicationBase, the sequence of operations and memory accesses does
+// not depend on the value of the scalar, provided it is in [0, 2⁸ˣfr.Bytes). Other scalars
+// are first reduced modulo the order of Base. The big.Int is still handled by math/big, which
+// does not guarantee constant-time operations; when the scalar is secret, use
+// ScalarMultiplicationBaseElementConstantTime.
+func (p *PointExtended) ScalarMultiplicationBaseConstantTime(scalar *big.Int) *PointExtended {
+	return p.mulBaseBytesConstantTime(baseScalarBytes(scalar))
+}
+
+// ScalarMultiplicationBaseElementConstantTime computes and returns p = s ⋅ Base
+//
+// Unlike ScalarMultiplicationBase, the sequence of operations and memory accesses does
+// not depend on s; it should be used when s is secret.
+func (p *PointExtended) ScalarMultiplicationBaseElementConstantTime(s *scalar.Element) *PointExtended {
+	return p.mulBaseBytesConstantTime(elementBytesConstantTime(s))
+}
+
+// mulBaseBytesConstantTime sets p = b ⋅ Base, where b is a big-endian integer on fr.Bytes bytes,
+// reading all the entries of each row of the table.
+func (p *PointExtended) mulBaseBytesConstantTime(b []byte) *PointExtended {
+	table := baseTable()
+
+	var res, t PointExtended
+	res.setInfinity()
+	for i := range b {
+		j := 2 * (len(b) - 1 - i)
+		t.lookupConstantTime(table[j][:], int(b[i]&0xf))
+		res.addConstantTime(&res, &t)
+		t.lookupConstantTime(table[j+1][:], int(b[i]>>4))
+		res.addConstantTime(&res, &t)
+	}
+
+	return p.Set(&res)
+}
+
+// baseScalarBytes returns the big-endian encoding of scalar on fr.Bytes bytes,
+// reducing it modulo the order of Base first if it is negative or too large.
+func baseScalarBytes(scalar *big.Int) []byte {
+	if scalar.Sign() == -1 || scalar.BitLen() > 8*fr.Bytes {
+		params := GetEdwardsCurve()
+		scalar = new(big.Int).Mod(scalar, &params.Order)
+	}
+	return scalar.FillBytes(make([]byte, fr.Bytes))
+}
+
+var (
+	_baseTable     [][16]PointExtended
+	_baseTableOnce sync.Once
+)
+
+// baseTable returns the table T[j][i] = i ⋅ 16ʲ ⋅ Base, for j < 2 ⋅ fr.Bytes.
+// It is computed on first use.
+func baseTable() [][16]PointExtended {
+	_baseTableOnce.Do(func() {
+		params := GetEdwardsCurve()
+		table := make([][16]PointExtended, 2*fr.Bytes)
+
+		var base PointExtended
+		base.FromAffine(&params.Base)
+		for j := range table {
+			table[j][0].setInfinity()
+			for i := 1; i < len(table[j]); i++ {
+				table[j][i].Add(&table[j][i-1], &base)
+			}
+			// base = 16 ⋅ base
+			base.Double(&table[j][8])
+		}
+		_baseTable = table
+	})
+	return _baseTable
+}
+
 // ScalarMultiplication scalar multiplication of a point
 // p1 in extended coordinates with a scalar in big.Int
 func (p *PointExtended) ScalarMultiplication(p1 *PointExtended, scalar *big.Int) *PointExtended {
@@ -0,0 +1,505 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package vss
+
+import (
+	"errors"
+	"sort"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+var (
+	errWrongPhase          = errors.New("dkg: method called out of order")
+	errUnknownDealer       = errors.New("dkg: unknown dealer")
+	errNotQualified        = errors.New("dkg: participant is disqualified")
+	errTooManyDisqualified = errors.New("dkg: not enough qualified dealers")
+	errMissingShare        = errors.New("dkg: no valid share from a qualified dealer")
+)
+
+// DealMessage is broadcast by a dealer in the first phase of the DKG.
+type DealMessage struct {
+	Dealer     uint32
+	Commitment PedersenCommitment // commitment to f_Dealer, blinded by f'_Dealer
+}
+
+// ShareMessage is sent privately by a dealer to each participant in the first phase of the DKG.
+type ShareMessage struct {
+	Dealer, Recipient uint32
+	Share             fr.Element // f_Dealer(Recipient)
+	Blinding          fr.Element // f'_Dealer(Recipient)
+}
+
+// Complaint is broadcast by Accuser when the share it received from Dealer is missing
+// or does not match the commitment of Dealer.
+type Complaint struct {
+	Accuser, Dealer uint32
+}
+
+// Justification is broadcast by a dealer in answer to a complaint: it reveals the
+// share of the accuser.
+type Justification ShareMessage
+
+// FeldmanMessage is broadcast by a qualified dealer to extract the public key.
+type FeldmanMessage struct {
+	Dealer     uint32
+	Commitment FeldmanCommitment // commitment to f_Dealer
+}
+
+// ExtractionComplaint is broadcast by Recipient when its share, valid for the Pedersen
+// commitment of Dealer, does not match its Feldman commitment. The share is revealed,
+// so that the complaint can be checked by everyone.
+type ExtractionComplaint ShareMessage
+
+// RevealMessage is broadcast by each participant to reconstruct the polynomial of a
+// dealer exposed by a valid extraction complaint.
+type RevealMessage ShareMessage
+
+// KeyShare is the output of the DKG for a participant.
+type KeyShare struct {
+	Index      uint32
+	Secret     fr.Element        // x_i = ∑_{j∈QUAL} f_j(i)
+	Commitment FeldmanCommitment // ∑_{j∈QUAL} Feldman commitments of f_j
+	Qualified  []uint32          // dealers that contributed to the key, sorted
+}
+
+// PublicKey returns the group public key x*G, where x = ∑_{j∈QUAL} f_j(0) is never known.
+func (k *KeyShare) PublicKey() curve.G1Affine {
+	return k.Commitment[0]
+}
+
+// PublicShare returns x_index*G, the public key of the share of participant index.
+func (k *KeyShare) PublicShare(index uint32) curve.G1Affine {
+	return k.Commitment.Evaluate(index)
+}
+
+// DKG phases
+const (
+	phaseDeal = iota
+	phaseComplaints
+	phaseJustifications
+	phaseExtraction
+	phaseReveal
+	phaseDone
+)
+
+// DKG is the state of a participant in the distributed key generation of
+// Gennaro, Jarecki, Krawczyk and Rabin, "Secure distributed key generation for discrete-log
+// based cryptosystems". Each participant is also a dealer.
+// All the messages except ShareMessage are broadcast, and the methods must be called in order:
+//
+//  1. Deal: the dealer shares a random secret with Pedersen VSS,
+//  2. ProcessDeals: the shares received are checked, complaints are issued against the dealers,
+//  3. Justify: the dealer answers the complaints against itself by revealing the shares,
+//  4. ProcessJustifications: the dealers with more than threshold-1 complaints or an invalid
+//     justification are disqualified, the others form the set QUAL,
+//  5. Extract: the qualified dealer publishes a Feldman commitment to its polynomial,
+//  6. ProcessExtraction: the shares are checked against the Feldman commitments, complaints are
+//     issued against the dealers whose Feldman and Pedersen commitments do not match,
+//  7. Reveal: the shares of the exposed dealers are revealed,
+//  8. Finalize: the polynomials of the exposed dealers are reconstructed in the clear.
+type DKG struct {
+	index     uint32
+	threshold int
+	n         int
+	phase     int
+
+	f, fBlind Polynomial
+
+	pedersen   map[uint32]PedersenCommitment // commitments of the dealers
+	shares     map[uint32]*ShareMessage      // shares received from the dealers
+	complaints map[uint32]bool               // dealers the participant complained about
+	qualified  []uint32
+	feldman    map[uint32]FeldmanCommitment // Feldman commitments of the qualified dealers
+	exposed    []uint32                     // qualified dealers whose Feldman commitment is invalid
+}
+
+// NewDKG returns the state of participant index in a DKG between participants
+// 1, ..., n producing a key shared with threshold t.
+func NewDKG(index uint32, threshold, n int) (*DKG, error) {
+	if threshold < 1 || threshold > n {
+		return nil, errInvalidThreshold
+	}
+	if index == 0 || int(index) > n {
+		return nil, errInvalidIndex
+	}
+	return &DKG{
+		index:      index,
+		threshold:  threshold,
+		n:          n,
+		pedersen:   make(map[uint32]PedersenCommitment, n),
+		shares:     make(map[uint32]*ShareMessage, n),
+		complaints: make(map[uint32]bool),
+		feldman:    make(map[uint32]FeldmanCommitment, n),
+	}, nil
+}
+
+// Deal samples the secret of the participant and returns the commitment to broadcast
+// and the shares to send privately to each other participant.
+func (d *DKG) Deal() (*DealMessage, []ShareMessage, error) {
+	if d.phase != phaseDeal {
+		return nil, nil, errWrongPhase
+	}
+
+	var secret fr.Element
+	if _, err := secret.SetRandom(); err != nil {
+		return nil, nil, err
+	}
+	var err error
+	if d.f, err = NewPolynomial(&secret, d.threshold); err != nil {
+		return nil, nil, err
+	}
+	if _, err = secret.SetRandom(); err != nil {
+		return nil, nil, err
+	}
+	if d.fBlind, err = NewPolynomial(&secret, d.threshold); err != nil {
+		return nil, nil, err
+	}
+
+	deal := DealMessage{Dealer: d.index}
+	if deal.Commitment, err = d.f.PedersenCommit(d.fBlind); err != nil {
+		return nil, nil, err
+	}
+
+	shares := make([]ShareMessage, 0, d.n-1)
+	for j := uint32(1); j <= uint32(d.n); j++ {
+		msg := d.shareFor(j)
+		if j == d.index {
+			d.shares[j] = &msg
+			continue
+		}
+		shares = append(shares, msg)
+	}
+	d.pedersen[d.index] = deal.Commitment
+	d.phase = phaseComplaints
+
+	return &deal, shares, nil
+}
+
+// ProcessDeals records the commitments broadcast by the other dealers and checks the
+// shares received from them. It returns the complaints to broadcast.
+// A dealer which did not broadcast a commitment is disqualified.
+func (d *DKG) ProcessDeals(deals []DealMessage, shares []ShareMessage) ([]Complaint, error) {
+	if d.phase != phaseComplaints {
+		return nil, errWrongPhase
+	}
+	for i := range deals {
+		if err := d.checkDealer(deals[i].Dealer); err != nil {
+			return nil, err
+		}
+		if deals[i].Dealer == d.index {
+			continue
+		}
+		// a malformed commitment disqualifies the dealer
+		if len(deals[i].Commitment) == d.threshold {
+			d.pedersen[deals[i].Dealer] = deals[i].Commitment
+		}
+	}
+	for i := range shares {
+		if shares[i].Recipient != d.index {
+			continue
+		}
+		if _, ok := d.pedersen[shares[i].Dealer]; ok && shares[i].Dealer != d.index {
+			msg := shares[i]
+			d.shares[msg.Dealer] = &msg
+		}
+	}
+
+	var complaints []Complaint
+	for _, dealer := range d.dealers() {
+		if msg, ok := d.shares[dealer]; !ok || !d.verifyPedersen(msg) {
+			delete(d.shares, dealer)
+			d.complaints[dealer] = true
+			complaints = append(complaints, Complaint{Accuser: d.index, Dealer: dealer})
+		}
+	}
+	d.phase = phaseJustifications
+	return complaints, nil
+}
+
+// Justify returns the justifications of the participant for the complaints against it.
+func (d *DKG) Justify(complaints []Complaint) ([]Justification, error) {
+	if d.phase != phaseJustifications {
+		return nil, errWrongPhase
+	}
+	var justifications []Justification
+	for _, c := range complaints {
+		if c.Dealer == d.index && c.Accuser != 0 && int(c.Accuser) <= d.n {
+			justifications = append(justifications, Justification(d.shareFor(c.Accuser)))
+		}
+	}
+	return justifications, nil
+}
+
+// ProcessJustifications computes the set QUAL of qualified dealers from all the
+// complaints and justifications broadcast. A dealer is disqualified if it received
+// at least threshold complaints, or if it did not answer a complaint with a valid share.
+// The participant updates its shares with the valid justifications.
+//
+// The complaints issued by the participant in ProcessDeals are always counted,
+// whether or not they are part of complaints.
+func (d *DKG) ProcessJustifications(complaints []Complaint, justifications []Justification) error {
+	if d.phase != phaseJustifications {
+		return errWrongPhase
+	}
+
+	accusers := make(map[uint32]map[uint32]bool)
+	accuse := func(accuser, dealer uint32) {
+		if accusers[dealer] == nil {
+			accusers[dealer] = make(map[uint32]bool)
+		}
+		accusers[dealer][accuser] = true
+	}
+	for _, c := range complaints {
+		if _, ok := d.pedersen[c.Dealer]; !ok || c.Accuser == 0 || int(c.Accuser) > d.n || c.Accuser == c.Dealer {
+			continue
+		}
+		accuse(c.Accuser, c.Dealer)
+	}
+	for dealer := range d.complaints {
+		accuse(d.index, dealer)
+	}
+
+	disqualified := make(map[uint32]bool)
+	for dealer, a := range accusers {
+		if len(a) >= d.threshold {
+			disqualified[dealer] = true
+			continue
+		}
+		for accuser := range a {
+			var valid *ShareMessage
+			for i := range justifications {
+				j := ShareMessage(justifications[i])
+				if j.Dealer == dealer && j.Recipient == accuser && d.verifyPedersen(&j) {
+					valid = &j
+					break
+				}
+			}
+			if valid == nil {
+				disqualified[dealer] = true
+				break
+			}
+			if accuser == d.index {
+				d.shares[dealer] = valid
+			}
+		}
+	}
+
+	d.qualified = d.qualified[:0]
+	for _, dealer := range d.dealers() {
+		if !disqualified[dealer] {
+			d.qualified = append(d.qualified, dealer)
+		}
+	}
+	if len(d.qualified) == 0 {
+		return errTooManyDisqualified
+	}
+	d.phase = phaseExtraction
+	return nil
+}
+
+// Qualified returns the set QUAL of qualified dealers, sorted.
+func (d *DKG) Qualified() []uint32 {
+	return append([]uint32(nil), d.qualified...)
+}
+
+// Extract returns the Feldman commitment to the polynomial of the participant,
+// to broadcast if it is qualified.
+func (d *DKG) Extract() (*FeldmanMessage, error) {
+	if d.phase != phaseExtraction {
+		return nil, errWrongPhase
+	}
+	if !d.isQualified(d.index) {
+		return nil, errNotQualified
+	}
+	c := d.f.FeldmanCommit()
+	d.feldman[d.index] = c
+	return &FeldmanMessage{Dealer: d.index, Commitment: c}, nil
+}
+
+// ProcessExtraction records the Feldman commitments of the qualified dealers and
+// checks the shares against them. It returns the complaints to broadcast.
+func (d *DKG) ProcessExtraction(msgs []FeldmanMessage) ([]ExtractionComplaint, error) {
+	if d.phase != phaseExtraction {
+		return nil, errWrongPhase
+	}
+	for i := range msgs {
+		if err := d.checkDealer(msgs[i].Dealer); err != nil {
+			return nil, err
+		}
+		if msgs[i].Dealer != d.index && d.isQualified(msgs[i].Dealer) && len(msgs[i].Commitment) == d.threshold {
+			d.feldman[msgs[i].Dealer] = msgs[i].Commitment
+		}
+	}
+
+	var complaints []ExtractionComplaint
+	for _, dealer := range d.qualified {
+		c, ok := d.feldman[dealer]
+		if !ok {
+			// everyone sees the missing commitment, no complaint needed
+			continue
+		}
+		msg, ok := d.shares[dealer]
+		if !ok {
+			return nil, errMissingShare
+		}
+		if !c.Verify(&Share{Index: d.index, Value: msg.Share}) {
+			complaints = append(complaints, ExtractionComplaint(*msg))
+		}
+	}
+	d.phase = phaseReveal
+	return complaints, nil
+}
+
+// Reveal determines the dealers exposed by the valid extraction complaints or by a missing
+// Feldman commitment, and returns the shares received from them, to broadcast.
+func (d *DKG) Reveal(complaints []ExtractionComplaint) ([]RevealMessage, error) {
+	if d.phase != phaseReveal {
+		return nil, errWrongPhase
+	}
+
+	exposed := make(map[uint32]bool)
+	for _, dealer := range d.qualified {
+		if _, ok := d.feldman[dealer]; !ok {
+			exposed[dealer] = true
+		}
+	}
+	for i := range complaints {
+		msg := ShareMessage(complaints[i])
+		c, ok := d.feldman[msg.Dealer]
+		if !ok || !d.verifyPedersen(&msg) {
+			continue
+		}
+		if !c.Verify(&Share{Index: msg.Recipient, Value: msg.Share}) {
+			exposed[msg.Dealer] = true
+		}
+	}
+
+	d.exposed = d.exposed[:0]
+	var reveals []RevealMessage
+	for _, dealer := range d.qualified {
+		if exposed[dealer] {
+			msg, ok := d.shares[dealer]
+			if !ok {
+				return nil, errMissingShare
+			}
+			d.exposed = append(d.exposed, dealer)
+			reveals = append(reveals, RevealMessage(*msg))
+		}
+	}
+	d.phase = phaseDone
+	return reveals, nil
+}
+
+// Finalize reconstructs the polynomials of the exposed dealers from the revealed shares
+// and returns the key share of the participant.
+func (d *DKG) Finalize(reveals []RevealMessage) (*KeyShare, error) {
+	if d.phase != phaseDone {
+		return nil, errWrongPhase
+	}
+
+	for _, dealer := range d.exposed {
+		// at least threshold honest participants reveal a valid share
+		var shares []Share
+		seen := make(map[uint32]bool)
+		for i := range reveals {
+			msg := ShareMessage(reveals[i])
+			if msg.Dealer != dealer || seen[msg.Recipient] || !d.verifyPedersen(&msg) {
+				continue
+			}
+			seen[msg.Recipient] = true
+			shares = append(shares, Share{Index: msg.Recipient, Value: msg.Share})
+			if len(shares) == d.threshold {
+				break
+			}
+		}
+		if len(shares) < d.threshold {
+			return nil, errNotEnoughShares
+		}
+		f, err := interpolate(shares)
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := d.shares[dealer]
+		if !ok {
+			return nil, errMissingShare
+		}
+		d.feldman[dealer] = f.FeldmanCommit()
+		var x fr.Element
+		x.SetUint64(uint64(d.index))
+		msg.Share = f.Eval(&x)
+	}
+
+	k := KeyShare{
+		Index:      d.index,
+		Commitment: make(FeldmanCommitment, d.threshold),
+		Qualified:  d.Qualified(),
+	}
+	acc := make([]curve.G1Jac, d.threshold)
+	for _, dealer := range d.qualified {
+		msg, ok := d.shares[dealer]
+		if !ok {
+			return nil, errMissingShare
+		}
+		k.Secret.Add(&k.Secret, &msg.Share)
+		for i := range acc {
+			acc[i].AddMixed(&d.feldman[dealer][i])
+		}
+	}
+	for i := range acc {
+		k.Commitment[i].FromJacobian(&acc[i])
+	}
+	return &k, nil
+}
+
+// shareFor returns the shares f(j), f'(j) dealt by the participant to participant j.
+func (d *DKG) shareFor(j uint32) ShareMessage {
+	s, b := d.f.Share(j), d.fBlind.Share(j)
+	return ShareMessage{Dealer: d.index, Recipient: j, Share: s.Value, Blinding: b.Value}
+}
+
+// verifyPedersen checks a share against the Pedersen commitment of its dealer.
+func (d *DKG) verifyPedersen(msg *ShareMessage) bool {
+	c, ok := d.pedersen[msg.Dealer]
+	if !ok {
+		return false
+	}
+	return c.Verify(&Share{Index: msg.Recipient, Value: msg.Share}, &Share{Index: msg.Recipient, Value: msg.Blinding})
+}
+
+// dealers returns the dealers which broadcast a well formed commitment, sorted.
+func (d *DKG) dealers() []uint32 {
+	dealers := make([]uint32, 0, len(d.pedersen))
+	for dealer := range d.pedersen {
+		dealers = append(dealers, dealer)
+	}
+	sort.Slice(dealers, func(i, j int) bool { return dealers[i] < dealers[j] })
+	return dealers
+}
+
+func (d *DKG) isQualified(dealer uint32) bool {
+	i := sort.Search(len(d.qualified), func(i int) bool { return d.qualified[i] >= dealer })
+	return i < len(d.qualified) && d.qualified[i] == dealer
+}
+
+func (d *DKG) checkDealer(dealer uint32) error {
+	if dealer == 0 || int(dealer) > d.n {
+		return errUnknownDealer
+	}
+	return nil
+}
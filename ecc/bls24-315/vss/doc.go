@@ -0,0 +1,38 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package vss provides verifiable secret sharing on bls24-315.
+//
+// A secret of fr is split into Shamir shares f(1), ..., f(n) of a random polynomial
+// f of degree t-1, any t of which reconstruct f(0). The dealer publishes a commitment
+// in G1 to f, against which each share is verified:
+//   - Feldman: [a_0*G, ..., a_{t-1}*G], computationally hiding,
+//   - Pedersen: [a_0*G + b_0*H, ..., a_{t-1}*G + b_{t-1}*H] for a blinding polynomial
+//     with coefficients b_k, perfectly hiding.
+//
+// H is a generator of G1 obtained by hashing to the curve, its discrete
+// logarithm in base G is unknown.
+//
+// The package also provides the distributed key generation of Gennaro, Jarecki,
+// Krawczyk and Rabin (Pedersen VSS followed by the extraction of the public key
+// with Feldman commitments, with complaints against the cheating dealers).
+//
+// # See also
+//
+// https://www.cs.umd.edu/~gasarch/TOPICS/secretsharing/feldmanVSS.pdf
+//
+// https://link.springer.com/article/10.1007/s00145-006-0347-3
+package vss
@@ -0,0 +1,405 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// smallRadices are the radices, besides 2, of the stages of a mixed-radix FFT,
+// in the order the DIF FFT runs them.
+var smallRadices = [...]uint64{3, 5}
+
+// isMixedRadix returns true if the cardinality of the domain is not a power of two
+func (domain *Domain) isMixedRadix() bool {
+	return domain.Cardinality&(domain.Cardinality-1) != 0
+}
+
+// mixedRadixCardinality returns the smallest n ≥ m of the form 2ᵃ·3ᵇ·5ᶜ such that
+// the field has a n-th root of unity (that is, n divides q-1).
+func mixedRadixCardinality(m uint64) (uint64, error) {
+	if m <= 1 {
+		return 1, nil
+	}
+	if m > 1<<62 {
+		return 0, errors.New("m is too big: the required root of unity does not exist")
+	}
+
+	qMinusOne := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	v3, v5 := valuation(qMinusOne, 3), valuation(qMinusOne, 5)
+
+	var best uint64
+	for b, p3 := 0, uint64(1); b <= v3 && p3 < 2*m; b, p3 = b+1, p3*3 {
+		for c, odd := 0, p3; c <= v5 && odd < 2*m; c, odd = c+1, odd*5 {
+			// complete the odd part with the smallest power of two
+			n := odd
+			for n < m {
+				n <<= 1
+			}
+			if bits.TrailingZeros64(n) > 60 {
+				continue
+			}
+			if best == 0 || n < best {
+				best = n
+			}
+		}
+	}
+	if best == 0 {
+		return 0, errors.New("m is too big: the required root of unity does not exist")
+	}
+	return best, nil
+}
+
+// valuation returns the largest k such that pᵏ divides n
+func valuation(n *big.Int, p uint64) int {
+	var q, r big.Int
+	q.Set(n)
+	bp := new(big.Int).SetUint64(p)
+	k := 0
+	for {
+		q.QuoRem(&q, bp, &r)
+		if r.Sign() != 0 {
+			return k
+		}
+		k++
+	}
+}
+
+// rootOfUnity returns a primitive n-th root of unity, or an error if n does not divide q-1
+func rootOfUnity(n uint64) (fr.Element, error) {
+	e := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	var r big.Int
+	e.QuoRem(e, new(big.Int).SetUint64(n), &r)
+	if r.Sign() != 0 {
+		return fr.Element{}, errors.New("the required root of unity does not exist")
+	}
+	var g fr.Element
+	g.SetUint64(7)
+	g.Exp(g, e)
+	return g, nil
+}
+
+// mixedRadices returns the radices of the odd stages of an FFT of size n, in the order the
+// DIF FFT runs them, and log₂ of the size of the power of two blocks the radix 2 stages run on.
+func mixedRadices(n uint64) (odd []uint64, logTwo int) {
+	logTwo = bits.TrailingZeros64(n)
+	n >>= logTwo
+	for _, r := range smallRadices {
+		for n%r == 0 {
+			odd = append(odd, r)
+			n /= r
+		}
+	}
+	if n != 1 {
+		panic("fft: unsupported domain cardinality")
+	}
+	return
+}
+
+// digitReverseIndex returns the position of the i-th coefficient in the output of a DIF FFT
+// of size n; it generalizes the bit-reversal to the mixed radix representation of i.
+func digitReverseIndex(i, n uint64, odd []uint64, logTwo int) uint64 {
+	var pos uint64
+	for _, r := range odd {
+		n /= r
+		pos += (i % r) * n
+		i /= r
+	}
+	// the remaining digits are in base 2
+	if logTwo > 0 {
+		pos += bits.Reverse64(i) >> (64 - logTwo)
+	}
+	return pos
+}
+
+// DigitReverse permutes v from the natural order to the order of the output of a DIF FFT
+// (and of the input of a DIT FFT) on this domain. len(v) must be domain.Cardinality.
+// On a power of two domain, this is BitReverse.
+func (domain *Domain) DigitReverse(v []fr.Element) {
+	if !domain.isMixedRadix() {
+		BitReverse(v)
+		return
+	}
+	domain.digitReverse(v, false)
+}
+
+// DigitReverseInverse is the inverse of DigitReverse: it permutes the output of a DIF FFT
+// on this domain back to the natural order. On a power of two domain, this is BitReverse.
+func (domain *Domain) DigitReverseInverse(v []fr.Element) {
+	if !domain.isMixedRadix() {
+		BitReverse(v)
+		return
+	}
+	domain.digitReverse(v, true)
+}
+
+func (domain *Domain) digitReverse(v []fr.Element, inverse bool) {
+	n := domain.Cardinality
+	if uint64(len(v)) != n {
+		panic("fft: len(v) must be the cardinality of the domain")
+	}
+	odd, logTwo := mixedRadices(n)
+	tmp := make([]fr.Element, n)
+	copy(tmp, v)
+	scheduler.Execute(nil, int(n), func(start, end int) {
+		for i := start; i < end; i++ {
+			j := digitReverseIndex(uint64(i), n, odd, logTwo)
+			if inverse {
+				v[i] = tmp[j]
+			} else {
+				v[j] = tmp[i]
+			}
+		}
+	})
+}
+
+// mixedRadixFFT computes the (inverse) FFT of a on a domain whose cardinality is not a power of two.
+// The DIF FFT runs the radix 3 and 5 stages on the whole vector, then the radix 2 stages on each
+// power of two block with difFFT; the DIT FFT runs the transposed stages in the reverse order.
+func (domain *Domain) mixedRadixFFT(a []fr.Element, decimation Decimation, inverse bool, opt fftConfig) {
+	n := domain.Cardinality
+	if uint64(len(a)) != n {
+		panic("fft: len(a) must be the cardinality of the domain")
+	}
+	if decimation != DIT && decimation != DIF {
+		panic("not implemented")
+	}
+	odd, logTwo := mixedRadices(n)
+
+	w, twiddles := domain.Generator, domain.twiddles
+	if inverse {
+		w, twiddles = domain.GeneratorInv, domain.twiddlesInv
+	}
+
+	if opt.coset && !inverse {
+		// the input of the DIT FFT is in digit-reversed order
+		domain.scaleByCosetTable(a, domain.mixedRadixCosetTable(false), nil, decimation == DIT, odd, logTwo, opt)
+	}
+
+	// generator of the power of two sub-domain the radix 2 stages run on
+	var w2 fr.Element
+	w2.Exp(w, new(big.Int).SetUint64(n>>logTwo))
+
+	twiddlesStartStage := 0
+	if !domain.withPrecompute {
+		twiddlesStartStage = 3
+		if logTwo < twiddlesStartStage {
+			twiddlesStartStage = logTwo
+		}
+		twiddles = make([][]fr.Element, logTwo-twiddlesStartStage)
+		wt := w2
+		wt.Exp(wt, big.NewInt(int64(1<<twiddlesStartStage)))
+		buildTwiddles(twiddles, wt, uint64(logTwo-twiddlesStartStage))
+	}
+
+	// ws[i] is the generator of the blocks of the i-th odd stage
+	ws := make([]fr.Element, len(odd)+1)
+	ws[0] = w
+	blockSizes := make([]int, len(odd)+1)
+	blockSizes[0] = int(n)
+	for i, r := range odd {
+		ws[i+1].Exp(ws[i], new(big.Int).SetUint64(r))
+		blockSizes[i+1] = blockSizes[i] / int(r)
+	}
+
+	if decimation == DIF {
+		for i, r := range odd {
+			if isDone(opt.done) {
+				return
+			}
+			radixStage(a, ws[i], int(r), blockSizes[i+1], true, opt)
+		}
+		radix2Blocks(a, w2, 1<<logTwo, twiddles, twiddlesStartStage, DIF, opt)
+	} else {
+		radix2Blocks(a, w2, 1<<logTwo, twiddles, twiddlesStartStage, DIT, opt)
+		for i := len(odd) - 1; i >= 0; i-- {
+			if isDone(opt.done) {
+				return
+			}
+			radixStage(a, ws[i], int(odd[i]), blockSizes[i+1], false, opt)
+		}
+	}
+
+	if !inverse || isDone(opt.done) {
+		return
+	}
+
+	// scale by CardinalityInv
+	if !opt.coset {
+		scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i].Mul(&a[i], &domain.CardinalityInv)
+			}
+		}, opt.nbTasks)
+		return
+	}
+	// the output of the DIF FFT is in digit-reversed order
+	domain.scaleByCosetTable(a, domain.mixedRadixCosetTable(true), &domain.CardinalityInv, decimation == DIF, odd, logTwo, opt)
+}
+
+// mixedRadixCosetTable returns the powers of FrMultiplicativeGen (or of its inverse),
+// computing them if the domain was created with the WithoutPrecompute option.
+func (domain *Domain) mixedRadixCosetTable(inverse bool) []fr.Element {
+	if domain.withPrecompute {
+		if inverse {
+			return domain.cosetTableInv
+		}
+		return domain.cosetTable
+	}
+	table := make([]fr.Element, domain.Cardinality)
+	if inverse {
+		BuildExpTable(domain.FrMultiplicativeGenInv, table)
+	} else {
+		BuildExpTable(domain.FrMultiplicativeGen, table)
+	}
+	return table
+}
+
+// scaleByCosetTable multiplies the i-th coefficient of a by table[i] (and by c, if not nil);
+// if digitReversed is set, the i-th coefficient is at position digitReverseIndex(i).
+func (domain *Domain) scaleByCosetTable(a, table []fr.Element, c *fr.Element, digitReversed bool, odd []uint64, logTwo int, opt fftConfig) {
+	n := domain.Cardinality
+	scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			j := uint64(i)
+			if digitReversed {
+				j = digitReverseIndex(j, n, odd, logTwo)
+			}
+			a[j].Mul(&a[j], &table[i])
+			if c != nil {
+				a[j].Mul(&a[j], c)
+			}
+		}
+	}, opt.nbTasks)
+}
+
+// radixStage runs a stage of radix r on each block of size r*m of a; w is a primitive root of
+// unity of order r*m. In DIF, the r-point DFTs are followed by the multiplication by the twiddles;
+// in DIT (the transposed stage), they are preceded by it.
+func radixStage(a []fr.Element, w fr.Element, r, m int, dif bool, opt fftConfig) {
+	// roots[k] = ωᵏ, where ω = wᵐ is a primitive r-th root of unity
+	roots := make([]fr.Element, r)
+	roots[0].SetOne()
+	roots[1].Exp(w, big.NewInt(int64(m)))
+	for k := 2; k < r; k++ {
+		roots[k].Mul(&roots[k-1], &roots[1])
+	}
+
+	work := func(start, end int) {
+		x := make([]fr.Element, r)
+		y := make([]fr.Element, r)
+		var wj, tw fr.Element
+		for t := start; t < end; t++ {
+			block, j := t/m, t%m
+			if j == 0 {
+				wj.SetOne()
+			} else if t == start {
+				wj.Exp(w, big.NewInt(int64(j)))
+			}
+			b := a[block*r*m : (block+1)*r*m]
+
+			if dif {
+				for k := 0; k < r; k++ {
+					x[k] = b[j+k*m]
+				}
+				smallDFT(y, x, roots)
+				tw.SetOne()
+				for s := 0; s < r; s++ {
+					b[j+s*m].Mul(&y[s], &tw)
+					tw.Mul(&tw, &wj)
+				}
+			} else {
+				tw.SetOne()
+				for s := 0; s < r; s++ {
+					x[s].Mul(&b[j+s*m], &tw)
+					tw.Mul(&tw, &wj)
+				}
+				smallDFT(y, x, roots)
+				for k := 0; k < r; k++ {
+					b[j+k*m] = y[k]
+				}
+			}
+			wj.Mul(&wj, &w)
+		}
+	}
+
+	nbButterflies := len(a) / r
+	if nbButterflies > butterflyThreshold {
+		scheduler.Execute(opt.scheduler, nbButterflies, work, opt.nbTasks)
+	} else {
+		work(0, nbButterflies)
+	}
+}
+
+// smallDFT sets y to the DFT of x, of size r = len(x); roots are the powers of a primitive r-th root of unity.
+func smallDFT(y, x, roots []fr.Element) {
+	if len(x) == 3 {
+		butterfly3(y, x, &roots[1])
+		return
+	}
+	var tmp fr.Element
+	for s := range y {
+		y[s] = x[0]
+		for k := 1; k < len(x); k++ {
+			tmp.Mul(&x[k], &roots[(s*k)%len(x)])
+			y[s].Add(&y[s], &tmp)
+		}
+	}
+}
+
+// butterfly3 computes the 3-point DFT of x with a single multiplication, using ω² = -1 - ω:
+// y₀ = x₀ + x₁ + x₂, y₁ = x₀ - x₂ + ω(x₁ - x₂), y₂ = x₀ - x₁ - ω(x₁ - x₂)
+func butterfly3(y, x []fr.Element, omega *fr.Element) {
+	var t fr.Element
+	t.Sub(&x[1], &x[2]).Mul(&t, omega)
+	y[0].Add(&x[0], &x[1]).Add(&y[0], &x[2])
+	y[1].Sub(&x[0], &x[2]).Add(&y[1], &t)
+	y[2].Sub(&x[0], &x[1]).Sub(&y[2], &t)
+}
+
+// radix2Blocks runs the radix 2 FFT on each block of size blockSize of a; w is a primitive root of unity
+// of order blockSize, and twiddles are the twiddles of the power of two FFT of that size.
+func radix2Blocks(a []fr.Element, w fr.Element, blockSize int, twiddles [][]fr.Element, twiddlesStartStage int, decimation Decimation, opt fftConfig) {
+	if blockSize == 1 {
+		return
+	}
+	nbBlocks := len(a) / blockSize
+
+	// the blocks are processed in parallel; the recursive calls split the remaining tasks
+	maxSplits := -1
+	if tasksPerBlock := opt.nbTasks / nbBlocks; tasksPerBlock > 1 {
+		maxSplits = bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(tasksPerBlock)))
+	}
+
+	scheduler.Execute(opt.scheduler, nbBlocks, func(start, end int) {
+		for b := start; b < end; b++ {
+			block := a[b*blockSize : (b+1)*blockSize]
+			if decimation == DIF {
+				difFFT(block, w, twiddles, twiddlesStartStage, 0, maxSplits, nil, opt)
+			} else {
+				ditFFT(block, w, twiddles, twiddlesStartStage, 0, maxSplits, nil, opt)
+			}
+		}
+	}, opt.nbTasks)
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+func TestMixedRadixCardinality(t *testing.T) {
+	for _, m := range []uint64{1, 2, 3, 5, 6, 7, 100, 1000, 3 << 10, 1<<19 + 1} {
+		domain := NewDomain(m, WithMixedRadix())
+		n := domain.Cardinality
+		if n < m || n > NewDomain(m).Cardinality {
+			t.Fatalf("m=%d: invalid cardinality %d", m, n)
+		}
+
+		// the generator must be a primitive n-th root of unity
+		var one, g fr.Element
+		one.SetOne()
+		g.Exp(domain.Generator, new(big.Int).SetUint64(n))
+		if !g.Equal(&one) {
+			t.Fatalf("m=%d: the generator is not a %d-th root of unity", m, n)
+		}
+		odd, logTwo := mixedRadices(n)
+		if logTwo > 0 {
+			odd = append(odd, 2)
+		}
+		for _, p := range odd {
+			g.Exp(domain.Generator, new(big.Int).SetUint64(n/p))
+			if g.Equal(&one) {
+				t.Fatalf("m=%d: the generator is not a primitive %d-th root of unity", m, n)
+			}
+		}
+	}
+}
+
+func TestMixedRadixFFT(t *testing.T) {
+	for _, m := range []uint64{3, 5, 15, 24, 45, 96, 300, 768 + 1} {
+		for _, precompute := range []bool{true, false} {
+			opts := []DomainOption{WithMixedRadix()}
+			if !precompute {
+				opts = append(opts, WithoutPrecompute())
+			}
+			domain := NewDomain(m, opts...)
+			n := int(domain.Cardinality)
+
+			pol := make([]fr.Element, n)
+			for i := range pol {
+				pol[i].SetRandom()
+			}
+
+			for _, coset := range []bool{false, true} {
+				var fftOpts []Option
+				shift := fr.One()
+				if coset {
+					fftOpts = append(fftOpts, OnCoset())
+					shift = domain.FrMultiplicativeGen
+				}
+
+				// naive evaluations on shift * <generator>
+				expected := make([]fr.Element, n)
+				var x fr.Element
+				x.Set(&shift)
+				for i := range expected {
+					expected[i] = evaluatePolynomial(pol, x)
+					x.Mul(&x, &domain.Generator)
+				}
+
+				// DIF: natural order -> digit-reversed order
+				got := make([]fr.Element, n)
+				copy(got, pol)
+				domain.FFT(got, DIF, fftOpts...)
+				domain.DigitReverseInverse(got)
+				assertEqualVectors(t, expected, got, "DIF", n, coset)
+
+				// DIT: digit-reversed order -> natural order
+				copy(got, pol)
+				domain.DigitReverse(got)
+				domain.FFT(got, DIT, fftOpts...)
+				assertEqualVectors(t, expected, got, "DIT", n, coset)
+
+				// inverse of the DIF output with DIT, and of the DIT output with DIF
+				copy(got, pol)
+				domain.FFT(got, DIF, fftOpts...)
+				domain.FFTInverse(got, DIT, fftOpts...)
+				assertEqualVectors(t, pol, got, "DIT(DIF)", n, coset)
+
+				domain.FFT(got, DIT, fftOpts...)
+				domain.FFTInverse(got, DIF, fftOpts...)
+				assertEqualVectors(t, pol, got, "DIF(DIT)", n, coset)
+			}
+		}
+	}
+}
+
+func TestMixedRadixDomainSerialization(t *testing.T) {
+	domain := NewDomain(3*64, WithMixedRadix())
+	var reconstructed Domain
+
+	var buf bytes.Buffer
+	if _, err := domain.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reconstructed.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(domain, &reconstructed) {
+		t.Fatal("Domain.SetBytes(Bytes()) failed")
+	}
+}
+
+func TestDigitReverse(t *testing.T) {
+	domain := NewDomain(5*3*16, WithMixedRadix())
+	n := int(domain.Cardinality)
+	v := make([]fr.Element, n)
+	for i := range v {
+		v[i].SetUint64(uint64(i))
+	}
+	w := make([]fr.Element, n)
+	copy(w, v)
+	domain.DigitReverse(w)
+	domain.DigitReverseInverse(w)
+	assertEqualVectors(t, v, w, "DigitReverseInverse(DigitReverse)", n, false)
+}
+
+func assertEqualVectors(t *testing.T, expected, got []fr.Element, name string, n int, coset bool) {
+	t.Helper()
+	for i := range expected {
+		if !expected[i].Equal(&got[i]) {
+			t.Fatalf("%s (n=%d, coset=%v): mismatch at index %d", name, n, coset, i)
+		}
+	}
+}
+
+func BenchmarkMixedRadixFFT(b *testing.B) {
+	const size = 3 << 16
+	domain := NewDomain(size, WithMixedRadix())
+	pol := make([]fr.Element, domain.Cardinality)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		domain.FFT(pol, DIF)
+	}
+}
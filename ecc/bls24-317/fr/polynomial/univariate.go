@@ -0,0 +1,382 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"errors"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
+)
+
+// below these sizes, the quadratic algorithms are faster than the FFT based ones
+const (
+	mulThreshold = 32
+	divThreshold = 64
+)
+
+// ErrDuplicatePoints is returned when interpolating on a set of points with repetitions
+var ErrDuplicatePoints = errors.New("interpolation points must be distinct")
+
+// Mul sets p to p1 * p2 and returns p.
+// The product is computed with an FFT when both operands are large enough.
+// This function allocates a new slice; p may alias p1 or p2.
+func (p *Polynomial) Mul(p1, p2 Polynomial) *Polynomial {
+	*p = mul(p1, p2)
+	return p
+}
+
+// Div sets p to the quotient of the Euclidean division of a by b and returns p.
+// It panics if b is the zero polynomial.
+func (p *Polynomial) Div(a, b Polynomial) *Polynomial {
+	*p, _ = divMod(a, b)
+	return p
+}
+
+// Mod sets p to the remainder of the Euclidean division of a by b and returns p.
+// It panics if b is the zero polynomial.
+func (p *Polynomial) Mod(a, b Polynomial) *Polynomial {
+	_, *p = divMod(a, b)
+	return p
+}
+
+// DivMod returns the quotient and the remainder of the Euclidean division of a by b,
+// such that a = q*b + r and deg(r) < deg(b).
+// It panics if b is the zero polynomial.
+func DivMod(a, b Polynomial) (q, r Polynomial) {
+	return divMod(a, b)
+}
+
+// DivByVanishing sets p to the quotient of the division of a by the vanishing polynomial
+// ∏ᵢ(X - points[i]) and returns p. The remainder is dropped; it is zero iff a vanishes on points.
+func (p *Polynomial) DivByVanishing(a Polynomial, points []fr.Element) *Polynomial {
+	*p, _ = divMod(a, Vanishing(points))
+	return p
+}
+
+// Vanishing returns the polynomial ∏ᵢ(X - points[i]), computed with a product tree.
+func Vanishing(points []fr.Element) Polynomial {
+	if len(points) == 0 {
+		return constant(1)
+	}
+	return newSubproductTree(points).root()
+}
+
+// EvalMany evaluates p at all the given points, with a subproduct tree
+// (O(n log²n) operations when p and points have n elements).
+func (p *Polynomial) EvalMany(points []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(points))
+	if len(points) == 0 {
+		return res
+	}
+	if len(points) <= mulThreshold || len(*p) <= mulThreshold {
+		for i := range points {
+			res[i] = p.Eval(&points[i])
+		}
+		return res
+	}
+	newSubproductTree(points).evaluate(*p, res)
+	return res
+}
+
+// Interpolate returns the polynomial of degree < len(xs) such that f(xs[i]) = ys[i].
+// It returns ErrDuplicatePoints if the xs are not distinct.
+func Interpolate(xs, ys []fr.Element) (Polynomial, error) {
+	if len(xs) != len(ys) {
+		return nil, errors.New("len(xs) != len(ys)")
+	}
+	if len(xs) == 0 {
+		return nil, errors.New("no interpolation point")
+	}
+	tree := newSubproductTree(xs)
+
+	// Lagrange weights: ys[i] / ∏_{j≠i}(xs[i] - xs[j]) = ys[i] / m'(xs[i]) where m = ∏(X - xs[j])
+	weights := make([]fr.Element, len(xs))
+	tree.evaluate(derivative(tree.root()), weights)
+	for i := range weights {
+		if weights[i].IsZero() {
+			return nil, ErrDuplicatePoints
+		}
+	}
+	weights = fr.BatchInvert(weights)
+	for i := range weights {
+		weights[i].Mul(&weights[i], &ys[i])
+	}
+
+	return tree.combine(weights), nil
+}
+
+// subproductTree stores the products of the linear factors (X - xᵢ) of a set of points,
+// pairwise, level by level: levels[0][i] = X - xᵢ and levels[l+1][i] = levels[l][2i] * levels[l][2i+1].
+type subproductTree struct {
+	points []fr.Element
+	levels [][]Polynomial
+}
+
+func newSubproductTree(points []fr.Element) *subproductTree {
+	leaves := make([]Polynomial, len(points))
+	for i := range points {
+		leaves[i] = make(Polynomial, 2)
+		leaves[i][0].Neg(&points[i])
+		leaves[i][1].SetOne()
+	}
+	t := &subproductTree{points: points, levels: [][]Polynomial{leaves}}
+	for level := leaves; len(level) > 1; {
+		next := make([]Polynomial, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 < len(level) {
+				next[i] = mul(level[2*i], level[2*i+1])
+			} else {
+				next[i] = level[2*i]
+			}
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t
+}
+
+func (t *subproductTree) root() Polynomial {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// evaluate sets res[i] = p(points[i]), reducing p modulo the nodes of the tree from the root down.
+func (t *subproductTree) evaluate(p Polynomial, res []fr.Element) {
+	top := len(t.levels) - 1
+	rems := []Polynomial{p}
+	if len(p) >= len(t.root()) {
+		_, rems[0] = divMod(p, t.root())
+	}
+	for l := top - 1; l >= 0; l-- {
+		next := make([]Polynomial, len(t.levels[l]))
+		for i := range next {
+			parent := rems[i/2]
+			if len(parent) < len(t.levels[l][i]) {
+				// already reduced, e.g. for the odd node carried over from the level below
+				next[i] = parent
+				continue
+			}
+			_, next[i] = divMod(parent, t.levels[l][i])
+		}
+		rems = next
+	}
+	// the remainders modulo X - xᵢ are the evaluations
+	for i := range res {
+		res[i] = rems[i].Eval(&t.points[i])
+	}
+}
+
+// combine returns ∑ᵢ weights[i] * ∏_{j≠i}(X - xⱼ), from the leaves up.
+func (t *subproductTree) combine(weights []fr.Element) Polynomial {
+	acc := make([]Polynomial, len(weights))
+	for i := range weights {
+		acc[i] = Polynomial{weights[i]}
+	}
+	for l := 0; l < len(t.levels)-1; l++ {
+		level := t.levels[l]
+		next := make([]Polynomial, (len(acc)+1)/2)
+		for i := range next {
+			if 2*i+1 >= len(acc) {
+				next[i] = acc[2*i]
+				continue
+			}
+			var left, right Polynomial
+			left.Mul(acc[2*i], level[2*i+1])
+			right.Mul(acc[2*i+1], level[2*i])
+			next[i] = *left.Add(left, right)
+		}
+		acc = next
+	}
+	return trim(acc[0])
+}
+
+// mul returns p1 * p2 in a newly allocated slice
+func mul(p1, p2 Polynomial) Polynomial {
+	if len(p1) == 0 || len(p2) == 0 {
+		return constant(0)
+	}
+	n := len(p1) + len(p2) - 1
+	if len(p1) <= mulThreshold || len(p2) <= mulThreshold {
+		res := make(Polynomial, n)
+		var tmp fr.Element
+		for i := range p1 {
+			for j := range p2 {
+				tmp.Mul(&p1[i], &p2[j])
+				res[i+j].Add(&res[i+j], &tmp)
+			}
+		}
+		return res
+	}
+
+	domain := getDomain(uint64(n))
+	size := int(domain.Cardinality)
+	a := make([]fr.Element, size)
+	b := make([]fr.Element, size)
+	copy(a, p1)
+	copy(b, p2)
+	domain.FFT(a, fft.DIF)
+	domain.FFT(b, fft.DIF)
+	for i := range a {
+		a[i].Mul(&a[i], &b[i])
+	}
+	domain.FFTInverse(a, fft.DIT)
+	return a[:n]
+}
+
+// divMod returns the quotient and the remainder of the Euclidean division of a by b
+func divMod(a, b Polynomial) (q, r Polynomial) {
+	b = trim(b)
+	if b[len(b)-1].IsZero() {
+		panic("polynomial: division by zero")
+	}
+	a = trim(a)
+	if len(a) < len(b) {
+		return constant(0), a.Clone()
+	}
+	m := len(b) - 1          // degree of b
+	k := len(a) - len(b) + 1 // number of coefficients of the quotient
+
+	if m == 0 {
+		var inv fr.Element
+		inv.Inverse(&b[0])
+		q = make(Polynomial, len(a))
+		for i := range a {
+			q[i].Mul(&a[i], &inv)
+		}
+		return q, constant(0)
+	}
+
+	if m <= divThreshold || k <= divThreshold {
+		// long division
+		rem := a.Clone()
+		q = make(Polynomial, k)
+		var inv, tmp fr.Element
+		inv.Inverse(&b[m])
+		for i := k - 1; i >= 0; i-- {
+			q[i].Mul(&rem[i+m], &inv)
+			for j := 0; j < m; j++ {
+				tmp.Mul(&q[i], &b[j])
+				rem[i+j].Sub(&rem[i+j], &tmp)
+			}
+		}
+		return q, trim(rem[:m])
+	}
+
+	// the reversed quotient is rev(a) / rev(b) mod Xᵏ
+	revA := reversed(a[len(a)-k:])
+	revQ := mul(revA, inverseModXPow(reversed(b), k))[:k]
+	q = reversed(revQ)
+
+	// r = a - b*q has degree < m
+	bq := mul(b, q)
+	r = make(Polynomial, m)
+	for i := range r {
+		r[i].Sub(&a[i], &bq[i])
+	}
+	return q, trim(r)
+}
+
+// inverseModXPow returns g such that f*g = 1 mod Xᵏ, using Newton iteration g ← g(2 - fg).
+// f[0] must not be zero.
+func inverseModXPow(f Polynomial, k int) Polynomial {
+	g := make(Polynomial, 1, k)
+	g[0].Inverse(&f[0])
+	var two fr.Element
+	two.SetUint64(2)
+	for l := 1; l < k; {
+		l = minInt(2*l, k)
+		t := make(Polynomial, l)
+		copy(t, mul(f[:minInt(len(f), l)], g))
+		for i := range t {
+			t[i].Neg(&t[i])
+		}
+		t[0].Add(&t[0], &two)
+		g = mul(g, t)
+		g = g[:minInt(len(g), l)]
+	}
+	return g
+}
+
+// derivative returns the formal derivative of p
+func derivative(p Polynomial) Polynomial {
+	if len(p) <= 1 {
+		return constant(0)
+	}
+	res := make(Polynomial, len(p)-1)
+	var c fr.Element
+	for i := range res {
+		c.SetUint64(uint64(i + 1))
+		res[i].Mul(&p[i+1], &c)
+	}
+	return res
+}
+
+// reversed returns the coefficients of p in reverse order, in a new slice
+func reversed(p Polynomial) Polynomial {
+	res := make(Polynomial, len(p))
+	for i := range p {
+		res[len(p)-1-i] = p[i]
+	}
+	return res
+}
+
+// trim removes the leading zero coefficients of p, keeping at least one coefficient
+func trim(p Polynomial) Polynomial {
+	n := len(p)
+	for n > 1 && p[n-1].IsZero() {
+		n--
+	}
+	if n == 0 {
+		return constant(0)
+	}
+	return p[:n]
+}
+
+func constant(c uint64) Polynomial {
+	res := make(Polynomial, 1)
+	res[0].SetUint64(c)
+	return res
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// domains caches the FFT domains used by mul, up to maxCachedDomainLog
+const maxCachedDomainLog = 20
+
+var domains [maxCachedDomainLog + 1]struct {
+	once   sync.Once
+	domain *fft.Domain
+}
+
+func getDomain(n uint64) *fft.Domain {
+	logN := bits.Len64(n - 1)
+	if logN > maxCachedDomainLog {
+		return fft.NewDomain(n)
+	}
+	d := &domains[logN]
+	d.once.Do(func() {
+		d.domain = fft.NewDomain(1 << logN)
+	})
+	return d.domain
+}
@@ -0,0 +1,275 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package tensorcommitment
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// MultilinearProof is a proof of the evaluations at a common point of the polynomials
+// appended to a TensorCommitment, seen as polynomial.MultiLin.
+//
+// A polynomial f in n variables appended to a state of 2ʳ rows fills a block M of
+// 2ⁿ⁻ʳ columns, M[i][j] = f[j·2ʳ+i], so that
+//
+//	f(x) = ∑ⱼ eq(x₁, .., xₙ₋ᵣ; j) ∑ᵢ eq(xₙ₋ᵣ₊₁, .., xₙ; i) M[i][j]
+//
+// The proof is the linear combination of the rows of the state with the coefficients
+// eq(xₙ₋ᵣ₊₁, .., xₙ; i), the linear combination of the rows with random coefficients αⁱ
+// testing the proximity of the rows to the code, and the columns of the encoded state
+// on which both are checked. α and the columns are derived with Fiat Shamir.
+type MultilinearProof struct {
+
+	// evaluations of the appended polynomials, in the order they were appended
+	ClaimedValues []fr.Element
+
+	// linear combination of the rows with the coefficients eq(xₙ₋ᵣ₊₁, .., xₙ; i)
+	Evaluation []fr.Element
+
+	// linear combination of the rows with the coefficients αⁱ
+	Proximity []fr.Element
+
+	// columns of the encoded state, at the positions derived with Fiat Shamir
+	Columns [][]fr.Element
+}
+
+// OpenMultilinear returns a proof of the evaluations at point of the polynomials appended
+// to tc. They must all be of size 2^len(point), and params.NbRows must be a power of 2
+// dividing it. Commit must have been called beforehand, digest is its output.
+// * h hash function used for Fiat Shamir
+// * dataTranscript extra data bound to the Fiat Shamir transcript
+func (tc *TensorCommitment) OpenMultilinear(point []fr.Element, digest Digest, h hash.Hash, dataTranscript ...[]byte) (MultilinearProof, error) {
+	var proof MultilinearProof
+
+	if !tc.isCommitted {
+		return proof, ErrCommitmentNotDone
+	}
+	if len(tc.sizes) == 0 {
+		return proof, ErrNoPolynomial
+	}
+	rows, columns, err := eqTables(tc.params, point)
+	if err != nil {
+		return proof, err
+	}
+	for _, size := range tc.sizes {
+		if size != tc.params.NbRows*len(columns) {
+			return proof, ErrInvalidPointSize
+		}
+	}
+
+	// the evaluation is [eq rows] x M x [eq columns]^t
+	if proof.Evaluation, err = tc.ProverComputeLinComb(rows); err != nil {
+		return proof, err
+	}
+	proof.ClaimedValues = evaluations(proof.Evaluation, columns, len(tc.sizes))
+
+	// proximity test
+	fs := fiatshamir.NewTranscript(h, "alpha", "columns")
+	alpha, err := deriveAlpha(fs, digest, point, &proof, dataTranscript...)
+	if err != nil {
+		return proof, err
+	}
+	if proof.Proximity, err = tc.ProverComputeLinComb(powers(alpha, tc.params.NbRows)); err != nil {
+		return proof, err
+	}
+
+	entryList, err := deriveEntryList(fs, h, tc.params, &proof)
+	if err != nil {
+		return proof, err
+	}
+	if proof.Columns, err = tc.ProverOpenColumns(entryList); err != nil {
+		return proof, err
+	}
+
+	return proof, nil
+}
+
+// VerifyMultilinear verifies that proof.ClaimedValues are the evaluations at point of the
+// polynomials committed in digest. h and dataTranscript must match the ones given to
+// OpenMultilinear.
+func VerifyMultilinear(digest Digest, proof *MultilinearProof, point []fr.Element, h hash.Hash, params *TcParams, dataTranscript ...[]byte) error {
+
+	rows, columns, err := eqTables(params, point)
+	if err != nil {
+		return err
+	}
+
+	// check the sizes
+	if len(proof.ClaimedValues) == 0 || len(proof.ClaimedValues) > params.NbColumns/len(columns) {
+		return ErrInvalidProofSize
+	}
+	if len(digest) != int(params.Domains[1].Cardinality) ||
+		len(proof.Evaluation) != params.NbColumns ||
+		len(proof.Proximity) != params.NbColumns ||
+		len(proof.Columns) != params.NbQueries {
+		return ErrInvalidProofSize
+	}
+	for i := range proof.Columns {
+		if len(proof.Columns[i]) != params.NbRows {
+			return ErrInvalidProofSize
+		}
+	}
+
+	// the claimed values are consistent with the linear combination
+	claimedValues := evaluations(proof.Evaluation, columns, len(proof.ClaimedValues))
+	for i := range claimedValues {
+		if !claimedValues[i].Equal(&proof.ClaimedValues[i]) {
+			return ErrProofFailedEval
+		}
+	}
+
+	// replay the transcript
+	fs := fiatshamir.NewTranscript(h, "alpha", "columns")
+	alpha, err := deriveAlpha(fs, digest, point, proof, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	entryList, err := deriveEntryList(fs, h, params, proof)
+	if err != nil {
+		return err
+	}
+
+	// both linear combinations are consistent with the opened columns
+	hColumns := params.MakeHash()
+	err = Verify(BuildProof(params, proof.Evaluation, entryList, proof.Columns), digest, rows, hColumns)
+	if err != nil {
+		return err
+	}
+	return Verify(BuildProof(params, proof.Proximity, entryList, proof.Columns), digest, powers(alpha, params.NbRows), hColumns)
+}
+
+// eqTables returns the tables of eq(xₙ₋ᵣ₊₁, .., xₙ; ·) indexing the rows, and of
+// eq(x₁, .., xₙ₋ᵣ; ·) indexing the columns, where x = point and 2ʳ = params.NbRows
+func eqTables(params *TcParams, point []fr.Element) (rows, columns polynomial.MultiLin, err error) {
+	if params.NbRows <= 0 || params.NbRows&(params.NbRows-1) != 0 {
+		return nil, nil, ErrNbRowsNotPowerOfTwo
+	}
+	nbRowVars := bits.TrailingZeros(uint(params.NbRows))
+	nbColumnVars := len(point) - nbRowVars
+	if nbColumnVars < 0 || nbColumnVars > bits.TrailingZeros(uint(params.NbColumns)) {
+		return nil, nil, ErrInvalidPointSize
+	}
+
+	rows = make(polynomial.MultiLin, params.NbRows)
+	rows[0].SetOne()
+	rows.Eq(point[nbColumnVars:])
+
+	columns = make(polynomial.MultiLin, 1<<nbColumnVars)
+	columns[0].SetOne()
+	columns.Eq(point[:nbColumnVars])
+
+	return rows, columns, nil
+}
+
+// evaluations returns the evaluations of the nbPolynomials polynomials whose blocks of
+// len(columns) columns are combined in linComb
+func evaluations(linComb []fr.Element, columns polynomial.MultiLin, nbPolynomials int) []fr.Element {
+	res := make([]fr.Element, nbPolynomials)
+	var tmp fr.Element
+	for k := range res {
+		block := linComb[k*len(columns) : (k+1)*len(columns)]
+		for j := range columns {
+			tmp.Mul(&columns[j], &block[j])
+			res[k].Add(&res[k], &tmp)
+		}
+	}
+	return res
+}
+
+// powers returns [1, x, .., xⁿ⁻¹]
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// deriveAlpha binds the digest, the point, the claimed values, the linear combination
+// computing them and dataTranscript to fs, and returns α
+func deriveAlpha(fs *fiatshamir.Transcript, digest Digest, point []fr.Element, proof *MultilinearProof, dataTranscript ...[]byte) (fr.Element, error) {
+	var alpha fr.Element
+
+	data := make([][]byte, 0, len(digest)+len(point)+len(proof.ClaimedValues)+len(proof.Evaluation)+len(dataTranscript))
+	data = append(data, digest...)
+	for i := range point {
+		data = append(data, point[i].Marshal())
+	}
+	for i := range proof.ClaimedValues {
+		data = append(data, proof.ClaimedValues[i].Marshal())
+	}
+	for i := range proof.Evaluation {
+		data = append(data, proof.Evaluation[i].Marshal())
+	}
+	data = append(data, dataTranscript...)
+
+	b, err := challenge(fs, "alpha", data...)
+	if err != nil {
+		return alpha, err
+	}
+	alpha.SetBytes(b)
+	return alpha, nil
+}
+
+// deriveEntryList binds the proximity linear combination to fs, and returns the
+// params.NbQueries columns of the encoded state to open
+func deriveEntryList(fs *fiatshamir.Transcript, h hash.Hash, params *TcParams, proof *MultilinearProof) ([]int, error) {
+	data := make([][]byte, len(proof.Proximity))
+	for i := range proof.Proximity {
+		data[i] = proof.Proximity[i].Marshal()
+	}
+	seed, err := challenge(fs, "columns", data...)
+	if err != nil {
+		return nil, err
+	}
+
+	// the i-th entry is H(seed || i) mod the size of the codewords
+	res := make([]int, params.NbQueries)
+	var buf [8]byte
+	var bEntry, bSize big.Int
+	bSize.SetUint64(params.Domains[1].Cardinality)
+	for i := range res {
+		h.Reset()
+		h.Write(seed)
+		binary.BigEndian.PutUint64(buf[:], uint64(i))
+		h.Write(buf[:])
+		bEntry.SetBytes(h.Sum(nil))
+		res[i] = int(bEntry.Mod(&bEntry, &bSize).Uint64())
+	}
+	return res, nil
+}
+
+// challenge binds data to the challenge challengeID of fs, and returns the challenge
+func challenge(fs *fiatshamir.Transcript, challengeID string, data ...[]byte) ([]byte, error) {
+	for i := range data {
+		if err := fs.Bind(challengeID, data[i]); err != nil {
+			return nil, err
+		}
+	}
+	return fs.ComputeChallenge(challengeID)
+}
@@ -0,0 +1,325 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+)
+
+// MultiExpStream computes the multi-exponentiation of points and scalars read from streams,
+// holding at most 2*chunkSize points and scalars in memory. See G1Jac.MultiExpStream.
+func (p *G1Affine) MultiExpStream(points, scalars io.Reader, chunkSize int, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpStream(points, scalars, chunkSize, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpStream computes the multi-exponentiation of points and scalars read from streams,
+// holding at most 2*chunkSize points and scalars in memory; the next chunk is read while the
+// msm of the current one is computed.
+//
+// points must contain a []G1Affine written by an Encoder with RawEncoding(), and scalars a
+// []fr.Element written by an Encoder; both start with the length of the slice. A memory-mapped file
+// can be passed through bytes.NewReader. Points are not subgroup-checked.
+//
+// The result is identical to MultiExp on the decoded slices.
+func (p *G1Jac) MultiExpStream(points, scalars io.Reader, chunkSize int, config ecc.MultiExpConfig) (*G1Jac, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunkSize must be positive")
+	}
+	nbPoints, err := readStreamLength(points)
+	if err != nil {
+		return nil, err
+	}
+	nbScalars, err := readStreamLength(scalars)
+	if err != nil {
+		return nil, err
+	}
+	if nbPoints != nbScalars {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+	if chunkSize > nbPoints {
+		chunkSize = nbPoints
+	}
+
+	type chunk struct {
+		points     []G1Affine
+		scalars    []fr.Element
+		bufPoints  []byte
+		bufScalars []byte
+	}
+	newChunk := func() *chunk {
+		return &chunk{
+			points:     make([]G1Affine, chunkSize),
+			scalars:    make([]fr.Element, chunkSize),
+			bufPoints:  make([]byte, chunkSize*SizeOfG1AffineUncompressed),
+			bufScalars: make([]byte, chunkSize*fr.Bytes),
+		}
+	}
+	read := func(c *chunk, n int) error {
+		c.points, c.scalars = c.points[:n], c.scalars[:n]
+		if err := readG1AffineChunk(points, c.points, c.bufPoints[:n*SizeOfG1AffineUncompressed], config.Scheduler); err != nil {
+			return err
+		}
+		return readScalarsChunk(scalars, c.scalars, c.bufScalars[:n*fr.Bytes], config.Scheduler)
+	}
+
+	p.Set(&g1Infinity)
+	if nbPoints == 0 {
+		return p, nil
+	}
+
+	current, next := newChunk(), newChunk()
+	if err := read(current, chunkSize); err != nil {
+		return nil, err
+	}
+
+	var _p G1Jac
+	for offset := 0; offset < nbPoints; {
+		n := len(current.points)
+		offset += n
+
+		// read the next chunk while we compute the msm of the current one
+		chRead := make(chan error, 1)
+		if offset < nbPoints {
+			nextSize := nbPoints - offset
+			if nextSize > chunkSize {
+				nextSize = chunkSize
+			}
+			// if the scheduler has no room, the chunk is read before the msm;
+			// chRead is buffered so that it doesn't block.
+			scheduler.Go(config.Scheduler, func() {
+				chRead <- read(next, nextSize)
+			})
+		} else {
+			close(chRead)
+		}
+
+		_, errMsm := _p.MultiExp(current.points, current.scalars, config)
+		errRead := <-chRead
+		if errMsm != nil {
+			return nil, errMsm
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+		p.AddAssign(&_p)
+
+		current, next = next, current
+	}
+
+	return p, nil
+}
+
+// readG1AffineChunk reads len(points) points in their raw (uncompressed) encoding;
+// buf is used as scratch space and must be of size len(points)*SizeOfG1AffineUncompressed.
+func readG1AffineChunk(r io.Reader, points []G1Affine, buf []byte, s scheduler.Scheduler) error {
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var nbErrs uint64
+	scheduler.Execute(s, len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*SizeOfG1AffineUncompressed : (i+1)*SizeOfG1AffineUncompressed]
+			if isCompressed(b[0]) {
+				atomic.AddUint64(&nbErrs, 1)
+				continue
+			}
+			if _, err := points[i].setBytes(b, false); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
+// MultiExpStream computes the multi-exponentiation of points and scalars read from streams,
+// holding at most 2*chunkSize points and scalars in memory. See G2Jac.MultiExpStream.
+func (p *G2Affine) MultiExpStream(points, scalars io.Reader, chunkSize int, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpStream(points, scalars, chunkSize, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpStream computes the multi-exponentiation of points and scalars read from streams,
+// holding at most 2*chunkSize points and scalars in memory; the next chunk is read while the
+// msm of the current one is computed.
+//
+// points must contain a []G2Affine written by an Encoder with RawEncoding(), and scalars a
+// []fr.Element written by an Encoder; both start with the length of the slice. A memory-mapped file
+// can be passed through bytes.NewReader. Points are not subgroup-checked.
+//
+// The result is identical to MultiExp on the decoded slices.
+func (p *G2Jac) MultiExpStream(points, scalars io.Reader, chunkSize int, config ecc.MultiExpConfig) (*G2Jac, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunkSize must be positive")
+	}
+	nbPoints, err := readStreamLength(points)
+	if err != nil {
+		return nil, err
+	}
+	nbScalars, err := readStreamLength(scalars)
+	if err != nil {
+		return nil, err
+	}
+	if nbPoints != nbScalars {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+	if chunkSize > nbPoints {
+		chunkSize = nbPoints
+	}
+
+	type chunk struct {
+		points     []G2Affine
+		scalars    []fr.Element
+		bufPoints  []byte
+		bufScalars []byte
+	}
+	newChunk := func() *chunk {
+		return &chunk{
+			points:     make([]G2Affine, chunkSize),
+			scalars:    make([]fr.Element, chunkSize),
+			bufPoints:  make([]byte, chunkSize*SizeOfG2AffineUncompressed),
+			bufScalars: make([]byte, chunkSize*fr.Bytes),
+		}
+	}
+	read := func(c *chunk, n int) error {
+		c.points, c.scalars = c.points[:n], c.scalars[:n]
+		if err := readG2AffineChunk(points, c.points, c.bufPoints[:n*SizeOfG2AffineUncompressed], config.Scheduler); err != nil {
+			return err
+		}
+		return readScalarsChunk(scalars, c.scalars, c.bufScalars[:n*fr.Bytes], config.Scheduler)
+	}
+
+	p.Set(&g2Infinity)
+	if nbPoints == 0 {
+		return p, nil
+	}
+
+	current, next := newChunk(), newChunk()
+	if err := read(current, chunkSize); err != nil {
+		return nil, err
+	}
+
+	var _p G2Jac
+	for offset := 0; offset < nbPoints; {
+		n := len(current.points)
+		offset += n
+
+		// read the next chunk while we compute the msm of the current one
+		chRead := make(chan error, 1)
+		if offset < nbPoints {
+			nextSize := nbPoints - offset
+			if nextSize > chunkSize {
+				nextSize = chunkSize
+			}
+			// if the scheduler has no room, the chunk is read before the msm;
+			// chRead is buffered so that it doesn't block.
+			scheduler.Go(config.Scheduler, func() {
+				chRead <- read(next, nextSize)
+			})
+		} else {
+			close(chRead)
+		}
+
+		_, errMsm := _p.MultiExp(current.points, current.scalars, config)
+		errRead := <-chRead
+		if errMsm != nil {
+			return nil, errMsm
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+		p.AddAssign(&_p)
+
+		current, next = next, current
+	}
+
+	return p, nil
+}
+
+// readG2AffineChunk reads len(points) points in their raw (uncompressed) encoding;
+// buf is used as scratch space and must be of size len(points)*SizeOfG2AffineUncompressed.
+func readG2AffineChunk(r io.Reader, points []G2Affine, buf []byte, s scheduler.Scheduler) error {
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var nbErrs uint64
+	scheduler.Execute(s, len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*SizeOfG2AffineUncompressed : (i+1)*SizeOfG2AffineUncompressed]
+			if isCompressed(b[0]) {
+				atomic.AddUint64(&nbErrs, 1)
+				continue
+			}
+			if _, err := points[i].setBytes(b, false); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
+// readStreamLength reads the length prefix written by an Encoder in front of a slice
+func readStreamLength(r io.Reader) (int, error) {
+	var buf [4]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint32(buf[:])), nil
+}
+
+// readScalarsChunk reads len(scalars) scalars in their canonical big-endian encoding;
+// buf is used as scratch space and must be of size len(scalars)*fr.Bytes.
+func readScalarsChunk(r io.Reader, scalars []fr.Element, buf []byte, s scheduler.Scheduler) error {
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var nbErrs uint64
+	scheduler.Execute(s, len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			var err error
+			scalars[i], err = fr.BigEndian.Element((*[fr.Bytes]byte)(buf[i*fr.Bytes : (i+1)*fr.Bytes]))
+			if err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return errors.New("invalid scalar encoding")
+	}
+	return nil
+}
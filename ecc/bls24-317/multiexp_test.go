@@ -17,6 +17,8 @@
 package bls24317
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -28,6 +30,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -125,7 +128,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 1; i < len(results); i++ {
 				if !results[i].Equal(&results[i-1]) {
@@ -161,7 +164,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -181,7 +184,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -267,7 +270,7 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 	results := make([]G1Jac, len(cRange))
 	for i, c := range cRange {
-		_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 	}
 
 	var r G1Jac
@@ -285,10 +288,94 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 }
 
+func TestMultiExpCtxG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	samplePoints := make([]G1Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	fillBenchScalars(sampleScalars)
+
+	t.Run("background", func(t *testing.T) {
+		var expected, got G1Affine
+		if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := got.MultiExpCtx(context.Background(), samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !expected.Equal(&got) {
+			t.Fatal("MultiExpCtx and MultiExp results differ")
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var p G1Affine
+		if _, err := p.MultiExpCtx(ctx, samplePoints, sampleScalars, ecc.MultiExpConfig{}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("cancelled while running", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("skipping large msm in short mode")
+		}
+		// points need not be on the curve here, only the cancellation is tested.
+		bigPoints := make([]G1Affine, 1<<18)
+		bigScalars := make([]fr.Element, len(bigPoints))
+		bigPoints[0] = samplePoints[1]
+		fillBenchBasesG1(bigPoints)
+		fillBenchScalars(bigScalars)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		var p G1Jac
+		if _, err := p.MultiExpCtx(ctx, bigPoints, bigScalars, ecc.MultiExpConfig{}); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestMultiExpSchedulerG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	samplePoints := make([]G1Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	fillBenchScalars(sampleScalars)
+
+	var expected G1Affine
+	if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a scheduler with a single task runs most of the chunks on the calling go routine
+	for _, nbTasks := range []int{1, 3} {
+		var got G1Affine
+		config := ecc.MultiExpConfig{Scheduler: scheduler.New(nbTasks)}
+		if _, err := got.MultiExp(samplePoints, sampleScalars, config); err != nil {
+			t.Fatal(err)
+		}
+		if !expected.Equal(&got) {
+			t.Fatalf("msm with a scheduler of %d tasks differs", nbTasks)
+		}
+	}
+}
+
 // _innerMsmG1Reference always do ext jacobian with c == 16
 func _innerMsmG1Reference(p *G1Jac, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
-	digits, _ := partitionScalars(scalars, 16, config.NbTasks)
+	digits, _ := partitionScalars(scalars, 16, config.NbTasks, nil)
 
 	nbChunks := computeNbChunks(16)
 
@@ -306,7 +393,7 @@ func _innerMsmG1Reference(p *G1Jac, points []G1Affine, scalars []fr.Element, con
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := processChunkG1Jacobian[bucketg1JacExtendedC16]
-		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil)
+		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil, nil)
 	}
 
 	return msmReduceChunkG1Affine(p, int(16), chChunks[:])
@@ -539,7 +626,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 1; i < len(results); i++ {
 				if !results[i].Equal(&results[i-1]) {
@@ -575,7 +662,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -595,7 +682,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -679,7 +766,7 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 	results := make([]G2Jac, len(cRange))
 	for i, c := range cRange {
-		_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}, nil)
 	}
 
 	var r G2Jac
@@ -697,10 +784,94 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 }
 
+func TestMultiExpCtxG2(t *testing.T) {
+	const nbSamples = 1 << 10
+	samplePoints := make([]G2Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	fillBenchScalars(sampleScalars)
+
+	t.Run("background", func(t *testing.T) {
+		var expected, got G2Affine
+		if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := got.MultiExpCtx(context.Background(), samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !expected.Equal(&got) {
+			t.Fatal("MultiExpCtx and MultiExp results differ")
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var p G2Affine
+		if _, err := p.MultiExpCtx(ctx, samplePoints, sampleScalars, ecc.MultiExpConfig{}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("cancelled while running", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("skipping large msm in short mode")
+		}
+		// points need not be on the curve here, only the cancellation is tested.
+		bigPoints := make([]G2Affine, 1<<18)
+		bigScalars := make([]fr.Element, len(bigPoints))
+		bigPoints[0] = samplePoints[1]
+		fillBenchBasesG2(bigPoints)
+		fillBenchScalars(bigScalars)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		var p G2Jac
+		if _, err := p.MultiExpCtx(ctx, bigPoints, bigScalars, ecc.MultiExpConfig{}); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestMultiExpSchedulerG2(t *testing.T) {
+	const nbSamples = 1 << 10
+	samplePoints := make([]G2Affine, nbSamples)
+	sampleScalars := make([]fr.Element, nbSamples)
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	fillBenchScalars(sampleScalars)
+
+	var expected G2Affine
+	if _, err := expected.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a scheduler with a single task runs most of the chunks on the calling go routine
+	for _, nbTasks := range []int{1, 3} {
+		var got G2Affine
+		config := ecc.MultiExpConfig{Scheduler: scheduler.New(nbTasks)}
+		if _, err := got.MultiExp(samplePoints, sampleScalars, config); err != nil {
+			t.Fatal(err)
+		}
+		if !expected.Equal(&got) {
+			t.Fatalf("msm with a scheduler of %d tasks differs", nbTasks)
+		}
+	}
+}
+
 // _innerMsmG2Reference always do ext jacobian with c == 16
 func _innerMsmG2Reference(p *G2Jac, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
-	digits, _ := partitionScalars(scalars, 16, config.NbTasks)
+	digits, _ := partitionScalars(scalars, 16, config.NbTasks, nil)
 
 	nbChunks := computeNbChunks(16)
 
@@ -718,7 +889,7 @@ func _innerMsmG2Reference(p *G2Jac, points []G2Affine, scalars []fr.Element, con
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := processChunkG2Jacobian[bucketg2JacExtendedC16]
-		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil)
+		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil, nil)
 	}
 
 	return msmReduceChunkG2Affine(p, int(16), chChunks[:])
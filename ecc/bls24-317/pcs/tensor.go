@@ -0,0 +1,395 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	tensorcommitment "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/tensor-commitment"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// NewTensor returns the commitment scheme of the tensorcommitment package, for polynomials
+// of size at most params.NbRows·params.NbColumns.
+//
+// p is laid out as a matrix M of params.NbRows rows, M[i][j] = p[j·NbRows+i], so that
+// p(z) = ∑ⱼ(∑ᵢzⁱM[i][j])(z^NbRows)ʲ. An opening at z is the linear combination of the rows
+// of M with the coefficients zⁱ, along with a combination with random coefficients αⁱ
+// testing the proximity of the committed rows to the code. Both are checked on
+// params.NbQueries columns. α and the columns are derived with Fiat-Shamir.
+//
+// The proofs of a batch opening are not aggregated.
+func NewTensor(params *tensorcommitment.TcParams) PolynomialCommitmentScheme {
+	return &tensorScheme{params: params}
+}
+
+type tensorScheme struct {
+	params *tensorcommitment.TcParams
+}
+
+type tensorCommitment struct {
+	digest tensorcommitment.Digest
+}
+
+type tensorOpeningProof struct {
+	claimedValue fr.Element
+
+	// linear combinations of the rows with the coefficients zⁱ and αⁱ
+	linCombZ, linCombAlpha []fr.Element
+
+	// queried columns of the encoded matrix
+	columns [][]fr.Element
+}
+
+type tensorBatchOpeningProof struct {
+	proofs []tensorOpeningProof
+}
+
+func (s *tensorScheme) Commit(p []fr.Element) (Commitment, error) {
+	tc, err := s.commit(p)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := tc.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &tensorCommitment{digest: digest}, nil
+}
+
+func (s *tensorScheme) Open(p []fr.Element, commitment Commitment, point fr.Element) (OpeningProof, error) {
+	c, ok := commitment.(*tensorCommitment)
+	if !ok {
+		return nil, ErrUnexpectedType
+	}
+	return s.open(p, c, point)
+}
+
+func (s *tensorScheme) Verify(commitment Commitment, proof OpeningProof, point fr.Element) error {
+	c, ok := commitment.(*tensorCommitment)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	p, ok := proof.(*tensorOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	return s.verify(c, p, point)
+}
+
+func (s *tensorScheme) BatchOpen(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	if len(polynomials) == 0 {
+		return nil, ErrZeroNbCommitments
+	}
+	if len(polynomials) != len(commitments) {
+		return nil, ErrInvalidNbCommitments
+	}
+	var res tensorBatchOpeningProof
+	res.proofs = make([]tensorOpeningProof, len(polynomials))
+	for i := range polynomials {
+		c, ok := commitments[i].(*tensorCommitment)
+		if !ok {
+			return nil, ErrUnexpectedType
+		}
+		proof, err := s.open(polynomials[i], c, point, dataTranscript...)
+		if err != nil {
+			return nil, err
+		}
+		res.proofs[i] = *proof
+	}
+	return &res, nil
+}
+
+func (s *tensorScheme) BatchVerify(commitments []Commitment, proof BatchOpeningProof, point fr.Element, dataTranscript ...[]byte) error {
+	p, ok := proof.(*tensorBatchOpeningProof)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	if len(commitments) == 0 {
+		return ErrZeroNbCommitments
+	}
+	if len(commitments) != len(p.proofs) {
+		return ErrInvalidNbCommitments
+	}
+	for i := range commitments {
+		c, ok := commitments[i].(*tensorCommitment)
+		if !ok {
+			return ErrUnexpectedType
+		}
+		if err := s.verify(c, &p.proofs[i], point, dataTranscript...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *tensorScheme) NewCommitment() Commitment {
+	return new(tensorCommitment)
+}
+
+func (s *tensorScheme) NewOpeningProof() OpeningProof {
+	return new(tensorOpeningProof)
+}
+
+func (s *tensorScheme) NewBatchOpeningProof() BatchOpeningProof {
+	return new(tensorBatchOpeningProof)
+}
+
+// commit returns a tensor commitment whose state holds p
+func (s *tensorScheme) commit(p []fr.Element) (*tensorcommitment.TensorCommitment, error) {
+	if len(p) > s.params.NbRows*s.params.NbColumns {
+		return nil, ErrInvalidPolynomialSize
+	}
+	tc := tensorcommitment.NewTensorCommitment(s.params)
+	if _, err := tc.Append(p); err != nil {
+		return nil, err
+	}
+	return tc, nil
+}
+
+func (s *tensorScheme) open(p []fr.Element, commitment *tensorCommitment, point fr.Element, dataTranscript ...[]byte) (*tensorOpeningProof, error) {
+	tc, err := s.commit(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tc.Commit(); err != nil {
+		return nil, err
+	}
+
+	var proof tensorOpeningProof
+	lz, zn := s.powers(point)
+	if proof.linCombZ, err = tc.ProverComputeLinComb(lz); err != nil {
+		return nil, err
+	}
+	proof.claimedValue = eval(proof.linCombZ, zn)
+
+	fs := fiatshamir.NewTranscript(s.params.MakeHash(), "alpha", "queries")
+	alpha, err := s.deriveAlpha(fs, commitment, &proof, point, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+	lAlpha, _ := s.powers(alpha)
+	if proof.linCombAlpha, err = tc.ProverComputeLinComb(lAlpha); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.deriveEntries(fs, &proof)
+	if err != nil {
+		return nil, err
+	}
+	if proof.columns, err = tc.ProverOpenColumns(entries); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+func (s *tensorScheme) verify(commitment *tensorCommitment, proof *tensorOpeningProof, point fr.Element, dataTranscript ...[]byte) error {
+	if len(commitment.digest) != int(s.params.Domains[1].Cardinality) {
+		return ErrInvalidProof
+	}
+	if len(proof.linCombZ) != s.params.NbColumns || len(proof.linCombAlpha) != s.params.NbColumns {
+		return ErrInvalidProof
+	}
+	if len(proof.columns) != s.params.NbQueries {
+		return ErrInvalidProof
+	}
+	for i := range proof.columns {
+		if len(proof.columns[i]) != s.params.NbRows {
+			return ErrInvalidProof
+		}
+	}
+
+	lz, zn := s.powers(point)
+	if claimedValue := eval(proof.linCombZ, zn); !claimedValue.Equal(&proof.claimedValue) {
+		return ErrVerifyOpeningProof
+	}
+
+	fs := fiatshamir.NewTranscript(s.params.MakeHash(), "alpha", "queries")
+	alpha, err := s.deriveAlpha(fs, commitment, proof, point, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	lAlpha, _ := s.powers(alpha)
+	entries, err := s.deriveEntries(fs, proof)
+	if err != nil {
+		return err
+	}
+
+	h := s.params.MakeHash()
+	tcProof := tensorcommitment.BuildProof(s.params, proof.linCombZ, entries, proof.columns)
+	if err := tensorcommitment.Verify(tcProof, commitment.digest, lz, h); err != nil {
+		return err
+	}
+	tcProof = tensorcommitment.BuildProof(s.params, proof.linCombAlpha, entries, proof.columns)
+	return tensorcommitment.Verify(tcProof, commitment.digest, lAlpha, h)
+}
+
+// powers returns [1, x, .., x^{NbRows-1}] and x^NbRows
+func (s *tensorScheme) powers(x fr.Element) ([]fr.Element, fr.Element) {
+	res := make([]fr.Element, s.params.NbRows)
+	var acc fr.Element
+	acc.SetOne()
+	for i := range res {
+		res[i] = acc
+		acc.Mul(&acc, &x)
+	}
+	return res, acc
+}
+
+// deriveAlpha binds the commitment, the point, the linear combination of the rows
+// with the powers of the point and dataTranscript to fs, and returns α
+func (s *tensorScheme) deriveAlpha(fs *fiatshamir.Transcript, commitment *tensorCommitment, proof *tensorOpeningProof, point fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	data := make([][]byte, 0, 1+len(commitment.digest)+len(proof.linCombZ)+len(dataTranscript))
+	data = append(data, point.Marshal())
+	data = append(data, commitment.digest...)
+	for i := range proof.linCombZ {
+		data = append(data, proof.linCombZ[i].Marshal())
+	}
+	data = append(data, dataTranscript...)
+
+	var alpha fr.Element
+	b, err := challenge(fs, "alpha", data...)
+	if err != nil {
+		return alpha, err
+	}
+	alpha.SetBytes(b)
+	return alpha, nil
+}
+
+// deriveEntries binds the random linear combination of the rows to fs, and
+// returns the queried columns
+func (s *tensorScheme) deriveEntries(fs *fiatshamir.Transcript, proof *tensorOpeningProof) ([]int, error) {
+	data := make([][]byte, len(proof.linCombAlpha))
+	for i := range proof.linCombAlpha {
+		data[i] = proof.linCombAlpha[i].Marshal()
+	}
+	seed, err := challenge(fs, "queries", data...)
+	if err != nil {
+		return nil, err
+	}
+	queries := deriveQueries(s.params.MakeHash(), seed, s.params.NbQueries, s.params.Domains[1].Cardinality)
+	entries := make([]int, len(queries))
+	for i := range queries {
+		entries[i] = int(queries[i])
+	}
+	return entries, nil
+}
+
+// WriteTo writes the digest to w.
+func (c *tensorCommitment) WriteTo(w io.Writer) (int64, error) {
+	return c.digest.WriteTo(w)
+}
+
+// ReadFrom decodes a digest written by WriteTo from r.
+func (c *tensorCommitment) ReadFrom(r io.Reader) (int64, error) {
+	return c.digest.ReadFrom(r)
+}
+
+func (p *tensorOpeningProof) ClaimedValue() fr.Element {
+	return p.claimedValue
+}
+
+func (p *tensorOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls24317.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&p.claimedValue,
+		p.linCombZ,
+		p.linCombAlpha,
+		p.columns,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+func (p *tensorOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls24317.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&p.claimedValue,
+		&p.linCombZ,
+		&p.linCombAlpha,
+		&p.columns,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+func (p *tensorBatchOpeningProof) ClaimedValues() []fr.Element {
+	res := make([]fr.Element, len(p.proofs))
+	for i := range p.proofs {
+		res[i] = p.proofs[i].claimedValue
+	}
+	return res
+}
+
+// WriteTo writes the number of proofs, followed by the proofs, to w.
+func (p *tensorBatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(p.proofs)))
+	k, err := w.Write(header[:])
+	n := int64(k)
+	if err != nil {
+		return n, err
+	}
+	for i := range p.proofs {
+		m, err := p.proofs[i].WriteTo(w)
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom decodes proofs written by WriteTo from r.
+func (p *tensorBatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	var header [4]byte
+	k, err := io.ReadFull(r, header[:])
+	n := int64(k)
+	if err != nil {
+		return n, err
+	}
+	nbProofs := binary.BigEndian.Uint32(header[:])
+	if nbProofs > maxNbOpenings {
+		return n, ErrInvalidProof
+	}
+	p.proofs = make([]tensorOpeningProof, nbProofs)
+	for i := range p.proofs {
+		m, err := p.proofs[i].ReadFrom(r)
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
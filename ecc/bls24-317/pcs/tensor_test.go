@@ -0,0 +1,32 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	tensorcommitment "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/tensor-commitment"
+)
+
+func TestTensor(t *testing.T) {
+	params, err := tensorcommitment.NewTCParams(4, 8, 8, sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testScheme(t, NewTensor(params), params.NbRows*params.NbColumns)
+}
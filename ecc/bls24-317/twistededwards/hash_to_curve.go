@@ -0,0 +1,199 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// The curve is birationally equivalent to the Montgomery curve
+// K*t² = s³ + J*s² + s, with J = 2(a+d)/(a-d) and K = 4/(a-d)
+// https://www.rfc-editor.org/rfc/rfc9380.html#appendix-D.1
+var (
+	hashToCurveOnce sync.Once
+	mapZ            fr.Element // non-square in fr
+	mapJDivK        fr.Element // J/K
+	mapInvKSquare   fr.Element // 1/K²
+	mapK            fr.Element
+)
+
+func initHashToCurveParams() {
+	initOnce.Do(initCurveParams)
+
+	var aMinusD, aPlusD fr.Element
+	aMinusD.Sub(&curveParams.A, &curveParams.D)
+	aPlusD.Add(&curveParams.A, &curveParams.D)
+
+	// K = 4/(a-d)
+	mapK.Inverse(&aMinusD).Double(&mapK).Double(&mapK)
+	// J/K = (a+d)/2
+	mapJDivK.Set(&aPlusD).Halve()
+	// 1/K² = (a-d)²/16
+	mapInvKSquare.Square(&aMinusD).Halve()
+	mapInvKSquare.Halve()
+	mapInvKSquare.Halve()
+	mapInvKSquare.Halve()
+
+	mapZ.SetInt64(7)
+}
+
+// MapToCurve implements the Elligator 2 method, mapping u to a point on the
+// birationally equivalent Montgomery curve, followed by the rational map to the
+// twisted Edwards curve.
+// No cofactor clearing: the result is not necessarily in the prime subgroup.
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-elligator-2-method
+func MapToCurve(u *fr.Element) PointAffine {
+	hashToCurveOnce.Do(initHashToCurveParams)
+
+	var tv1, x1, x2, gx1, gx2, x, y, y1, y2 fr.Element
+	var one fr.Element
+	one.SetOne()
+
+	tv1.Square(u)           //    1.  tv1 = u²
+	tv1.Mul(&tv1, &mapZ)    //    2.  tv1 = Z * tv1
+	tv1.Add(&tv1, &one)     //    3.  tv1 = 1 + tv1
+	tv1.Inverse(&tv1)       //    4.  tv1 = inv0(tv1)
+	x1.Mul(&tv1, &mapJDivK) //    5.  x1 = (J / K) * tv1
+	x1.Neg(&x1)             //    6.  x1 = -x1
+	if x1.IsZero() {        //    7.  x1 = CMOV(x1, -(J / K), x1 == 0)
+		x1.Neg(&mapJDivK)
+	}
+
+	mapG(&gx1, &x1)        //    8.  gx1 = x1³ + (J / K) * x1² + x1 / K²
+	x2.Add(&x1, &mapJDivK) //    9.  x2 = -x1 - J / K
+	x2.Neg(&x2)            //
+	mapG(&gx2, &x2)        //    10. gx2 = x2³ + (J / K) * x2² + x2 / K²
+
+	gx1NotSquare := gx1.Legendre() >> 1 //    11. e1 = is_square(gx1)
+	// gx1NotSquare = 0 if gx1 is a square, -1 otherwise
+
+	// gx1 or gx2 is a square since (J²-4)/K² = a*d is not
+	y1.Sqrt(&gx1)
+	y2.Sqrt(&gx2)
+
+	x.Select(gx1NotSquare, &x1, &x2) //    12. x = CMOV(x2, x1, e1)
+	y.Select(gx1NotSquare, &y1, &y2) //    13. y = CMOV(y2, y1, e1)
+
+	// 14. y = CMOV(y, -y, sgn0(y) != e1), that is sgn0(y) = 1 iff x = x1
+	tv1.Neg(&y)
+	signsNotEqual := sgn0(&y) ^ uint64(gx1NotSquare+1)
+	y.Select(int(signsNotEqual), &y, &tv1)
+
+	var s, t fr.Element
+	s.Mul(&x, &mapK) //    15. s = x * K
+	t.Mul(&y, &mapK) //    16. t = y * K
+
+	return montgomeryToEdwards(&s, &t)
+}
+
+// mapG sets z = x³ + (J / K) * x² + x / K²
+func mapG(z, x *fr.Element) {
+	var tv fr.Element
+	tv.Square(x)
+	z.Add(x, &mapJDivK).Mul(z, &tv)
+	tv.Mul(x, &mapInvKSquare)
+	z.Add(z, &tv)
+}
+
+// montgomeryToEdwards applies the rational map (s, t) -> (s / t, (s - 1) / (s + 1))
+// from the Montgomery curve to the twisted Edwards curve, sending the
+// exceptional cases t = 0 or s = -1 to the identity (0, 1).
+func montgomeryToEdwards(s, t *fr.Element) PointAffine {
+	var res PointAffine
+	var one, sPlusOne, den fr.Element
+	one.SetOne()
+
+	// a single inversion for 1 / (t * (s + 1))
+	sPlusOne.Add(s, &one)
+	den.Mul(t, &sPlusOne)
+	if den.IsZero() {
+		res.setInfinity()
+		return res
+	}
+	den.Inverse(&den)
+
+	res.X.Mul(s, &sPlusOne).Mul(&res.X, &den)
+	res.Y.Sub(s, &one).Mul(&res.Y, t).Mul(&res.Y, &den)
+
+	return res
+}
+
+// sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-the-sgn0-function
+// The sign of an element is not obviously related to that of its Montgomery form
+func sgn0(z *fr.Element) uint64 {
+
+	nonMont := z.Bits()
+
+	// m == 1
+	return nonMont[0] % 2
+
+}
+
+// EncodeToCurve hashes a message to a point on the curve using the
+// Elligator 2 map, followed by cofactor clearing.
+// It is faster than HashToCurve, but the result is not uniformly distributed. Unsuitable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#roadmap
+func EncodeToCurve(msg, dst []byte) (PointAffine, error) {
+
+	var res PointAffine
+	u, err := fr.Hash(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+
+	res = MapToCurve(&u[0])
+	res.clearCofactor(&res)
+
+	return res, nil
+}
+
+// HashToCurve hashes a message to a point on the curve using the
+// Elligator 2 map, followed by cofactor clearing.
+// Slower than EncodeToCurve, but usable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#roadmap
+func HashToCurve(msg, dst []byte) (PointAffine, error) {
+	u, err := fr.Hash(msg, dst, 2)
+	if err != nil {
+		return PointAffine{}, err
+	}
+
+	Q0 := MapToCurve(&u[0])
+	Q1 := MapToCurve(&u[1])
+
+	var res PointAffine
+	res.Add(&Q0, &Q1).
+		clearCofactor(&res)
+
+	return res, nil
+}
+
+// clearCofactor sets p to cofactor*p1, in the prime subgroup
+func (p *PointAffine) clearCofactor(p1 *PointAffine) *PointAffine {
+	initOnce.Do(initCurveParams)
+
+	var cofactor big.Int
+	curveParams.Cofactor.BigInt(&cofactor)
+
+	return p.ScalarMultiplication(p1, &cofactor)
+}
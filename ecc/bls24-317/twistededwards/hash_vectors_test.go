@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS24317_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x15dc5c7d23f34997a9de07963f91eb3e9c387ff1159038bfe211f2b4da3ba61e", "0x1a3263f229b30b91b99ad657c7fd11d27911ae7fdd3bc3872210db7c4feb8c19"},
+				Q: point{"0x1a131fab153be0e75b5709910c8c7029e2d0e950db802e6580b189982e4622c6", "0x34d8a55b0a504bd5c82a98b858a7cac206d22238ef5c4ff9cb3284591e748b08"},
+				u: "0x1cad9101f5d59349b0616d3eb420adde0f3c6d881d0574e5937a91c5d73fcce8",
+			},
+			{
+				msg: "abc", P: point{"0x52db391ab74c77f0d9e013eca0db7b62372952f777e436774707af9a9dc0077", "0x2e918db1d2bcbe5dfe1c4e5ad7450a9aa9ecdce05b0e6981209607531e6b7550"},
+				Q: point{"0x17abb788de39b0b7ac2faa708cf36c4aecc0410efd4411804a3db0d62336f371", "0x3be2982bcd87197744a125e5985ebac012f42e31721dc7b7524a6d1ffe5e65a0"},
+				u: "0x3671431697e2485a816623047a0d155912a71055c2f6fbc1e71e0dbe59dc8aa7",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x11a255a8ef36b8f193ff3608f07a3213cbc4303a588ae5b6faf9209be6eb451a", "0x1808d626e8871298a43cc0d87ce97db01366084ec647b6e1fa036d59d5d03ea1"},
+				Q: point{"0x2275fa8e6088cb6f96789c2b8f59b7e5f6ca60348bb3e870f8e7ddae3157ca6f", "0x2f518254c848216015f9729788c820a6527b36fcbfae41245bab574ad4bb394a"},
+				u: "0x2e46cb28593b4c431589df681476a3eaeb69c18ecddc0a8f402fcc5e32bedd7d",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x2acdee6b49dc9dd94e17002ff827405dc986f0ebf90bee78e4c0c69d187d5a23", "0x244af4fea7d3b89219851402942934d31ae6a01ca85dd9cd78c806a099263936"},
+				Q: point{"0x346a9f76094138dc7bd2d703219fa3b666645bc521e459d20007547d130d8e24", "0x9f537626c2dfa1e690c545f680661b5926724bb26e7f0ebaea8c7593ada3625"},
+				u: "0xbd1075ee8707ec275f55c41f072cfd36102fa68fd5e0db90ab43f0541155685",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x12ad03427d9188f7d94ab8a8be98a26459a8aef1e89f549f7e4fd211e67d6bcc", "0x3185fcb981c4f472632297f344875b654eb17f6802f69baa60cbd6704d317690"},
+				Q: point{"0x16b7a0efd5b60ee7207eed4a28b7bc40d85acd1aa4982432141ba493bdca129d", "0x359b9b4dcc5fdf2c2ac71102cdec7f3c34fea3dbf25dbfe56bc85470073046a1"},
+				u: "0x43a305e5e09e2b47abb4ede14152c949f144b4529187de174892fcc80acb62c7",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BLS24317_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x28d618088cb7e05d5916705338a5ce67a0694585e19bc916fcb9771192146b31", "0x39919fd367b08f7bbc4bb03f0ad7cfa556c5006f562b863baf9178e517315177"},
+				Q0: point{"0x2b43a2f160485ec704c364c4f2195d78d5ccb1a5e4f4e241348daab0c0e9ec92", "0x33b026efb06e8953941c2cfd5b611482075ceff8ae99c57889e84fc0cd720ba7"},
+				Q1: point{"0x367223d8734c8a87e3d49d16754757e004cd25c47c68896a6d470501326738f9", "0x3ea1516557bd0baa964b78b52d34906e3f9486dd29eb87353dc5ff595cfc2671"},
+				u0: "0x40c552386a035cf5e84f6fbdd9de93e65c9c1503bba3de268ec3d668cfe8e40a", u1: "0x3e07e4c16fb58237c7973c97aa93005ff6b06c7e4df4ab63e2df95c6cdc23d8",
+			},
+			{
+				msg: "abc", P: point{"0x3d7299aab8ad6be7b4de41853caf6c71f6902f3deb144aefc7945e4411b56363", "0x36030d367b9c22fc01c8e4124d45681237ccba6487e572f2330d6b3b54d45b8d"},
+				Q0: point{"0x27a7262f1eb4f99acf9286e0ba2a264f8717d2b15b49e5c1b6853a6f797f1792", "0x169a3836263b8460a01a7856a36bc1c3b0205b768b537840e5cf8aedffb41fcb"},
+				Q1: point{"0x37a34bcc6fbc289f1cb1c557827b00d6e63e5941510696d578293504b39e9c4b", "0x23447b9c90dfd0a006fc88f800e34ea7509db7f70d6b2743e2b56bc51cd6ee1e"},
+				u0: "0x2682eae83cf1205d80079e66d06689e8f7b7ccd1bde0403258890409770e6da4", u1: "0x2ae092060522ac0443751a39d6150b0805e22a091ca77feada42b03f6b2bcec6",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x420f929a11a3d3020a5a166c959c1d100f6e7186ba23e00c392bfef95c35b1f5", "0x2e05d0240485c742bf340aa755bf8ecba96a5c68e0e4963fd5ce8ac759d4026b"},
+				Q0: point{"0x57f48ddba9c1eac763db26356f9ec87de0c1c67cc1c4ba4e0a3b69c0afcd740", "0x8f789259dd1a0be48caabac3ef3676cceee88bfbe5de8089b040c68571a1837"},
+				Q1: point{"0x371e2d0249c804cfe1fc79723636c697f2bc041e6235120e12ee970c07b688b9", "0x343ea6e66a0d3cf2f4869c79dc449bd075ee42304be40d082d2e25a379459f4a"},
+				u0: "0xd8094a45159d805a43c21156ddf98c50eea4ec6fba69781d6a6970e29a442b5", u1: "0x3e7158879f545f4edc3ee47c1697a818f22c9d638ee9aeac2f87b94ba10d3601",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x432425990ef02f57bcea977808e0ed914c1e22d1e8b09c3fa3adddcd801b8f26", "0xf37be0be299991fda0b77cbbf9a218ddd8dfb23beb4697c0db45039c6f1a56b"},
+				Q0: point{"0xac477bfe6164a4d07eabc99ecef750f1754b529224f17892cdd33cd343b42d8", "0x36ea6c0c1179dd742d3da9dfaf054c569f0c172bcf7eae968f834a2f4c07ac52"},
+				Q1: point{"0x2a164d85b57896431a47fb721b09f50fad51c28054c254072b4655c96ccbce30", "0x12b1a024c4269c9be13f7f1e1ed812184b7dd7c81982ffbc1be39a00585222e1"},
+				u0: "0x25f6a038eb3b4418f4ecb4821e6afeb140092dc75f612d167431e742caddc174", u1: "0x37b9b6ee891a12c00ffe38345c51acc4b12d2ebd4cb7009c6e29fcd53b21701d",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x30e77cbd1b509eeea5a8c974dc732bfb7f42cb26fdc0725e45e834e47901f626", "0x155eb42f89ebcc2a150167d5a9625eae6634c8acdbc23d4a2da784672c85be8"},
+				Q0: point{"0x26dc14b02dfb5a994ca7e5c3d0468a5b16f0f65c43fd54eb486309c6e83ccfbb", "0x381d994da0f375741f8ddb31558499df58321f914423454c969cbac4dfb434d7"},
+				Q1: point{"0x2e8e4a090d4f69c81f5fdd331cf7887768cf0faadd4721f1ddcaf09759982fd4", "0x44655a2c1bdb1a37dc35b6c8e1d227adea1a62845ac8d05d8872e1f65692b8b"},
+				u0: "0x2795f9b2ecb418c7a0c54d61d3205196ad319c9b2c9bf0c74fbb49ff6657f569", u1: "0x26f4a13e14bc77fd61c80ff50d986ef2ed70c6722c62580e5d52064fb0ba9557",
+			},
+		}}
+}
@@ -0,0 +1,225 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+)
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+//
+// The scalars are reduced modulo the order of the prime subgroup.
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointAffine) MultiExp(points []PointAffine, scalars []big.Int, config ecc.MultiExpConfig) (*PointAffine, error) {
+	var _p PointExtended
+	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&_p)
+	return p, nil
+}
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+//
+// The scalars are reduced modulo the order of the prime subgroup.
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []big.Int, config ecc.MultiExpConfig) (*PointExtended, error) {
+	// step 1
+	// we reduce the scalars modulo the subgroup order
+	// step 2
+	// we compute, for each scalars over c-bit wide windows, nbChunk digits
+	// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract
+	// 2^{c} to the current digit, making it negative.
+	// negative digits are processed by adding -P into the bucket instead of P
+	// step 3
+	// each chunk places the points into 2^{c-1} buckets (extended coordinates, mixed addition)
+	// and computes the weighted sum of its buckets
+	// step 4
+	// reduce the weighted sums of the chunks into our result
+
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	initOnce.Do(initCurveParams)
+	bases := points
+	_scalars := make([]big.Int, nbPoints)
+	scheduler.Execute(config.Scheduler, nbPoints, func(start, end int) {
+		for i := start; i < end; i++ {
+			_scalars[i].Mod(&scalars[i], &curveParams.Order)
+		}
+	}, config.NbTasks)
+
+	maxBits := 0
+	for i := range _scalars {
+		if l := _scalars[i].BitLen(); l > maxBits {
+			maxBits = l
+		}
+	}
+
+	p.setInfinity()
+	if maxBits == 0 {
+		return p, nil
+	}
+
+	c := bestC(len(bases), maxBits)
+	// the last digit absorbs the carry of the previous window and must stay below 2^{c-1}
+	nbChunks := (maxBits + c + 1) / c
+
+	digits := partitionScalars(_scalars, c, nbChunks, config.NbTasks, config.Scheduler)
+
+	// each chunk is processed in its own go routine and sends its result in chChunks[j]
+	chChunks := make([]chan PointExtended, nbChunks)
+	for j := range chChunks {
+		chChunks[j] = make(chan PointExtended, 1)
+	}
+
+	// we use a semaphore to limit the number of go routines running concurrently
+	sem := make(chan struct{}, config.NbTasks)
+	n := len(bases)
+	for j := nbChunks - 1; j >= 0; j-- {
+		j := j
+		scheduler.Go(config.Scheduler, func() {
+			sem <- struct{}{}
+			processChunk(chChunks[j], c, bases, digits[j*n:(j+1)*n])
+			<-sem
+		})
+	}
+
+	// reduce the weighted sums of the chunks
+	total := <-chChunks[nbChunks-1]
+	p.Set(&total)
+	for j := nbChunks - 2; j >= 0; j-- {
+		for l := 0; l < c; l++ {
+			p.Double(p)
+		}
+		totalj := <-chChunks[j]
+		p.Add(p, &totalj)
+	}
+
+	return p, nil
+}
+
+// bestC returns the window size minimizing the approximate cost (in group operations)
+// cost = bits/c * (nbPoints + 2^{c})
+func bestC(nbPoints, nbBits int) int {
+	var C int
+	min := math.MaxFloat64
+	for c := 2; c <= 16; c++ {
+		cost := float64((nbBits+c+1)/c) * float64(nbPoints+(1<<c))
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// partitionScalars computes, for each non-negative scalar, nbChunks signed digits in [-2^{c-1}, 2^{c-1}).
+// digits[j*len(scalars)+i] is the j-th digit of scalars[i].
+func partitionScalars(scalars []big.Int, c, nbChunks, nbTasks int, s scheduler.Scheduler) []int32 {
+	n := len(scalars)
+	digits := make([]int32, n*nbChunks)
+
+	max := 1 << (c - 1) // max value we want for our digits
+	mask := 1<<c - 1    // low c bits are 1
+
+	scheduler.Execute(s, n, func(start, end int) {
+		for i := start; i < end; i++ {
+			words := scalars[i].Bits()
+			carry := 0
+			for j := 0; j < nbChunks; j++ {
+				digit := window(words, j*c, c, mask) + carry
+
+				// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract
+				// 2^{c} to the current digit, making it negative.
+				carry = 0
+				if digit >= max {
+					digit -= 1 << c
+					carry = 1
+				}
+				digits[j*n+i] = int32(digit)
+			}
+		}
+	}, nbTasks)
+
+	return digits
+}
+
+// window returns the c bits of words starting at bit start.
+func window(words []big.Word, start, c, mask int) int {
+	w := start / bits.UintSize
+	if w >= len(words) {
+		return 0
+	}
+	o := start % bits.UintSize
+	v := uint64(words[w]) >> o
+	if o+c > bits.UintSize && w+1 < len(words) {
+		v |= uint64(words[w+1]) << (bits.UintSize - o)
+	}
+	return int(v) & mask
+}
+
+// processChunk places the points into buckets based on their digits and
+// sends the weighted sum ∑ i*bucket[i-1] in chRes.
+func processChunk(chRes chan<- PointExtended, c int, points []PointAffine, digits []int32) {
+	buckets := make([]PointExtended, 1<<(c-1))
+	for i := range buckets {
+		buckets[i].setInfinity()
+	}
+
+	var neg PointAffine
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+		if digit > 0 {
+			buckets[digit-1].MixedAdd(&buckets[digit-1], &points[i])
+		} else {
+			neg.Neg(&points[i])
+			buckets[-digit-1].MixedAdd(&buckets[-digit-1], &neg)
+		}
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var runningSum, total PointExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.Add(&runningSum, &buckets[k])
+		total.Add(&total, &runningSum)
+	}
+
+	chRes <- total
+}
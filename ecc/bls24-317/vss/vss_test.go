@@ -0,0 +1,196 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package vss
+
+import (
+	"math/big"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+func TestShamir(t *testing.T) {
+	const threshold, n = 3, 6
+
+	var secret fr.Element
+	secret.SetRandom()
+	shares, f, err := Split(&secret, threshold, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != n || len(f) != threshold || !f[0].Equal(&secret) {
+		t.Fatal("wrong sharing")
+	}
+
+	for _, subset := range [][]int{{0, 1, 2}, {5, 3, 1}, {4, 0, 2, 1}} {
+		var s []Share
+		for _, i := range subset {
+			s = append(s, shares[i])
+		}
+		res, err := Reconstruct(s, threshold)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Equal(&secret) {
+			t.Fatal("wrong secret reconstructed")
+		}
+	}
+
+	// threshold-1 shares are not enough
+	if _, err := Reconstruct(shares[:threshold-1], threshold); err != errNotEnoughShares {
+		t.Fatal("expected errNotEnoughShares")
+	}
+	res, err := Reconstruct(shares[:threshold-1], threshold-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Equal(&secret) {
+		t.Fatal("threshold-1 shares should not reconstruct the secret")
+	}
+
+	if _, err := LagrangeCoefficients([]uint32{1, 2, 1}); err != errDuplicateIndex {
+		t.Fatal("expected errDuplicateIndex")
+	}
+	if _, err := LagrangeCoefficients([]uint32{0, 2}); err != errInvalidIndex {
+		t.Fatal("expected errInvalidIndex")
+	}
+	if _, _, err := Split(&secret, n+1, n); err != errInvalidThreshold {
+		t.Fatal("expected errInvalidThreshold")
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	var secret fr.Element
+	secret.SetRandom()
+	f, err := NewPolynomial(&secret, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shares := []Share{f.Share(3), f.Share(9), f.Share(1), f.Share(4), f.Share(7)}
+	g, err := interpolate(shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range f {
+		if !f[k].Equal(&g[k]) {
+			t.Fatal("wrong interpolation")
+		}
+	}
+}
+
+func TestFeldman(t *testing.T) {
+	const threshold, n = 3, 5
+
+	var secret fr.Element
+	secret.SetRandom()
+	shares, f, err := Split(&secret, threshold, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := f.FeldmanCommit()
+
+	for i := range shares {
+		if !c.Verify(&shares[i]) {
+			t.Fatal("valid share rejected")
+		}
+	}
+	bad := shares[0]
+	bad.Value.Add(&bad.Value, &secret)
+	if c.Verify(&bad) {
+		t.Fatal("invalid share accepted")
+	}
+	bad = shares[0]
+	bad.Index = 2
+	if c.Verify(&bad) {
+		t.Fatal("share with a wrong index accepted")
+	}
+
+	// reconstruction in the exponent
+	public := make([]PublicShare, n)
+	for i := range shares {
+		public[i] = PublicShare{Index: shares[i].Index, Value: c.Evaluate(shares[i].Index)}
+	}
+	pk, err := ReconstructInExponent(public[2:], threshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Equal(&c[0]) {
+		t.Fatal("wrong reconstruction in the exponent")
+	}
+	G, _ := Generators()
+	var expected curve.G1Affine
+	expected.ScalarMultiplication(&G, secret.BigInt(new(big.Int)))
+	if !pk.Equal(&expected) {
+		t.Fatal("commitment does not match the secret")
+	}
+}
+
+func TestPedersen(t *testing.T) {
+	const threshold, n = 3, 5
+
+	var secret, r fr.Element
+	secret.SetRandom()
+	r.SetRandom()
+	shares, f, err := Split(&secret, threshold, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blindings, fBlind, err := Split(&r, threshold, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := f.PedersenCommit(fBlind)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range shares {
+		if !c.Verify(&shares[i], &blindings[i]) {
+			t.Fatal("valid share rejected")
+		}
+	}
+	if c.Verify(&shares[0], &blindings[1]) {
+		t.Fatal("share with a wrong blinding accepted")
+	}
+	bad := shares[1]
+	bad.Value.Add(&bad.Value, &r)
+	if c.Verify(&bad, &blindings[1]) {
+		t.Fatal("invalid share accepted")
+	}
+
+	if _, err := f.PedersenCommit(fBlind[:1]); err != errDegreeMismatch {
+		t.Fatal("expected errDegreeMismatch")
+	}
+
+	G, H := Generators()
+	if G.Equal(&H) || !H.IsInSubGroup() {
+		t.Fatal("invalid generators")
+	}
+}
+
+func BenchmarkFeldmanVerify(b *testing.B) {
+	var secret fr.Element
+	secret.SetRandom()
+	shares, f, _ := Split(&secret, 16, 32)
+	c := f.FeldmanCommit()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Verify(&shares[i%len(shares)])
+	}
+}
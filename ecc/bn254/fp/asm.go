@@ -22,6 +22,9 @@ package fp
 import "golang.org/x/sys/cpu"
 
 var (
-	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
-	_          = supportAdx
+	supportAdx        = cpu.X86.HasADX && cpu.X86.HasBMI2
+	_                 = supportAdx
+	supportAvx2       = supportAdx && cpu.X86.HasAVX2
+	supportAvx512     = supportAdx && cpu.X86.HasAVX512
+	supportAvx512IFMA = supportAvx512 && cpu.X86.HasAVX512IFMA && cpu.X86.HasAVX512DQ
 )
@@ -1,5 +1,5 @@
-//go:build !amd64 || purego
-// +build !amd64 purego
+//go:build (!amd64 && !arm64) || purego
+// +build !amd64,!arm64 purego
 
 // Copyright 2020 ConsenSys Software Inc.
 //
@@ -18,9 +18,11 @@ package fri
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"hash"
+	"math"
 	"math/big"
 	"math/bits"
 
@@ -32,42 +34,27 @@ import (
 )
 
 var (
-	ErrLowDegree            = errors.New("the fully folded polynomial in not of degree 1")
+	ErrLowDegree            = errors.New("the fully folded polynomial does not match the folded evaluations")
 	ErrProximityTestFolding = errors.New("one round of interaction failed")
 	ErrOddSize              = errors.New("the size should be even")
 	ErrMerkleRoot           = errors.New("merkle roots of the opening and the proof of proximity don't coincide")
 	ErrMerklePath           = errors.New("merkle path proof is wrong")
 	ErrRangePosition        = errors.New("the asked opening position is out of range")
+	ErrClaimedValue         = errors.New("the claimed value does not match the opened leaf")
+	ErrProofOfWork          = errors.New("the proof of work is wrong")
+	ErrInvalidProof         = errors.New("the proof does not match the parameters of the iopp")
+	ErrPolynomialSize       = errors.New("the polynomial is too large")
+	ErrFoldingArity         = errors.New("the folding arity must be 2, 4, 8 or 16")
+	ErrRho                  = errors.New("ρ must be a power of 2 larger than 1")
+	ErrNbQueries            = errors.New("either the number of queries or the security level must be positive")
+	ErrGrindingBits         = errors.New("the number of grinding bits must be in [0, 64)")
+	ErrFinalDegree          = errors.New("the final degree must be non negative")
+	ErrSizeTooSmall         = errors.New("the size must be at least the folding arity")
 )
 
-const rho = 8
-
-const nbRounds = 1
-
-// 2^{-1}, used several times
-var twoInv fr.Element
-
 // Digest commitment of a polynomial.
 type Digest []byte
 
-// merkleProof helper structure to build the merkle proof
-// At each round, two contiguous values from the evaluated polynomial
-// are queried. For one value, the full Merkle path will be provided.
-// For the neighbor value, only the leaf is provided (so ProofSet will
-// be empty), since the Merkle path is the same as for the first value.
-type MerkleProof struct {
-
-	// Merkle root
-	MerkleRoot []byte
-
-	// ProofSet stores [leaf ∥ node_1 ∥ .. ∥ merkleRoot ], where the leaf is not
-	// hashed.
-	ProofSet [][]byte
-
-	// number of leaves of the tree.
-	numLeaves uint64
-}
-
 // MerkleProof used to open a polynomial
 type OpeningProof struct {
 
@@ -79,48 +66,27 @@ type OpeningProof struct {
 	numLeaves  uint64
 	index      uint64
 
-	// ClaimedValue value of the leaf. This field is exported
+	// ClaimedValue value of the polynomial at the opened position. This field is exported
 	// because it's needed for protocols using polynomial commitment
 	// schemes (to verify an algebraic relation).
 	ClaimedValue fr.Element
 }
 
-// IOPP Interactive Oracle Proof of Proximity
-type IOPP uint
+// Query contains the openings, for one query of the verifier, of the successive
+// folded functions on the fibers of the queried point.
+type Query struct {
 
-const (
-	// Multiplicative version of FRI, using the map x->x², on a
-	// power of 2 subgroup of Fr^{*}.
-	RADIX_2_FRI IOPP = iota
-)
-
-// round contains the data corresponding to a single round
-// of fri.
-// It consists of a list of Interactions between the prover and the verifier,
-// where each interaction contains a challenge provided by the verifier, as
-// well as MerkleProofs for the queries of the verifier. The Merkle proofs
-// correspond to the openings of the i-th folded polynomial at 2 points that
-// belong to the same fiber of x -> x².
-type Round struct {
-
-	// stores the Interactions between the prover and the verifier.
-	// Each interaction results in a set or merkle proofs, corresponding
-	// to the queries of the verifier.
-	Interactions [][2]MerkleProof
-
-	// evaluation stores the evaluation of the fully folded polynomial.
-	// The fully folded polynomial is constant, and is evaluated on a
-	// a set of size \rho. Since the polynomial is supposed to be constant,
-	// only one evaluation, corresponding to the polynomial, is given. Since
-	// the prover cannot know in advance which entry the verifier will query,
-	// providing a single evaluation
-	Evaluation fr.Element
+	// ProofSets[i] stores [leaf ∥ node_1 ∥ ..] for the i-th folded function, where the
+	// leaf is the concatenation of the evaluations on the queried fiber.
+	ProofSets [][][]byte
 }
 
 // ProofOfProximity proof of proximity, attesting that
 // a function is d-close to a low degree polynomial.
 //
-// It is composed of a series of Interactions, emulated with Fiat Shamir,
+// The prover commits to the evaluations of the successive folded functions, sends the
+// fully folded polynomial and answers the queries of the verifier. The interaction is
+// emulated with Fiat Shamir.
 type ProofOfProximity struct {
 
 	// ID unique ID attached to the proof of proximity. It's needed for
@@ -128,9 +94,18 @@ type ProofOfProximity struct {
 	// from the proof of proximity.
 	ID []byte
 
-	// round contains the data corresponding to a single round
-	// of fri. There are nbRounds rounds of Interactions.
-	Rounds []Round
+	// Roots of the Merkle trees of the evaluations of the successive folded functions.
+	// Roots[0] commits to the evaluations of the polynomial.
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the fully folded polynomial.
+	FinalPolynomial []fr.Element
+
+	// Nonce solution of the proof of work, if Params.GrindingBits > 0.
+	Nonce uint64
+
+	// Queries openings of the folded functions, one per query of the verifier.
+	Queries []Query
 }
 
 // Iopp interface that an iopp should implement
@@ -149,147 +124,286 @@ type Iopp interface {
 
 	// Verifies the opening of a polynomial at gⁱ where i = position.
 	VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error
+
+	// Params returns the parameters of the iopp, with the number of queries resolved.
+	Params() Params
 }
 
-// GetRho returns the factor ρ = size_code_word/size_polynomial
-func GetRho() int {
-	return rho
+// Params parameters of an IOPP.
+type Params struct {
+
+	// FoldingArity number of evaluations folded into one at each step, with the map
+	// x->x^FoldingArity. It must be 2, 4, 8 or 16.
+	FoldingArity int
+
+	// Rho factor ρ = size_code_word/size_polynomial, inverse of the rate of the Reed
+	// Solomon code. It must be a power of 2 larger than 1.
+	Rho int
+
+	// NbQueries number of queries of the verifier. If it is 0, it is derived from
+	// SecurityLevel.
+	NbQueries int
+
+	// SecurityLevel number of bits of security targeted when NbQueries is 0. Following
+	// the usual conjecture on the soundness of FRI, each query brings log₂(ρ) bits, and
+	// the grinding GrindingBits bits.
+	SecurityLevel int
+
+	// GrindingBits number of leading zero bits of the proof of work the prover solves
+	// before the queries are derived. 0 disables the grinding.
+	GrindingBits int
+
+	// FinalDegree the folding stops once the folded polynomial is of degree at most
+	// FinalDegree, or is smaller than FoldingArity. The prover then sends its coefficients.
+	FinalDegree int
 }
 
-func init() {
-	twoInv.SetUint64(2).Inverse(&twoInv)
+// DefaultParams returns the parameters of the radix-2 FRI, with ρ = 8, folding down to a
+// constant, and as many queries as needed for 128 bits of security.
+func DefaultParams() Params {
+	return Params{
+		FoldingArity:  2,
+		Rho:           8,
+		SecurityLevel: 128,
+	}
 }
 
-// New creates a new IOPP capable to handle degree(size) polynomials.
-func (iopp IOPP) New(size uint64, h hash.Hash) Iopp {
+// resolve checks the parameters, and sets the number of queries if it is 0.
+func (params Params) resolve() (Params, error) {
+	switch params.FoldingArity {
+	case 2, 4, 8, 16:
+	default:
+		return params, ErrFoldingArity
+	}
+	if params.Rho < 2 || params.Rho&(params.Rho-1) != 0 {
+		return params, ErrRho
+	}
+	if params.GrindingBits < 0 || params.GrindingBits >= 64 {
+		return params, ErrGrindingBits
+	}
+	if params.FinalDegree < 0 {
+		return params, ErrFinalDegree
+	}
+	if params.NbQueries < 0 || (params.NbQueries == 0 && params.SecurityLevel <= 0) {
+		return params, ErrNbQueries
+	}
+	if params.NbQueries == 0 {
+		bitsPerQuery := bits.TrailingZeros(uint(params.Rho))
+		nbBits := params.SecurityLevel - params.GrindingBits
+		params.NbQueries = int(math.Ceil(float64(nbBits) / float64(bitsPerQuery)))
+		if params.NbQueries < 1 {
+			params.NbQueries = 1
+		}
+	}
+	return params, nil
+}
+
+// IOPP Interactive Oracle Proof of Proximity
+type IOPP uint
+
+const (
+	// Multiplicative version of FRI, using the map x->xᵏ, where k is the folding arity,
+	// on a power of 2 subgroup of Fr^{*}.
+	MULTIPLICATIVE_FRI IOPP = iota
+
+	// Deprecated: the folding arity is set in Params, use MULTIPLICATIVE_FRI.
+	RADIX_2_FRI = MULTIPLICATIVE_FRI
+)
+
+// New creates a new IOPP capable to handle degree(size) polynomials, configured with params.
+func (iopp IOPP) New(size uint64, h hash.Hash, params Params) (Iopp, error) {
 	switch iopp {
-	case RADIX_2_FRI:
-		return newRadixTwoFri(size, h)
+	case MULTIPLICATIVE_FRI:
+		return newMultiplicativeFri(size, h, params)
 	default:
 		panic("iopp name is not recognized")
 	}
 }
 
-// radixTwoFri empty structs implementing compressionFunction for
-// the squaring function.
-type radixTwoFri struct {
+// multiplicativeFri implements the multiplicative FRI, folding the evaluations of
+// the polynomial on the fibers of x->xᵏ, where k is the folding arity.
+type multiplicativeFri struct {
 
 	// hash function that is used for Fiat Shamir and for committing to
 	// the oracles.
 	h hash.Hash
 
-	// nbSteps number of Interactions between the prover and the verifier
+	// parameters of the iopp, with the number of queries resolved
+	params Params
+
+	// nbSteps number of foldings
 	nbSteps int
 
+	// size of the polynomials, and of the fully folded polynomial
+	size, finalSize uint64
+
 	// domain used to build the Reed Solomon code from the given polynomial.
 	// The size of the domain is ρ*size_polynomial.
 	domain *fft.Domain
-}
 
-func newRadixTwoFri(size uint64, h hash.Hash) radixTwoFri {
+	// domain on which the fully folded polynomial is evaluated, of size ρ*finalSize
+	finalDomain *fft.Domain
 
-	var res radixTwoFri
+	// omegaInv[j] = ω⁻ʲ, where ω is the primitive k-th root of unity of domain
+	omegaInv []fr.Element
 
-	// computing the number of steps
-	n := ecc.NextPowerOfTwo(size)
-	nbSteps := bits.TrailingZeros(uint(n))
-	res.nbSteps = nbSteps
+	// k⁻¹
+	arityInv fr.Element
+}
 
-	// extending the domain
-	n = n * rho
+func newMultiplicativeFri(size uint64, h hash.Hash, params Params) (*multiplicativeFri, error) {
 
-	// building the domains
-	res.domain = fft.NewDomain(n)
+	params, err := params.resolve()
+	if err != nil {
+		return nil, err
+	}
+	k := uint64(params.FoldingArity)
 
-	// hash function
+	var res multiplicativeFri
 	res.h = h
+	res.params = params
 
-	return res
+	// computing the number of steps: the polynomial is folded at least once, and
+	// until it is of degree at most FinalDegree
+	res.size = ecc.NextPowerOfTwo(size)
+	if res.size < k {
+		return nil, ErrSizeTooSmall
+	}
+	res.finalSize = res.size / k
+	res.nbSteps = 1
+	for res.finalSize > uint64(params.FinalDegree+1) && res.finalSize >= k {
+		res.finalSize /= k
+		res.nbSteps++
+	}
+
+	// building the domains
+	res.domain = fft.NewDomain(res.size * uint64(params.Rho))
+	res.finalDomain = fft.NewDomain(res.finalSize * uint64(params.Rho))
+
+	// k-th roots of unity, to fold the evaluations on a fiber
+	var omegaInv fr.Element
+	omegaInv.Exp(res.domain.GeneratorInv, new(big.Int).SetUint64(res.domain.Cardinality/k))
+	res.omegaInv = make([]fr.Element, k)
+	res.omegaInv[0].SetOne()
+	for j := 1; j < len(res.omegaInv); j++ {
+		res.omegaInv[j].Mul(&res.omegaInv[j-1], &omegaInv)
+	}
+	res.arityInv.SetUint64(k).Inverse(&res.arityInv)
+
+	return &res, nil
 }
 
-// convertCanonicalSorted convert the index i, an entry in a
-// sorted polynomial, to the corresponding entry in canonical
-// representation. n is the size of the polynomial.
-func convertCanonicalSorted(i, n int) int {
+// Params returns the parameters of the iopp, with the number of queries resolved.
+func (s *multiplicativeFri) Params() Params {
+	return s.params
+}
 
-	if i < n/2 {
-		return 2 * i
-	} else {
-		l := n - (i + 1)
-		l = 2 * l
-		return n - l - 1
+// evaluate returns the evaluations of p on the domain, in natural order
+func (s *multiplicativeFri) evaluate(p []fr.Element) ([]fr.Element, error) {
+	if uint64(len(p)) > s.size {
+		return nil, ErrPolynomialSize
 	}
-
+	res := make([]fr.Element, s.domain.Cardinality)
+	copy(res, p)
+	s.domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res, nil
 }
 
-// deriveQueriesPositions derives the indices of the oracle
-// function that the verifier has to pick, in sorted form.
-// * pos is the initial position, i.e. the logarithm of the first challenge
-// * size is the size of the initial polynomial
-// * The result is a slice of []int, where each entry is a tuple (iₖ), such that
-// the verifier needs to evaluate ∑ₖ oracle(iₖ)xᵏ to build
-// the folded function.
-func (s radixTwoFri) deriveQueriesPositions(pos int, size int) []int {
+// leaves groups the evaluations of a function on a domain of size n (in natural
+// order) by fibers of x->xᵏ: the t-th leaf is the concatenation of the evaluations
+// at g^{t+j*n/k}, for j < k.
+func (s *multiplicativeFri) leaves(evaluations []fr.Element) [][]byte {
+	k := s.params.FoldingArity
+	m := len(evaluations) / k
+	res := make([][]byte, m)
+	for t := range res {
+		res[t] = make([]byte, 0, k*fr.Bytes)
+		for j := 0; j < k; j++ {
+			res[t] = append(res[t], evaluations[t+j*m].Marshal()...)
+		}
+	}
+	return res
+}
 
-	_s := size / 2
-	res := make([]int, s.nbSteps)
-	res[0] = pos
-	for i := 1; i < s.nbSteps; i++ {
-		t := (res[i-1] - (res[i-1] % 2)) / 2
-		res[i] = convertCanonicalSorted(t, _s)
-		_s = _s / 2
+// parseLeaf returns the evaluations concatenated in leaf
+func (s *multiplicativeFri) parseLeaf(leaf []byte) ([]fr.Element, error) {
+	if len(leaf) != s.params.FoldingArity*fr.Bytes {
+		return nil, ErrInvalidProof
+	}
+	res := make([]fr.Element, s.params.FoldingArity)
+	for j := range res {
+		if err := res[j].SetBytesCanonical(leaf[j*fr.Bytes : (j+1)*fr.Bytes]); err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
+// fold folds f, given by its evaluations on a domain of size n whose generator is g⁻¹ = gInv,
+// into the evaluations of ∑ⱼβʲfⱼ on the domain of size n/k, where f = ∑ⱼXʲfⱼ(Xᵏ).
+func (s *multiplicativeFri) fold(evaluations []fr.Element, gInv, beta fr.Element) []fr.Element {
+	k := s.params.FoldingArity
+	m := len(evaluations) / k
+	res := make([]fr.Element, m)
+	fiber := make([]fr.Element, k)
+	var xInv fr.Element
+	xInv.SetOne()
+	for t := range res {
+		for j := range fiber {
+			fiber[j] = evaluations[t+j*m]
+		}
+		res[t] = s.foldFiber(fiber, xInv, beta)
+		xInv.Mul(&xInv, &gInv)
+	}
 	return res
 }
 
-// sort orders the evaluation of a polynomial on a domain
-// such that contiguous entries are in the same fiber:
-// {q(g⁰), q(g^{n/2}), q(g¹), q(g^{1+n/2}),...,q(g^{n/2-1}), q(gⁿ⁻¹)}
-func sort(evaluations []fr.Element) []fr.Element {
-	q := make([]fr.Element, len(evaluations))
-	n := len(evaluations) / 2
-	for i := 0; i < n; i++ {
-		q[2*i].Set(&evaluations[i])
-		q[2*i+1].Set(&evaluations[i+n])
-	}
-	return q
+// foldFiber returns ∑ⱼβʲfⱼ(xᵏ) from vⱼ = f(xωʲ), where f = ∑ⱼXʲfⱼ(Xᵏ).
+//
+// Since vⱼ = ∑ₘωʲᵐ(xᵐfₘ(xᵏ)), the xᵐfₘ(xᵏ) are obtained with an inverse DFT of size k.
+func (s *multiplicativeFri) foldFiber(v []fr.Element, xInv, beta fr.Element) fr.Element {
+	k := len(v)
+	var res, c, tmp, r fr.Element
+
+	// res = ∑ₘ(βx⁻¹)ᵐ(xᵐfₘ(xᵏ))
+	r.Mul(&beta, &xInv)
+	for m := k - 1; m >= 0; m-- {
+		c.SetZero()
+		for j := 0; j < k; j++ {
+			tmp.Mul(&v[j], &s.omegaInv[(j*m)%k])
+			c.Add(&c, &tmp)
+		}
+		res.Mul(&res, &r).Add(&res, &c)
+	}
+	res.Mul(&res, &s.arityInv)
+
+	return res
 }
 
 // Opens a polynomial at gⁱ where i = position.
-func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error) {
+func (s *multiplicativeFri) Open(p []fr.Element, position uint64) (OpeningProof, error) {
 
 	// check that position is in the correct range
 	if position >= s.domain.Cardinality {
 		return OpeningProof{}, ErrRangePosition
 	}
 
-	// put q in evaluation form
-	q := make([]fr.Element, s.domain.Cardinality)
-	copy(q, p)
-	s.domain.FFT(q, fft.DIF)
-	fft.BitReverse(q)
-
-	// sort q to have fibers in contiguous entries. The goal is to have one
-	// Merkle path for both openings of entries which are in the same fiber.
-	q = sort(q)
-
-	// build the Merkle proof, we the position is converted to fit the sorted polynomial
-	pos := convertCanonicalSorted(int(position), len(q))
-
-	tree := merkletree.New(s.h)
-	err := tree.SetIndex(uint64(pos))
+	// put p in evaluation form
+	evaluations, err := s.evaluate(p)
 	if err != nil {
 		return OpeningProof{}, err
 	}
-	for i := 0; i < len(q); i++ {
-		tree.Push(q[i].Marshal())
-	}
-	var res OpeningProof
-	res.merkleRoot, res.ProofSet, res.index, res.numLeaves = tree.Prove()
 
-	// set the claimed value, which is the first entry of the Merkle proof
-	res.ClaimedValue.SetBytes(res.ProofSet[0])
+	// the leaves are the fibers of the first folding, as in the proof of proximity
+	tree := newMerkleTree(s.h, s.leaves(evaluations))
+
+	var res OpeningProof
+	res.numLeaves = s.domain.Cardinality / uint64(s.params.FoldingArity)
+	res.index = position % res.numLeaves
+	res.merkleRoot = tree.root()
+	res.ProofSet = tree.proofSet(res.index)
+	res.ClaimedValue.Set(&evaluations[position])
 
 	return res, nil
 }
@@ -299,400 +413,347 @@ func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error)
 // * openingProof Merkle path proof
 // * pp proof of proximity, needed because before opening Merkle path proof one should be sure that the
 // committed values come from a polynomial. During the verification of the Merkle path proof, the root
-// hash of the Merkle path is compared to the root hash of the first interaction of the proof of proximity,
-// those should be equal, if not an error is raised.
-func (s radixTwoFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
+// hash of the Merkle path is compared to the root hash of the first folded function of the proof of
+// proximity, those should be equal, if not an error is raised.
+func (s *multiplicativeFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
 
-	// To query the Merkle path, we look at the first series of Interactions, and check whether it's the point
-	// at 'position' or its neighbor that contains the full Merkle path.
-	var fullMerkleProof int
-	if len(pp.Rounds[0].Interactions[0][0].ProofSet) > len(pp.Rounds[0].Interactions[0][1].ProofSet) {
-		fullMerkleProof = 0
-	} else {
-		fullMerkleProof = 1
+	if position >= s.domain.Cardinality {
+		return ErrRangePosition
+	}
+	if len(pp.Roots) == 0 {
+		return ErrInvalidProof
 	}
 
 	// check that the merkle roots coincide
-	if !bytes.Equal(openingProof.merkleRoot, pp.Rounds[0].Interactions[0][fullMerkleProof].MerkleRoot) {
+	if !bytes.Equal(openingProof.merkleRoot, pp.Roots[0]) {
 		return ErrMerkleRoot
 	}
 
-	// convert position to the sorted version
-	sizePoly := s.domain.Cardinality
-	pos := convertCanonicalSorted(int(position), int(sizePoly))
-
-	// check the Merkle proof
-	res := merkletree.VerifyProof(s.h, openingProof.merkleRoot, openingProof.ProofSet, uint64(pos), openingProof.numLeaves)
-	if !res {
+	// check the Merkle proof of the fiber containing position
+	numLeaves := s.domain.Cardinality / uint64(s.params.FoldingArity)
+	if !merkletree.VerifyProof(s.h, pp.Roots[0], openingProof.ProofSet, position%numLeaves, numLeaves) {
 		return ErrMerklePath
 	}
-	return nil
-
-}
-
-// foldPolynomialLagrangeBasis folds a polynomial p, expressed in Lagrange basis.
-//
-// Fᵣ[X]/(Xⁿ-1) is a free module of rank 2 on Fᵣ[Y]/(Y^{n/2}-1). If
-// p∈ Fᵣ[X]/(Xⁿ-1), expressed in Lagrange basis, the function finds the coordinates
-// p₁, p₂ of p in Fᵣ[Y]/(Y^{n/2}-1), expressed in Lagrange basis. Finally, it computes
-// p₁ + x*p₂ and returns it.
-//
-// * p is the polynomial to fold, in Lagrange basis, sorted like this: p = [p(1),p(-1),p(g),p(-g),p(g²),p(-g²),...]
-// * g is a generator of the subgroup of Fᵣ^{*} of size len(p)
-// * x is the folding challenge x, used to return p₁+x*p₂
-func foldPolynomialLagrangeBasis(pSorted []fr.Element, gInv, x fr.Element) []fr.Element {
-
-	// we have the following system
-	// p₁(g²ⁱ)+gⁱp₂(g²ⁱ) = p(gⁱ)
-	// p₁(g²ⁱ)-gⁱp₂(g²ⁱ) = p(-gⁱ)
-	// we solve the system for p₁(g²ⁱ),p₂(g²ⁱ)
-	s := len(pSorted)
-	res := make([]fr.Element, s/2)
-
-	var p1, p2, acc fr.Element
-	acc.SetOne()
-
-	for i := 0; i < s/2; i++ {
-
-		p1.Add(&pSorted[2*i], &pSorted[2*i+1])
-		p2.Sub(&pSorted[2*i], &pSorted[2*i+1]).Mul(&p2, &acc)
-		res[i].Mul(&p2, &x).Add(&res[i], &p1).Mul(&res[i], &twoInv)
-
-		acc.Mul(&acc, &gInv)
 
+	// check the claimed value
+	fiber, err := s.parseLeaf(openingProof.ProofSet[0])
+	if err != nil {
+		return err
+	}
+	if !fiber[position/numLeaves].Equal(&openingProof.ClaimedValue) {
+		return ErrClaimedValue
 	}
 
-	return res
+	return nil
 }
 
-// buildProofOfProximitySingleRound generates a proof that a function, given as an oracle from
-// the verifier point of view, is in fact δ-close to a polynomial.
-// * salt is a variable for multi rounds, it allows to generate different challenges using Fiat Shamir
-// * p is in evaluation form
-func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.Element) (Round, error) {
-
-	// the proof will contain nbSteps Interactions
-	var res Round
-	res.Interactions = make([][2]MerkleProof, s.nbSteps)
-
-	// Fiat Shamir transcript to derive the challenges. The xᵢ are used to fold the
-	// polynomials.
-	// During the i-th round, the prover has a polynomial P of degree n. The verifier sends
-	// xᵢ∈ Fᵣ to the prover. The prover expresses F in Fᵣ[X,Y]/<Y-X²> as
-	// P₀(Y)+X P₁(Y) where P₀, P₁ are of degree n/2, and he then folds the polynomial
-	// by replacing x by xᵢ.
-	xis := make([]string, s.nbSteps+1)
+// challengesID returns the names of the challenges of the Fiat Shamir transcript: the
+// folding challenges βᵢ, the seed of the proof of work and the seed of the queries
+func (s *multiplicativeFri) challengesID() []string {
+	res := make([]string, s.nbSteps+2)
 	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
+		res[i] = fmt.Sprintf("beta%d", i)
 	}
-	xis[s.nbSteps] = "s0"
-	fs := fiatshamir.NewTranscript(s.h, xis...)
+	res[s.nbSteps] = "grinding"
+	res[s.nbSteps+1] = "queries"
+	return res
+}
 
-	// the salt is binded to the first challenge, to ensure the challenges
-	// are different at each round.
-	err := fs.Bind(xis[0], salt.Marshal())
+// deriveBeta binds the root of the i-th folded function to fs, and returns the i-th folding challenge
+func (s *multiplicativeFri) deriveBeta(fs *fiatshamir.Transcript, i int, root []byte) (fr.Element, error) {
+	var beta fr.Element
+	id := fmt.Sprintf("beta%d", i)
+	if err := fs.Bind(id, root); err != nil {
+		return beta, err
+	}
+	b, err := fs.ComputeChallenge(id)
 	if err != nil {
-		return Round{}, err
+		return beta, err
 	}
+	beta.SetBytes(b)
+	return beta, nil
+}
 
-	// step 1 : fold the polynomial using the xi
-
-	// evalsAtRound stores the list of the nbSteps polynomial evaluations, each evaluation
-	// corresponds to the evaluation o the folded polynomial at round i.
-	evalsAtRound := make([][]fr.Element, s.nbSteps)
-
-	// evaluate p and sort the result
-	_p := make([]fr.Element, s.domain.Cardinality)
-	copy(_p, p)
-
-	// gInv inverse of the generator of the cyclic group of size the size of the polynomial.
-	// The size of the cyclic group is ρ*s.domainSize, and not s.domainSize.
-	var gInv fr.Element
-	gInv.Set(&s.domain.GeneratorInv)
-
-	for i := 0; i < s.nbSteps; i++ {
-
-		evalsAtRound[i] = sort(_p)
-
-		// compute the root hash, needed to derive xi
-		t := merkletree.New(s.h)
-		for k := 0; k < len(_p); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
-		}
-		rh := t.Root()
-		err := fs.Bind(xis[i], rh)
-		if err != nil {
-			return res, err
+// deriveGrindingSeed binds the final polynomial to fs, and returns the seed of the proof of work
+func (s *multiplicativeFri) deriveGrindingSeed(fs *fiatshamir.Transcript, finalPolynomial []fr.Element) ([]byte, error) {
+	for i := range finalPolynomial {
+		if err := fs.Bind("grinding", finalPolynomial[i].Marshal()); err != nil {
+			return nil, err
 		}
+	}
+	return fs.ComputeChallenge("grinding")
+}
 
-		// derive the challenge
-		bxi, err := fs.ComputeChallenge(xis[i])
-		if err != nil {
-			return res, err
+// checkProofOfWork returns true if H(seed ∥ nonce) starts with GrindingBits zero bits
+func (s *multiplicativeFri) checkProofOfWork(seed []byte, nonce uint64) bool {
+	if s.params.GrindingBits == 0 {
+		return true
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], nonce)
+	s.h.Reset()
+	s.h.Write(seed)
+	s.h.Write(buf[:])
+	digest := s.h.Sum(nil)
+
+	nbZeros := 0
+	for _, b := range digest {
+		nbZeros += bits.LeadingZeros8(b)
+		if b != 0 || nbZeros >= s.params.GrindingBits {
+			break
 		}
-		var xi fr.Element
-		xi.SetBytes(bxi)
-
-		// fold _p, reusing its memory
-		_p = foldPolynomialLagrangeBasis(evalsAtRound[i], gInv, xi)
-
-		// g <- g²
-		gInv.Square(&gInv)
-
 	}
+	return nbZeros >= s.params.GrindingBits
+}
 
-	// last round, provide the evaluation. The fully folded polynomial is of size rho. It should
-	// correspond to the evaluation of a polynomial of degree 1 on ρ points, so those points
-	// are supposed to be on a line.
-	res.Evaluation.Set(&_p[0])
-
-	// step 2: provide the Merkle proofs of the queries
+// grind returns the smallest nonce solving the proof of work
+func (s *multiplicativeFri) grind(seed []byte) uint64 {
+	var nonce uint64
+	for !s.checkProofOfWork(seed, nonce) {
+		nonce++
+	}
+	return nonce
+}
 
-	// derive the verifier queries
-	err = fs.Bind(xis[s.nbSteps], res.Evaluation.Marshal())
-	if err != nil {
-		return res, err
+// deriveQueries binds the nonce to fs, and returns the queried fibers of the first
+// folding: the i-th one is H(seed ∥ i) mod the number of fibers.
+func (s *multiplicativeFri) deriveQueries(fs *fiatshamir.Transcript, nonce uint64) ([]uint64, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], nonce)
+	if err := fs.Bind("queries", buf[:]); err != nil {
+		return nil, err
 	}
-	binSeed, err := fs.ComputeChallenge(xis[s.nbSteps])
+	seed, err := fs.ComputeChallenge("queries")
 	if err != nil {
-		return res, err
+		return nil, err
 	}
-	var bPos, bCardinality big.Int
-	bPos.SetBytes(binSeed)
-	bCardinality.SetUint64(s.domain.Cardinality)
-	bPos.Mod(&bPos, &bCardinality)
-	si := s.deriveQueriesPositions(int(bPos.Uint64()), int(s.domain.Cardinality))
-
-	for i := 0; i < s.nbSteps; i++ {
 
-		// build proofs of queries at s[i]
-		t := merkletree.New(s.h)
-		err := t.SetIndex(uint64(si[i]))
-		if err != nil {
-			return res, err
-		}
-		for k := 0; k < len(evalsAtRound[i]); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
-		}
-		mr, ProofSet, _, numLeaves := t.Prove()
-
-		// c denotes the entry that contains the full Merkle proof. The entry 1-c will
-		// only contain 2 elements, which are the neighbor point, and the hash of the
-		// first point. The remaining of the Merkle path is common to both the original
-		// point and its neighbor.
-		c := si[i] % 2
-		res.Interactions[i][c] = MerkleProof{mr, ProofSet, numLeaves}
-		res.Interactions[i][1-c] = MerkleProof{
-			mr,
-			make([][]byte, 2),
-			numLeaves,
-		}
-		res.Interactions[i][1-c].ProofSet[0] = evalsAtRound[i][si[i]+1-2*c].Marshal()
+	res := make([]uint64, s.params.NbQueries)
+	var bPos, bNbFibers big.Int
+	bNbFibers.SetUint64(s.domain.Cardinality / uint64(s.params.FoldingArity))
+	for i := range res {
 		s.h.Reset()
-		_, err = s.h.Write(res.Interactions[i][c].ProofSet[0])
-		if err != nil {
-			return res, err
-		}
-		res.Interactions[i][1-c].ProofSet[1] = s.h.Sum(nil)
-
+		s.h.Write(seed)
+		binary.BigEndian.PutUint64(buf[:], uint64(i))
+		s.h.Write(buf[:])
+		bPos.SetBytes(s.h.Sum(nil))
+		res[i] = bPos.Mod(&bPos, &bNbFibers).Uint64()
 	}
-
 	return res, nil
-
 }
 
 // BuildProofOfProximity generates a proof that a function, given as an oracle from
 // the verifier point of view, is in fact δ-close to a polynomial.
-func (s radixTwoFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, error) {
+func (s *multiplicativeFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, error) {
 
-	// the proof will contain nbSteps Interactions
 	var proof ProofOfProximity
-	proof.Rounds = make([]Round, nbRounds)
 
 	// evaluate p
-	// evaluate p and sort the result
-	_p := make([]fr.Element, s.domain.Cardinality)
-	copy(_p, p)
-	s.domain.FFT(_p, fft.DIF)
-	fft.BitReverse(_p)
-
-	var err error
-	var salt, one fr.Element
-	one.SetOne()
-	for i := 0; i < nbRounds; i++ {
-		proof.Rounds[i], err = s.buildProofOfProximitySingleRound(salt, _p)
+	evaluations, err := s.evaluate(p)
+	if err != nil {
+		return proof, err
+	}
+
+	// Fiat Shamir transcript to derive the challenges. The βᵢ are used to fold the
+	// polynomials.
+	// During the i-th step, the prover has a polynomial P of size n. The verifier sends
+	// βᵢ∈ Fᵣ to the prover. The prover expresses P as ∑ⱼXʲPⱼ(Xᵏ) where the Pⱼ are of
+	// size n/k, and folds it into ∑ⱼβᵢʲPⱼ.
+	fs := fiatshamir.NewTranscript(s.h, s.challengesID()...)
+
+	// step 1: commit to the successive folded functions
+	trees := make([]*merkleTree, s.nbSteps)
+	proof.Roots = make([][]byte, s.nbSteps)
+	var gInv fr.Element
+	gInv.Set(&s.domain.GeneratorInv)
+	bArity := big.NewInt(int64(s.params.FoldingArity))
+	for i := 0; i < s.nbSteps; i++ {
+		trees[i] = newMerkleTree(s.h, s.leaves(evaluations))
+		proof.Roots[i] = trees[i].root()
+
+		beta, err := s.deriveBeta(fs, i, proof.Roots[i])
 		if err != nil {
 			return proof, err
 		}
-		salt.Add(&salt, &one)
+		evaluations = s.fold(evaluations, gInv, beta)
+
+		// g <- gᵏ
+		gInv.Exp(gInv, bArity)
 	}
 
-	return proof, nil
-}
+	// the fully folded polynomial, in canonical form
+	s.finalDomain.FFTInverse(evaluations, fft.DIF)
+	fft.BitReverse(evaluations)
+	proof.FinalPolynomial = evaluations[:s.finalSize]
 
-// verifyProofOfProximitySingleRound verifies the proof of proximity. It returns an error if the
-// verification fails.
-func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Round) error {
+	// step 2: proof of work
+	seed, err := s.deriveGrindingSeed(fs, proof.FinalPolynomial)
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = s.grind(seed)
 
-	// Fiat Shamir transcript to derive the challenges
-	xis := make([]string, s.nbSteps+1)
-	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
+	// step 3: open the folded functions on the queried fibers
+	queries, err := s.deriveQueries(fs, proof.Nonce)
+	if err != nil {
+		return proof, err
+	}
+	k := uint64(s.params.FoldingArity)
+	proof.Queries = make([]Query, len(queries))
+	for q, t := range queries {
+		proof.Queries[q].ProofSets = make([][][]byte, s.nbSteps)
+		n := s.domain.Cardinality / k
+		for i := 0; i < s.nbSteps; i++ {
+			t %= n
+			proof.Queries[q].ProofSets[i] = trees[i].proofSet(t)
+			n /= k
+		}
 	}
-	xis[s.nbSteps] = "s0"
-	fs := fiatshamir.NewTranscript(s.h, xis...)
 
-	xi := make([]fr.Element, s.nbSteps)
+	return proof, nil
+}
 
-	// the salt is binded to the first challenge, to ensure the challenges
-	// are different at each round.
-	err := fs.Bind(xis[0], salt.Marshal())
-	if err != nil {
-		return err
+// VerifyProofOfProximity verifies the proof, by replaying the transcript and checking
+// the foldings on each query.
+func (s *multiplicativeFri) VerifyProofOfProximity(proof ProofOfProximity) error {
+
+	if len(proof.Roots) != s.nbSteps ||
+		uint64(len(proof.FinalPolynomial)) != s.finalSize ||
+		len(proof.Queries) != s.params.NbQueries {
+		return ErrInvalidProof
 	}
 
-	for i := 0; i < s.nbSteps; i++ {
-		err := fs.Bind(xis[i], proof.Interactions[i][0].MerkleRoot)
-		if err != nil {
-			return err
-		}
-		bxi, err := fs.ComputeChallenge(xis[i])
-		if err != nil {
+	// Fiat Shamir transcript to derive the challenges
+	fs := fiatshamir.NewTranscript(s.h, s.challengesID()...)
+	betas := make([]fr.Element, s.nbSteps)
+	for i := range betas {
+		var err error
+		if betas[i], err = s.deriveBeta(fs, i, proof.Roots[i]); err != nil {
 			return err
 		}
-		xi[i].SetBytes(bxi)
 	}
-
-	// derive the verifier queries
-	// for i := 0; i < len(proof.evaluation); i++ {
-	// 	err := fs.Bind(xis[s.nbSteps], proof.evaluation[i].Marshal())
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// }
-	err = fs.Bind(xis[s.nbSteps], proof.Evaluation.Marshal())
+	seed, err := s.deriveGrindingSeed(fs, proof.FinalPolynomial)
 	if err != nil {
 		return err
 	}
-	binSeed, err := fs.ComputeChallenge(xis[s.nbSteps])
+	if !s.checkProofOfWork(seed, proof.Nonce) {
+		return ErrProofOfWork
+	}
+	queries, err := s.deriveQueries(fs, proof.Nonce)
 	if err != nil {
 		return err
 	}
-	var bPos, bCardinality big.Int
-	bPos.SetBytes(binSeed)
-	bCardinality.SetUint64(s.domain.Cardinality)
-	bPos.Mod(&bPos, &bCardinality)
-	si := s.deriveQueriesPositions(int(bPos.Uint64()), int(s.domain.Cardinality))
-
-	// for each round check the Merkle proof and the correctness of the folding
-
-	// current size of the polynomial
-	var accGInv fr.Element
-	accGInv.Set(&s.domain.GeneratorInv)
-	for i := 0; i < s.nbSteps; i++ {
-
-		// correctness of Merkle proof
-		// c is the entry containing the full Merkle proof.
-		c := si[i] % 2
-		res := merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][c].MerkleRoot,
-			proof.Interactions[i][c].ProofSet,
-			uint64(si[i]),
-			proof.Interactions[i][c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
-		}
 
-		// we verify the Merkle proof for the neighbor query, to do that we have
-		// to pick the full Merkle proof of the first entry, stripped off of the leaf and
-		// the first node. We replace the leaf and the first node by the leaf and the first
-		// node of the partial Merkle proof, since the leaf and the first node of both proofs
-		// are the only entries that differ.
-		ProofSet := make([][]byte, len(proof.Interactions[i][c].ProofSet))
-		copy(ProofSet[2:], proof.Interactions[i][c].ProofSet[2:])
-		ProofSet[0] = proof.Interactions[i][1-c].ProofSet[0]
-		ProofSet[1] = proof.Interactions[i][1-c].ProofSet[1]
-		res = merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][1-c].MerkleRoot,
-			ProofSet,
-			uint64(si[i]+1-2*c),
-			proof.Interactions[i][1-c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
+	for q := range queries {
+		if err := s.verifyQuery(queries[q], &proof.Queries[q], &proof, betas); err != nil {
+			return err
 		}
+	}
 
-		// correctness of the folding
-		if i < s.nbSteps-1 {
+	return nil
+}
 
-			var fe, fo, l, r, fn fr.Element
+// verifyQuery checks the Merkle proofs of the fibers opened by query, that the folded
+// evaluation on a fiber is in the next one, and that the last folded evaluation is
+// consistent with the final polynomial.
+func (s *multiplicativeFri) verifyQuery(t uint64, query *Query, proof *ProofOfProximity, betas []fr.Element) error {
 
-			// l = P(gⁱ), r = P(g^{i+n/2})
-			l.SetBytes(proof.Interactions[i][0].ProofSet[0])
-			r.SetBytes(proof.Interactions[i][1].ProofSet[0])
+	if len(query.ProofSets) != s.nbSteps {
+		return ErrInvalidProof
+	}
 
-			// (g^{si[i]}, g^{si[i]+1}) is the fiber of g^{2*si[i]}. The system to solve
-			// (for P₀(g^{2si[i]}), P₀(g^{2si[i]}) ) is:
-			// P(g^{si[i]}) = P₀(g^{2si[i]}) +  g^{si[i]/2}*P₀(g^{2si[i]})
-			// P(g^{si[i]+1}) = P₀(g^{2si[i]}) -  g^{si[i]/2}*P₀(g^{2si[i]})
-			bm := big.NewInt(int64(si[i] / 2))
-			var ginv fr.Element
-			ginv.Exp(accGInv, bm)
-			fe.Add(&l, &r)                                      // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Sub(&l, &r).Mul(&fo, &ginv)                      // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Mul(&fo, &xi[i]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
+	k := uint64(s.params.FoldingArity)
+	bArity := new(big.Int).SetUint64(k)
+	var gInv, xInv, folded fr.Element
+	gInv.Set(&s.domain.GeneratorInv)
 
-			fn.SetBytes(proof.Interactions[i+1][si[i+1]%2].ProofSet[0])
+	// number of fibers of the current folded function
+	n := s.domain.Cardinality / k
+	for i := 0; i < s.nbSteps; i++ {
 
-			if !fo.Equal(&fn) {
-				return ErrProximityTestFolding
-			}
+		// the folded evaluation of the previous step is at position t, which is
+		// the j-th entry of the fiber t mod n
+		j := t / n
+		t %= n
 
-			// next inverse generator
-			accGInv.Square(&accGInv)
+		if !merkletree.VerifyProof(s.h, proof.Roots[i], query.ProofSets[i], t, n) {
+			return ErrMerklePath
+		}
+		fiber, err := s.parseLeaf(query.ProofSets[i][0])
+		if err != nil {
+			return err
 		}
 
-	}
-
-	// last transition
-	var fe, fo, l, r fr.Element
-
-	l.SetBytes(proof.Interactions[s.nbSteps-1][0].ProofSet[0])
-	r.SetBytes(proof.Interactions[s.nbSteps-1][1].ProofSet[0])
-
-	_si := si[s.nbSteps-1] / 2
+		// correctness of the folding
+		if i > 0 && !fiber[j].Equal(&folded) {
+			return ErrProximityTestFolding
+		}
 
-	accGInv.Exp(accGInv, big.NewInt(int64(_si)))
+		// fold the fiber of g^t
+		xInv.Exp(gInv, new(big.Int).SetUint64(t))
+		folded = s.foldFiber(fiber, xInv, betas[i])
 
-	fe.Add(&l, &r)                                                // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Sub(&l, &r).Mul(&fo, &accGInv)                             // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Mul(&fo, &xi[s.nbSteps-1]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
+		// g <- gᵏ
+		gInv.Exp(gInv, bArity)
+		n /= k
+	}
 
-	// Last step: the final evaluation should be the evaluation of a degree 0 polynomial,
-	// so it must be constant.
-	if !fo.Equal(&proof.Evaluation) {
-		return ErrProximityTestFolding
+	// last step: the folded evaluation is the one of the final polynomial at g^t
+	var x, y fr.Element
+	x.Exp(s.finalDomain.Generator, new(big.Int).SetUint64(t))
+	for i := len(proof.FinalPolynomial) - 1; i >= 0; i-- {
+		y.Mul(&y, &x).Add(&y, &proof.FinalPolynomial[i])
+	}
+	if !y.Equal(&folded) {
+		return ErrLowDegree
 	}
 
 	return nil
 }
 
-// VerifyProofOfProximity verifies the proof, by checking each interaction one
-// by one.
-func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
+// merkleTree stores all the levels of a Merkle tree with a power of 2 number of leaves,
+// hashed as in the merkletree package, to produce several proofs without rebuilding it.
+type merkleTree struct {
+	leaves [][]byte
 
-	var salt, one fr.Element
-	one.SetOne()
-	for i := 0; i < nbRounds; i++ {
-		err := s.verifyProofOfProximitySingleRound(salt, proof.Rounds[i])
-		if err != nil {
-			return err
+	// levels[0] contains the hashes of the leaves, and the last level the root
+	levels [][][]byte
+}
+
+func newMerkleTree(h hash.Hash, leaves [][]byte) *merkleTree {
+	res := merkleTree{leaves: leaves}
+	level := make([][]byte, len(leaves))
+	for i := range leaves {
+		h.Reset()
+		h.Write(leaves[i])
+		level[i] = h.Sum(nil)
+	}
+	res.levels = append(res.levels, level)
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			h.Reset()
+			h.Write(level[2*i])
+			h.Write(level[2*i+1])
+			next[i] = h.Sum(nil)
 		}
-		salt.Add(&salt, &one)
+		res.levels = append(res.levels, next)
+		level = next
 	}
-	return nil
+	return &res
+}
+
+func (t *merkleTree) root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
 
+// proofSet returns [leaf ∥ node_1 ∥ ..], the Merkle proof of the i-th leaf
+func (t *merkleTree) proofSet(i uint64) [][]byte {
+	res := make([][]byte, 1, len(t.levels))
+	res[0] = t.leaves[i]
+	for l := 0; l < len(t.levels)-1; l++ {
+		res = append(res, t.levels[l][i^1])
+		i >>= 1
+	}
+	return res
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -166,6 +167,17 @@ func TestMulMod(t *testing.T) {
 // Test the fact that the limb decomposition allows obtaining the original
 // field element by evaluating the polynomial whose the coeffiients are the
 // limbs.
+func TestWithScheduler(t *testing.T) {
+	expected, err := NewRSis(5, 6, 8, 32)
+	require.NoError(t, err)
+
+	sis, err := NewRSis(5, 6, 8, 32, WithScheduler(scheduler.New(1)))
+	require.NoError(t, err)
+
+	require.Equal(t, expected.A, sis.A, "the key should not depend on the scheduler")
+	require.Equal(t, expected.Ag, sis.Ag, "the key should not depend on the scheduler")
+}
+
 func TestLimbDecomposition(t *testing.T) {
 
 	// Skipping the test for 32 bits
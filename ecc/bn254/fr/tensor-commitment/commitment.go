@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys Software Inc.
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,12 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
 package tensorcommitment
 
 import (
 	"bytes"
 	"errors"
 	"hash"
+	"math"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
@@ -33,6 +36,12 @@ var (
 	ErrProofFailedOob      = errors.New("the entry is out of bound")
 	ErrMaxNbColumns        = errors.New("the state is full")
 	ErrCommitmentNotDone   = errors.New("the proof cannot be built before the computation of the digest")
+	ErrNoPolynomial        = errors.New("no polynomial has been appended")
+	ErrNbRowsNotPowerOfTwo = errors.New("the number of rows must be a power of 2")
+	ErrInvalidPointSize    = errors.New("the size of the point does not match the size of the polynomials")
+	ErrInvalidProofSize    = errors.New("the sizes in the proof do not match the parameters")
+	ErrInvalidDigest       = errors.New("the encoded digest is invalid")
+	ErrProofFailedEval     = errors.New("the claimed values are inconsistent with the linear combination")
 )
 
 // commitment (TODO Merkle tree for that...)
@@ -80,6 +89,10 @@ type TcParams struct {
 	// Rho⁻¹, rate of the RS code ( > 1)
 	Rho int
 
+	// NbQueries number of columns opened by a MultilinearProof. NewTCParams sets it so that
+	// a polynomial far from the code passes the proximity test with probability 2⁻¹²⁸.
+	NbQueries int
+
 	// Function that returns a fresh hasher. The returned hash function is used for hashing the
 	// columns. We use this and not directly a hasher for threadsafety hasher. Indeed, if different
 	// thread share the same hasher, they will end up mixing hash inputs that should remain separate.
@@ -119,6 +132,9 @@ type TensorCommitment struct {
 
 	// counts the number of time `Append` was called (atomic).
 	NbAppendsSoFar int
+
+	// sizes of the polynomials appended so far, in order
+	sizes []int
 }
 
 // NewTensorCommitment returns a new TensorCommitment
@@ -140,12 +156,25 @@ func NewTCParams(codeRate, NbColumns, NbRows int, makeHash func() hash.Hash) (*T
 	// rate
 	res.Rho = codeRate
 
+	// number of columns opened by the multilinear proofs
+	res.NbQueries = nbQueries(codeRate)
+
 	// Hash function
 	res.MakeHash = makeHash
 
 	return &res, nil
 }
 
+// securityLevel is the number of bits of security targeted by the default number of queries
+const securityLevel = 128
+
+// nbQueries returns the number of columns to open so that a matrix whose rows are at
+// relative distance δ = (1-1/ρ)/3 from the code is caught with probability 1-2⁻¹²⁸.
+func nbQueries(rho int) int {
+	delta := (1 - 1/float64(rho)) / 3
+	return int(math.Ceil(securityLevel / -math.Log2(1-delta)))
+}
+
 // Initializes an instance of tensor commitment that we can use start
 // appending value into it
 func NewTensorCommitment(params *TcParams) *TensorCommitment {
@@ -211,6 +240,9 @@ func (tc *TensorCommitment) Append(ps ...[]fr.Element) ([][]byte, error) {
 	// have been appended so far and how many columns.
 	tc.NbAppendsSoFar += len(ps)
 	tc.NbColumnsHashed += totalNumberOfColumnsTakenByPs
+	for _, p := range ps {
+		tc.sizes = append(tc.sizes, len(p))
+	}
 
 	backupCurrentColumnToFill := currentColumnToFill
 
@@ -303,44 +335,12 @@ func (tc *TensorCommitment) Commit() (Digest, error) {
 
 }
 
-// BuildProofAtOnceForTest builds a proof to be tested against a previous commitment of a list of
-// polynomials.
-// * l the random linear coefficients used for the linear combination of size NbRows
-// * entryList list of columns to hash
-// l and entryList are supposed to be precomputed using Fiat Shamir
-//
-// The proof is the linear combination (using l) of the encoded rows of p written
-// as a matrix. Only the entries contained in entryList are kept.
-func (tc *TensorCommitment) BuildProofAtOnceForTest(l []fr.Element, entryList []int) (Proof, error) {
-	linComb, err := tc.ProverComputeLinComb(l)
-	if err != nil {
-		return Proof{}, err
-	}
-
-	openedColumns, err := tc.ProverOpenColumns(entryList)
-	if err != nil {
-		return Proof{}, err
-	}
-
-	return BuildProof(tc.params, linComb, entryList, openedColumns), nil
-}
-
-// func printVector(v []fr.Element) {
-// 	fmt.Printf("[")
-// 	for i := 0; i < len(v); i++ {
-// 		fmt.Printf("%s,", v[i].String())
-// 	}
-// 	fmt.Printf("]\n")
-// }
-
-// BuildProof builds a proof to be tested against a previous commitment of a list of
-// polynomials.
-// * l the random linear coefficients used for the linear combination of size NbRows
-// * entryList list of columns to hash
-// l and entryList are supposed to be precomputed using Fiat Shamir
+// ProverComputeLinComb returns the linear combination (using l) of the rows of the
+// state, before encoding.
+// * l the linear coefficients used for the linear combination of size NbRows
 //
-// The proof is the linear combination (using l) of the encoded rows of p written
-// as a matrix. Only the entries contained in entryList are kept.
+// l is either derived with Fiat Shamir, or is the tensor of a point at which the
+// polynomials are opened (see OpenMultilinear).
 func (tc *TensorCommitment) ProverComputeLinComb(l []fr.Element) ([]fr.Element, error) {
 
 	// check that the digest has been computed
@@ -364,6 +364,7 @@ func (tc *TensorCommitment) ProverComputeLinComb(l []fr.Element) ([]fr.Element,
 	return linComb, nil
 }
 
+// ProverOpenColumns returns the columns of the encoded state listed in entryList
 func (tc *TensorCommitment) ProverOpenColumns(entryList []int) ([][]fr.Element, error) {
 
 	// check that the digest has been computed
@@ -428,19 +429,26 @@ func evalAtPower(p []fr.Element, x fr.Element, n int) fr.Element {
 // digest: hash of the polynomial
 // l: random coefficients for the linear combination, chosen by the verifier
 // h: hash function that is used for hashing the columns of the polynomial
-// TODO make this function private and add a Verify function that derives
-// the randomness using Fiat Shamir
 //
-// Note (alex), A more convenient API would be to expose two functions,
-// one that does FS for you and what that let you do it for yourself. And likewise
-// for the prover.
+// The caller is responsible for deriving l and proof.EntryList. VerifyMultilinear
+// derives them with Fiat Shamir.
 func Verify(proof Proof, digest Digest, l []fr.Element, h hash.Hash) error {
 
+	// canonical form of the linear combination, to evaluate its encoding
+	linCombCanonical := make([]fr.Element, proof.Domain.Cardinality)
+	copy(linCombCanonical, proof.LinearCombination)
+	proof.Domain.FFTInverse(linCombCanonical, fft.DIF)
+	fft.BitReverse(linCombCanonical)
+
 	// for each entry in the list -> it corresponds to the sampling
 	// set on which we probabilistically check that
 	// Encoded(linear_combination) = linear_combination(encoded)
 	for i := 0; i < len(proof.EntryList); i++ {
 
+		if proof.EntryList[i] < 0 || proof.EntryList[i] >= len(digest) {
+			return ErrProofFailedOob
+		}
+
 		// check that the hash of the columns correspond to what's in the digest
 		h.Reset()
 		for j := 0; j < len(proof.Columns[i]); j++ {
@@ -451,10 +459,6 @@ func Verify(proof Proof, digest Digest, l []fr.Element, h hash.Hash) error {
 			return ErrProofFailedHash
 		}
 
-		if proof.EntryList[i] >= len(digest) {
-			return ErrProofFailedOob
-		}
-
 		// linear combination of the i-th column, whose entries
 		// are the entryList[i]-th entries of the encoded lines
 		// of p
@@ -467,12 +471,7 @@ func Verify(proof Proof, digest Digest, l []fr.Element, h hash.Hash) error {
 		}
 
 		// entry i of the encoded linear combination
-		var encodedLinComb fr.Element
-		linCombCanonical := make([]fr.Element, proof.Domain.Cardinality)
-		copy(linCombCanonical, proof.LinearCombination)
-		proof.Domain.FFTInverse(linCombCanonical, fft.DIF)
-		fft.BitReverse(linCombCanonical)
-		encodedLinComb = evalAtPower(linCombCanonical, proof.Generator, proof.EntryList[i])
+		encodedLinComb := evalAtPower(linCombCanonical, proof.Generator, proof.EntryList[i])
 
 		// compare both values
 		if !encodedLinComb.Equal(&linCombEncoded) {
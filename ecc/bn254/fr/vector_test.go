@@ -18,7 +18,9 @@ package fr
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/stretchr/testify/require"
+	"math/big"
 	"reflect"
 	"sort"
 	"testing"
@@ -80,6 +82,175 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 	assert.True(reflect.DeepEqual(v3, v2))
 }
 
+func TestVectorOps(t *testing.T) {
+	// sizes around the assembly block sizes
+	sizes := []int{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 31, 64, 255, 256, 257, 1000}
+	for _, n := range sizes {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			assert := require.New(t)
+
+			a, b := randomVector(n), randomVector(n)
+			var c Element
+			c.SetRandom()
+
+			res := make(Vector, n)
+
+			res.Add(a, b)
+			for i := 0; i < n; i++ {
+				var expected Element
+				expected.Add(&a[i], &b[i])
+				assert.True(res[i].Equal(&expected), "Add mismatch at %d", i)
+			}
+
+			res.Sub(a, b)
+			for i := 0; i < n; i++ {
+				var expected Element
+				expected.Sub(&a[i], &b[i])
+				assert.True(res[i].Equal(&expected), "Sub mismatch at %d", i)
+			}
+
+			res.Mul(a, b)
+			for i := 0; i < n; i++ {
+				var expected Element
+				expected.Mul(&a[i], &b[i])
+				assert.True(res[i].Equal(&expected), "Mul mismatch at %d", i)
+			}
+
+			res.ScalarMul(a, &c)
+			for i := 0; i < n; i++ {
+				var expected Element
+				expected.Mul(&a[i], &c)
+				assert.True(res[i].Equal(&expected), "ScalarMul mismatch at %d", i)
+			}
+
+			var sum, innerProduct Element
+			for i := 0; i < n; i++ {
+				var tmp Element
+				tmp.Mul(&a[i], &b[i])
+				innerProduct.Add(&innerProduct, &tmp)
+				sum.Add(&sum, &a[i])
+			}
+			s := a.Sum()
+			assert.True(s.Equal(&sum), "Sum mismatch")
+			ip := a.InnerProduct(b)
+			assert.True(ip.Equal(&innerProduct), "InnerProduct mismatch")
+
+			// the result may alias the operands
+			expected := make(Vector, n)
+			expected.Mul(a, a)
+			res = make(Vector, n)
+			copy(res, a)
+			res.Mul(res, res)
+			assert.True(reflect.DeepEqual(expected, res), "Mul with aliased operands")
+			expected.Add(a, b)
+			copy(res, a)
+			res.Add(res, b)
+			assert.True(reflect.DeepEqual(expected, res), "Add with aliased operands")
+		})
+	}
+}
+
+func TestVectorSumLargeValues(t *testing.T) {
+	assert := require.New(t)
+
+	// q - 1 maximizes the accumulated words
+	const n = 1025
+	v := make(Vector, n)
+	var expected Element
+	for i := range v {
+		v[i].SetOne()
+		v[i].Neg(&v[i])
+		expected.Add(&expected, &v[i])
+	}
+	s := v.Sum()
+	assert.True(s.Equal(&expected))
+
+	var nMinusOne Element
+	nMinusOne.SetInt64(-n)
+	assert.True(s.Equal(&nMinusOne))
+}
+
+func TestVectorExp(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 17
+	a := randomVector(n)
+	a[3].SetZero()
+	res := make(Vector, n)
+	for _, k := range []int64{0, 1, 2, 3, 5, 13, 1 << 40, -1, -2, -7} {
+		res.Exp(a, k)
+		for i := 0; i < n; i++ {
+			var expected Element
+			expected.Exp(a[i], big.NewInt(k))
+			assert.True(res[i].Equal(&expected), "Exp(%d) mismatch at %d", k, i)
+		}
+	}
+
+	// the result may alias the operand
+	expected := make(Vector, n)
+	expected.Exp(a, 5)
+	a.Exp(a, 5)
+	assert.True(reflect.DeepEqual(expected, a))
+}
+
+func TestVectorOpsLength(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := randomVector(3), randomVector(4)
+	res := make(Vector, 3)
+	assert.Panics(func() { res.Add(a, b) })
+	assert.Panics(func() { res.Sub(a, b) })
+	assert.Panics(func() { res.Mul(a, b) })
+	assert.Panics(func() { res.ScalarMul(b, &a[0]) })
+	assert.Panics(func() { a.InnerProduct(b) })
+	assert.Panics(func() { res.Exp(b, 2) })
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const N = 1 << 16
+	a, c := randomVector(N), randomVector(N)
+	res := make(Vector, N)
+
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("ScalarMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run("Sum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a.Sum()
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
+
+func randomVector(n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
 func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
 	r := bytes.NewReader(data)
 	_, err, chErr := vector.AsyncReadFrom(r)
@@ -65,3 +65,8 @@ func initCurveParams() {
 func mulByA(x *fr.Element) {
 	x.Neg(x)
 }
+
+// mulByAConstantTime is mulByA using the constant-time field operations
+func mulByAConstantTime(x *fr.Element) {
+	x.NegConstantTime(x)
+}
@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BN254_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x449221b3435000826966805000f47cdc59542eb462065d44478efb930d70c1f", "0x18a1a6130f9ccef9d90fc7029d56ec68f96ff83706e0c3294842eb87429bf4a6"},
+				Q: point{"0x15cb5422dc9c0476210f1f19bdd5c7c8752ad20a4565d8f39e095b6fb98bc56b", "0xdc19f043c7a80d59253001df78247e0ffcb0e735a7713e06ed1697c29afbcfe"},
+				u: "0x3a7eca1aec82ccef49fcfbe15128af7b97b1a7ad3e38c5285fc6c8706f9495e",
+			},
+			{
+				msg: "abc", P: point{"0x1bf0c55bb17a55c263feec02b476a5511a7c05cba26f0e04c8092f69810fda2f", "0x1e3c40f635707d92b345c3e7640c40bd8c54fd8f93f39802f7f02ee5cbaf1de3"},
+				Q: point{"0x1d00bc9a22d31e271cbf26fd4dbcb59e7b8abde1b3e95afe994c5e8dd7295b43", "0x2cf9c6fc6180609c084cf486dbad6f13e832bdf3baf337ff77b70eb97985b92b"},
+				u: "0x2a2b7f9ceae78c3999dc0d265d8f8c91c74f8ca0dd264c4c69b91509e62605c1",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x24c5d69c222b6c48bc94fdb5b82a1c836ac8cd529890b4e5e06240d4ae6405b2", "0x2de608d97245ba2d85066d82dd2628f05889e419a23624756f5a124f71937a34"},
+				Q: point{"0x2c7aac674aa04f6442b7733d5c6ff5772ce5094c21aafaf9ff681904ad06d9ab", "0x216611ad4c0047e5e65ea1bdf5c3e12fbf103eb42b80de1712c469918c3c5244"},
+				u: "0x2cc76939c35ab4d2d37adcd85581531fe4f97e99ef304a52d1e0efb8fdaa2b79",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x2bdeb4b66fd5d8b0ec5aec78918767602ffd70771e93416fada137e4a79e4226", "0x8a9e7653e8d355344c3ad1cd665edffab0dd30194626d8b723a0994cc59e9bb"},
+				Q: point{"0x1df45acaa47bb4b164a5fc332145d91b997588857031d4918f6c28d571e2cb4e", "0x241cfaf511917da4be4b5c519e876fd35ad98fc56702b683a18a5d0939e3124e"},
+				u: "0x179290a374dab7012d256cefe3e628d167adcbd14c4f51ce560c3aa1f4d48374",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x924f1af658d8fc841bddcd1de67a89720bb0a1f633e4a5ace7c187cd2fe77c1", "0x23264886713286f8a7567a07e00152270ec549bfdbf882eaa673bc2c664f14b7"},
+				Q: point{"0xf7b90710364a63630aad5f417483054e42360227cc7be8b6ae05bd1324a1795", "0x89f837fe80cc84a3eedb1bee85c55146ec04beb695a5cec001a0844d37113e9"},
+				u: "0xfe9f85d48e3eb42e57b5b9e30b0cb1b9feec69ea3993e1210ceecc576d84839",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BN254_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x2ebfcf0b878d9baa4e907a2d9c8ca1b41c55952f28184ceb49e5caa33a11e11d", "0x15047a583d58892c30074c5f7dbe72595b68706c2b13a4524a8183c201e4b643"},
+				Q0: point{"0x2d6efba336ac3823d4bcf1140f08c6e6f11033f6d385e6bdfc2d8d06a9fd41b8", "0x9c3a81ac1af5c163c2d83fe2d3d492bff9b9be4c262864a14d344f625b13df6"},
+				Q1: point{"0x1dad5562134055b240031d60f725bbe4c229ddb324bef8c5bd5c362267133253", "0xd1ad7240c9972e43df11d45e804b292ca8fe58b0184619b37e06ecb68657506"},
+				u0: "0x207efffc06a9e0e07a34be8d90abbaebff92608fcfc3872bfca22562735c7b28", u1: "0xfb8a9e56a5353178c1e60e6adeef68fd4c85803185835ec0c84a201e4b34823",
+			},
+			{
+				msg: "abc", P: point{"0x2c4ded8164788b1b14ac0ab61669833de3aefb135348a550f4d2ced5d2014107", "0x1857685b55845335f1b81380b74c5637d7b2251f16d9d60ff3987af68b9f702f"},
+				Q0: point{"0x256489b514fb836f12053c11e6f31fa474e412774428e1aba912488ff6938dea", "0x167b894c5378bad103f85b5795eb42a454bf0788841f3cd2b5adcdb9e14604c4"},
+				Q1: point{"0x15cc969869a092a68fa48701476a1bd4bb9bbf50f2b85fbc33674fc48da02f1f", "0x128b43048fe03186821a228ca9b73d5148baa8496962cfb8959cf72886dd5b7"},
+				u0: "0x1cc94d86b44726b7f853873421d9728bc9b0198179ec1d5d9727bd80dcf968e2", u1: "0x2160f401e2307381df615f7c0fd946f68342ab96ee7f391453478c29a41eddf9",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x11b076be6035ce689af60ac2e89286586bb2a20e6ef615115116cccf39b6316f", "0x2b1c99ad7328c4edd8fcb4c9b1936a5c53dda019164f19cecfbf33193eac50d0"},
+				Q0: point{"0xbed3a3baafc8c5645a230845091fb1b4cdfe5a3e2d4df1e3e6c8455bb884c22", "0x9cfd50ba1e80e1a073c290476de015fa016f0778f49e136612d8a1f581d147b"},
+				Q1: point{"0xd401a4901da36c9ce92e3b56357d80f772233bb3402367e6348e488f2bc7f26", "0x37189ddb430969d3587e2a0a9faafcc4292591ff2d51c6d3341bdcad3d7f7e0"},
+				u0: "0x276b6e4ba16e1e203567a7da7c713090ceeff0f07d5e9d210e6c35660a5224b1", u1: "0x30199faeab8531fdad21f2049a6c424aa880b24c44cb1fa09ca2a601d51e08e7",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x1db21431b5c48e79aeab997b7e81916f15d31cb925bed665d3943b5ed533173a", "0x232ba630f54f1a55215901c5303bded6460389b86e20aaa5923899b16002e1a3"},
+				Q0: point{"0x2a9a933522d32095c02950db49f76939272b1cdf4d4bc2c81cfb57e1ebbbd1b7", "0x2727a0a9bf1c967232c7e7b0fe3e80eba6bc429855d98599f4645c71242af95"},
+				Q1: point{"0xda75ee5b997eb7f2582b00e7766956101e03d70d5876a832e072d16a95e43f5", "0x2ca71bbf7066628a67f49c33de10523d29e10a8eca9d1952e740566b40dee78d"},
+				u0: "0xd44b26c2f5cf5961743f90d0e052642a5402669f26fbe0d064d87621b75a77f", u1: "0x2889c3f686f2f9e64c1c029a1da6bc67d675ff8cf4e90871a36ab90dd963107e",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x25aaf1552f93670af71be96e4d896f48d38b8aab38550adfa4f68a40a94317d4", "0x2dad6889367cbf6c8c14bd6c97d340cb495e34fc6bc03c56cc6d91914233e8bd"},
+				Q0: point{"0xf98cafd6b472108fa2ccd6c2fef2acfc3f6312567edae30cbd1e58ee59c1e1e", "0xa32b28ce359be37b3978336150191c6a3cf0ca0f7eea2941dac7f3d65407532"},
+				Q1: point{"0x2400dfe70a6c217f74530a2a39748ea5fb7c50f66b020051accd8044c90b1e04", "0x1f9ec9273607eb866bccd6cf5e464e17cfcc53b5b20d9e9f50afc96788935ffc"},
+				u0: "0xdc771c85e3013f713f890e0682e90afed9ee5ed5f052d26e1a684a8057f1007", u1: "0x2d751568393617b14e4e74a47d5eb3173b8be60123d4671b79ab9799e5a22f2",
+			},
+		}}
+}
@@ -0,0 +1,22 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package zeromorph provides the Zeromorph commitment scheme for multilinear polynomials
+// (https://eprint.iacr.org/2023/917), on top of the univariate kzg SRS.
+//
+// A polynomial.MultiLin f in n variables is committed to as the kzg commitment of
+// ∑_{b∈{0,1}ⁿ}f(b)X^b. An evaluation proof at a point of 𝔽ⁿ has n+2 points of G1.
+package zeromorph
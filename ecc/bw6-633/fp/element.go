@@ -2281,6 +2281,976 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+// AddConstantTime z = x + y (mod q)
+//
+// Unlike Add, it does not branch on x, y or the result; it should be used when they are secret.
+func (z *Element) AddConstantTime(x, y *Element) *Element {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	z[4], carry = bits.Add64(x[4], y[4], carry)
+	z[5], carry = bits.Add64(x[5], y[5], carry)
+	z[6], carry = bits.Add64(x[6], y[6], carry)
+	z[7], carry = bits.Add64(x[7], y[7], carry)
+	z[8], carry = bits.Add64(x[8], y[8], carry)
+	z[9], carry = bits.Add64(x[9], y[9], carry)
+
+	// if the last addition overflowed or z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		t[4], b = bits.Sub64(z[4], q4, b)
+		t[5], b = bits.Sub64(z[5], q5, b)
+		t[6], b = bits.Sub64(z[6], q6, b)
+		t[7], b = bits.Sub64(z[7], q7, b)
+		t[8], b = bits.Sub64(z[8], q8, b)
+		t[9], b = bits.Sub64(z[9], q9, b)
+		// keep z iff carry == 0 and z < q
+		mask := -(b &^ carry)
+		z[0] = t[0] ^ (mask & (t[0] ^ z[0]))
+		z[1] = t[1] ^ (mask & (t[1] ^ z[1]))
+		z[2] = t[2] ^ (mask & (t[2] ^ z[2]))
+		z[3] = t[3] ^ (mask & (t[3] ^ z[3]))
+		z[4] = t[4] ^ (mask & (t[4] ^ z[4]))
+		z[5] = t[5] ^ (mask & (t[5] ^ z[5]))
+		z[6] = t[6] ^ (mask & (t[6] ^ z[6]))
+		z[7] = t[7] ^ (mask & (t[7] ^ z[7]))
+		z[8] = t[8] ^ (mask & (t[8] ^ z[8]))
+		z[9] = t[9] ^ (mask & (t[9] ^ z[9]))
+	}
+	return z
+}
+
+// DoubleConstantTime z = x + x (mod q), see AddConstantTime
+func (z *Element) DoubleConstantTime(x *Element) *Element {
+	return z.AddConstantTime(x, x)
+}
+
+// SubConstantTime z = x - y (mod q)
+//
+// Unlike Sub, it does not branch on x, y or the result; it should be used when they are secret.
+func (z *Element) SubConstantTime(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+	z[6], b = bits.Sub64(x[6], y[6], b)
+	z[7], b = bits.Sub64(x[7], y[7], b)
+	z[8], b = bits.Sub64(x[8], y[8], b)
+	z[9], b = bits.Sub64(x[9], y[9], b)
+	// if x < y → z += q
+	mask := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	z[1], c = bits.Add64(z[1], q1&mask, c)
+	z[2], c = bits.Add64(z[2], q2&mask, c)
+	z[3], c = bits.Add64(z[3], q3&mask, c)
+	z[4], c = bits.Add64(z[4], q4&mask, c)
+	z[5], c = bits.Add64(z[5], q5&mask, c)
+	z[6], c = bits.Add64(z[6], q6&mask, c)
+	z[7], c = bits.Add64(z[7], q7&mask, c)
+	z[8], c = bits.Add64(z[8], q8&mask, c)
+	z[9], _ = bits.Add64(z[9], q9&mask, c)
+	return z
+}
+
+// NegConstantTime z = q - x
+//
+// Unlike Neg, it does not branch on x; it should be used when x is secret.
+func (z *Element) NegConstantTime(x *Element) *Element {
+	// mask == 0 iff x == 0
+	acc := x[0] | x[1] | x[2] | x[3] | x[4] | x[5] | x[6] | x[7] | x[8] | x[9]
+	mask := -((acc | -acc) >> 63)
+	var borrow uint64
+	z[0], borrow = bits.Sub64(q0, x[0], 0)
+	z[1], borrow = bits.Sub64(q1, x[1], borrow)
+	z[2], borrow = bits.Sub64(q2, x[2], borrow)
+	z[3], borrow = bits.Sub64(q3, x[3], borrow)
+	z[4], borrow = bits.Sub64(q4, x[4], borrow)
+	z[5], borrow = bits.Sub64(q5, x[5], borrow)
+	z[6], borrow = bits.Sub64(q6, x[6], borrow)
+	z[7], borrow = bits.Sub64(q7, x[7], borrow)
+	z[8], borrow = bits.Sub64(q8, x[8], borrow)
+	z[9], _ = bits.Sub64(q9, x[9], borrow)
+	z[0] &= mask
+	z[1] &= mask
+	z[2] &= mask
+	z[3] &= mask
+	z[4] &= mask
+	z[5] &= mask
+	z[6] &= mask
+	z[7] &= mask
+	z[8] &= mask
+	z[9] &= mask
+	return z
+}
+
+// MulConstantTime z = x * y (mod q)
+//
+// Unlike Mul, it does not branch on x, y or the result, and is never dispatched to assembly;
+// it should be used when they are secret.
+//
+// x and y must be less than q
+func (z *Element) MulConstantTime(x, y *Element) *Element {
+	// see Mul for the algorithm
+
+	var t0, t1, t2, t3, t4, t5, t6, t7, t8, t9 uint64
+	var u0, u1, u2, u3, u4, u5, u6, u7, u8, u9 uint64
+	{
+		var c0, c1, c2 uint64
+		v := x[0]
+		u0, t0 = bits.Mul64(v, y[0])
+		u1, t1 = bits.Mul64(v, y[1])
+		u2, t2 = bits.Mul64(v, y[2])
+		u3, t3 = bits.Mul64(v, y[3])
+		u4, t4 = bits.Mul64(v, y[4])
+		u5, t5 = bits.Mul64(v, y[5])
+		u6, t6 = bits.Mul64(v, y[6])
+		u7, t7 = bits.Mul64(v, y[7])
+		u8, t8 = bits.Mul64(v, y[8])
+		u9, t9 = bits.Mul64(v, y[9])
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, 0, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[1]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[2]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[3]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[4]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[5]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[6]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[7]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[8]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[9]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+		u4, c1 = bits.Mul64(v, y[4])
+		t4, c0 = bits.Add64(c1, t4, c0)
+		u5, c1 = bits.Mul64(v, y[5])
+		t5, c0 = bits.Add64(c1, t5, c0)
+		u6, c1 = bits.Mul64(v, y[6])
+		t6, c0 = bits.Add64(c1, t6, c0)
+		u7, c1 = bits.Mul64(v, y[7])
+		t7, c0 = bits.Add64(c1, t7, c0)
+		u8, c1 = bits.Mul64(v, y[8])
+		t8, c0 = bits.Add64(c1, t8, c0)
+		u9, c1 = bits.Mul64(v, y[9])
+		t9, c0 = bits.Add64(c1, t9, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		t4, c0 = bits.Add64(u3, t4, c0)
+		t5, c0 = bits.Add64(u4, t5, c0)
+		t6, c0 = bits.Add64(u5, t6, c0)
+		t7, c0 = bits.Add64(u6, t7, c0)
+		t8, c0 = bits.Add64(u7, t8, c0)
+		t9, c0 = bits.Add64(u8, t9, c0)
+		c2, _ = bits.Add64(u9, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+		t2, c0 = bits.Add64(t3, c1, c0)
+		u4, c1 = bits.Mul64(m, q4)
+		t3, c0 = bits.Add64(t4, c1, c0)
+		u5, c1 = bits.Mul64(m, q5)
+		t4, c0 = bits.Add64(t5, c1, c0)
+		u6, c1 = bits.Mul64(m, q6)
+		t5, c0 = bits.Add64(t6, c1, c0)
+		u7, c1 = bits.Mul64(m, q7)
+		t6, c0 = bits.Add64(t7, c1, c0)
+		u8, c1 = bits.Mul64(m, q8)
+		t7, c0 = bits.Add64(t8, c1, c0)
+		u9, c1 = bits.Mul64(m, q9)
+
+		t8, c0 = bits.Add64(0, c1, c0)
+		u9, _ = bits.Add64(u9, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		t3, c0 = bits.Add64(u3, t3, c0)
+		t4, c0 = bits.Add64(u4, t4, c0)
+		t5, c0 = bits.Add64(u5, t5, c0)
+		t6, c0 = bits.Add64(u6, t6, c0)
+		t7, c0 = bits.Add64(u7, t7, c0)
+		t8, c0 = bits.Add64(u8, t8, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t8, c0 = bits.Add64(t9, t8, 0)
+		t9, _ = bits.Add64(u9, c2, c0)
+
+	}
+	z[0] = t0
+	z[1] = t1
+	z[2] = t2
+	z[3] = t3
+	z[4] = t4
+	z[5] = t5
+	z[6] = t6
+	z[7] = t7
+	z[8] = t8
+	z[9] = t9
+
+	// if z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		t[4], b = bits.Sub64(z[4], q4, b)
+		t[5], b = bits.Sub64(z[5], q5, b)
+		t[6], b = bits.Sub64(z[6], q6, b)
+		t[7], b = bits.Sub64(z[7], q7, b)
+		t[8], b = bits.Sub64(z[8], q8, b)
+		t[9], b = bits.Sub64(z[9], q9, b)
+		// keep z iff z < q
+		mask := -(b)
+		z[0] = t[0] ^ (mask & (t[0] ^ z[0]))
+		z[1] = t[1] ^ (mask & (t[1] ^ z[1]))
+		z[2] = t[2] ^ (mask & (t[2] ^ z[2]))
+		z[3] = t[3] ^ (mask & (t[3] ^ z[3]))
+		z[4] = t[4] ^ (mask & (t[4] ^ z[4]))
+		z[5] = t[5] ^ (mask & (t[5] ^ z[5]))
+		z[6] = t[6] ^ (mask & (t[6] ^ z[6]))
+		z[7] = t[7] ^ (mask & (t[7] ^ z[7]))
+		z[8] = t[8] ^ (mask & (t[8] ^ z[8]))
+		z[9] = t[9] ^ (mask & (t[9] ^ z[9]))
+	}
+	return z
+}
+
+// SquareConstantTime z = x * x (mod q), see MulConstantTime
+func (z *Element) SquareConstantTime(x *Element) *Element {
+	return z.MulConstantTime(x, x)
+}
+
+// InverseConstantTime z = x⁻¹ (mod q) = x**(q-2) (mod q)
+//
+// Unlike Inverse, the sequence of operations does not depend on x;
+// it should be used when x is secret. If x == 0, sets and returns z = x.
+func (z *Element) InverseConstantTime(x *Element) *Element {
+	// e == q-2 is public
+	e := Modulus()
+	e.Sub(e, big.NewInt(2))
+
+	var res Element
+	res.Set(x)
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		res.SquareConstantTime(&res)
+		if e.Bit(i) == 1 {
+			res.MulConstantTime(&res, x)
+		}
+	}
+
+	return z.Set(&res)
+}
+
+// BytesConstantTime returns the value of z as a big-endian byte array.
+//
+// Unlike Bytes, it does not branch on z; it should be used when z is secret.
+func (z *Element) BytesConstantTime() (res [Bytes]byte) {
+	// the Montgomery multiplication by 1 converts z out of Montgomery form,
+	// without the conditional subtraction of fromMont
+	var _z Element
+	_z.MulConstantTime(z, &Element{1})
+	binary.BigEndian.PutUint64(res[72:80], _z[0])
+	binary.BigEndian.PutUint64(res[64:72], _z[1])
+	binary.BigEndian.PutUint64(res[56:64], _z[2])
+	binary.BigEndian.PutUint64(res[48:56], _z[3])
+	binary.BigEndian.PutUint64(res[40:48], _z[4])
+	binary.BigEndian.PutUint64(res[32:40], _z[5])
+	binary.BigEndian.PutUint64(res[24:32], _z[6])
+	binary.BigEndian.PutUint64(res[16:24], _z[7])
+	binary.BigEndian.PutUint64(res[8:16], _z[8])
+	binary.BigEndian.PutUint64(res[0:8], _z[9])
+	return
+}
+
+// SetBytesConstantTime interprets e as the bytes of a big-endian unsigned integer of any length,
+// sets z to that value (mod q), and returns z.
+//
+// Unlike SetBytes, it only branches on the length of e, not on its content;
+// it should be used when e is secret.
+func (z *Element) SetBytesConstantTime(e []byte) *Element {
+	// the chunks c of e are accumulated as if they were Montgomery forms (i.e. as c ⋅ R⁻¹),
+	// and the result is multiplied by R at the end.
+	const chunkSize = 8
+	shift := Element{0, 1}
+	shift.Mul(&shift, &rSquare) // 2^(8*chunkSize), public
+
+	var res, c Element
+	for len(e) > 0 {
+		n := len(e) % chunkSize
+		if n == 0 {
+			n = chunkSize
+		}
+		var w uint64
+		for _, b := range e[:n] {
+			w = w<<8 | uint64(b)
+		}
+		e = e[n:]
+		c[0] = w
+		res.MulConstantTime(&res, &shift).AddConstantTime(&res, &c)
+	}
+
+	return z.MulConstantTime(&res, &rSquare)
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
@@ -0,0 +1,1512 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $0x6fe802ff40300001, R17
+	MOVD $0x421ee5da52bde502, R19
+	MOVD $0xdec1d01aa27a1ae0, R20
+	MOVD $0xd3f7498be97c5eaf, R21
+	MOVD $0x04c23a02b586d650, R22
+	MOVD x+8(FP), R0
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	MOVD 32(R0), R5
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R11
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R1, R11, R6
+	UMULH R1, R11, R13
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R12
+	MUL  R12, R6, R12
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R12, R17, R15
+	UMULH R12, R17, R14
+	ADDS  R6, R15, R15
+	ADC   ZR, R14, R14
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R2, R11, R7
+	UMULH R2, R11, R16
+	ADDS  R13, R7, R7
+	ADC   ZR, R16, R13
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R12, R19, R15
+	UMULH R12, R19, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R7, R15, R6
+	ADC   ZR, R16, R14
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R3, R11, R8
+	UMULH R3, R11, R16
+	ADDS  R13, R8, R8
+	ADC   ZR, R16, R13
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R12, R20, R15
+	UMULH R12, R20, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R8, R15, R7
+	ADC   ZR, R16, R14
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R4, R11, R9
+	UMULH R4, R11, R16
+	ADDS  R13, R9, R9
+	ADC   ZR, R16, R13
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R12, R21, R15
+	UMULH R12, R21, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R9, R15, R8
+	ADC   ZR, R16, R14
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R5, R11, R10
+	UMULH R5, R11, R16
+	ADDS  R13, R10, R10
+	ADC   ZR, R16, R13
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R12, R22, R15
+	UMULH R12, R22, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R10, R15, R9
+	ADC   ZR, R16, R14
+
+	// t[4] = C + A
+	ADD  R14, R13, R10
+	MOVD 8(R0), R11
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R1, R11, R15
+	UMULH R1, R11, R16
+	ADDS  R15, R6, R6
+	ADC   ZR, R16, R13
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R12
+	MUL  R12, R6, R12
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R12, R17, R15
+	UMULH R12, R17, R14
+	ADDS  R6, R15, R15
+	ADC   ZR, R14, R14
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R2, R11, R15
+	UMULH R2, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R7, R7
+	ADC   ZR, R16, R13
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R12, R19, R15
+	UMULH R12, R19, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R7, R15, R6
+	ADC   ZR, R16, R14
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R3, R11, R15
+	UMULH R3, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R8, R8
+	ADC   ZR, R16, R13
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R12, R20, R15
+	UMULH R12, R20, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R8, R15, R7
+	ADC   ZR, R16, R14
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R4, R11, R15
+	UMULH R4, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R9, R9
+	ADC   ZR, R16, R13
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R12, R21, R15
+	UMULH R12, R21, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R9, R15, R8
+	ADC   ZR, R16, R14
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R5, R11, R15
+	UMULH R5, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R10, R10
+	ADC   ZR, R16, R13
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R12, R22, R15
+	UMULH R12, R22, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R10, R15, R9
+	ADC   ZR, R16, R14
+
+	// t[4] = C + A
+	ADD  R14, R13, R10
+	MOVD 16(R0), R11
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R1, R11, R15
+	UMULH R1, R11, R16
+	ADDS  R15, R6, R6
+	ADC   ZR, R16, R13
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R12
+	MUL  R12, R6, R12
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R12, R17, R15
+	UMULH R12, R17, R14
+	ADDS  R6, R15, R15
+	ADC   ZR, R14, R14
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R2, R11, R15
+	UMULH R2, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R7, R7
+	ADC   ZR, R16, R13
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R12, R19, R15
+	UMULH R12, R19, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R7, R15, R6
+	ADC   ZR, R16, R14
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R3, R11, R15
+	UMULH R3, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R8, R8
+	ADC   ZR, R16, R13
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R12, R20, R15
+	UMULH R12, R20, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R8, R15, R7
+	ADC   ZR, R16, R14
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R4, R11, R15
+	UMULH R4, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R9, R9
+	ADC   ZR, R16, R13
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R12, R21, R15
+	UMULH R12, R21, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R9, R15, R8
+	ADC   ZR, R16, R14
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R5, R11, R15
+	UMULH R5, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R10, R10
+	ADC   ZR, R16, R13
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R12, R22, R15
+	UMULH R12, R22, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R10, R15, R9
+	ADC   ZR, R16, R14
+
+	// t[4] = C + A
+	ADD  R14, R13, R10
+	MOVD 24(R0), R11
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R1, R11, R15
+	UMULH R1, R11, R16
+	ADDS  R15, R6, R6
+	ADC   ZR, R16, R13
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R12
+	MUL  R12, R6, R12
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R12, R17, R15
+	UMULH R12, R17, R14
+	ADDS  R6, R15, R15
+	ADC   ZR, R14, R14
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R2, R11, R15
+	UMULH R2, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R7, R7
+	ADC   ZR, R16, R13
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R12, R19, R15
+	UMULH R12, R19, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R7, R15, R6
+	ADC   ZR, R16, R14
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R3, R11, R15
+	UMULH R3, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R8, R8
+	ADC   ZR, R16, R13
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R12, R20, R15
+	UMULH R12, R20, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R8, R15, R7
+	ADC   ZR, R16, R14
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R4, R11, R15
+	UMULH R4, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R9, R9
+	ADC   ZR, R16, R13
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R12, R21, R15
+	UMULH R12, R21, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R9, R15, R8
+	ADC   ZR, R16, R14
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R5, R11, R15
+	UMULH R5, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R10, R10
+	ADC   ZR, R16, R13
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R12, R22, R15
+	UMULH R12, R22, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R10, R15, R9
+	ADC   ZR, R16, R14
+
+	// t[4] = C + A
+	ADD  R14, R13, R10
+	MOVD 32(R0), R11
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R1, R11, R15
+	UMULH R1, R11, R16
+	ADDS  R15, R6, R6
+	ADC   ZR, R16, R13
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R12
+	MUL  R12, R6, R12
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R12, R17, R15
+	UMULH R12, R17, R14
+	ADDS  R6, R15, R15
+	ADC   ZR, R14, R14
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R2, R11, R15
+	UMULH R2, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R7, R7
+	ADC   ZR, R16, R13
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R12, R19, R15
+	UMULH R12, R19, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R7, R15, R6
+	ADC   ZR, R16, R14
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R3, R11, R15
+	UMULH R3, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R8, R8
+	ADC   ZR, R16, R13
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R12, R20, R15
+	UMULH R12, R20, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R8, R15, R7
+	ADC   ZR, R16, R14
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R4, R11, R15
+	UMULH R4, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R9, R9
+	ADC   ZR, R16, R13
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R12, R21, R15
+	UMULH R12, R21, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R9, R15, R8
+	ADC   ZR, R16, R14
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R5, R11, R15
+	UMULH R5, R11, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R15, R10, R10
+	ADC   ZR, R16, R13
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R12, R22, R15
+	UMULH R12, R22, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R10, R15, R9
+	ADC   ZR, R16, R14
+
+	// t[4] = C + A
+	ADD R14, R13, R10
+
+	// reduce if necessary
+	SUBS R17, R6, R1
+	SBCS R19, R7, R2
+	SBCS R20, R8, R3
+	SBCS R21, R9, R4
+	SBCS R22, R10, R5
+	CSEL CS, R1, R6, R6
+	CSEL CS, R2, R7, R7
+	CSEL CS, R3, R8, R8
+	CSEL CS, R4, R9, R9
+	CSEL CS, R5, R10, R10
+	MOVD res+0(FP), R0
+	STP  (R6, R7), 0(R0)
+	STP  (R8, R9), 16(R0)
+	MOVD R10, 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	MOVD 32(R0), R6
+	MOVD b+8(FP), R1
+	LDP  0(R1), (R7, R8)
+	LDP  16(R1), (R9, R10)
+	MOVD 32(R1), R11
+	MOVD $0x6fe802ff40300001, R17
+	MOVD $0x421ee5da52bde502, R19
+	MOVD $0xdec1d01aa27a1ae0, R20
+	MOVD $0xd3f7498be97c5eaf, R21
+	MOVD $0x04c23a02b586d650, R22
+
+	// t = a + b
+	ADDS R7, R2, R12
+	ADCS R8, R3, R13
+	ADCS R9, R4, R14
+	ADCS R10, R5, R15
+	ADCS R11, R6, R16
+
+	// a = a - b
+	SUBS R7, R2, R2
+	SBCS R8, R3, R3
+	SBCS R9, R4, R4
+	SBCS R10, R5, R5
+	SBCS R11, R6, R6
+
+	// b = q if a - b underflowed, 0 otherwise
+	CSEL CC, R17, ZR, R7
+	CSEL CC, R19, ZR, R8
+	CSEL CC, R20, ZR, R9
+	CSEL CC, R21, ZR, R10
+	CSEL CC, R22, ZR, R11
+
+	// b = (a - b) mod q
+	ADDS R7, R2, R7
+	ADCS R8, R3, R8
+	ADCS R9, R4, R9
+	ADCS R10, R5, R10
+	ADCS R11, R6, R11
+	STP  (R7, R8), 0(R1)
+	STP  (R9, R10), 16(R1)
+	MOVD R11, 32(R1)
+
+	// a = (a + b) mod q
+	SUBS R17, R12, R2
+	SBCS R19, R13, R3
+	SBCS R20, R14, R4
+	SBCS R21, R15, R5
+	SBCS R22, R16, R6
+	CSEL CS, R2, R12, R12
+	CSEL CS, R3, R13, R13
+	CSEL CS, R4, R14, R14
+	CSEL CS, R5, R15, R15
+	CSEL CS, R6, R16, R16
+	STP  (R12, R13), 0(R0)
+	STP  (R14, R15), 16(R0)
+	MOVD R16, 32(R0)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVD $0x6fe802ff40300001, R14
+	MOVD $0x421ee5da52bde502, R15
+	MOVD $0xdec1d01aa27a1ae0, R16
+	MOVD $0xd3f7498be97c5eaf, R17
+	MOVD $0x04c23a02b586d650, R19
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l1:
+	CBZ    R3, l2
+	LDP.P  16(R1), (R4, R5)
+	LDP.P  16(R1), (R6, R7)
+	MOVD.P 8(R1), R8
+	LDP.P  16(R2), (R9, R10)
+	LDP.P  16(R2), (R11, R12)
+	MOVD.P 8(R2), R13
+
+	// a[i] + b[i]
+	ADDS   R9, R4, R4
+	ADCS   R10, R5, R5
+	ADCS   R11, R6, R6
+	ADCS   R12, R7, R7
+	ADCS   R13, R8, R8
+	SUBS   R14, R4, R9
+	SBCS   R15, R5, R10
+	SBCS   R16, R6, R11
+	SBCS   R17, R7, R12
+	SBCS   R19, R8, R13
+	CSEL   CS, R9, R4, R4
+	CSEL   CS, R10, R5, R5
+	CSEL   CS, R11, R6, R6
+	CSEL   CS, R12, R7, R7
+	CSEL   CS, R13, R8, R8
+	STP.P  (R4, R5), 16(R0)
+	STP.P  (R6, R7), 16(R0)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVD $0x6fe802ff40300001, R14
+	MOVD $0x421ee5da52bde502, R15
+	MOVD $0xdec1d01aa27a1ae0, R16
+	MOVD $0xd3f7498be97c5eaf, R17
+	MOVD $0x04c23a02b586d650, R19
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l3:
+	CBZ    R3, l4
+	LDP.P  16(R1), (R4, R5)
+	LDP.P  16(R1), (R6, R7)
+	MOVD.P 8(R1), R8
+	LDP.P  16(R2), (R9, R10)
+	LDP.P  16(R2), (R11, R12)
+	MOVD.P 8(R2), R13
+
+	// a[i] - b[i]
+	SUBS R9, R4, R4
+	SBCS R10, R5, R5
+	SBCS R11, R6, R6
+	SBCS R12, R7, R7
+	SBCS R13, R8, R8
+
+	// add q if a[i] - b[i] underflowed
+	CSEL   CC, R14, ZR, R9
+	CSEL   CC, R15, ZR, R10
+	CSEL   CC, R16, ZR, R11
+	CSEL   CC, R17, ZR, R12
+	CSEL   CC, R19, ZR, R13
+	ADDS   R9, R4, R4
+	ADCS   R10, R5, R5
+	ADCS   R11, R6, R6
+	ADCS   R12, R7, R7
+	ADCS   R13, R8, R8
+	STP.P  (R4, R5), 16(R0)
+	STP.P  (R6, R7), 16(R0)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    l3
+
+l4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVD $0x6fe802ff40300001, R21
+	MOVD $0x421ee5da52bde502, R22
+	MOVD $0xdec1d01aa27a1ae0, R23
+	MOVD $0xd3f7498be97c5eaf, R24
+	MOVD $0x04c23a02b586d650, R25
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l5:
+	CBZ    R3, l6
+	LDP.P  16(R1), (R4, R5)
+	LDP.P  16(R1), (R6, R7)
+	MOVD.P 8(R1), R8
+	MOVD   0(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R14, R9
+	UMULH R4, R14, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R14, R10
+	UMULH R5, R14, R20
+	ADDS  R16, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R14, R11
+	UMULH R6, R14, R20
+	ADDS  R16, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R14, R12
+	UMULH R7, R14, R20
+	ADDS  R16, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R8, R14, R13
+	UMULH R8, R14, R20
+	ADDS  R16, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 8(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 16(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 24(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 32(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD R17, R16, R13
+
+	// reduce if necessary
+	SUBS   R21, R9, R4
+	SBCS   R22, R10, R5
+	SBCS   R23, R11, R6
+	SBCS   R24, R12, R7
+	SBCS   R25, R13, R8
+	CSEL   CS, R4, R9, R9
+	CSEL   CS, R5, R10, R10
+	CSEL   CS, R6, R11, R11
+	CSEL   CS, R7, R12, R12
+	CSEL   CS, R8, R13, R13
+	STP.P  (R9, R10), 16(R0)
+	STP.P  (R11, R12), 16(R0)
+	MOVD.P R13, 8(R0)
+	ADD    $0x0000000000000028, R2, R2
+	SUB    $1, R3, R3
+	JMP    l5
+
+l6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVD $0x6fe802ff40300001, R21
+	MOVD $0x421ee5da52bde502, R22
+	MOVD $0xdec1d01aa27a1ae0, R23
+	MOVD $0xd3f7498be97c5eaf, R24
+	MOVD $0x04c23a02b586d650, R25
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+
+l7:
+	CBZ    R3, l8
+	LDP.P  16(R1), (R4, R5)
+	LDP.P  16(R1), (R6, R7)
+	MOVD.P 8(R1), R8
+	MOVD   0(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[0]
+	MUL   R4, R14, R9
+	UMULH R4, R14, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[0] + A
+	MUL   R5, R14, R10
+	UMULH R5, R14, R20
+	ADDS  R16, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[0] + A
+	MUL   R6, R14, R11
+	UMULH R6, R14, R20
+	ADDS  R16, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[0] + A
+	MUL   R7, R14, R12
+	UMULH R7, R14, R20
+	ADDS  R16, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[0] + A
+	MUL   R8, R14, R13
+	UMULH R8, R14, R20
+	ADDS  R16, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 8(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[1]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[1] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[1] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[1] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[1] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 16(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[2]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[2] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[2] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[2] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[2] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 24(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[3]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[3] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[3] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[3] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[3] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD  R17, R16, R13
+	MOVD 32(R2), R14
+
+	// (A,t[0]) := t[0] + x[0]*y[4]
+	MUL   R4, R14, R19
+	UMULH R4, R14, R20
+	ADDS  R19, R9, R9
+	ADC   ZR, R20, R16
+
+	// m := t[0]*q'[0] mod W
+	MOVD $0x702ff9ff402fffff, R15
+	MUL  R15, R9, R15
+
+	// C,_ := t[0] + m*q[0]
+	MUL   R15, R21, R19
+	UMULH R15, R21, R17
+	ADDS  R9, R19, R19
+	ADC   ZR, R17, R17
+
+	// (A,t[1]) := t[1] + x[1]*y[4] + A
+	MUL   R5, R14, R19
+	UMULH R5, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R10, R10
+	ADC   ZR, R20, R16
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	MUL   R15, R22, R19
+	UMULH R15, R22, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+
+	// (A,t[2]) := t[2] + x[2]*y[4] + A
+	MUL   R6, R14, R19
+	UMULH R6, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R11, R11
+	ADC   ZR, R20, R16
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	MUL   R15, R23, R19
+	UMULH R15, R23, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+
+	// (A,t[3]) := t[3] + x[3]*y[4] + A
+	MUL   R7, R14, R19
+	UMULH R7, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R12, R12
+	ADC   ZR, R20, R16
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	MUL   R15, R24, R19
+	UMULH R15, R24, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+
+	// (A,t[4]) := t[4] + x[4]*y[4] + A
+	MUL   R8, R14, R19
+	UMULH R8, R14, R20
+	ADDS  R16, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R19, R13, R13
+	ADC   ZR, R20, R16
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	MUL   R15, R25, R19
+	UMULH R15, R25, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+
+	// t[4] = C + A
+	ADD R17, R16, R13
+
+	// reduce if necessary
+	SUBS   R21, R9, R4
+	SBCS   R22, R10, R5
+	SBCS   R23, R11, R6
+	SBCS   R24, R12, R7
+	SBCS   R25, R13, R8
+	CSEL   CS, R4, R9, R9
+	CSEL   CS, R5, R10, R10
+	CSEL   CS, R6, R11, R11
+	CSEL   CS, R7, R12, R12
+	CSEL   CS, R8, R13, R13
+	STP.P  (R9, R10), 16(R0)
+	STP.P  (R11, R12), 16(R0)
+	MOVD.P R13, 8(R0)
+	SUB    $1, R3, R3
+	JMP    l7
+
+l8:
+	RET
@@ -17,6 +17,7 @@
 package fri
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"math/big"
@@ -28,19 +29,6 @@ import (
 	"github.com/leanovate/gopter/prop"
 )
 
-// logFiber returns u, v such that {g^u, g^v} = f⁻¹((g²)^{_p})
-func logFiber(_p, _n int) (_u, _v big.Int) {
-	if _p%2 == 0 {
-		_u.SetInt64(int64(_p / 2))
-		_v.SetInt64(int64(_p/2 + _n/2))
-	} else {
-		l := (_n - 1 - _p) / 2
-		_u.SetInt64(int64(_n - 1 - l))
-		_v.SetInt64(int64(_n - 1 - l - _n/2))
-	}
-	return
-}
-
 func randomPolynomial(size uint64, seed int32) []fr.Element {
 	p := make([]fr.Element, size)
 	p[0].SetUint64(uint64(seed))
@@ -50,16 +38,13 @@ func randomPolynomial(size uint64, seed int32) []fr.Element {
 	return p
 }
 
-// convertOrderCanonical convert the index i, an entry in a
-// sorted polynomial, to the corresponding entry in canonical
-// representation. n is the size of the polynomial.
-func convertSortedCanonical(i, n int) int {
-	if i%2 == 0 {
-		return i / 2
-	} else {
-		l := (n - 1 - i) / 2
-		return n - 1 - l
+func newIopp(t testing.TB, size uint64, params Params) Iopp {
+	t.Helper()
+	iop, err := MULTIPLICATIVE_FRI.New(size, sha256.New(), params)
+	if err != nil {
+		t.Fatal(err)
 	}
+	return iop
 }
 
 func TestFRI(t *testing.T) {
@@ -70,14 +55,13 @@ func TestFRI(t *testing.T) {
 	properties := gopter.NewProperties(parameters)
 
 	size := 4096
+	s := newIopp(t, uint64(size), DefaultParams())
+	rho := s.Params().Rho
 
 	properties.Property("verifying wrong opening should fail", prop.ForAll(
 
 		func(m int32) bool {
 
-			_s := RADIX_2_FRI.New(uint64(size), sha256.New())
-			s := _s.(radixTwoFri)
-
 			p := randomPolynomial(uint64(size), m)
 
 			pos := int64(m % 4096)
@@ -102,12 +86,9 @@ func TestFRI(t *testing.T) {
 
 		func(m int32) bool {
 
-			_s := RADIX_2_FRI.New(uint64(size), sha256.New())
-			s := _s.(radixTwoFri)
-
 			p := randomPolynomial(uint64(size), m)
 
-			pos := uint64(m % int32(size))
+			pos := uint64(m % int32(rho*size))
 			pp, _ := s.BuildProofOfProximity(p)
 
 			openingProof, err := s.Open(p, uint64(pos))
@@ -127,15 +108,12 @@ func TestFRI(t *testing.T) {
 	properties.Property("The claimed value of a polynomial should match P(x)", prop.ForAll(
 		func(m int32) bool {
 
-			_s := RADIX_2_FRI.New(uint64(size), sha256.New())
-			s := _s.(radixTwoFri)
-
 			p := randomPolynomial(uint64(size), m)
 
 			// check the opening value
 			var g fr.Element
 			pos := int64(m % 4096)
-			g.Set(&s.domain.Generator)
+			g.Set(&s.(*multiplicativeFri).domain.Generator)
 			g.Exp(g, big.NewInt(pos))
 
 			var val fr.Element
@@ -155,61 +133,178 @@ func TestFRI(t *testing.T) {
 		gen.Int32Range(0, int32(rho*size)),
 	))
 
-	properties.Property("Derive queries position: points should belong the correct fiber", prop.ForAll(
+	properties.Property("verifying a correctly formed proof should succeed", prop.ForAll(
 
 		func(m int32) bool {
 
-			_s := RADIX_2_FRI.New(uint64(size), sha256.New())
-			s := _s.(radixTwoFri)
-
-			var g fr.Element
+			p := randomPolynomial(uint64(size), m)
 
-			_m := int(m) % size
-			pos := s.deriveQueriesPositions(_m, int(s.domain.Cardinality))
-			g.Set(&s.domain.Generator)
-			n := int(s.domain.Cardinality)
+			proof, err := s.BuildProofOfProximity(p)
+			if err != nil {
+				t.Fatal(err)
+			}
 
-			for i := 0; i < len(pos)-1; i++ {
+			err = s.VerifyProofOfProximity(proof)
+			return err == nil
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
 
-				u, v := logFiber(pos[i], n)
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
-				var g1, g2, g3 fr.Element
-				g1.Exp(g, &u).Square(&g1)
-				g2.Exp(g, &v).Square(&g2)
-				nextPos := convertSortedCanonical(pos[i+1], n/2)
-				g3.Square(&g).Exp(g3, big.NewInt(int64(nextPos)))
+}
 
-				if !g1.Equal(&g2) || !g1.Equal(&g3) {
-					return false
+func TestFRIParams(t *testing.T) {
+	const size = 256
+
+	for _, arity := range []int{2, 4, 8, 16} {
+		for _, rho := range []int{2, 4} {
+			for _, finalDegree := range []int{0, 3} {
+				params := Params{
+					FoldingArity:  arity,
+					Rho:           rho,
+					SecurityLevel: 40,
+					GrindingBits:  4,
+					FinalDegree:   finalDegree,
 				}
-				g.Square(&g)
-				n = n >> 1
+				name := fmt.Sprintf("arity=%d/rho=%d/finalDegree=%d", arity, rho, finalDegree)
+				t.Run(name, func(t *testing.T) {
+					iop := newIopp(t, size, params)
+					// (40 - 4) bits of security, log₂(ρ) bits per query
+					expectedNbQueries := map[int]int{2: 36, 4: 18}[rho]
+					if nbQueries := iop.Params().NbQueries; nbQueries != expectedNbQueries {
+						t.Fatalf("expected %d queries, got %d", expectedNbQueries, nbQueries)
+					}
+
+					var p [size]fr.Element
+					for i := range p {
+						p[i].SetRandom()
+					}
+					proof, err := iop.BuildProofOfProximity(p[:])
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := iop.VerifyProofOfProximity(proof); err != nil {
+						t.Fatal(err)
+					}
+
+					// every fiber opened by the proof has FoldingArity evaluations
+					for _, q := range proof.Queries {
+						for _, proofSet := range q.ProofSets {
+							if len(proofSet[0]) != arity*fr.Bytes {
+								t.Fatal("wrong size of the opened fibers")
+							}
+						}
+					}
+
+					// the final polynomial is of degree at most FinalDegree
+					if len(proof.FinalPolynomial) > finalDegree+1 && len(proof.FinalPolynomial) >= arity {
+						t.Fatal("the final polynomial is too large")
+					}
+
+					// tampered final polynomial
+					proof.FinalPolynomial[0].SetRandom()
+					if err := iop.VerifyProofOfProximity(proof); err == nil {
+						t.Fatal("verifying a tampered proof should fail")
+					}
+				})
 			}
-			return true
-		},
-		gen.Int32Range(0, int32(rho*size)),
-	))
+		}
+	}
+}
 
-	properties.Property("verifying a correctly formed proof should succeed", prop.ForAll(
+func TestFRIErrors(t *testing.T) {
+	for _, params := range []Params{
+		{FoldingArity: 3, Rho: 8, NbQueries: 4},
+		{FoldingArity: 2, Rho: 6, NbQueries: 4},
+		{FoldingArity: 2, Rho: 1, NbQueries: 4},
+		{FoldingArity: 2, Rho: 8},
+		{FoldingArity: 2, Rho: 8, NbQueries: 4, GrindingBits: 64},
+		{FoldingArity: 2, Rho: 8, NbQueries: 4, FinalDegree: -1},
+	} {
+		if _, err := MULTIPLICATIVE_FRI.New(64, sha256.New(), params); err == nil {
+			t.Fatalf("%+v should be rejected", params)
+		}
+	}
+	if _, err := MULTIPLICATIVE_FRI.New(8, sha256.New(), Params{FoldingArity: 16, Rho: 2, NbQueries: 4}); err != ErrSizeTooSmall {
+		t.Fatal("a polynomial smaller than the folding arity should be rejected")
+	}
 
-		func(s int32) bool {
+	iop := newIopp(t, 64, Params{FoldingArity: 4, Rho: 2, NbQueries: 4, GrindingBits: 8})
+	p := randomPolynomial(64, 5)
+	proof, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.Nonce++
+	if err := iop.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying a wrong proof of work should fail")
+	}
+	proof.Nonce--
 
-			p := randomPolynomial(uint64(size), s)
+	// a leaf of an opened fiber is modified
+	proof.Queries[0].ProofSets[1][0][0] ^= 1
+	if err := iop.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying a tampered fiber should fail")
+	}
 
-			iop := RADIX_2_FRI.New(uint64(size), sha256.New())
-			proof, err := iop.BuildProofOfProximity(p)
-			if err != nil {
-				t.Fatal(err)
-			}
+	// a verifier with other parameters rejects the proof
+	other := newIopp(t, 64, Params{FoldingArity: 2, Rho: 2, NbQueries: 4, GrindingBits: 8})
+	if err := other.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying with other parameters should fail")
+	}
 
-			err = iop.VerifyProofOfProximity(proof)
-			return err == nil
-		},
-		gen.Int32Range(0, int32(rho*size)),
-	))
+	if _, err := iop.BuildProofOfProximity(randomPolynomial(65, 5)); err != ErrPolynomialSize {
+		t.Fatal("a polynomial larger than the size should be rejected")
+	}
+}
 
-	properties.TestingRun(t, gopter.ConsoleReporter(false))
+func TestMarshal(t *testing.T) {
+	const size = 64
+	iop := newIopp(t, size, Params{FoldingArity: 4, Rho: 4, SecurityLevel: 64, GrindingBits: 4, FinalDegree: 1})
+	p := randomPolynomial(uint64(size), 3)
 
+	pp, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	openingProof, err := iop.Open(p, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := pp.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openingProof.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	written := int64(buf.Len())
+
+	var ppRead ProofOfProximity
+	var openingProofRead OpeningProof
+	n, err := ppRead.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := openingProofRead.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n+m != written {
+		t.Fatalf("read %d bytes, wrote %d", n+m, written)
+	}
+
+	if err := iop.VerifyProofOfProximity(ppRead); err != nil {
+		t.Fatal(err)
+	}
+	if err := iop.VerifyOpening(5, openingProofRead, ppRead); err != nil {
+		t.Fatal(err)
+	}
+	if !openingProofRead.ClaimedValue.Equal(&openingProof.ClaimedValue) {
+		t.Fatal("claimed value mismatch")
+	}
 }
 
 // Benchmarks
@@ -226,7 +321,7 @@ func BenchmarkProximityVerification(b *testing.B) {
 			p[k].SetRandom()
 		}
 
-		iop := RADIX_2_FRI.New(uint64(size), sha256.New())
+		iop := newIopp(b, uint64(size), DefaultParams())
 		proof, _ := iop.BuildProofOfProximity(p)
 
 		b.Run(fmt.Sprintf("Polynomial size %d", size), func(b *testing.B) {
@@ -238,3 +333,25 @@ func BenchmarkProximityVerification(b *testing.B) {
 
 	}
 }
+
+func BenchmarkProofOfProximity(b *testing.B) {
+
+	const size = 1 << 14
+	p := make([]fr.Element, size)
+	for k := 0; k < size; k++ {
+		p[k].SetRandom()
+	}
+
+	for _, arity := range []int{2, 4, 8, 16} {
+		params := DefaultParams()
+		params.FoldingArity = arity
+		iop := newIopp(b, size, params)
+
+		b.Run(fmt.Sprintf("arity %d", arity), func(b *testing.B) {
+			b.ResetTimer()
+			for l := 0; l < b.N; l++ {
+				_, _ = iop.BuildProofOfProximity(p)
+			}
+		})
+	}
+}
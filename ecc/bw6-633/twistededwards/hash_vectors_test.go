@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6633_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0xf17a6d1d48cd2c38fdb1b8172f669225f09853d48d2cb1941f109fb9b1bc98bb5168a5a77c8f8e", "0x177665d363ae5c4de701f27ac74d9aa64bcaf067fbeabd33d0a468d4db03080252e41fe240d14c2"},
+				Q: point{"0x38132551161b80b33ca811d4d0858995fc952c2d48e7e0897b7f2603523f8da54f8a770395bc5a2", "0x2550d2db31068ebffffd48231370a94dfa01709e0fae5b72020d46face4f920d8a6558a468c3ca"},
+				u: "0x1649cad1336be0716c622484b0c2dc47b124958f263d544fac2f888fe9e4130fe037f9586ccebf9",
+			},
+			{
+				msg: "abc", P: point{"0x20e207bac34676d02be159866fbf7e0c98bf417dad38e78018950747c27e2b9d6e01c47f0e73d96", "0x4623603a4936d8c9e0c13563ea6863b77eca24583a13ce3f2ae8d71235b0b095885bc8dad77be4d"},
+				Q: point{"0x32765e072e40c9c7de2779f5b09dfe97f282175c5af022cfa795ac442421d7a41cef283e518b722", "0x28b0b01ebc88b0c69d6ceb56fba89ef6f7e8ac8f115c424d8927c446efbe4857b98ca3c131eb2e7"},
+				u: "0x3dff10122be42bb213cf4c87822b58900f21a3331d63011f7b3069f67c56f80c6bfecdcc5d7e05a",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x220607d6e7781d7de50bddc19c15c2e591fd5a2d75535170ec4071e6e1b5a1a90f5396c1e869a3a", "0x3fc8ee6a56aeaee52a5b23bd8644ba133d8f30123ae406ea647b879d689e5ab5c45899cbeae5855"},
+				Q: point{"0x32f60e99421e569e14caad64f59a8eeaad731e4d2e26c63214755ab33921e27b6b2183cabd9d422", "0x43d2d5fac46da0bea51d36452c0ee8144ea3b77587a62742404ca3c9a2acc193c7976cf45356e02"},
+				u: "0x439f2a7518a6ef59c9e31f3c12bdd9663fb220a3a76f1f5d2c96b89eb892e26b0716f5bfac8cc36",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x1f003561826ebfa32a766e62c6b660de613429620923bae5ecfb26f6ea1369c526719369b095cd7", "0x279b793b567b7c52962fed76a97c95dbf8ae2501e595f6fe5b688aeffb06f67225ba32aba3a907"},
+				Q: point{"0x9ca711d1eeb1564a6f711784c7a7030ef5a1cdfd763ae28869110a02bcf42a18d85b2065d1f433", "0x9c5eb4342343546fad17c27cbfe34e1319e3989875ca6512ed4c1c7faa814a02c354bee9c4c264"},
+				u: "0x7b31d8e5093fb9e9e825a4eef25b8ec21500f0778ccc50cc13d821f13ae9bb4a84c93b861eaacb",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x216bb13a30e76774073a16f948a844370788fa4032a1c0de6c23d1c6e03151fd2f631067d8e5d87", "0x44b88f142519c2d589ba471e19fecc2b91e6f629147326b336868ff4c1d2632be086344c0f20e0e"},
+				Q: point{"0x2b732ace25bfc1d825be18cba2f8443d5f212031e50a8da4d7b15bfa0f390358cdb1b6098afc18a", "0x117e9ea3e46de55fc155429a9dfa2d87f69f64ad58d76a6bd5431ebf4cac2433ceea02947346544"},
+				u: "0x31e5f039c5dfbfc7c2ba163058da9a7b8778d6e638972d1e18584f7d7b48dbb7e271cd394951fe8",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6633_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x156ce4106fe09ac0d343abbaaaf4859939433bfa11d4a94ca2b407fdf979eef458462d21e623cc8", "0x4017a7827bf04ced250623289b0449cd5a8f543babb13645650d976adb63ddf12c205a2f14dff8b"},
+				Q0: point{"0x17a9e186ad355500e4fa6527101174bb83c02ba90ee6bb7c310dd9fd35e0a4a922115b72a087b8f", "0x90e62dc573ad17e7c09beaf099df79490aa4e69d69da9864a9b85e2c6db783842de08a6ff41e97"},
+				Q1: point{"0x1fa74c71ff2cdd9f5f1cf2e11bff8f2b5a1a83397d8ded03f42ecd476576d128c7c6ce730e5b9ca", "0x289230f3a23baec25b27aa1d10461348a29942f704b70851bc9cc840335c6048a5a78abc36a386d"},
+				u0: "0x2cd10744bb7a7e4ec24fbbca8d40622583899706931c220dba34dacc51d4cf5f2e2c641e28659", u1: "0x3a684a6f6722821db0af3697c044c0101f038bf6cc913e513a0b0d201acf2ef4425df2fe6ad824b",
+			},
+			{
+				msg: "abc", P: point{"0x961173356e14d0ac82d5ca65605abf880b7b1814b45c4c297a2d4e35f29d0002964912c852db5c", "0x2e3df55d779d3a145f2d983107878dfc073639602a299394659b9726037c60c720391bf725ac66c"},
+				Q0: point{"0x259ad72d00161078ff0f1b3606e5bd4d19af988c44dfd92524956af7d65597e7778851b26507363", "0x348984ca4680de2bf53c4cded739839da2023369a0c75e6b8c792da088696c8f5f9462e29f02ce0"},
+				Q1: point{"0x1543478da84bb77615e19c04dd93d7a122636bb204237d82e75664cb75ed45686eac6cf4255304", "0x4073ff0e0940cc5e7e7498b2f95b700c54fc4cb265fd5e6ee021525b233a6bc77f8067a43df716"},
+				u0: "0xe46f9f7569ed9aeceda6952c0c64b139fcce1d63832d43c1dfeb958220c2ad7fe6f71ec8755501", u1: "0x14158bdec1b9186eabc24e2f43710b9ecf70b1dffcf8897cfcbf3db8a4bf9e3afd88b230e87aa35",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0xa9ecaeb1deb95266ccbce93cbe726b391b855c9a430fade63f542d9a8761e83613ff4c0d1426", "0x185cf78675dbf7f9918b4a382011a2e6fa172a3477c4a1747c0aa2db9a8b567334f4081eb889ad7"},
+				Q0: point{"0x420f046685f620fb3dc7fb72aa503d059fcaf3d46f0fa3e4619109cb2e25ba2671dc95e8f7479db", "0x39c30c29f4e8a080c6cb50ca2250cfffcdec2f1e778abf216b357279e79c247c4c010e46e728a6a"},
+				Q1: point{"0x1d1937618252cf03024270dcee56601599fa01e6b92006c414d622a030f40a319dfa98f46f75b95", "0xf58463a94db97d2472fe60cd9dd69fc8eba41c2a44da57c7a425cb5e6cceb63d45e5eb01d17894"},
+				u0: "0x15669598a1cc50a4b38109232fd30b07e1f9776439f0262b6d5f635faa03e9515a1602cc6b5b48", u1: "0x3a15bd259ae1c94e4cec7497cf216ef9262c844e02ee11bbc27391d0926de4870555ee4a1f39f82",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x2ade318a22ffe35ec36c5ed58edc10f47226ab9b474888a1bbd8e853f1b8237639c35a1e1128511", "0x306129fc654c4ee51fae81a876e122d22eb11ca1f7015e226ac3db25546fb904043fb16d690868f"},
+				Q0: point{"0x3ed78f7be4b688f77895ec8f003c8223ab5dbc76928dcf52dded48d161f27d676d1ffd7d09afba1", "0x15941c9fac706d2035a39181cc8d847202ec4d8d1bbe40c5902d33cef293e43592c83acf32b8f95"},
+				Q1: point{"0x1d43f7de296e6a3405eab05df496261b40da37e1f11bc0e929b77196eb3eabddb59ed53c1294453", "0x4ac9ee2f1d31d7c8eeec07a6655748dba83ee6a37af790742be468dd57959ef2da458466822a8c7"},
+				u0: "0x2a0d066e77f0dd3c97724394c1c91d8d7e6a167147a5b280a283a11c2471721bcf6d50231f25bb7", u1: "0x3efdb0189b58c7d13162daacae7f565a9188160812fa30eb41e7fdc0d4c2cfd400f99272aaabb47",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x23cf560ce603873b60de589d44d9e53e48f73fda428cb3e967cf55cd404815af04504e00bc31782", "0x18ea87d5239fbf22df2f5e6bc4bb22164dac23592b84c88afd61715cbbb8af76f17970157bb6a15"},
+				Q0: point{"0xb00d8f948369e5f20f5d936273bc1e890e2fb6cd5d50d29c47128a8ee043a9025e555260cd0fd", "0x41a3e6f6247acc99e7568142be7e667e3e827737ad4c83a082a4d04c6e174c2cef68f5c67108558"},
+				Q1: point{"0x2df9c47745da71a10b9ad47168bf5d9b911e1d6ee0e9be982cfc35d9c88255aa8c10536dd2aa5aa", "0x3c2b76488f9c8f68bda98d242a18884ff41049223446867d45c5c3cca7bf6dbda7502582e80fbb8"},
+				u0: "0x2214ba6a0828c805bde4bf7e6db6cd397d7f1daf4d0c01a0bb40c07e45fa5dfdf8565b15eb9748f", u1: "0xc7dab546d291dc7f50ed8945d6e7290bbf1092f267af1dfbc6b0bd211cf0d28163659556445774",
+			},
+		}}
+}
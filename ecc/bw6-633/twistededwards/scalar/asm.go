@@ -0,0 +1,30 @@
+//go:build !noadx
+// +build !noadx
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package scalar
+
+import "golang.org/x/sys/cpu"
+
+var (
+	supportAdx        = cpu.X86.HasADX && cpu.X86.HasBMI2
+	_                 = supportAdx
+	supportAvx2       = supportAdx && cpu.X86.HasAVX2
+	supportAvx512     = supportAdx && cpu.X86.HasAVX512
+	supportAvx512IFMA = supportAvx512 && cpu.X86.HasAVX512IFMA && cpu.X86.HasAVX512DQ
+)
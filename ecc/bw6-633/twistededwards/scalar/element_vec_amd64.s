@@ -0,0 +1,1452 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x95587428a793f7af
+DATA q<>+8(SB)/8, $0x0f328ceee31a83eb
+DATA q<>+16(SB)/8, $0xfbd83a035540306f
+DATA q<>+24(SB)/8, $0x1a7ee9317d2f8bd5
+DATA q<>+32(SB)/8, $0x0098474056b0daca
+GLOBL q<>(SB), (RODATA+NOPTR), $40
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x3186234a131a40b1
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, ra4, rb0, rb1, rb2, rb3, rb4) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	MOVQ    ra4, rb4;        \
+	SBBQ    q<>+32(SB), ra4; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+	CMOVQCS rb4, ra4;        \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $5
+DATA indexGatherScatter<>+16(SB)/8, $10
+DATA indexGatherScatter<>+24(SB)/8, $15
+DATA indexGatherScatter<>+32(SB)/8, $20
+DATA indexGatherScatter<>+40(SB)/8, $25
+DATA indexGatherScatter<>+48(SB)/8, $30
+DATA indexGatherScatter<>+56(SB)/8, $35
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0x87428a793f7af
+DATA qLimbs52<>+8(SB)/8, $0xeee31a83eb955
+DATA qLimbs52<>+16(SB)/8, $0x540306f0f328c
+DATA qLimbs52<>+24(SB)/8, $0x8bd5fbd83a035
+DATA qLimbs52<>+32(SB)/8, $0xa1a7ee9317d2f
+DATA qLimbs52<>+40(SB)/8, $0x98474056b0dac
+DATA qLimbs52<>+48(SB)/8, $0x0
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $56
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// a[i] + b[i]
+	MOVQ 0(DX), SI
+	MOVQ 8(DX), DI
+	MOVQ 16(DX), R8
+	MOVQ 24(DX), R9
+	MOVQ 32(DX), R10
+	ADDQ 0(CX), SI
+	ADCQ 8(CX), DI
+	ADCQ 16(CX), R8
+	ADCQ 24(CX), R9
+	ADCQ 32(CX), R10
+
+	// reduce element(SI,DI,R8,R9,R10) using temp registers (R11,R12,R13,R14,R15)
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13,R14,R15)
+
+	// res[i] = a[i] + b[i]
+	MOVQ SI, 0(AX)
+	MOVQ DI, 8(AX)
+	MOVQ R8, 16(AX)
+	MOVQ R9, 24(AX)
+	MOVQ R10, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000028, AX
+	ADDQ $0x0000000000000028, DX
+	ADDQ $0x0000000000000028, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), $40-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// res[i] = a[i] - b[i], the MOVQ don't change the carry flag
+	MOVQ 0(DX), SI
+	SUBQ 0(CX), SI
+	MOVQ SI, 0(AX)
+	MOVQ 8(DX), SI
+	SBBQ 8(CX), SI
+	MOVQ SI, 8(AX)
+	MOVQ 16(DX), SI
+	SBBQ 16(CX), SI
+	MOVQ SI, 16(AX)
+	MOVQ 24(DX), SI
+	SBBQ 24(CX), SI
+	MOVQ SI, 24(AX)
+	MOVQ 32(DX), SI
+	SBBQ 32(CX), SI
+	MOVQ SI, 32(AX)
+	SBBQ DI, DI     // mask = -borrow
+
+	// res[i] += q if a[i] < b[i]
+	MOVQ q<>+0(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q0-40(SP)
+	MOVQ q<>+8(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q1-32(SP)
+	MOVQ q<>+16(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q2-24(SP)
+	MOVQ q<>+24(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q3-16(SP)
+	MOVQ q<>+32(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q4-8(SP)
+	MOVQ q0-40(SP), SI
+	ADDQ SI, 0(AX)
+	MOVQ q1-32(SP), SI
+	ADCQ SI, 8(AX)
+	MOVQ q2-24(SP), SI
+	ADCQ SI, 16(AX)
+	MOVQ q3-16(SP), SI
+	ADCQ SI, 24(AX)
+	MOVQ q4-8(SP), SI
+	ADCQ SI, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000028, AX
+	ADDQ $0x0000000000000028, DX
+	ADDQ $0x0000000000000028, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[10]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $640-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $3, DX                  // we load 8 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+	VPXORQ       Z5, Z5, Z5
+	VPXORQ       Z6, Z6, Z6
+	VPXORQ       Z7, Z7, Z7
+	VPXORQ       Z8, Z8, Z8
+	VPXORQ       Z9, Z9, Z9
+	VPXORQ       Z10, Z10, Z10
+	VPXORQ       Z11, Z11, Z11
+	VPXORQ       Z12, Z12, Z12
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6           // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z8, Z8
+	VMOVDQU64 64(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z4, Z4
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z9, Z9
+	VMOVDQU64 128(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z5, Z5
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z10, Z10
+	VMOVDQU64 192(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z6, Z6
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z11, Z11
+	VMOVDQU64 256(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z7, Z7
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z12, Z12
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000140, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-640(SP)
+	VMOVDQU64 Z4, acc1-576(SP)
+	VMOVDQU64 Z5, acc2-512(SP)
+	VMOVDQU64 Z6, acc3-448(SP)
+	VMOVDQU64 Z7, acc4-384(SP)
+	VMOVDQU64 Z8, acc5-320(SP)
+	VMOVDQU64 Z9, acc6-256(SP)
+	VMOVDQU64 Z10, acc7-192(SP)
+	VMOVDQU64 Z11, acc8-128(SP)
+	VMOVDQU64 Z12, acc9-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-640(SP), BX
+	ADDQ lane5-600(SP), BX
+	ADDQ lane10-560(SP), BX
+	ADDQ lane15-520(SP), BX
+	ADDQ lane20-480(SP), BX
+	ADDQ lane25-440(SP), BX
+	ADDQ lane30-400(SP), BX
+	ADDQ lane35-360(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-632(SP), BX
+	ADDQ lane6-592(SP), BX
+	ADDQ lane11-552(SP), BX
+	ADDQ lane16-512(SP), BX
+	ADDQ lane21-472(SP), BX
+	ADDQ lane26-432(SP), BX
+	ADDQ lane31-392(SP), BX
+	ADDQ lane36-352(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-624(SP), BX
+	ADDQ lane7-584(SP), BX
+	ADDQ lane12-544(SP), BX
+	ADDQ lane17-504(SP), BX
+	ADDQ lane22-464(SP), BX
+	ADDQ lane27-424(SP), BX
+	ADDQ lane32-384(SP), BX
+	ADDQ lane37-344(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-616(SP), BX
+	ADDQ lane8-576(SP), BX
+	ADDQ lane13-536(SP), BX
+	ADDQ lane18-496(SP), BX
+	ADDQ lane23-456(SP), BX
+	ADDQ lane28-416(SP), BX
+	ADDQ lane33-376(SP), BX
+	ADDQ lane38-336(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-608(SP), BX
+	ADDQ lane9-568(SP), BX
+	ADDQ lane14-528(SP), BX
+	ADDQ lane19-488(SP), BX
+	ADDQ lane24-448(SP), BX
+	ADDQ lane29-408(SP), BX
+	ADDQ lane34-368(SP), BX
+	ADDQ lane39-328(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane40-320(SP), BX
+	ADDQ lane45-280(SP), BX
+	ADDQ lane50-240(SP), BX
+	ADDQ lane55-200(SP), BX
+	ADDQ lane60-160(SP), BX
+	ADDQ lane65-120(SP), BX
+	ADDQ lane70-80(SP), BX
+	ADDQ lane75-40(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane41-312(SP), BX
+	ADDQ lane46-272(SP), BX
+	ADDQ lane51-232(SP), BX
+	ADDQ lane56-192(SP), BX
+	ADDQ lane61-152(SP), BX
+	ADDQ lane66-112(SP), BX
+	ADDQ lane71-72(SP), BX
+	ADDQ lane76-32(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane42-304(SP), BX
+	ADDQ lane47-264(SP), BX
+	ADDQ lane52-224(SP), BX
+	ADDQ lane57-184(SP), BX
+	ADDQ lane62-144(SP), BX
+	ADDQ lane67-104(SP), BX
+	ADDQ lane72-64(SP), BX
+	ADDQ lane77-24(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane43-296(SP), BX
+	ADDQ lane48-256(SP), BX
+	ADDQ lane53-216(SP), BX
+	ADDQ lane58-176(SP), BX
+	ADDQ lane63-136(SP), BX
+	ADDQ lane68-96(SP), BX
+	ADDQ lane73-56(SP), BX
+	ADDQ lane78-16(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane44-288(SP), BX
+	ADDQ lane49-248(SP), BX
+	ADDQ lane54-208(SP), BX
+	ADDQ lane59-168(SP), BX
+	ADDQ lane64-128(SP), BX
+	ADDQ lane69-88(SP), BX
+	ADDQ lane74-48(SP), BX
+	ADDQ lane79-8(SP), BX
+	MOVQ BX, 72(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[10]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $320-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $2, DX                  // we load 4 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+	VPXOR        Y5, Y5, Y5
+	VPXOR        Y6, Y6, Y6
+	VPXOR        Y7, Y7, Y7
+	VPXOR        Y8, Y8, Y8
+	VPXOR        Y9, Y9, Y9
+	VPXOR        Y10, Y10, Y10
+	VPXOR        Y11, Y11, Y11
+	VPXOR        Y12, Y12, Y12
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8           // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y8, Y8
+	VMOVDQU 32(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y4, Y4
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y9, Y9
+	VMOVDQU 64(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y5, Y5
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y10, Y10
+	VMOVDQU 96(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y6, Y6
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y11, Y11
+	VMOVDQU 128(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y7, Y7
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y12, Y12
+
+	// increment pointers to visit next elements
+	ADDQ $0x00000000000000a0, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-320(SP)
+	VMOVDQU Y4, acc1-288(SP)
+	VMOVDQU Y5, acc2-256(SP)
+	VMOVDQU Y6, acc3-224(SP)
+	VMOVDQU Y7, acc4-192(SP)
+	VMOVDQU Y8, acc5-160(SP)
+	VMOVDQU Y9, acc6-128(SP)
+	VMOVDQU Y10, acc7-96(SP)
+	VMOVDQU Y11, acc8-64(SP)
+	VMOVDQU Y12, acc9-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-320(SP), BX
+	ADDQ lane5-280(SP), BX
+	ADDQ lane10-240(SP), BX
+	ADDQ lane15-200(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-312(SP), BX
+	ADDQ lane6-272(SP), BX
+	ADDQ lane11-232(SP), BX
+	ADDQ lane16-192(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-304(SP), BX
+	ADDQ lane7-264(SP), BX
+	ADDQ lane12-224(SP), BX
+	ADDQ lane17-184(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-296(SP), BX
+	ADDQ lane8-256(SP), BX
+	ADDQ lane13-216(SP), BX
+	ADDQ lane18-176(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-288(SP), BX
+	ADDQ lane9-248(SP), BX
+	ADDQ lane14-208(SP), BX
+	ADDQ lane19-168(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane20-160(SP), BX
+	ADDQ lane25-120(SP), BX
+	ADDQ lane30-80(SP), BX
+	ADDQ lane35-40(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane21-152(SP), BX
+	ADDQ lane26-112(SP), BX
+	ADDQ lane31-72(SP), BX
+	ADDQ lane36-32(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane22-144(SP), BX
+	ADDQ lane27-104(SP), BX
+	ADDQ lane32-64(SP), BX
+	ADDQ lane37-24(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane23-136(SP), BX
+	ADDQ lane28-96(SP), BX
+	ADDQ lane33-56(SP), BX
+	ADDQ lane38-16(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane24-128(SP), BX
+	ADDQ lane29-88(SP), BX
+	ADDQ lane34-48(SP), BX
+	ADDQ lane39-8(SP), BX
+	MOVQ BX, 72(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $896-32
+	MOVQ         $0x0006234a131a40b1, SI
+	VPBROADCASTQ SI, Z11
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z12
+	VMOVDQU64    indexGatherScatter<>(SB), Z13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z13*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z13*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z13*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z13*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z13*8), K1, Z4
+	VMOVDQU64  Z0, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a0-896(SP)
+	VPSRLQ     $52, Z0, Z10
+	VPSLLQ     $12, Z1, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a1-832(SP)
+	VPSRLQ     $40, Z1, Z10
+	VPSLLQ     $24, Z2, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a2-768(SP)
+	VPSRLQ     $28, Z2, Z10
+	VPSLLQ     $36, Z3, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a3-704(SP)
+	VPSRLQ     $16, Z3, Z10
+	VPSLLQ     $48, Z4, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a4-640(SP)
+	VPSRLQ     $4, Z4, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a5-576(SP)
+	VPSRLQ     $56, Z4, Z10
+	VMOVDQU64  Z10, a6-512(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 44 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z13*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(CX)(Z13*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(CX)(Z13*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(CX)(Z13*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(CX)(Z13*8), K1, Z4
+	VPSLLQ     $44, Z0, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b0-448(SP)
+	VPSRLQ     $8, Z0, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b1-384(SP)
+	VPSRLQ     $60, Z0, Z10
+	VPSLLQ     $4, Z1, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b2-320(SP)
+	VPSRLQ     $48, Z1, Z10
+	VPSLLQ     $16, Z2, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b3-256(SP)
+	VPSRLQ     $36, Z2, Z10
+	VPSLLQ     $28, Z3, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b4-192(SP)
+	VPSRLQ     $24, Z3, Z10
+	VPSLLQ     $40, Z4, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, b5-128(SP)
+	VPSRLQ     $12, Z4, Z10
+	VMOVDQU64  Z10, b6-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+
+	// t += a * b[0]
+	VMOVDQU64   b0-448(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z0
+	VPMADD52HUQ a0-896(SP), Z8, Z1
+	VPMADD52LUQ a1-832(SP), Z8, Z1
+	VPMADD52HUQ a1-832(SP), Z8, Z2
+	VPMADD52LUQ a2-768(SP), Z8, Z2
+	VPMADD52HUQ a2-768(SP), Z8, Z3
+	VPMADD52LUQ a3-704(SP), Z8, Z3
+	VPMADD52HUQ a3-704(SP), Z8, Z4
+	VPMADD52LUQ a4-640(SP), Z8, Z4
+	VPMADD52HUQ a4-640(SP), Z8, Z5
+	VPMADD52LUQ a5-576(SP), Z8, Z5
+	VPMADD52HUQ a5-576(SP), Z8, Z6
+	VPMADD52LUQ a6-512(SP), Z8, Z6
+	VPMADD52HUQ a6-512(SP), Z8, Z7
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z0, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z7
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z10
+	VPADDQ Z10, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-384(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z1
+	VPMADD52HUQ a0-896(SP), Z8, Z2
+	VPMADD52LUQ a1-832(SP), Z8, Z2
+	VPMADD52HUQ a1-832(SP), Z8, Z3
+	VPMADD52LUQ a2-768(SP), Z8, Z3
+	VPMADD52HUQ a2-768(SP), Z8, Z4
+	VPMADD52LUQ a3-704(SP), Z8, Z4
+	VPMADD52HUQ a3-704(SP), Z8, Z5
+	VPMADD52LUQ a4-640(SP), Z8, Z5
+	VPMADD52HUQ a4-640(SP), Z8, Z6
+	VPMADD52LUQ a5-576(SP), Z8, Z6
+	VPMADD52HUQ a5-576(SP), Z8, Z7
+	VPMADD52LUQ a6-512(SP), Z8, Z7
+	VPMADD52HUQ a6-512(SP), Z8, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z1, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z10
+	VPADDQ Z10, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-320(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z2
+	VPMADD52HUQ a0-896(SP), Z8, Z3
+	VPMADD52LUQ a1-832(SP), Z8, Z3
+	VPMADD52HUQ a1-832(SP), Z8, Z4
+	VPMADD52LUQ a2-768(SP), Z8, Z4
+	VPMADD52HUQ a2-768(SP), Z8, Z5
+	VPMADD52LUQ a3-704(SP), Z8, Z5
+	VPMADD52HUQ a3-704(SP), Z8, Z6
+	VPMADD52LUQ a4-640(SP), Z8, Z6
+	VPMADD52HUQ a4-640(SP), Z8, Z7
+	VPMADD52LUQ a5-576(SP), Z8, Z7
+	VPMADD52HUQ a5-576(SP), Z8, Z0
+	VPMADD52LUQ a6-512(SP), Z8, Z0
+	VPMADD52HUQ a6-512(SP), Z8, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z2, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z10
+	VPADDQ Z10, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-256(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z3
+	VPMADD52HUQ a0-896(SP), Z8, Z4
+	VPMADD52LUQ a1-832(SP), Z8, Z4
+	VPMADD52HUQ a1-832(SP), Z8, Z5
+	VPMADD52LUQ a2-768(SP), Z8, Z5
+	VPMADD52HUQ a2-768(SP), Z8, Z6
+	VPMADD52LUQ a3-704(SP), Z8, Z6
+	VPMADD52HUQ a3-704(SP), Z8, Z7
+	VPMADD52LUQ a4-640(SP), Z8, Z7
+	VPMADD52HUQ a4-640(SP), Z8, Z0
+	VPMADD52LUQ a5-576(SP), Z8, Z0
+	VPMADD52HUQ a5-576(SP), Z8, Z1
+	VPMADD52LUQ a6-512(SP), Z8, Z1
+	VPMADD52HUQ a6-512(SP), Z8, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z3, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z10
+	VPADDQ Z10, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-192(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z4
+	VPMADD52HUQ a0-896(SP), Z8, Z5
+	VPMADD52LUQ a1-832(SP), Z8, Z5
+	VPMADD52HUQ a1-832(SP), Z8, Z6
+	VPMADD52LUQ a2-768(SP), Z8, Z6
+	VPMADD52HUQ a2-768(SP), Z8, Z7
+	VPMADD52LUQ a3-704(SP), Z8, Z7
+	VPMADD52HUQ a3-704(SP), Z8, Z0
+	VPMADD52LUQ a4-640(SP), Z8, Z0
+	VPMADD52HUQ a4-640(SP), Z8, Z1
+	VPMADD52LUQ a5-576(SP), Z8, Z1
+	VPMADD52HUQ a5-576(SP), Z8, Z2
+	VPMADD52LUQ a6-512(SP), Z8, Z2
+	VPMADD52HUQ a6-512(SP), Z8, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z4, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z10
+	VPADDQ Z10, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-128(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z5
+	VPMADD52HUQ a0-896(SP), Z8, Z6
+	VPMADD52LUQ a1-832(SP), Z8, Z6
+	VPMADD52HUQ a1-832(SP), Z8, Z7
+	VPMADD52LUQ a2-768(SP), Z8, Z7
+	VPMADD52HUQ a2-768(SP), Z8, Z0
+	VPMADD52LUQ a3-704(SP), Z8, Z0
+	VPMADD52HUQ a3-704(SP), Z8, Z1
+	VPMADD52LUQ a4-640(SP), Z8, Z1
+	VPMADD52HUQ a4-640(SP), Z8, Z2
+	VPMADD52LUQ a5-576(SP), Z8, Z2
+	VPMADD52HUQ a5-576(SP), Z8, Z3
+	VPMADD52LUQ a6-512(SP), Z8, Z3
+	VPMADD52HUQ a6-512(SP), Z8, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z5, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z10
+	VPADDQ Z10, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-64(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z6
+	VPMADD52HUQ a0-896(SP), Z8, Z7
+	VPMADD52LUQ a1-832(SP), Z8, Z7
+	VPMADD52HUQ a1-832(SP), Z8, Z0
+	VPMADD52LUQ a2-768(SP), Z8, Z0
+	VPMADD52HUQ a2-768(SP), Z8, Z1
+	VPMADD52LUQ a3-704(SP), Z8, Z1
+	VPMADD52HUQ a3-704(SP), Z8, Z2
+	VPMADD52LUQ a4-640(SP), Z8, Z2
+	VPMADD52HUQ a4-640(SP), Z8, Z3
+	VPMADD52LUQ a5-576(SP), Z8, Z3
+	VPMADD52HUQ a5-576(SP), Z8, Z4
+	VPMADD52LUQ a6-512(SP), Z8, Z4
+	VPMADD52HUQ a6-512(SP), Z8, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z6, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z10
+	VPADDQ Z10, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// propagate the carries
+	VPSRLQ $52, Z7, Z10
+	VPADDQ Z10, Z0, Z0
+	VPANDQ Z12, Z7, Z7
+	VPSRLQ $52, Z0, Z10
+	VPADDQ Z10, Z1, Z1
+	VPANDQ Z12, Z0, Z0
+	VPSRLQ $52, Z1, Z10
+	VPADDQ Z10, Z2, Z2
+	VPANDQ Z12, Z1, Z1
+	VPSRLQ $52, Z2, Z10
+	VPADDQ Z10, Z3, Z3
+	VPANDQ Z12, Z2, Z2
+	VPSRLQ $52, Z3, Z10
+	VPADDQ Z10, Z4, Z4
+	VPANDQ Z12, Z3, Z3
+	VPSRLQ $52, Z4, Z10
+	VPADDQ Z10, Z5, Z5
+	VPANDQ Z12, Z4, Z4
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z7, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a0-896(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a1-832(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a2-768(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a3-704(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a4-640(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a5-576(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z8
+	VPADDQ      Z10, Z8, Z8
+	VMOVDQU64   Z8, a6-512(SP)
+	VPMOVQ2M    Z8, K2
+	VMOVDQU64   Z7, K2, a0-896(SP)
+	VMOVDQU64   Z0, K2, a1-832(SP)
+	VMOVDQU64   Z1, K2, a2-768(SP)
+	VMOVDQU64   Z2, K2, a3-704(SP)
+	VMOVDQU64   Z3, K2, a4-640(SP)
+	VMOVDQU64   Z4, K2, a5-576(SP)
+	VMOVDQU64   Z5, K2, a6-512(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-896(SP), Z7
+	VPSLLQ      $52, a1-832(SP), Z10
+	VPORQ       Z10, Z7, Z7
+	VPSRLQ      $12, a1-832(SP), Z0
+	VPSLLQ      $40, a2-768(SP), Z10
+	VPORQ       Z10, Z0, Z0
+	VPSRLQ      $24, a2-768(SP), Z1
+	VPSLLQ      $28, a3-704(SP), Z10
+	VPORQ       Z10, Z1, Z1
+	VPSRLQ      $36, a3-704(SP), Z2
+	VPSLLQ      $16, a4-640(SP), Z10
+	VPORQ       Z10, Z2, Z2
+	VPSRLQ      $48, a4-640(SP), Z3
+	VPSLLQ      $4, a5-576(SP), Z10
+	VPORQ       Z10, Z3, Z3
+	VPSLLQ      $56, a6-512(SP), Z10
+	VPORQ       Z10, Z3, Z3
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z7, K1, 0(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z13*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000140, AX
+	ADDQ $0x0000000000000140, DX
+	ADDQ $0x0000000000000140, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $896-32
+	MOVQ         $0x0006234a131a40b1, SI
+	VPBROADCASTQ SI, Z11
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z12
+	VMOVDQU64    indexGatherScatter<>(SB), Z13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPBROADCASTQ 8(CX), Z1
+	VPBROADCASTQ 16(CX), Z2
+	VPBROADCASTQ 24(CX), Z3
+	VPBROADCASTQ 32(CX), Z4
+	VPSLLQ       $44, Z0, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b0-448(SP)
+	VPSRLQ       $8, Z0, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b1-384(SP)
+	VPSRLQ       $60, Z0, Z10
+	VPSLLQ       $4, Z1, Z8
+	VPORQ        Z8, Z10, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b2-320(SP)
+	VPSRLQ       $48, Z1, Z10
+	VPSLLQ       $16, Z2, Z8
+	VPORQ        Z8, Z10, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b3-256(SP)
+	VPSRLQ       $36, Z2, Z10
+	VPSLLQ       $28, Z3, Z8
+	VPORQ        Z8, Z10, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b4-192(SP)
+	VPSRLQ       $24, Z3, Z10
+	VPSLLQ       $40, Z4, Z8
+	VPORQ        Z8, Z10, Z10
+	VPANDQ       Z12, Z10, Z10
+	VMOVDQU64    Z10, b5-128(SP)
+	VPSRLQ       $12, Z4, Z10
+	VMOVDQU64    Z10, b6-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z13*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z13*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z13*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z13*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z13*8), K1, Z4
+	VMOVDQU64  Z0, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a0-896(SP)
+	VPSRLQ     $52, Z0, Z10
+	VPSLLQ     $12, Z1, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a1-832(SP)
+	VPSRLQ     $40, Z1, Z10
+	VPSLLQ     $24, Z2, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a2-768(SP)
+	VPSRLQ     $28, Z2, Z10
+	VPSLLQ     $36, Z3, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a3-704(SP)
+	VPSRLQ     $16, Z3, Z10
+	VPSLLQ     $48, Z4, Z8
+	VPORQ      Z8, Z10, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a4-640(SP)
+	VPSRLQ     $4, Z4, Z10
+	VPANDQ     Z12, Z10, Z10
+	VMOVDQU64  Z10, a5-576(SP)
+	VPSRLQ     $56, Z4, Z10
+	VMOVDQU64  Z10, a6-512(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+
+	// t += a * b[0]
+	VMOVDQU64   b0-448(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z0
+	VPMADD52HUQ a0-896(SP), Z8, Z1
+	VPMADD52LUQ a1-832(SP), Z8, Z1
+	VPMADD52HUQ a1-832(SP), Z8, Z2
+	VPMADD52LUQ a2-768(SP), Z8, Z2
+	VPMADD52HUQ a2-768(SP), Z8, Z3
+	VPMADD52LUQ a3-704(SP), Z8, Z3
+	VPMADD52HUQ a3-704(SP), Z8, Z4
+	VPMADD52LUQ a4-640(SP), Z8, Z4
+	VPMADD52HUQ a4-640(SP), Z8, Z5
+	VPMADD52LUQ a5-576(SP), Z8, Z5
+	VPMADD52HUQ a5-576(SP), Z8, Z6
+	VPMADD52LUQ a6-512(SP), Z8, Z6
+	VPMADD52HUQ a6-512(SP), Z8, Z7
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z0, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z7
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z10
+	VPADDQ Z10, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-384(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z1
+	VPMADD52HUQ a0-896(SP), Z8, Z2
+	VPMADD52LUQ a1-832(SP), Z8, Z2
+	VPMADD52HUQ a1-832(SP), Z8, Z3
+	VPMADD52LUQ a2-768(SP), Z8, Z3
+	VPMADD52HUQ a2-768(SP), Z8, Z4
+	VPMADD52LUQ a3-704(SP), Z8, Z4
+	VPMADD52HUQ a3-704(SP), Z8, Z5
+	VPMADD52LUQ a4-640(SP), Z8, Z5
+	VPMADD52HUQ a4-640(SP), Z8, Z6
+	VPMADD52LUQ a5-576(SP), Z8, Z6
+	VPMADD52HUQ a5-576(SP), Z8, Z7
+	VPMADD52LUQ a6-512(SP), Z8, Z7
+	VPMADD52HUQ a6-512(SP), Z8, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z1, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z10
+	VPADDQ Z10, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-320(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z2
+	VPMADD52HUQ a0-896(SP), Z8, Z3
+	VPMADD52LUQ a1-832(SP), Z8, Z3
+	VPMADD52HUQ a1-832(SP), Z8, Z4
+	VPMADD52LUQ a2-768(SP), Z8, Z4
+	VPMADD52HUQ a2-768(SP), Z8, Z5
+	VPMADD52LUQ a3-704(SP), Z8, Z5
+	VPMADD52HUQ a3-704(SP), Z8, Z6
+	VPMADD52LUQ a4-640(SP), Z8, Z6
+	VPMADD52HUQ a4-640(SP), Z8, Z7
+	VPMADD52LUQ a5-576(SP), Z8, Z7
+	VPMADD52HUQ a5-576(SP), Z8, Z0
+	VPMADD52LUQ a6-512(SP), Z8, Z0
+	VPMADD52HUQ a6-512(SP), Z8, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z2, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z10
+	VPADDQ Z10, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-256(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z3
+	VPMADD52HUQ a0-896(SP), Z8, Z4
+	VPMADD52LUQ a1-832(SP), Z8, Z4
+	VPMADD52HUQ a1-832(SP), Z8, Z5
+	VPMADD52LUQ a2-768(SP), Z8, Z5
+	VPMADD52HUQ a2-768(SP), Z8, Z6
+	VPMADD52LUQ a3-704(SP), Z8, Z6
+	VPMADD52HUQ a3-704(SP), Z8, Z7
+	VPMADD52LUQ a4-640(SP), Z8, Z7
+	VPMADD52HUQ a4-640(SP), Z8, Z0
+	VPMADD52LUQ a5-576(SP), Z8, Z0
+	VPMADD52HUQ a5-576(SP), Z8, Z1
+	VPMADD52LUQ a6-512(SP), Z8, Z1
+	VPMADD52HUQ a6-512(SP), Z8, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z3, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z10
+	VPADDQ Z10, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-192(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z4
+	VPMADD52HUQ a0-896(SP), Z8, Z5
+	VPMADD52LUQ a1-832(SP), Z8, Z5
+	VPMADD52HUQ a1-832(SP), Z8, Z6
+	VPMADD52LUQ a2-768(SP), Z8, Z6
+	VPMADD52HUQ a2-768(SP), Z8, Z7
+	VPMADD52LUQ a3-704(SP), Z8, Z7
+	VPMADD52HUQ a3-704(SP), Z8, Z0
+	VPMADD52LUQ a4-640(SP), Z8, Z0
+	VPMADD52HUQ a4-640(SP), Z8, Z1
+	VPMADD52LUQ a5-576(SP), Z8, Z1
+	VPMADD52HUQ a5-576(SP), Z8, Z2
+	VPMADD52LUQ a6-512(SP), Z8, Z2
+	VPMADD52HUQ a6-512(SP), Z8, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z4, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z10
+	VPADDQ Z10, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-128(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z5
+	VPMADD52HUQ a0-896(SP), Z8, Z6
+	VPMADD52LUQ a1-832(SP), Z8, Z6
+	VPMADD52HUQ a1-832(SP), Z8, Z7
+	VPMADD52LUQ a2-768(SP), Z8, Z7
+	VPMADD52HUQ a2-768(SP), Z8, Z0
+	VPMADD52LUQ a3-704(SP), Z8, Z0
+	VPMADD52HUQ a3-704(SP), Z8, Z1
+	VPMADD52LUQ a4-640(SP), Z8, Z1
+	VPMADD52HUQ a4-640(SP), Z8, Z2
+	VPMADD52LUQ a5-576(SP), Z8, Z2
+	VPMADD52HUQ a5-576(SP), Z8, Z3
+	VPMADD52LUQ a6-512(SP), Z8, Z3
+	VPMADD52HUQ a6-512(SP), Z8, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z5, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z10
+	VPADDQ Z10, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-64(SP), Z8
+	VPMADD52LUQ a0-896(SP), Z8, Z6
+	VPMADD52HUQ a0-896(SP), Z8, Z7
+	VPMADD52LUQ a1-832(SP), Z8, Z7
+	VPMADD52HUQ a1-832(SP), Z8, Z0
+	VPMADD52LUQ a2-768(SP), Z8, Z0
+	VPMADD52HUQ a2-768(SP), Z8, Z1
+	VPMADD52LUQ a3-704(SP), Z8, Z1
+	VPMADD52HUQ a3-704(SP), Z8, Z2
+	VPMADD52LUQ a4-640(SP), Z8, Z2
+	VPMADD52HUQ a4-640(SP), Z8, Z3
+	VPMADD52LUQ a5-576(SP), Z8, Z3
+	VPMADD52HUQ a5-576(SP), Z8, Z4
+	VPMADD52LUQ a6-512(SP), Z8, Z4
+	VPMADD52HUQ a6-512(SP), Z8, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z9, Z9, Z9
+	VPMADD52LUQ Z11, Z6, Z9
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z9, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z9, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z9, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z9, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z9, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z9, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z9, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z9, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z9, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z9, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z9, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z9, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z9, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z9, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z10
+	VPADDQ Z10, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// propagate the carries
+	VPSRLQ $52, Z7, Z10
+	VPADDQ Z10, Z0, Z0
+	VPANDQ Z12, Z7, Z7
+	VPSRLQ $52, Z0, Z10
+	VPADDQ Z10, Z1, Z1
+	VPANDQ Z12, Z0, Z0
+	VPSRLQ $52, Z1, Z10
+	VPADDQ Z10, Z2, Z2
+	VPANDQ Z12, Z1, Z1
+	VPSRLQ $52, Z2, Z10
+	VPADDQ Z10, Z3, Z3
+	VPANDQ Z12, Z2, Z2
+	VPSRLQ $52, Z3, Z10
+	VPADDQ Z10, Z4, Z4
+	VPANDQ Z12, Z3, Z3
+	VPSRLQ $52, Z4, Z10
+	VPADDQ Z10, Z5, Z5
+	VPANDQ Z12, Z4, Z4
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z7, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a0-896(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a1-832(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a2-768(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a3-704(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a4-640(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z8
+	VPADDQ      Z10, Z8, Z8
+	VPSRAQ      $52, Z8, Z10
+	VPANDQ      Z12, Z8, Z8
+	VMOVDQU64   Z8, a5-576(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z8
+	VPADDQ      Z10, Z8, Z8
+	VMOVDQU64   Z8, a6-512(SP)
+	VPMOVQ2M    Z8, K2
+	VMOVDQU64   Z7, K2, a0-896(SP)
+	VMOVDQU64   Z0, K2, a1-832(SP)
+	VMOVDQU64   Z1, K2, a2-768(SP)
+	VMOVDQU64   Z2, K2, a3-704(SP)
+	VMOVDQU64   Z3, K2, a4-640(SP)
+	VMOVDQU64   Z4, K2, a5-576(SP)
+	VMOVDQU64   Z5, K2, a6-512(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-896(SP), Z7
+	VPSLLQ      $52, a1-832(SP), Z10
+	VPORQ       Z10, Z7, Z7
+	VPSRLQ      $12, a1-832(SP), Z0
+	VPSLLQ      $40, a2-768(SP), Z10
+	VPORQ       Z10, Z0, Z0
+	VPSRLQ      $24, a2-768(SP), Z1
+	VPSLLQ      $28, a3-704(SP), Z10
+	VPORQ       Z10, Z1, Z1
+	VPSRLQ      $36, a3-704(SP), Z2
+	VPSLLQ      $16, a4-640(SP), Z10
+	VPORQ       Z10, Z2, Z2
+	VPSRLQ      $48, a4-640(SP), Z3
+	VPSLLQ      $4, a5-576(SP), Z10
+	VPORQ       Z10, Z3, Z3
+	VPSLLQ      $56, a6-512(SP), Z10
+	VPORQ       Z10, Z3, Z3
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z7, K1, 0(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z13*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z13*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000140, AX
+	ADDQ $0x0000000000000140, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
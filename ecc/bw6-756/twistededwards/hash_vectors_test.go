@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6756_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x3c4d4f209c806d75f1aaa939c776a4d28cd9eee9fc9bc71533568c5dd026b5a315d469872717344c8dec968b4e4d4d3", "0x1dad19f58de1be2636e831ac8618c9c1af913e8dbe66df11f2dc8e6c674279ac040f5ba7baffe3e030b0e08ebd5a8d0"},
+				Q: point{"0x865e85013263f4697a58a4159fef6bd6a3db170e6d546ac682b47b9ae57558334c409f645bc4f64a54bb76ffe7cf79", "0x2b71cc503123a1efef4b04eacf282dbaac5a9c5ad7fba3646c632a3e726382832973efd393622254d6517c93d9944"},
+				u: "0x1a4244a6ea6de9bf045d195e71897be4a5a378c8edb397fef55befa770e5fbd4651b6f444b9c4a3a6f3fe7fb26e205b",
+			},
+			{
+				msg: "abc", P: point{"0x8d54a110d36c009e2c4e4c30e84721b2894afd1fd89e978a7375ddb25ba1d733d4ce1ffeb45fa40b820ae69cf19905", "0x18f899e5051875374c1f5883914581ecdec1612c0f02c1844b7e25eca932d6aad13efd0990e989f0f38bcfce4be7022"},
+				Q: point{"0x3dadd8b8886a3ecb672eeb7ff76de9be43ada0f848feca8c2d66ea895154e28e5b7c06d2b5a1d75c3177ab5d787317f", "0x3ccc0de750f8d2dbb88c1bf8c5d39c8012437ab986f03b321d18439ee06a47ffc55a19f4d9aa94f4230f4ed351797db"},
+				u: "0x1eb67b090682c556c37712a38d238ba205cda5ab5637409016561742973ef19497d6a979e266cc782a4abbfb99f894c",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x245c9be86511eef1ba49bfb35a05c629da3c2b058ac4f0fb19192b242829f6dca2541d1c95fc76df4033853bfcad29e", "0x3a3310c880464b089eb5088928aaf835cd0c14942b15c326ad4c71019803454db0bb8fa44d6ac720edc214769639786"},
+				Q: point{"0x1e8df17590324d30f6d3151b3a65be421c6411b377f255eb5ab327b2f7a64505bf4a297b0a533b3f573d6cb275bc14c", "0x3859cabffa6c8f1a16636f7883afe8a184d51bdfabdcad2486b9311332435fdfa56a03b02183b2730cd9765236a055e"},
+				u: "0xb4b22701264d6e38a7634ec5f46692c9c8d5950b3cd91d2d06ff21d4b7b2a8604528d393505b9295120aa740d43d76",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x264bcbe10f3043952534e1beb95dc16a8968b7c533c2387e2a83416b88957083e322a0f7ffecb0e65f70d6be77c2f3d", "0x384093094cb01ff731232c4d18c3699210ff18a6e92b942ed58848d00af0ed884af88ad73d70d48b531b0979c163c38"},
+				Q: point{"0xc7a696639f9677e5ac5af37ac967acf44edeb7d282397fd68729a75f23569abd516230cc608f51872295d0166f2c40", "0x80149bc90303340b666345fddab11c4e2c6a4d16d3c4175d8f6505c9ea55c55663efc32ef79b02ff03d80f67b01cb1"},
+				u: "0x147c180b723e66e01324245f8ea5526497f9488259272480ac5a25d50cb3fe7c283ff6d5eb4ce9df5f763c6883011ca",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x19002f261faa81f8a4e53d3145fdcd986186640d10d3baecc61b24a075415e60a738ed397eb2017ff2b353386828ec1", "0x3bc427affdf541f899a6aaa2b11053e3aef3f5efe765c07cc66cdadb8c8725d06b85228d2334218fe7ffb30dd23f1e5"},
+				Q: point{"0x2c0ddb47881fe56de93142cba6cc18b786b0fc481ce314818989b06a139a30119df27b0d3955b5c277f631552eaaeab", "0x31e7bff666964f3048439cde97638af94b2903ac8829970db7f7997835bc17636b8a8b5c1c88a6352936f7f2ed76887"},
+				u: "0x71fabe49dab900635160aa142e717fa8a77ae0ad408007b8c8e17e9f9421e22a2da4c347a8efb916ebf52cfa35d27c",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6756_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x1a086a4046d1c259920984921f378ffa369c004f5d4eb41626d64688c3ce500d24b5c60a9b91684d723790a39d43dea", "0x324ad27a20acc055d5cfa36f52a292f45fdb0dd82c1f227eb70203ca01660b300a434cb62db049b4bba02573f13100e"},
+				Q0: point{"0x1fd699d26cf8d1dfd630ed3227a208aa6ba967e4f263706af782eabd66d5bf151fa2501d90ad7df35ea7a13ef573cb4", "0x1aa76535e4c6c6031039eed013f1b4d5e348619f991340158536db916875f6d3e44694ef90f1bfebea2b4c2de302232"},
+				Q1: point{"0x6c159143c77c3239cb7e4b07109f9ad7f171b2cfc8bb30e61bcee36dfe9b4d8763bd12cebe20cfe3eb15a332dab967", "0x12f7b82f9ae6e7e54ae5eaba9dca37fac083b059c71d3568820e6dd1e8cc05e027776661f272513ac0fdc9e405475ab"},
+				u0: "0x1e17aa1d48ab7aa31a2f66e1939a5cd5a7b4fd0c56147bba61076672062983c13bafedf63267015442c904b3bdc7cda", u1: "0x151542bfeab9823b1482e0f0edf3cd47db136dcc48a6968919f761883c549bdec7a1574b9abdb07093d19c7351b0e85",
+			},
+			{
+				msg: "abc", P: point{"0x3651ab0b3b0efa193e78dad3bd4a0bc82f5636cb18a305691e29a733fd4385e96a2723a4cd319b5658c307a3efbceee", "0x2f47598af77811616a0557027f792909a25d18c8b1a8e393fc7002f36bd6bf3702577e41c487df619c6ec362c68b019"},
+				Q0: point{"0x20daf8553a5e0c3ee541ffda428c3f48dcf5aa4da76233f93098a65b08da806606d9aaae009046c75f18667c04f3332", "0xb5352ea5e5367616d7d138b3f44cf85f5e23c3da165c302bde7aa7dd5b0130ed0007ce6f17c9ecc59fb46693d50284"},
+				Q1: point{"0x1ceaedd251beccb7a42102a37e7dde9d3d957575a057626a685fe715a5ca2c6311c98e867880711c3a1e14f2c7f68e1", "0x1757f3d8d0d6789cc53576465b105b815a1fad024247ea3c2f71cb7eda3173eddf7d2d27b1aac356b242c8696ad7b36"},
+				u0: "0x1c70a777c1a4630f2249d386f918b2d41917c0255abec93ffc3e5609d49effd099fba5f466613ed6499122ed1cd916d", u1: "0x8044c65e3011a36fe89767296cbaf2a94dd3db821d211e66c174172e240c792f4ed07bcef9e98b9b253628389bcf8b",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x1e0522ad97cd9439691f3cddfbbb30c2e043001bcfb74e8bac23a5cb6a25e7ffde711af4b96670fca19540ac2c8b57d", "0x11c1094777df1ccb56a4850eb049b7608474b76ffd7a818784e3f66e6049b886ede80f72ecebeafde550b4c5db76eae"},
+				Q0: point{"0x2484b78599de547a6d25a340c6aa88819f5102655d293b6ebe0f7abafa03ae805e5b6ab00a47a04a15b33547466fb52", "0x1d3bed63f7dfccae180717f0e0603367f7a1e9fecc11961035fd7d7ef14c738e3328e108eade1ae35fae3a4556d7ce"},
+				Q1: point{"0xdb759bd178a9dd78f476204076c835a1630a567b2014ba00d7e5b3dcf14bd0b323822c875d7c59cda5df9d4a6a87a7", "0x14d06da2c4fef4b53e9398139785e19f6c1e2e738d0346152c5e70261fff370dc2482020b26ef01ff9a308c30aa6b8f"},
+				u0: "0x18a157c43341fdea2b70441622357be2393c13d7eed09491b007a2ff9e38172db760dd1653db510ced317f76005ae02", u1: "0xea04b2f57b863481c2b7d7ba3e2700832576b490d4180ed5ec6d5e91d3aabc218cd951e4df973fc253b6b24bced746",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x31f03dc1455e7537e674cf7123dcf4fa04adcdf8516e101f08b9bbeda217a93fb3f42d0044812e9c22051c2e15b6797", "0x6cbe72aaab880ceeaba22c8d3db102849b3670b63b9aa981cb28740397e2d1371322aa0dd20bc1be59d182886a7dad"},
+				Q0: point{"0x33fdca40c666e52bdd2e133f9497112163e1b46acf6fc777e17657f3cce64b130350d6075a56c9e187ffab11e7548f8", "0x134a393d01c440efabdffd3f1b98a20b83083b9a2eebd2fad6e2b889c1ce7aa0fedbcbd6f8986c9115a6a21ca0eae07"},
+				Q1: point{"0x22f560fd4e2e2d2bc0ce67132a9bfa851a3d122d703bf0be7f86f0038981883782c825ca4fcff6077eb2341699295c", "0x1fb63e795c100719b4cb1a876804e6a97a83fc1a4ab10f2ee01eb028e3b9d8f734affc50ea102b76cc958771f5da0ec"},
+				u0: "0x2f51ff8e8bd663fe6b839e1261142970c8c45efb9e5384d079d6f4c2d2b621e3d35194b44364c1c1c822c2aeec92ad4", u1: "0x20c72655057afce437a85afc9c200ac56138486cfb13aec82342941cc50c80ddbfafe333d74a98cc08217ce66e9db25",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0xae9cf1ec3e39c15d720f5bb4f955ca35f819f76cf1b302f21bb707e41f5097127e4045230a7054fa89ec5e5d5d56f0", "0x2f5d46af0a330b40de735b647c58944d2f69b0cb1f38ea6461bfc48ef83d279519b0369a45b8ad58f93f8d8c1801a85"},
+				Q0: point{"0x346effcf30a9d0a4efc71638b2f7c0d50e37cba7bb417a7c76c9eafa7ccd65cbee884d2d2b7b61d1416894de4332d05", "0x24aec8e05ce17422a44c993f6d14d322624abee548b365244fa2709e19179a24357056707fc0a2d8e129d77a7687287"},
+				Q1: point{"0xc1050274d5ae9df3cd428d686fbd6afc3023e24a32ba0af8969ac21a7ee944befc53928ffde2ddf800c9e59b3ca2d5", "0x31b64b265040c164558dd1b8e93082b2cdd52974681eb5530ceb083357443f6d5c00016d687d9cfa427be2be64831bc"},
+				u0: "0x226516e0588427e102e5839665143de07f6aaad35dfecd428321989054203efb445baba475010887f8385d8134db25f", u1: "0x33f6ae51369c6727d9343701dab928716a2221a121d4d7651efa189eb881ba2a0dc5d04f3526f1463378ae342473abe",
+			},
+		}}
+}
@@ -0,0 +1,3646 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xf49d00000000008b
+DATA q<>+8(SB)/8, $0xe6913e6870000082
+DATA q<>+16(SB)/8, $0x160cf8aeeaf0a437
+DATA q<>+24(SB)/8, $0x98a116c25667a8f8
+DATA q<>+32(SB)/8, $0x71dcd3dc73ebff2e
+DATA q<>+40(SB)/8, $0x8689c8ed12f9fd90
+DATA q<>+48(SB)/8, $0x03cebaff25b42304
+DATA q<>+56(SB)/8, $0x707ba638e584e919
+DATA q<>+64(SB)/8, $0x528275ef8087be41
+DATA q<>+72(SB)/8, $0xb926186a81d14688
+DATA q<>+80(SB)/8, $0xd187c94004faff3e
+DATA q<>+88(SB)/8, $0x0122e824fb83ce0a
+GLOBL q<>(SB), (RODATA+NOPTR), $96
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x0a5593568fa798dd
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, ra4, ra5, ra6, ra7, ra8, ra9, ra10, ra11, rb0, rb1, rb2, rb3, rb4, rb5, rb6, rb7, rb8, rb9, rb10, rb11) \
+	MOVQ    ra0, rb0;         \
+	SUBQ    q<>(SB), ra0;     \
+	MOVQ    ra1, rb1;         \
+	SBBQ    q<>+8(SB), ra1;   \
+	MOVQ    ra2, rb2;         \
+	SBBQ    q<>+16(SB), ra2;  \
+	MOVQ    ra3, rb3;         \
+	SBBQ    q<>+24(SB), ra3;  \
+	MOVQ    ra4, rb4;         \
+	SBBQ    q<>+32(SB), ra4;  \
+	MOVQ    ra5, rb5;         \
+	SBBQ    q<>+40(SB), ra5;  \
+	MOVQ    ra6, rb6;         \
+	SBBQ    q<>+48(SB), ra6;  \
+	MOVQ    ra7, rb7;         \
+	SBBQ    q<>+56(SB), ra7;  \
+	MOVQ    ra8, rb8;         \
+	SBBQ    q<>+64(SB), ra8;  \
+	MOVQ    ra9, rb9;         \
+	SBBQ    q<>+72(SB), ra9;  \
+	MOVQ    ra10, rb10;       \
+	SBBQ    q<>+80(SB), ra10; \
+	MOVQ    ra11, rb11;       \
+	SBBQ    q<>+88(SB), ra11; \
+	CMOVQCS rb0, ra0;         \
+	CMOVQCS rb1, ra1;         \
+	CMOVQCS rb2, ra2;         \
+	CMOVQCS rb3, ra3;         \
+	CMOVQCS rb4, ra4;         \
+	CMOVQCS rb5, ra5;         \
+	CMOVQCS rb6, ra6;         \
+	CMOVQCS rb7, ra7;         \
+	CMOVQCS rb8, ra8;         \
+	CMOVQCS rb9, ra9;         \
+	CMOVQCS rb10, ra10;       \
+	CMOVQCS rb11, ra11;       \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $12
+DATA indexGatherScatter<>+16(SB)/8, $24
+DATA indexGatherScatter<>+24(SB)/8, $36
+DATA indexGatherScatter<>+32(SB)/8, $48
+DATA indexGatherScatter<>+40(SB)/8, $60
+DATA indexGatherScatter<>+48(SB)/8, $72
+DATA indexGatherScatter<>+56(SB)/8, $84
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0xd00000000008b
+DATA qLimbs52<>+8(SB)/8, $0x6870000082f49
+DATA qLimbs52<>+16(SB)/8, $0xaf0a437e6913e
+DATA qLimbs52<>+24(SB)/8, $0xa8f8160cf8aee
+DATA qLimbs52<>+32(SB)/8, $0xe98a116c25667
+DATA qLimbs52<>+40(SB)/8, $0xdcd3dc73ebff2
+DATA qLimbs52<>+48(SB)/8, $0x8ed12f9fd9071
+DATA qLimbs52<>+56(SB)/8, $0x25b423048689c
+DATA qLimbs52<>+64(SB)/8, $0x4e91903cebaff
+DATA qLimbs52<>+72(SB)/8, $0x41707ba638e58
+DATA qLimbs52<>+80(SB)/8, $0x28275ef8087be
+DATA qLimbs52<>+88(SB)/8, $0x186a81d146885
+DATA qLimbs52<>+96(SB)/8, $0x4faff3eb926
+DATA qLimbs52<>+104(SB)/8, $0x83ce0ad187c94
+DATA qLimbs52<>+112(SB)/8, $0x122e824fb
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $120
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), $96-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// t = a[i] + b[i], the MOVQ don't change the carry flag
+	MOVQ 0(DX), SI
+	ADDQ 0(CX), SI
+	MOVQ SI, t0-96(SP)
+	MOVQ 8(DX), SI
+	ADCQ 8(CX), SI
+	MOVQ SI, t1-88(SP)
+	MOVQ 16(DX), SI
+	ADCQ 16(CX), SI
+	MOVQ SI, t2-80(SP)
+	MOVQ 24(DX), SI
+	ADCQ 24(CX), SI
+	MOVQ SI, t3-72(SP)
+	MOVQ 32(DX), SI
+	ADCQ 32(CX), SI
+	MOVQ SI, t4-64(SP)
+	MOVQ 40(DX), SI
+	ADCQ 40(CX), SI
+	MOVQ SI, t5-56(SP)
+	MOVQ 48(DX), SI
+	ADCQ 48(CX), SI
+	MOVQ SI, t6-48(SP)
+	MOVQ 56(DX), SI
+	ADCQ 56(CX), SI
+	MOVQ SI, t7-40(SP)
+	MOVQ 64(DX), SI
+	ADCQ 64(CX), SI
+	MOVQ SI, t8-32(SP)
+	MOVQ 72(DX), SI
+	ADCQ 72(CX), SI
+	MOVQ SI, t9-24(SP)
+	MOVQ 80(DX), SI
+	ADCQ 80(CX), SI
+	MOVQ SI, t10-16(SP)
+	MOVQ 88(DX), SI
+	ADCQ 88(CX), SI
+	MOVQ SI, t11-8(SP)
+
+	// res[i] = t - q
+	MOVQ t0-96(SP), SI
+	SUBQ q<>+0(SB), SI
+	MOVQ SI, 0(AX)
+	MOVQ t1-88(SP), SI
+	SBBQ q<>+8(SB), SI
+	MOVQ SI, 8(AX)
+	MOVQ t2-80(SP), SI
+	SBBQ q<>+16(SB), SI
+	MOVQ SI, 16(AX)
+	MOVQ t3-72(SP), SI
+	SBBQ q<>+24(SB), SI
+	MOVQ SI, 24(AX)
+	MOVQ t4-64(SP), SI
+	SBBQ q<>+32(SB), SI
+	MOVQ SI, 32(AX)
+	MOVQ t5-56(SP), SI
+	SBBQ q<>+40(SB), SI
+	MOVQ SI, 40(AX)
+	MOVQ t6-48(SP), SI
+	SBBQ q<>+48(SB), SI
+	MOVQ SI, 48(AX)
+	MOVQ t7-40(SP), SI
+	SBBQ q<>+56(SB), SI
+	MOVQ SI, 56(AX)
+	MOVQ t8-32(SP), SI
+	SBBQ q<>+64(SB), SI
+	MOVQ SI, 64(AX)
+	MOVQ t9-24(SP), SI
+	SBBQ q<>+72(SB), SI
+	MOVQ SI, 72(AX)
+	MOVQ t10-16(SP), SI
+	SBBQ q<>+80(SB), SI
+	MOVQ SI, 80(AX)
+	MOVQ t11-8(SP), SI
+	SBBQ q<>+88(SB), SI
+	MOVQ SI, 88(AX)
+
+	// res[i] = t if t < q
+	MOVQ    0(AX), SI
+	CMOVQCS t0-96(SP), SI
+	MOVQ    SI, 0(AX)
+	MOVQ    8(AX), SI
+	CMOVQCS t1-88(SP), SI
+	MOVQ    SI, 8(AX)
+	MOVQ    16(AX), SI
+	CMOVQCS t2-80(SP), SI
+	MOVQ    SI, 16(AX)
+	MOVQ    24(AX), SI
+	CMOVQCS t3-72(SP), SI
+	MOVQ    SI, 24(AX)
+	MOVQ    32(AX), SI
+	CMOVQCS t4-64(SP), SI
+	MOVQ    SI, 32(AX)
+	MOVQ    40(AX), SI
+	CMOVQCS t5-56(SP), SI
+	MOVQ    SI, 40(AX)
+	MOVQ    48(AX), SI
+	CMOVQCS t6-48(SP), SI
+	MOVQ    SI, 48(AX)
+	MOVQ    56(AX), SI
+	CMOVQCS t7-40(SP), SI
+	MOVQ    SI, 56(AX)
+	MOVQ    64(AX), SI
+	CMOVQCS t8-32(SP), SI
+	MOVQ    SI, 64(AX)
+	MOVQ    72(AX), SI
+	CMOVQCS t9-24(SP), SI
+	MOVQ    SI, 72(AX)
+	MOVQ    80(AX), SI
+	CMOVQCS t10-16(SP), SI
+	MOVQ    SI, 80(AX)
+	MOVQ    88(AX), SI
+	CMOVQCS t11-8(SP), SI
+	MOVQ    SI, 88(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000060, AX
+	ADDQ $0x0000000000000060, DX
+	ADDQ $0x0000000000000060, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), $96-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// res[i] = a[i] - b[i], the MOVQ don't change the carry flag
+	MOVQ 0(DX), SI
+	SUBQ 0(CX), SI
+	MOVQ SI, 0(AX)
+	MOVQ 8(DX), SI
+	SBBQ 8(CX), SI
+	MOVQ SI, 8(AX)
+	MOVQ 16(DX), SI
+	SBBQ 16(CX), SI
+	MOVQ SI, 16(AX)
+	MOVQ 24(DX), SI
+	SBBQ 24(CX), SI
+	MOVQ SI, 24(AX)
+	MOVQ 32(DX), SI
+	SBBQ 32(CX), SI
+	MOVQ SI, 32(AX)
+	MOVQ 40(DX), SI
+	SBBQ 40(CX), SI
+	MOVQ SI, 40(AX)
+	MOVQ 48(DX), SI
+	SBBQ 48(CX), SI
+	MOVQ SI, 48(AX)
+	MOVQ 56(DX), SI
+	SBBQ 56(CX), SI
+	MOVQ SI, 56(AX)
+	MOVQ 64(DX), SI
+	SBBQ 64(CX), SI
+	MOVQ SI, 64(AX)
+	MOVQ 72(DX), SI
+	SBBQ 72(CX), SI
+	MOVQ SI, 72(AX)
+	MOVQ 80(DX), SI
+	SBBQ 80(CX), SI
+	MOVQ SI, 80(AX)
+	MOVQ 88(DX), SI
+	SBBQ 88(CX), SI
+	MOVQ SI, 88(AX)
+	SBBQ DI, DI     // mask = -borrow
+
+	// res[i] += q if a[i] < b[i]
+	MOVQ q<>+0(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q0-96(SP)
+	MOVQ q<>+8(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q1-88(SP)
+	MOVQ q<>+16(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q2-80(SP)
+	MOVQ q<>+24(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q3-72(SP)
+	MOVQ q<>+32(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q4-64(SP)
+	MOVQ q<>+40(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q5-56(SP)
+	MOVQ q<>+48(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q6-48(SP)
+	MOVQ q<>+56(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q7-40(SP)
+	MOVQ q<>+64(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q8-32(SP)
+	MOVQ q<>+72(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q9-24(SP)
+	MOVQ q<>+80(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q10-16(SP)
+	MOVQ q<>+88(SB), SI
+	ANDQ DI, SI
+	MOVQ SI, q11-8(SP)
+	MOVQ q0-96(SP), SI
+	ADDQ SI, 0(AX)
+	MOVQ q1-88(SP), SI
+	ADCQ SI, 8(AX)
+	MOVQ q2-80(SP), SI
+	ADCQ SI, 16(AX)
+	MOVQ q3-72(SP), SI
+	ADCQ SI, 24(AX)
+	MOVQ q4-64(SP), SI
+	ADCQ SI, 32(AX)
+	MOVQ q5-56(SP), SI
+	ADCQ SI, 40(AX)
+	MOVQ q6-48(SP), SI
+	ADCQ SI, 48(AX)
+	MOVQ q7-40(SP), SI
+	ADCQ SI, 56(AX)
+	MOVQ q8-32(SP), SI
+	ADCQ SI, 64(AX)
+	MOVQ q9-24(SP), SI
+	ADCQ SI, 72(AX)
+	MOVQ q10-16(SP), SI
+	ADCQ SI, 80(AX)
+	MOVQ q11-8(SP), SI
+	ADCQ SI, 88(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000060, AX
+	ADDQ $0x0000000000000060, DX
+	ADDQ $0x0000000000000060, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[24]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $384-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $1, DX                  // we load 2 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+	VPXORQ       Z5, Z5, Z5
+	VPXORQ       Z6, Z6, Z6
+	VPXORQ       Z7, Z7, Z7
+	VPXORQ       Z8, Z8, Z8
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6          // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z6, Z6
+	VMOVDQU64 64(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z4, Z4
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z7, Z7
+	VMOVDQU64 128(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z5, Z5
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z8, Z8
+
+	// increment pointers to visit next elements
+	ADDQ $0x00000000000000c0, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-384(SP)
+	VMOVDQU64 Z4, acc1-320(SP)
+	VMOVDQU64 Z5, acc2-256(SP)
+	VMOVDQU64 Z6, acc3-192(SP)
+	VMOVDQU64 Z7, acc4-128(SP)
+	VMOVDQU64 Z8, acc5-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-384(SP), BX
+	ADDQ lane12-288(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-376(SP), BX
+	ADDQ lane13-280(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-368(SP), BX
+	ADDQ lane14-272(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-360(SP), BX
+	ADDQ lane15-264(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-352(SP), BX
+	ADDQ lane16-256(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-344(SP), BX
+	ADDQ lane17-248(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane6-336(SP), BX
+	ADDQ lane18-240(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane7-328(SP), BX
+	ADDQ lane19-232(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane8-320(SP), BX
+	ADDQ lane20-224(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane9-312(SP), BX
+	ADDQ lane21-216(SP), BX
+	MOVQ BX, 72(CX)
+	MOVQ lane10-304(SP), BX
+	ADDQ lane22-208(SP), BX
+	MOVQ BX, 80(CX)
+	MOVQ lane11-296(SP), BX
+	ADDQ lane23-200(SP), BX
+	MOVQ BX, 88(CX)
+	MOVQ lane24-192(SP), BX
+	ADDQ lane36-96(SP), BX
+	MOVQ BX, 96(CX)
+	MOVQ lane25-184(SP), BX
+	ADDQ lane37-88(SP), BX
+	MOVQ BX, 104(CX)
+	MOVQ lane26-176(SP), BX
+	ADDQ lane38-80(SP), BX
+	MOVQ BX, 112(CX)
+	MOVQ lane27-168(SP), BX
+	ADDQ lane39-72(SP), BX
+	MOVQ BX, 120(CX)
+	MOVQ lane28-160(SP), BX
+	ADDQ lane40-64(SP), BX
+	MOVQ BX, 128(CX)
+	MOVQ lane29-152(SP), BX
+	ADDQ lane41-56(SP), BX
+	MOVQ BX, 136(CX)
+	MOVQ lane30-144(SP), BX
+	ADDQ lane42-48(SP), BX
+	MOVQ BX, 144(CX)
+	MOVQ lane31-136(SP), BX
+	ADDQ lane43-40(SP), BX
+	MOVQ BX, 152(CX)
+	MOVQ lane32-128(SP), BX
+	ADDQ lane44-32(SP), BX
+	MOVQ BX, 160(CX)
+	MOVQ lane33-120(SP), BX
+	ADDQ lane45-24(SP), BX
+	MOVQ BX, 168(CX)
+	MOVQ lane34-112(SP), BX
+	ADDQ lane46-16(SP), BX
+	MOVQ BX, 176(CX)
+	MOVQ lane35-104(SP), BX
+	ADDQ lane47-8(SP), BX
+	MOVQ BX, 184(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[24]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $192-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+	VPXOR        Y5, Y5, Y5
+	VPXOR        Y6, Y6, Y6
+	VPXOR        Y7, Y7, Y7
+	VPXOR        Y8, Y8, Y8
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8          // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y6, Y6
+	VMOVDQU 32(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y4, Y4
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y7, Y7
+	VMOVDQU 64(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y5, Y5
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y8, Y8
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000060, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-192(SP)
+	VMOVDQU Y4, acc1-160(SP)
+	VMOVDQU Y5, acc2-128(SP)
+	VMOVDQU Y6, acc3-96(SP)
+	VMOVDQU Y7, acc4-64(SP)
+	VMOVDQU Y8, acc5-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-192(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-184(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-176(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-168(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-160(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-152(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane6-144(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane7-136(SP), BX
+	MOVQ BX, 56(CX)
+	MOVQ lane8-128(SP), BX
+	MOVQ BX, 64(CX)
+	MOVQ lane9-120(SP), BX
+	MOVQ BX, 72(CX)
+	MOVQ lane10-112(SP), BX
+	MOVQ BX, 80(CX)
+	MOVQ lane11-104(SP), BX
+	MOVQ BX, 88(CX)
+	MOVQ lane12-96(SP), BX
+	MOVQ BX, 96(CX)
+	MOVQ lane13-88(SP), BX
+	MOVQ BX, 104(CX)
+	MOVQ lane14-80(SP), BX
+	MOVQ BX, 112(CX)
+	MOVQ lane15-72(SP), BX
+	MOVQ BX, 120(CX)
+	MOVQ lane16-64(SP), BX
+	MOVQ BX, 128(CX)
+	MOVQ lane17-56(SP), BX
+	MOVQ BX, 136(CX)
+	MOVQ lane18-48(SP), BX
+	MOVQ BX, 144(CX)
+	MOVQ lane19-40(SP), BX
+	MOVQ BX, 152(CX)
+	MOVQ lane20-32(SP), BX
+	MOVQ BX, 160(CX)
+	MOVQ lane21-24(SP), BX
+	MOVQ BX, 168(CX)
+	MOVQ lane22-16(SP), BX
+	MOVQ BX, 176(CX)
+	MOVQ lane23-8(SP), BX
+	MOVQ BX, 184(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $1920-32
+	MOVQ         $0x000593568fa798dd, SI
+	VPBROADCASTQ SI, Z19
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z20
+	VMOVDQU64    indexGatherScatter<>(SB), Z21
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z21*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z21*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z21*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z21*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z21*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(DX)(Z21*8), K1, Z5
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 48(DX)(Z21*8), K1, Z6
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 56(DX)(Z21*8), K1, Z7
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 64(DX)(Z21*8), K1, Z8
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 72(DX)(Z21*8), K1, Z9
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 80(DX)(Z21*8), K1, Z10
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 88(DX)(Z21*8), K1, Z11
+	VMOVDQU64  Z0, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a0-1920(SP)
+	VPSRLQ     $52, Z0, Z18
+	VPSLLQ     $12, Z1, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a1-1856(SP)
+	VPSRLQ     $40, Z1, Z18
+	VPSLLQ     $24, Z2, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a2-1792(SP)
+	VPSRLQ     $28, Z2, Z18
+	VPSLLQ     $36, Z3, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a3-1728(SP)
+	VPSRLQ     $16, Z3, Z18
+	VPSLLQ     $48, Z4, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a4-1664(SP)
+	VPSRLQ     $4, Z4, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a5-1600(SP)
+	VPSRLQ     $56, Z4, Z18
+	VPSLLQ     $8, Z5, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a6-1536(SP)
+	VPSRLQ     $44, Z5, Z18
+	VPSLLQ     $20, Z6, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a7-1472(SP)
+	VPSRLQ     $32, Z6, Z18
+	VPSLLQ     $32, Z7, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a8-1408(SP)
+	VPSRLQ     $20, Z7, Z18
+	VPSLLQ     $44, Z8, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a9-1344(SP)
+	VPSRLQ     $8, Z8, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a10-1280(SP)
+	VPSRLQ     $60, Z8, Z18
+	VPSLLQ     $4, Z9, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a11-1216(SP)
+	VPSRLQ     $48, Z9, Z18
+	VPSLLQ     $16, Z10, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a12-1152(SP)
+	VPSRLQ     $36, Z10, Z18
+	VPSLLQ     $28, Z11, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a13-1088(SP)
+	VPSRLQ     $24, Z11, Z18
+	VMOVDQU64  Z18, a14-1024(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 12 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z21*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(CX)(Z21*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(CX)(Z21*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(CX)(Z21*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(CX)(Z21*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(CX)(Z21*8), K1, Z5
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 48(CX)(Z21*8), K1, Z6
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 56(CX)(Z21*8), K1, Z7
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 64(CX)(Z21*8), K1, Z8
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 72(CX)(Z21*8), K1, Z9
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 80(CX)(Z21*8), K1, Z10
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 88(CX)(Z21*8), K1, Z11
+	VPSLLQ     $12, Z0, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b0-960(SP)
+	VPSRLQ     $40, Z0, Z18
+	VPSLLQ     $24, Z1, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b1-896(SP)
+	VPSRLQ     $28, Z1, Z18
+	VPSLLQ     $36, Z2, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b2-832(SP)
+	VPSRLQ     $16, Z2, Z18
+	VPSLLQ     $48, Z3, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b3-768(SP)
+	VPSRLQ     $4, Z3, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b4-704(SP)
+	VPSRLQ     $56, Z3, Z18
+	VPSLLQ     $8, Z4, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b5-640(SP)
+	VPSRLQ     $44, Z4, Z18
+	VPSLLQ     $20, Z5, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b6-576(SP)
+	VPSRLQ     $32, Z5, Z18
+	VPSLLQ     $32, Z6, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b7-512(SP)
+	VPSRLQ     $20, Z6, Z18
+	VPSLLQ     $44, Z7, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b8-448(SP)
+	VPSRLQ     $8, Z7, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b9-384(SP)
+	VPSRLQ     $60, Z7, Z18
+	VPSLLQ     $4, Z8, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b10-320(SP)
+	VPSRLQ     $48, Z8, Z18
+	VPSLLQ     $16, Z9, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b11-256(SP)
+	VPSRLQ     $36, Z9, Z18
+	VPSLLQ     $28, Z10, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b12-192(SP)
+	VPSRLQ     $24, Z10, Z18
+	VPSLLQ     $40, Z11, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, b13-128(SP)
+	VPSRLQ     $12, Z11, Z18
+	VMOVDQU64  Z18, b14-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+	VPXORQ     Z9, Z9, Z9
+	VPXORQ     Z10, Z10, Z10
+	VPXORQ     Z11, Z11, Z11
+	VPXORQ     Z12, Z12, Z12
+	VPXORQ     Z13, Z13, Z13
+	VPXORQ     Z14, Z14, Z14
+	VPXORQ     Z15, Z15, Z15
+
+	// t += a * b[0]
+	VMOVDQU64   b0-960(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z0
+	VPMADD52HUQ a0-1920(SP), Z16, Z1
+	VPMADD52LUQ a1-1856(SP), Z16, Z1
+	VPMADD52HUQ a1-1856(SP), Z16, Z2
+	VPMADD52LUQ a2-1792(SP), Z16, Z2
+	VPMADD52HUQ a2-1792(SP), Z16, Z3
+	VPMADD52LUQ a3-1728(SP), Z16, Z3
+	VPMADD52HUQ a3-1728(SP), Z16, Z4
+	VPMADD52LUQ a4-1664(SP), Z16, Z4
+	VPMADD52HUQ a4-1664(SP), Z16, Z5
+	VPMADD52LUQ a5-1600(SP), Z16, Z5
+	VPMADD52HUQ a5-1600(SP), Z16, Z6
+	VPMADD52LUQ a6-1536(SP), Z16, Z6
+	VPMADD52HUQ a6-1536(SP), Z16, Z7
+	VPMADD52LUQ a7-1472(SP), Z16, Z7
+	VPMADD52HUQ a7-1472(SP), Z16, Z8
+	VPMADD52LUQ a8-1408(SP), Z16, Z8
+	VPMADD52HUQ a8-1408(SP), Z16, Z9
+	VPMADD52LUQ a9-1344(SP), Z16, Z9
+	VPMADD52HUQ a9-1344(SP), Z16, Z10
+	VPMADD52LUQ a10-1280(SP), Z16, Z10
+	VPMADD52HUQ a10-1280(SP), Z16, Z11
+	VPMADD52LUQ a11-1216(SP), Z16, Z11
+	VPMADD52HUQ a11-1216(SP), Z16, Z12
+	VPMADD52LUQ a12-1152(SP), Z16, Z12
+	VPMADD52HUQ a12-1152(SP), Z16, Z13
+	VPMADD52LUQ a13-1088(SP), Z16, Z13
+	VPMADD52HUQ a13-1088(SP), Z16, Z14
+	VPMADD52LUQ a14-1024(SP), Z16, Z14
+	VPMADD52HUQ a14-1024(SP), Z16, Z15
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z0, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z15
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z18
+	VPADDQ Z18, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-896(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z1
+	VPMADD52HUQ a0-1920(SP), Z16, Z2
+	VPMADD52LUQ a1-1856(SP), Z16, Z2
+	VPMADD52HUQ a1-1856(SP), Z16, Z3
+	VPMADD52LUQ a2-1792(SP), Z16, Z3
+	VPMADD52HUQ a2-1792(SP), Z16, Z4
+	VPMADD52LUQ a3-1728(SP), Z16, Z4
+	VPMADD52HUQ a3-1728(SP), Z16, Z5
+	VPMADD52LUQ a4-1664(SP), Z16, Z5
+	VPMADD52HUQ a4-1664(SP), Z16, Z6
+	VPMADD52LUQ a5-1600(SP), Z16, Z6
+	VPMADD52HUQ a5-1600(SP), Z16, Z7
+	VPMADD52LUQ a6-1536(SP), Z16, Z7
+	VPMADD52HUQ a6-1536(SP), Z16, Z8
+	VPMADD52LUQ a7-1472(SP), Z16, Z8
+	VPMADD52HUQ a7-1472(SP), Z16, Z9
+	VPMADD52LUQ a8-1408(SP), Z16, Z9
+	VPMADD52HUQ a8-1408(SP), Z16, Z10
+	VPMADD52LUQ a9-1344(SP), Z16, Z10
+	VPMADD52HUQ a9-1344(SP), Z16, Z11
+	VPMADD52LUQ a10-1280(SP), Z16, Z11
+	VPMADD52HUQ a10-1280(SP), Z16, Z12
+	VPMADD52LUQ a11-1216(SP), Z16, Z12
+	VPMADD52HUQ a11-1216(SP), Z16, Z13
+	VPMADD52LUQ a12-1152(SP), Z16, Z13
+	VPMADD52HUQ a12-1152(SP), Z16, Z14
+	VPMADD52LUQ a13-1088(SP), Z16, Z14
+	VPMADD52HUQ a13-1088(SP), Z16, Z15
+	VPMADD52LUQ a14-1024(SP), Z16, Z15
+	VPMADD52HUQ a14-1024(SP), Z16, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z1, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z18
+	VPADDQ Z18, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-832(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z2
+	VPMADD52HUQ a0-1920(SP), Z16, Z3
+	VPMADD52LUQ a1-1856(SP), Z16, Z3
+	VPMADD52HUQ a1-1856(SP), Z16, Z4
+	VPMADD52LUQ a2-1792(SP), Z16, Z4
+	VPMADD52HUQ a2-1792(SP), Z16, Z5
+	VPMADD52LUQ a3-1728(SP), Z16, Z5
+	VPMADD52HUQ a3-1728(SP), Z16, Z6
+	VPMADD52LUQ a4-1664(SP), Z16, Z6
+	VPMADD52HUQ a4-1664(SP), Z16, Z7
+	VPMADD52LUQ a5-1600(SP), Z16, Z7
+	VPMADD52HUQ a5-1600(SP), Z16, Z8
+	VPMADD52LUQ a6-1536(SP), Z16, Z8
+	VPMADD52HUQ a6-1536(SP), Z16, Z9
+	VPMADD52LUQ a7-1472(SP), Z16, Z9
+	VPMADD52HUQ a7-1472(SP), Z16, Z10
+	VPMADD52LUQ a8-1408(SP), Z16, Z10
+	VPMADD52HUQ a8-1408(SP), Z16, Z11
+	VPMADD52LUQ a9-1344(SP), Z16, Z11
+	VPMADD52HUQ a9-1344(SP), Z16, Z12
+	VPMADD52LUQ a10-1280(SP), Z16, Z12
+	VPMADD52HUQ a10-1280(SP), Z16, Z13
+	VPMADD52LUQ a11-1216(SP), Z16, Z13
+	VPMADD52HUQ a11-1216(SP), Z16, Z14
+	VPMADD52LUQ a12-1152(SP), Z16, Z14
+	VPMADD52HUQ a12-1152(SP), Z16, Z15
+	VPMADD52LUQ a13-1088(SP), Z16, Z15
+	VPMADD52HUQ a13-1088(SP), Z16, Z0
+	VPMADD52LUQ a14-1024(SP), Z16, Z0
+	VPMADD52HUQ a14-1024(SP), Z16, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z2, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z18
+	VPADDQ Z18, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-768(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z3
+	VPMADD52HUQ a0-1920(SP), Z16, Z4
+	VPMADD52LUQ a1-1856(SP), Z16, Z4
+	VPMADD52HUQ a1-1856(SP), Z16, Z5
+	VPMADD52LUQ a2-1792(SP), Z16, Z5
+	VPMADD52HUQ a2-1792(SP), Z16, Z6
+	VPMADD52LUQ a3-1728(SP), Z16, Z6
+	VPMADD52HUQ a3-1728(SP), Z16, Z7
+	VPMADD52LUQ a4-1664(SP), Z16, Z7
+	VPMADD52HUQ a4-1664(SP), Z16, Z8
+	VPMADD52LUQ a5-1600(SP), Z16, Z8
+	VPMADD52HUQ a5-1600(SP), Z16, Z9
+	VPMADD52LUQ a6-1536(SP), Z16, Z9
+	VPMADD52HUQ a6-1536(SP), Z16, Z10
+	VPMADD52LUQ a7-1472(SP), Z16, Z10
+	VPMADD52HUQ a7-1472(SP), Z16, Z11
+	VPMADD52LUQ a8-1408(SP), Z16, Z11
+	VPMADD52HUQ a8-1408(SP), Z16, Z12
+	VPMADD52LUQ a9-1344(SP), Z16, Z12
+	VPMADD52HUQ a9-1344(SP), Z16, Z13
+	VPMADD52LUQ a10-1280(SP), Z16, Z13
+	VPMADD52HUQ a10-1280(SP), Z16, Z14
+	VPMADD52LUQ a11-1216(SP), Z16, Z14
+	VPMADD52HUQ a11-1216(SP), Z16, Z15
+	VPMADD52LUQ a12-1152(SP), Z16, Z15
+	VPMADD52HUQ a12-1152(SP), Z16, Z0
+	VPMADD52LUQ a13-1088(SP), Z16, Z0
+	VPMADD52HUQ a13-1088(SP), Z16, Z1
+	VPMADD52LUQ a14-1024(SP), Z16, Z1
+	VPMADD52HUQ a14-1024(SP), Z16, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z3, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z18
+	VPADDQ Z18, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-704(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z4
+	VPMADD52HUQ a0-1920(SP), Z16, Z5
+	VPMADD52LUQ a1-1856(SP), Z16, Z5
+	VPMADD52HUQ a1-1856(SP), Z16, Z6
+	VPMADD52LUQ a2-1792(SP), Z16, Z6
+	VPMADD52HUQ a2-1792(SP), Z16, Z7
+	VPMADD52LUQ a3-1728(SP), Z16, Z7
+	VPMADD52HUQ a3-1728(SP), Z16, Z8
+	VPMADD52LUQ a4-1664(SP), Z16, Z8
+	VPMADD52HUQ a4-1664(SP), Z16, Z9
+	VPMADD52LUQ a5-1600(SP), Z16, Z9
+	VPMADD52HUQ a5-1600(SP), Z16, Z10
+	VPMADD52LUQ a6-1536(SP), Z16, Z10
+	VPMADD52HUQ a6-1536(SP), Z16, Z11
+	VPMADD52LUQ a7-1472(SP), Z16, Z11
+	VPMADD52HUQ a7-1472(SP), Z16, Z12
+	VPMADD52LUQ a8-1408(SP), Z16, Z12
+	VPMADD52HUQ a8-1408(SP), Z16, Z13
+	VPMADD52LUQ a9-1344(SP), Z16, Z13
+	VPMADD52HUQ a9-1344(SP), Z16, Z14
+	VPMADD52LUQ a10-1280(SP), Z16, Z14
+	VPMADD52HUQ a10-1280(SP), Z16, Z15
+	VPMADD52LUQ a11-1216(SP), Z16, Z15
+	VPMADD52HUQ a11-1216(SP), Z16, Z0
+	VPMADD52LUQ a12-1152(SP), Z16, Z0
+	VPMADD52HUQ a12-1152(SP), Z16, Z1
+	VPMADD52LUQ a13-1088(SP), Z16, Z1
+	VPMADD52HUQ a13-1088(SP), Z16, Z2
+	VPMADD52LUQ a14-1024(SP), Z16, Z2
+	VPMADD52HUQ a14-1024(SP), Z16, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z4, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z18
+	VPADDQ Z18, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-640(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z5
+	VPMADD52HUQ a0-1920(SP), Z16, Z6
+	VPMADD52LUQ a1-1856(SP), Z16, Z6
+	VPMADD52HUQ a1-1856(SP), Z16, Z7
+	VPMADD52LUQ a2-1792(SP), Z16, Z7
+	VPMADD52HUQ a2-1792(SP), Z16, Z8
+	VPMADD52LUQ a3-1728(SP), Z16, Z8
+	VPMADD52HUQ a3-1728(SP), Z16, Z9
+	VPMADD52LUQ a4-1664(SP), Z16, Z9
+	VPMADD52HUQ a4-1664(SP), Z16, Z10
+	VPMADD52LUQ a5-1600(SP), Z16, Z10
+	VPMADD52HUQ a5-1600(SP), Z16, Z11
+	VPMADD52LUQ a6-1536(SP), Z16, Z11
+	VPMADD52HUQ a6-1536(SP), Z16, Z12
+	VPMADD52LUQ a7-1472(SP), Z16, Z12
+	VPMADD52HUQ a7-1472(SP), Z16, Z13
+	VPMADD52LUQ a8-1408(SP), Z16, Z13
+	VPMADD52HUQ a8-1408(SP), Z16, Z14
+	VPMADD52LUQ a9-1344(SP), Z16, Z14
+	VPMADD52HUQ a9-1344(SP), Z16, Z15
+	VPMADD52LUQ a10-1280(SP), Z16, Z15
+	VPMADD52HUQ a10-1280(SP), Z16, Z0
+	VPMADD52LUQ a11-1216(SP), Z16, Z0
+	VPMADD52HUQ a11-1216(SP), Z16, Z1
+	VPMADD52LUQ a12-1152(SP), Z16, Z1
+	VPMADD52HUQ a12-1152(SP), Z16, Z2
+	VPMADD52LUQ a13-1088(SP), Z16, Z2
+	VPMADD52HUQ a13-1088(SP), Z16, Z3
+	VPMADD52LUQ a14-1024(SP), Z16, Z3
+	VPMADD52HUQ a14-1024(SP), Z16, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z5, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z18
+	VPADDQ Z18, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-576(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z6
+	VPMADD52HUQ a0-1920(SP), Z16, Z7
+	VPMADD52LUQ a1-1856(SP), Z16, Z7
+	VPMADD52HUQ a1-1856(SP), Z16, Z8
+	VPMADD52LUQ a2-1792(SP), Z16, Z8
+	VPMADD52HUQ a2-1792(SP), Z16, Z9
+	VPMADD52LUQ a3-1728(SP), Z16, Z9
+	VPMADD52HUQ a3-1728(SP), Z16, Z10
+	VPMADD52LUQ a4-1664(SP), Z16, Z10
+	VPMADD52HUQ a4-1664(SP), Z16, Z11
+	VPMADD52LUQ a5-1600(SP), Z16, Z11
+	VPMADD52HUQ a5-1600(SP), Z16, Z12
+	VPMADD52LUQ a6-1536(SP), Z16, Z12
+	VPMADD52HUQ a6-1536(SP), Z16, Z13
+	VPMADD52LUQ a7-1472(SP), Z16, Z13
+	VPMADD52HUQ a7-1472(SP), Z16, Z14
+	VPMADD52LUQ a8-1408(SP), Z16, Z14
+	VPMADD52HUQ a8-1408(SP), Z16, Z15
+	VPMADD52LUQ a9-1344(SP), Z16, Z15
+	VPMADD52HUQ a9-1344(SP), Z16, Z0
+	VPMADD52LUQ a10-1280(SP), Z16, Z0
+	VPMADD52HUQ a10-1280(SP), Z16, Z1
+	VPMADD52LUQ a11-1216(SP), Z16, Z1
+	VPMADD52HUQ a11-1216(SP), Z16, Z2
+	VPMADD52LUQ a12-1152(SP), Z16, Z2
+	VPMADD52HUQ a12-1152(SP), Z16, Z3
+	VPMADD52LUQ a13-1088(SP), Z16, Z3
+	VPMADD52HUQ a13-1088(SP), Z16, Z4
+	VPMADD52LUQ a14-1024(SP), Z16, Z4
+	VPMADD52HUQ a14-1024(SP), Z16, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z6, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z18
+	VPADDQ Z18, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// t += a * b[7]
+	VMOVDQU64   b7-512(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z7
+	VPMADD52HUQ a0-1920(SP), Z16, Z8
+	VPMADD52LUQ a1-1856(SP), Z16, Z8
+	VPMADD52HUQ a1-1856(SP), Z16, Z9
+	VPMADD52LUQ a2-1792(SP), Z16, Z9
+	VPMADD52HUQ a2-1792(SP), Z16, Z10
+	VPMADD52LUQ a3-1728(SP), Z16, Z10
+	VPMADD52HUQ a3-1728(SP), Z16, Z11
+	VPMADD52LUQ a4-1664(SP), Z16, Z11
+	VPMADD52HUQ a4-1664(SP), Z16, Z12
+	VPMADD52LUQ a5-1600(SP), Z16, Z12
+	VPMADD52HUQ a5-1600(SP), Z16, Z13
+	VPMADD52LUQ a6-1536(SP), Z16, Z13
+	VPMADD52HUQ a6-1536(SP), Z16, Z14
+	VPMADD52LUQ a7-1472(SP), Z16, Z14
+	VPMADD52HUQ a7-1472(SP), Z16, Z15
+	VPMADD52LUQ a8-1408(SP), Z16, Z15
+	VPMADD52HUQ a8-1408(SP), Z16, Z0
+	VPMADD52LUQ a9-1344(SP), Z16, Z0
+	VPMADD52HUQ a9-1344(SP), Z16, Z1
+	VPMADD52LUQ a10-1280(SP), Z16, Z1
+	VPMADD52HUQ a10-1280(SP), Z16, Z2
+	VPMADD52LUQ a11-1216(SP), Z16, Z2
+	VPMADD52HUQ a11-1216(SP), Z16, Z3
+	VPMADD52LUQ a12-1152(SP), Z16, Z3
+	VPMADD52HUQ a12-1152(SP), Z16, Z4
+	VPMADD52LUQ a13-1088(SP), Z16, Z4
+	VPMADD52HUQ a13-1088(SP), Z16, Z5
+	VPMADD52LUQ a14-1024(SP), Z16, Z5
+	VPMADD52HUQ a14-1024(SP), Z16, Z6
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z7, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z6
+
+	// t >>= 52
+	VPSRLQ $52, Z7, Z18
+	VPADDQ Z18, Z8, Z8
+	VPXORQ Z7, Z7, Z7
+
+	// t += a * b[8]
+	VMOVDQU64   b8-448(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z8
+	VPMADD52HUQ a0-1920(SP), Z16, Z9
+	VPMADD52LUQ a1-1856(SP), Z16, Z9
+	VPMADD52HUQ a1-1856(SP), Z16, Z10
+	VPMADD52LUQ a2-1792(SP), Z16, Z10
+	VPMADD52HUQ a2-1792(SP), Z16, Z11
+	VPMADD52LUQ a3-1728(SP), Z16, Z11
+	VPMADD52HUQ a3-1728(SP), Z16, Z12
+	VPMADD52LUQ a4-1664(SP), Z16, Z12
+	VPMADD52HUQ a4-1664(SP), Z16, Z13
+	VPMADD52LUQ a5-1600(SP), Z16, Z13
+	VPMADD52HUQ a5-1600(SP), Z16, Z14
+	VPMADD52LUQ a6-1536(SP), Z16, Z14
+	VPMADD52HUQ a6-1536(SP), Z16, Z15
+	VPMADD52LUQ a7-1472(SP), Z16, Z15
+	VPMADD52HUQ a7-1472(SP), Z16, Z0
+	VPMADD52LUQ a8-1408(SP), Z16, Z0
+	VPMADD52HUQ a8-1408(SP), Z16, Z1
+	VPMADD52LUQ a9-1344(SP), Z16, Z1
+	VPMADD52HUQ a9-1344(SP), Z16, Z2
+	VPMADD52LUQ a10-1280(SP), Z16, Z2
+	VPMADD52HUQ a10-1280(SP), Z16, Z3
+	VPMADD52LUQ a11-1216(SP), Z16, Z3
+	VPMADD52HUQ a11-1216(SP), Z16, Z4
+	VPMADD52LUQ a12-1152(SP), Z16, Z4
+	VPMADD52HUQ a12-1152(SP), Z16, Z5
+	VPMADD52LUQ a13-1088(SP), Z16, Z5
+	VPMADD52HUQ a13-1088(SP), Z16, Z6
+	VPMADD52LUQ a14-1024(SP), Z16, Z6
+	VPMADD52HUQ a14-1024(SP), Z16, Z7
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z8, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z7
+
+	// t >>= 52
+	VPSRLQ $52, Z8, Z18
+	VPADDQ Z18, Z9, Z9
+	VPXORQ Z8, Z8, Z8
+
+	// t += a * b[9]
+	VMOVDQU64   b9-384(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z9
+	VPMADD52HUQ a0-1920(SP), Z16, Z10
+	VPMADD52LUQ a1-1856(SP), Z16, Z10
+	VPMADD52HUQ a1-1856(SP), Z16, Z11
+	VPMADD52LUQ a2-1792(SP), Z16, Z11
+	VPMADD52HUQ a2-1792(SP), Z16, Z12
+	VPMADD52LUQ a3-1728(SP), Z16, Z12
+	VPMADD52HUQ a3-1728(SP), Z16, Z13
+	VPMADD52LUQ a4-1664(SP), Z16, Z13
+	VPMADD52HUQ a4-1664(SP), Z16, Z14
+	VPMADD52LUQ a5-1600(SP), Z16, Z14
+	VPMADD52HUQ a5-1600(SP), Z16, Z15
+	VPMADD52LUQ a6-1536(SP), Z16, Z15
+	VPMADD52HUQ a6-1536(SP), Z16, Z0
+	VPMADD52LUQ a7-1472(SP), Z16, Z0
+	VPMADD52HUQ a7-1472(SP), Z16, Z1
+	VPMADD52LUQ a8-1408(SP), Z16, Z1
+	VPMADD52HUQ a8-1408(SP), Z16, Z2
+	VPMADD52LUQ a9-1344(SP), Z16, Z2
+	VPMADD52HUQ a9-1344(SP), Z16, Z3
+	VPMADD52LUQ a10-1280(SP), Z16, Z3
+	VPMADD52HUQ a10-1280(SP), Z16, Z4
+	VPMADD52LUQ a11-1216(SP), Z16, Z4
+	VPMADD52HUQ a11-1216(SP), Z16, Z5
+	VPMADD52LUQ a12-1152(SP), Z16, Z5
+	VPMADD52HUQ a12-1152(SP), Z16, Z6
+	VPMADD52LUQ a13-1088(SP), Z16, Z6
+	VPMADD52HUQ a13-1088(SP), Z16, Z7
+	VPMADD52LUQ a14-1024(SP), Z16, Z7
+	VPMADD52HUQ a14-1024(SP), Z16, Z8
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z9, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z8
+
+	// t >>= 52
+	VPSRLQ $52, Z9, Z18
+	VPADDQ Z18, Z10, Z10
+	VPXORQ Z9, Z9, Z9
+
+	// t += a * b[10]
+	VMOVDQU64   b10-320(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z10
+	VPMADD52HUQ a0-1920(SP), Z16, Z11
+	VPMADD52LUQ a1-1856(SP), Z16, Z11
+	VPMADD52HUQ a1-1856(SP), Z16, Z12
+	VPMADD52LUQ a2-1792(SP), Z16, Z12
+	VPMADD52HUQ a2-1792(SP), Z16, Z13
+	VPMADD52LUQ a3-1728(SP), Z16, Z13
+	VPMADD52HUQ a3-1728(SP), Z16, Z14
+	VPMADD52LUQ a4-1664(SP), Z16, Z14
+	VPMADD52HUQ a4-1664(SP), Z16, Z15
+	VPMADD52LUQ a5-1600(SP), Z16, Z15
+	VPMADD52HUQ a5-1600(SP), Z16, Z0
+	VPMADD52LUQ a6-1536(SP), Z16, Z0
+	VPMADD52HUQ a6-1536(SP), Z16, Z1
+	VPMADD52LUQ a7-1472(SP), Z16, Z1
+	VPMADD52HUQ a7-1472(SP), Z16, Z2
+	VPMADD52LUQ a8-1408(SP), Z16, Z2
+	VPMADD52HUQ a8-1408(SP), Z16, Z3
+	VPMADD52LUQ a9-1344(SP), Z16, Z3
+	VPMADD52HUQ a9-1344(SP), Z16, Z4
+	VPMADD52LUQ a10-1280(SP), Z16, Z4
+	VPMADD52HUQ a10-1280(SP), Z16, Z5
+	VPMADD52LUQ a11-1216(SP), Z16, Z5
+	VPMADD52HUQ a11-1216(SP), Z16, Z6
+	VPMADD52LUQ a12-1152(SP), Z16, Z6
+	VPMADD52HUQ a12-1152(SP), Z16, Z7
+	VPMADD52LUQ a13-1088(SP), Z16, Z7
+	VPMADD52HUQ a13-1088(SP), Z16, Z8
+	VPMADD52LUQ a14-1024(SP), Z16, Z8
+	VPMADD52HUQ a14-1024(SP), Z16, Z9
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z10, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z9
+
+	// t >>= 52
+	VPSRLQ $52, Z10, Z18
+	VPADDQ Z18, Z11, Z11
+	VPXORQ Z10, Z10, Z10
+
+	// t += a * b[11]
+	VMOVDQU64   b11-256(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z11
+	VPMADD52HUQ a0-1920(SP), Z16, Z12
+	VPMADD52LUQ a1-1856(SP), Z16, Z12
+	VPMADD52HUQ a1-1856(SP), Z16, Z13
+	VPMADD52LUQ a2-1792(SP), Z16, Z13
+	VPMADD52HUQ a2-1792(SP), Z16, Z14
+	VPMADD52LUQ a3-1728(SP), Z16, Z14
+	VPMADD52HUQ a3-1728(SP), Z16, Z15
+	VPMADD52LUQ a4-1664(SP), Z16, Z15
+	VPMADD52HUQ a4-1664(SP), Z16, Z0
+	VPMADD52LUQ a5-1600(SP), Z16, Z0
+	VPMADD52HUQ a5-1600(SP), Z16, Z1
+	VPMADD52LUQ a6-1536(SP), Z16, Z1
+	VPMADD52HUQ a6-1536(SP), Z16, Z2
+	VPMADD52LUQ a7-1472(SP), Z16, Z2
+	VPMADD52HUQ a7-1472(SP), Z16, Z3
+	VPMADD52LUQ a8-1408(SP), Z16, Z3
+	VPMADD52HUQ a8-1408(SP), Z16, Z4
+	VPMADD52LUQ a9-1344(SP), Z16, Z4
+	VPMADD52HUQ a9-1344(SP), Z16, Z5
+	VPMADD52LUQ a10-1280(SP), Z16, Z5
+	VPMADD52HUQ a10-1280(SP), Z16, Z6
+	VPMADD52LUQ a11-1216(SP), Z16, Z6
+	VPMADD52HUQ a11-1216(SP), Z16, Z7
+	VPMADD52LUQ a12-1152(SP), Z16, Z7
+	VPMADD52HUQ a12-1152(SP), Z16, Z8
+	VPMADD52LUQ a13-1088(SP), Z16, Z8
+	VPMADD52HUQ a13-1088(SP), Z16, Z9
+	VPMADD52LUQ a14-1024(SP), Z16, Z9
+	VPMADD52HUQ a14-1024(SP), Z16, Z10
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z11, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z10
+
+	// t >>= 52
+	VPSRLQ $52, Z11, Z18
+	VPADDQ Z18, Z12, Z12
+	VPXORQ Z11, Z11, Z11
+
+	// t += a * b[12]
+	VMOVDQU64   b12-192(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z12
+	VPMADD52HUQ a0-1920(SP), Z16, Z13
+	VPMADD52LUQ a1-1856(SP), Z16, Z13
+	VPMADD52HUQ a1-1856(SP), Z16, Z14
+	VPMADD52LUQ a2-1792(SP), Z16, Z14
+	VPMADD52HUQ a2-1792(SP), Z16, Z15
+	VPMADD52LUQ a3-1728(SP), Z16, Z15
+	VPMADD52HUQ a3-1728(SP), Z16, Z0
+	VPMADD52LUQ a4-1664(SP), Z16, Z0
+	VPMADD52HUQ a4-1664(SP), Z16, Z1
+	VPMADD52LUQ a5-1600(SP), Z16, Z1
+	VPMADD52HUQ a5-1600(SP), Z16, Z2
+	VPMADD52LUQ a6-1536(SP), Z16, Z2
+	VPMADD52HUQ a6-1536(SP), Z16, Z3
+	VPMADD52LUQ a7-1472(SP), Z16, Z3
+	VPMADD52HUQ a7-1472(SP), Z16, Z4
+	VPMADD52LUQ a8-1408(SP), Z16, Z4
+	VPMADD52HUQ a8-1408(SP), Z16, Z5
+	VPMADD52LUQ a9-1344(SP), Z16, Z5
+	VPMADD52HUQ a9-1344(SP), Z16, Z6
+	VPMADD52LUQ a10-1280(SP), Z16, Z6
+	VPMADD52HUQ a10-1280(SP), Z16, Z7
+	VPMADD52LUQ a11-1216(SP), Z16, Z7
+	VPMADD52HUQ a11-1216(SP), Z16, Z8
+	VPMADD52LUQ a12-1152(SP), Z16, Z8
+	VPMADD52HUQ a12-1152(SP), Z16, Z9
+	VPMADD52LUQ a13-1088(SP), Z16, Z9
+	VPMADD52HUQ a13-1088(SP), Z16, Z10
+	VPMADD52LUQ a14-1024(SP), Z16, Z10
+	VPMADD52HUQ a14-1024(SP), Z16, Z11
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z12, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z11
+
+	// t >>= 52
+	VPSRLQ $52, Z12, Z18
+	VPADDQ Z18, Z13, Z13
+	VPXORQ Z12, Z12, Z12
+
+	// t += a * b[13]
+	VMOVDQU64   b13-128(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z13
+	VPMADD52HUQ a0-1920(SP), Z16, Z14
+	VPMADD52LUQ a1-1856(SP), Z16, Z14
+	VPMADD52HUQ a1-1856(SP), Z16, Z15
+	VPMADD52LUQ a2-1792(SP), Z16, Z15
+	VPMADD52HUQ a2-1792(SP), Z16, Z0
+	VPMADD52LUQ a3-1728(SP), Z16, Z0
+	VPMADD52HUQ a3-1728(SP), Z16, Z1
+	VPMADD52LUQ a4-1664(SP), Z16, Z1
+	VPMADD52HUQ a4-1664(SP), Z16, Z2
+	VPMADD52LUQ a5-1600(SP), Z16, Z2
+	VPMADD52HUQ a5-1600(SP), Z16, Z3
+	VPMADD52LUQ a6-1536(SP), Z16, Z3
+	VPMADD52HUQ a6-1536(SP), Z16, Z4
+	VPMADD52LUQ a7-1472(SP), Z16, Z4
+	VPMADD52HUQ a7-1472(SP), Z16, Z5
+	VPMADD52LUQ a8-1408(SP), Z16, Z5
+	VPMADD52HUQ a8-1408(SP), Z16, Z6
+	VPMADD52LUQ a9-1344(SP), Z16, Z6
+	VPMADD52HUQ a9-1344(SP), Z16, Z7
+	VPMADD52LUQ a10-1280(SP), Z16, Z7
+	VPMADD52HUQ a10-1280(SP), Z16, Z8
+	VPMADD52LUQ a11-1216(SP), Z16, Z8
+	VPMADD52HUQ a11-1216(SP), Z16, Z9
+	VPMADD52LUQ a12-1152(SP), Z16, Z9
+	VPMADD52HUQ a12-1152(SP), Z16, Z10
+	VPMADD52LUQ a13-1088(SP), Z16, Z10
+	VPMADD52HUQ a13-1088(SP), Z16, Z11
+	VPMADD52LUQ a14-1024(SP), Z16, Z11
+	VPMADD52HUQ a14-1024(SP), Z16, Z12
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z13, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z12
+
+	// t >>= 52
+	VPSRLQ $52, Z13, Z18
+	VPADDQ Z18, Z14, Z14
+	VPXORQ Z13, Z13, Z13
+
+	// t += a * b[14]
+	VMOVDQU64   b14-64(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z14
+	VPMADD52HUQ a0-1920(SP), Z16, Z15
+	VPMADD52LUQ a1-1856(SP), Z16, Z15
+	VPMADD52HUQ a1-1856(SP), Z16, Z0
+	VPMADD52LUQ a2-1792(SP), Z16, Z0
+	VPMADD52HUQ a2-1792(SP), Z16, Z1
+	VPMADD52LUQ a3-1728(SP), Z16, Z1
+	VPMADD52HUQ a3-1728(SP), Z16, Z2
+	VPMADD52LUQ a4-1664(SP), Z16, Z2
+	VPMADD52HUQ a4-1664(SP), Z16, Z3
+	VPMADD52LUQ a5-1600(SP), Z16, Z3
+	VPMADD52HUQ a5-1600(SP), Z16, Z4
+	VPMADD52LUQ a6-1536(SP), Z16, Z4
+	VPMADD52HUQ a6-1536(SP), Z16, Z5
+	VPMADD52LUQ a7-1472(SP), Z16, Z5
+	VPMADD52HUQ a7-1472(SP), Z16, Z6
+	VPMADD52LUQ a8-1408(SP), Z16, Z6
+	VPMADD52HUQ a8-1408(SP), Z16, Z7
+	VPMADD52LUQ a9-1344(SP), Z16, Z7
+	VPMADD52HUQ a9-1344(SP), Z16, Z8
+	VPMADD52LUQ a10-1280(SP), Z16, Z8
+	VPMADD52HUQ a10-1280(SP), Z16, Z9
+	VPMADD52LUQ a11-1216(SP), Z16, Z9
+	VPMADD52HUQ a11-1216(SP), Z16, Z10
+	VPMADD52LUQ a12-1152(SP), Z16, Z10
+	VPMADD52HUQ a12-1152(SP), Z16, Z11
+	VPMADD52LUQ a13-1088(SP), Z16, Z11
+	VPMADD52HUQ a13-1088(SP), Z16, Z12
+	VPMADD52LUQ a14-1024(SP), Z16, Z12
+	VPMADD52HUQ a14-1024(SP), Z16, Z13
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z14, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z13
+
+	// t >>= 52
+	VPSRLQ $52, Z14, Z18
+	VPADDQ Z18, Z15, Z15
+	VPXORQ Z14, Z14, Z14
+
+	// propagate the carries
+	VPSRLQ $52, Z15, Z18
+	VPADDQ Z18, Z0, Z0
+	VPANDQ Z20, Z15, Z15
+	VPSRLQ $52, Z0, Z18
+	VPADDQ Z18, Z1, Z1
+	VPANDQ Z20, Z0, Z0
+	VPSRLQ $52, Z1, Z18
+	VPADDQ Z18, Z2, Z2
+	VPANDQ Z20, Z1, Z1
+	VPSRLQ $52, Z2, Z18
+	VPADDQ Z18, Z3, Z3
+	VPANDQ Z20, Z2, Z2
+	VPSRLQ $52, Z3, Z18
+	VPADDQ Z18, Z4, Z4
+	VPANDQ Z20, Z3, Z3
+	VPSRLQ $52, Z4, Z18
+	VPADDQ Z18, Z5, Z5
+	VPANDQ Z20, Z4, Z4
+	VPSRLQ $52, Z5, Z18
+	VPADDQ Z18, Z6, Z6
+	VPANDQ Z20, Z5, Z5
+	VPSRLQ $52, Z6, Z18
+	VPADDQ Z18, Z7, Z7
+	VPANDQ Z20, Z6, Z6
+	VPSRLQ $52, Z7, Z18
+	VPADDQ Z18, Z8, Z8
+	VPANDQ Z20, Z7, Z7
+	VPSRLQ $52, Z8, Z18
+	VPADDQ Z18, Z9, Z9
+	VPANDQ Z20, Z8, Z8
+	VPSRLQ $52, Z9, Z18
+	VPADDQ Z18, Z10, Z10
+	VPANDQ Z20, Z9, Z9
+	VPSRLQ $52, Z10, Z18
+	VPADDQ Z18, Z11, Z11
+	VPANDQ Z20, Z10, Z10
+	VPSRLQ $52, Z11, Z18
+	VPADDQ Z18, Z12, Z12
+	VPANDQ Z20, Z11, Z11
+	VPSRLQ $52, Z12, Z18
+	VPADDQ Z18, Z13, Z13
+	VPANDQ Z20, Z12, Z12
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z15, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a0-1920(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a1-1856(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a2-1792(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a3-1728(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a4-1664(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a5-1600(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a6-1536(SP)
+	VPSUBQ.BCST qLimbs52<>+56(SB), Z6, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a7-1472(SP)
+	VPSUBQ.BCST qLimbs52<>+64(SB), Z7, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a8-1408(SP)
+	VPSUBQ.BCST qLimbs52<>+72(SB), Z8, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a9-1344(SP)
+	VPSUBQ.BCST qLimbs52<>+80(SB), Z9, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a10-1280(SP)
+	VPSUBQ.BCST qLimbs52<>+88(SB), Z10, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a11-1216(SP)
+	VPSUBQ.BCST qLimbs52<>+96(SB), Z11, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a12-1152(SP)
+	VPSUBQ.BCST qLimbs52<>+104(SB), Z12, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a13-1088(SP)
+	VPSUBQ.BCST qLimbs52<>+112(SB), Z13, Z16
+	VPADDQ      Z18, Z16, Z16
+	VMOVDQU64   Z16, a14-1024(SP)
+	VPMOVQ2M    Z16, K2
+	VMOVDQU64   Z15, K2, a0-1920(SP)
+	VMOVDQU64   Z0, K2, a1-1856(SP)
+	VMOVDQU64   Z1, K2, a2-1792(SP)
+	VMOVDQU64   Z2, K2, a3-1728(SP)
+	VMOVDQU64   Z3, K2, a4-1664(SP)
+	VMOVDQU64   Z4, K2, a5-1600(SP)
+	VMOVDQU64   Z5, K2, a6-1536(SP)
+	VMOVDQU64   Z6, K2, a7-1472(SP)
+	VMOVDQU64   Z7, K2, a8-1408(SP)
+	VMOVDQU64   Z8, K2, a9-1344(SP)
+	VMOVDQU64   Z9, K2, a10-1280(SP)
+	VMOVDQU64   Z10, K2, a11-1216(SP)
+	VMOVDQU64   Z11, K2, a12-1152(SP)
+	VMOVDQU64   Z12, K2, a13-1088(SP)
+	VMOVDQU64   Z13, K2, a14-1024(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-1920(SP), Z15
+	VPSLLQ      $52, a1-1856(SP), Z18
+	VPORQ       Z18, Z15, Z15
+	VPSRLQ      $12, a1-1856(SP), Z0
+	VPSLLQ      $40, a2-1792(SP), Z18
+	VPORQ       Z18, Z0, Z0
+	VPSRLQ      $24, a2-1792(SP), Z1
+	VPSLLQ      $28, a3-1728(SP), Z18
+	VPORQ       Z18, Z1, Z1
+	VPSRLQ      $36, a3-1728(SP), Z2
+	VPSLLQ      $16, a4-1664(SP), Z18
+	VPORQ       Z18, Z2, Z2
+	VPSRLQ      $48, a4-1664(SP), Z3
+	VPSLLQ      $4, a5-1600(SP), Z18
+	VPORQ       Z18, Z3, Z3
+	VPSLLQ      $56, a6-1536(SP), Z18
+	VPORQ       Z18, Z3, Z3
+	VPSRLQ      $8, a6-1536(SP), Z4
+	VPSLLQ      $44, a7-1472(SP), Z18
+	VPORQ       Z18, Z4, Z4
+	VPSRLQ      $20, a7-1472(SP), Z5
+	VPSLLQ      $32, a8-1408(SP), Z18
+	VPORQ       Z18, Z5, Z5
+	VPSRLQ      $32, a8-1408(SP), Z6
+	VPSLLQ      $20, a9-1344(SP), Z18
+	VPORQ       Z18, Z6, Z6
+	VPSRLQ      $44, a9-1344(SP), Z7
+	VPSLLQ      $8, a10-1280(SP), Z18
+	VPORQ       Z18, Z7, Z7
+	VPSLLQ      $60, a11-1216(SP), Z18
+	VPORQ       Z18, Z7, Z7
+	VPSRLQ      $4, a11-1216(SP), Z8
+	VPSLLQ      $48, a12-1152(SP), Z18
+	VPORQ       Z18, Z8, Z8
+	VPSRLQ      $16, a12-1152(SP), Z9
+	VPSLLQ      $36, a13-1088(SP), Z18
+	VPORQ       Z18, Z9, Z9
+	VPSRLQ      $28, a13-1088(SP), Z10
+	VPSLLQ      $24, a14-1024(SP), Z18
+	VPORQ       Z18, Z10, Z10
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z15, K1, 0(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z4, K1, 40(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 48(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z6, K1, 56(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z7, K1, 64(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z8, K1, 72(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z9, K1, 80(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z10, K1, 88(AX)(Z21*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000300, AX
+	ADDQ $0x0000000000000300, DX
+	ADDQ $0x0000000000000300, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $1920-32
+	MOVQ         $0x000593568fa798dd, SI
+	VPBROADCASTQ SI, Z19
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z20
+	VMOVDQU64    indexGatherScatter<>(SB), Z21
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPBROADCASTQ 8(CX), Z1
+	VPBROADCASTQ 16(CX), Z2
+	VPBROADCASTQ 24(CX), Z3
+	VPBROADCASTQ 32(CX), Z4
+	VPBROADCASTQ 40(CX), Z5
+	VPBROADCASTQ 48(CX), Z6
+	VPBROADCASTQ 56(CX), Z7
+	VPBROADCASTQ 64(CX), Z8
+	VPBROADCASTQ 72(CX), Z9
+	VPBROADCASTQ 80(CX), Z10
+	VPBROADCASTQ 88(CX), Z11
+	VPSLLQ       $12, Z0, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b0-960(SP)
+	VPSRLQ       $40, Z0, Z18
+	VPSLLQ       $24, Z1, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b1-896(SP)
+	VPSRLQ       $28, Z1, Z18
+	VPSLLQ       $36, Z2, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b2-832(SP)
+	VPSRLQ       $16, Z2, Z18
+	VPSLLQ       $48, Z3, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b3-768(SP)
+	VPSRLQ       $4, Z3, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b4-704(SP)
+	VPSRLQ       $56, Z3, Z18
+	VPSLLQ       $8, Z4, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b5-640(SP)
+	VPSRLQ       $44, Z4, Z18
+	VPSLLQ       $20, Z5, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b6-576(SP)
+	VPSRLQ       $32, Z5, Z18
+	VPSLLQ       $32, Z6, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b7-512(SP)
+	VPSRLQ       $20, Z6, Z18
+	VPSLLQ       $44, Z7, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b8-448(SP)
+	VPSRLQ       $8, Z7, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b9-384(SP)
+	VPSRLQ       $60, Z7, Z18
+	VPSLLQ       $4, Z8, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b10-320(SP)
+	VPSRLQ       $48, Z8, Z18
+	VPSLLQ       $16, Z9, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b11-256(SP)
+	VPSRLQ       $36, Z9, Z18
+	VPSLLQ       $28, Z10, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b12-192(SP)
+	VPSRLQ       $24, Z10, Z18
+	VPSLLQ       $40, Z11, Z16
+	VPORQ        Z16, Z18, Z18
+	VPANDQ       Z20, Z18, Z18
+	VMOVDQU64    Z18, b13-128(SP)
+	VPSRLQ       $12, Z11, Z18
+	VMOVDQU64    Z18, b14-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z21*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z21*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z21*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z21*8), K1, Z3
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 32(DX)(Z21*8), K1, Z4
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 40(DX)(Z21*8), K1, Z5
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 48(DX)(Z21*8), K1, Z6
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 56(DX)(Z21*8), K1, Z7
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 64(DX)(Z21*8), K1, Z8
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 72(DX)(Z21*8), K1, Z9
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 80(DX)(Z21*8), K1, Z10
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 88(DX)(Z21*8), K1, Z11
+	VMOVDQU64  Z0, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a0-1920(SP)
+	VPSRLQ     $52, Z0, Z18
+	VPSLLQ     $12, Z1, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a1-1856(SP)
+	VPSRLQ     $40, Z1, Z18
+	VPSLLQ     $24, Z2, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a2-1792(SP)
+	VPSRLQ     $28, Z2, Z18
+	VPSLLQ     $36, Z3, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a3-1728(SP)
+	VPSRLQ     $16, Z3, Z18
+	VPSLLQ     $48, Z4, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a4-1664(SP)
+	VPSRLQ     $4, Z4, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a5-1600(SP)
+	VPSRLQ     $56, Z4, Z18
+	VPSLLQ     $8, Z5, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a6-1536(SP)
+	VPSRLQ     $44, Z5, Z18
+	VPSLLQ     $20, Z6, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a7-1472(SP)
+	VPSRLQ     $32, Z6, Z18
+	VPSLLQ     $32, Z7, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a8-1408(SP)
+	VPSRLQ     $20, Z7, Z18
+	VPSLLQ     $44, Z8, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a9-1344(SP)
+	VPSRLQ     $8, Z8, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a10-1280(SP)
+	VPSRLQ     $60, Z8, Z18
+	VPSLLQ     $4, Z9, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a11-1216(SP)
+	VPSRLQ     $48, Z9, Z18
+	VPSLLQ     $16, Z10, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a12-1152(SP)
+	VPSRLQ     $36, Z10, Z18
+	VPSLLQ     $28, Z11, Z16
+	VPORQ      Z16, Z18, Z18
+	VPANDQ     Z20, Z18, Z18
+	VMOVDQU64  Z18, a13-1088(SP)
+	VPSRLQ     $24, Z11, Z18
+	VMOVDQU64  Z18, a14-1024(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+	VPXORQ     Z9, Z9, Z9
+	VPXORQ     Z10, Z10, Z10
+	VPXORQ     Z11, Z11, Z11
+	VPXORQ     Z12, Z12, Z12
+	VPXORQ     Z13, Z13, Z13
+	VPXORQ     Z14, Z14, Z14
+	VPXORQ     Z15, Z15, Z15
+
+	// t += a * b[0]
+	VMOVDQU64   b0-960(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z0
+	VPMADD52HUQ a0-1920(SP), Z16, Z1
+	VPMADD52LUQ a1-1856(SP), Z16, Z1
+	VPMADD52HUQ a1-1856(SP), Z16, Z2
+	VPMADD52LUQ a2-1792(SP), Z16, Z2
+	VPMADD52HUQ a2-1792(SP), Z16, Z3
+	VPMADD52LUQ a3-1728(SP), Z16, Z3
+	VPMADD52HUQ a3-1728(SP), Z16, Z4
+	VPMADD52LUQ a4-1664(SP), Z16, Z4
+	VPMADD52HUQ a4-1664(SP), Z16, Z5
+	VPMADD52LUQ a5-1600(SP), Z16, Z5
+	VPMADD52HUQ a5-1600(SP), Z16, Z6
+	VPMADD52LUQ a6-1536(SP), Z16, Z6
+	VPMADD52HUQ a6-1536(SP), Z16, Z7
+	VPMADD52LUQ a7-1472(SP), Z16, Z7
+	VPMADD52HUQ a7-1472(SP), Z16, Z8
+	VPMADD52LUQ a8-1408(SP), Z16, Z8
+	VPMADD52HUQ a8-1408(SP), Z16, Z9
+	VPMADD52LUQ a9-1344(SP), Z16, Z9
+	VPMADD52HUQ a9-1344(SP), Z16, Z10
+	VPMADD52LUQ a10-1280(SP), Z16, Z10
+	VPMADD52HUQ a10-1280(SP), Z16, Z11
+	VPMADD52LUQ a11-1216(SP), Z16, Z11
+	VPMADD52HUQ a11-1216(SP), Z16, Z12
+	VPMADD52LUQ a12-1152(SP), Z16, Z12
+	VPMADD52HUQ a12-1152(SP), Z16, Z13
+	VPMADD52LUQ a13-1088(SP), Z16, Z13
+	VPMADD52HUQ a13-1088(SP), Z16, Z14
+	VPMADD52LUQ a14-1024(SP), Z16, Z14
+	VPMADD52HUQ a14-1024(SP), Z16, Z15
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z0, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z15
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z18
+	VPADDQ Z18, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-896(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z1
+	VPMADD52HUQ a0-1920(SP), Z16, Z2
+	VPMADD52LUQ a1-1856(SP), Z16, Z2
+	VPMADD52HUQ a1-1856(SP), Z16, Z3
+	VPMADD52LUQ a2-1792(SP), Z16, Z3
+	VPMADD52HUQ a2-1792(SP), Z16, Z4
+	VPMADD52LUQ a3-1728(SP), Z16, Z4
+	VPMADD52HUQ a3-1728(SP), Z16, Z5
+	VPMADD52LUQ a4-1664(SP), Z16, Z5
+	VPMADD52HUQ a4-1664(SP), Z16, Z6
+	VPMADD52LUQ a5-1600(SP), Z16, Z6
+	VPMADD52HUQ a5-1600(SP), Z16, Z7
+	VPMADD52LUQ a6-1536(SP), Z16, Z7
+	VPMADD52HUQ a6-1536(SP), Z16, Z8
+	VPMADD52LUQ a7-1472(SP), Z16, Z8
+	VPMADD52HUQ a7-1472(SP), Z16, Z9
+	VPMADD52LUQ a8-1408(SP), Z16, Z9
+	VPMADD52HUQ a8-1408(SP), Z16, Z10
+	VPMADD52LUQ a9-1344(SP), Z16, Z10
+	VPMADD52HUQ a9-1344(SP), Z16, Z11
+	VPMADD52LUQ a10-1280(SP), Z16, Z11
+	VPMADD52HUQ a10-1280(SP), Z16, Z12
+	VPMADD52LUQ a11-1216(SP), Z16, Z12
+	VPMADD52HUQ a11-1216(SP), Z16, Z13
+	VPMADD52LUQ a12-1152(SP), Z16, Z13
+	VPMADD52HUQ a12-1152(SP), Z16, Z14
+	VPMADD52LUQ a13-1088(SP), Z16, Z14
+	VPMADD52HUQ a13-1088(SP), Z16, Z15
+	VPMADD52LUQ a14-1024(SP), Z16, Z15
+	VPMADD52HUQ a14-1024(SP), Z16, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z1, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z18
+	VPADDQ Z18, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-832(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z2
+	VPMADD52HUQ a0-1920(SP), Z16, Z3
+	VPMADD52LUQ a1-1856(SP), Z16, Z3
+	VPMADD52HUQ a1-1856(SP), Z16, Z4
+	VPMADD52LUQ a2-1792(SP), Z16, Z4
+	VPMADD52HUQ a2-1792(SP), Z16, Z5
+	VPMADD52LUQ a3-1728(SP), Z16, Z5
+	VPMADD52HUQ a3-1728(SP), Z16, Z6
+	VPMADD52LUQ a4-1664(SP), Z16, Z6
+	VPMADD52HUQ a4-1664(SP), Z16, Z7
+	VPMADD52LUQ a5-1600(SP), Z16, Z7
+	VPMADD52HUQ a5-1600(SP), Z16, Z8
+	VPMADD52LUQ a6-1536(SP), Z16, Z8
+	VPMADD52HUQ a6-1536(SP), Z16, Z9
+	VPMADD52LUQ a7-1472(SP), Z16, Z9
+	VPMADD52HUQ a7-1472(SP), Z16, Z10
+	VPMADD52LUQ a8-1408(SP), Z16, Z10
+	VPMADD52HUQ a8-1408(SP), Z16, Z11
+	VPMADD52LUQ a9-1344(SP), Z16, Z11
+	VPMADD52HUQ a9-1344(SP), Z16, Z12
+	VPMADD52LUQ a10-1280(SP), Z16, Z12
+	VPMADD52HUQ a10-1280(SP), Z16, Z13
+	VPMADD52LUQ a11-1216(SP), Z16, Z13
+	VPMADD52HUQ a11-1216(SP), Z16, Z14
+	VPMADD52LUQ a12-1152(SP), Z16, Z14
+	VPMADD52HUQ a12-1152(SP), Z16, Z15
+	VPMADD52LUQ a13-1088(SP), Z16, Z15
+	VPMADD52HUQ a13-1088(SP), Z16, Z0
+	VPMADD52LUQ a14-1024(SP), Z16, Z0
+	VPMADD52HUQ a14-1024(SP), Z16, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z2, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z18
+	VPADDQ Z18, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-768(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z3
+	VPMADD52HUQ a0-1920(SP), Z16, Z4
+	VPMADD52LUQ a1-1856(SP), Z16, Z4
+	VPMADD52HUQ a1-1856(SP), Z16, Z5
+	VPMADD52LUQ a2-1792(SP), Z16, Z5
+	VPMADD52HUQ a2-1792(SP), Z16, Z6
+	VPMADD52LUQ a3-1728(SP), Z16, Z6
+	VPMADD52HUQ a3-1728(SP), Z16, Z7
+	VPMADD52LUQ a4-1664(SP), Z16, Z7
+	VPMADD52HUQ a4-1664(SP), Z16, Z8
+	VPMADD52LUQ a5-1600(SP), Z16, Z8
+	VPMADD52HUQ a5-1600(SP), Z16, Z9
+	VPMADD52LUQ a6-1536(SP), Z16, Z9
+	VPMADD52HUQ a6-1536(SP), Z16, Z10
+	VPMADD52LUQ a7-1472(SP), Z16, Z10
+	VPMADD52HUQ a7-1472(SP), Z16, Z11
+	VPMADD52LUQ a8-1408(SP), Z16, Z11
+	VPMADD52HUQ a8-1408(SP), Z16, Z12
+	VPMADD52LUQ a9-1344(SP), Z16, Z12
+	VPMADD52HUQ a9-1344(SP), Z16, Z13
+	VPMADD52LUQ a10-1280(SP), Z16, Z13
+	VPMADD52HUQ a10-1280(SP), Z16, Z14
+	VPMADD52LUQ a11-1216(SP), Z16, Z14
+	VPMADD52HUQ a11-1216(SP), Z16, Z15
+	VPMADD52LUQ a12-1152(SP), Z16, Z15
+	VPMADD52HUQ a12-1152(SP), Z16, Z0
+	VPMADD52LUQ a13-1088(SP), Z16, Z0
+	VPMADD52HUQ a13-1088(SP), Z16, Z1
+	VPMADD52LUQ a14-1024(SP), Z16, Z1
+	VPMADD52HUQ a14-1024(SP), Z16, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z3, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z18
+	VPADDQ Z18, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-704(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z4
+	VPMADD52HUQ a0-1920(SP), Z16, Z5
+	VPMADD52LUQ a1-1856(SP), Z16, Z5
+	VPMADD52HUQ a1-1856(SP), Z16, Z6
+	VPMADD52LUQ a2-1792(SP), Z16, Z6
+	VPMADD52HUQ a2-1792(SP), Z16, Z7
+	VPMADD52LUQ a3-1728(SP), Z16, Z7
+	VPMADD52HUQ a3-1728(SP), Z16, Z8
+	VPMADD52LUQ a4-1664(SP), Z16, Z8
+	VPMADD52HUQ a4-1664(SP), Z16, Z9
+	VPMADD52LUQ a5-1600(SP), Z16, Z9
+	VPMADD52HUQ a5-1600(SP), Z16, Z10
+	VPMADD52LUQ a6-1536(SP), Z16, Z10
+	VPMADD52HUQ a6-1536(SP), Z16, Z11
+	VPMADD52LUQ a7-1472(SP), Z16, Z11
+	VPMADD52HUQ a7-1472(SP), Z16, Z12
+	VPMADD52LUQ a8-1408(SP), Z16, Z12
+	VPMADD52HUQ a8-1408(SP), Z16, Z13
+	VPMADD52LUQ a9-1344(SP), Z16, Z13
+	VPMADD52HUQ a9-1344(SP), Z16, Z14
+	VPMADD52LUQ a10-1280(SP), Z16, Z14
+	VPMADD52HUQ a10-1280(SP), Z16, Z15
+	VPMADD52LUQ a11-1216(SP), Z16, Z15
+	VPMADD52HUQ a11-1216(SP), Z16, Z0
+	VPMADD52LUQ a12-1152(SP), Z16, Z0
+	VPMADD52HUQ a12-1152(SP), Z16, Z1
+	VPMADD52LUQ a13-1088(SP), Z16, Z1
+	VPMADD52HUQ a13-1088(SP), Z16, Z2
+	VPMADD52LUQ a14-1024(SP), Z16, Z2
+	VPMADD52HUQ a14-1024(SP), Z16, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z4, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z18
+	VPADDQ Z18, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// t += a * b[5]
+	VMOVDQU64   b5-640(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z5
+	VPMADD52HUQ a0-1920(SP), Z16, Z6
+	VPMADD52LUQ a1-1856(SP), Z16, Z6
+	VPMADD52HUQ a1-1856(SP), Z16, Z7
+	VPMADD52LUQ a2-1792(SP), Z16, Z7
+	VPMADD52HUQ a2-1792(SP), Z16, Z8
+	VPMADD52LUQ a3-1728(SP), Z16, Z8
+	VPMADD52HUQ a3-1728(SP), Z16, Z9
+	VPMADD52LUQ a4-1664(SP), Z16, Z9
+	VPMADD52HUQ a4-1664(SP), Z16, Z10
+	VPMADD52LUQ a5-1600(SP), Z16, Z10
+	VPMADD52HUQ a5-1600(SP), Z16, Z11
+	VPMADD52LUQ a6-1536(SP), Z16, Z11
+	VPMADD52HUQ a6-1536(SP), Z16, Z12
+	VPMADD52LUQ a7-1472(SP), Z16, Z12
+	VPMADD52HUQ a7-1472(SP), Z16, Z13
+	VPMADD52LUQ a8-1408(SP), Z16, Z13
+	VPMADD52HUQ a8-1408(SP), Z16, Z14
+	VPMADD52LUQ a9-1344(SP), Z16, Z14
+	VPMADD52HUQ a9-1344(SP), Z16, Z15
+	VPMADD52LUQ a10-1280(SP), Z16, Z15
+	VPMADD52HUQ a10-1280(SP), Z16, Z0
+	VPMADD52LUQ a11-1216(SP), Z16, Z0
+	VPMADD52HUQ a11-1216(SP), Z16, Z1
+	VPMADD52LUQ a12-1152(SP), Z16, Z1
+	VPMADD52HUQ a12-1152(SP), Z16, Z2
+	VPMADD52LUQ a13-1088(SP), Z16, Z2
+	VPMADD52HUQ a13-1088(SP), Z16, Z3
+	VPMADD52LUQ a14-1024(SP), Z16, Z3
+	VPMADD52HUQ a14-1024(SP), Z16, Z4
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z5, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z4
+
+	// t >>= 52
+	VPSRLQ $52, Z5, Z18
+	VPADDQ Z18, Z6, Z6
+	VPXORQ Z5, Z5, Z5
+
+	// t += a * b[6]
+	VMOVDQU64   b6-576(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z6
+	VPMADD52HUQ a0-1920(SP), Z16, Z7
+	VPMADD52LUQ a1-1856(SP), Z16, Z7
+	VPMADD52HUQ a1-1856(SP), Z16, Z8
+	VPMADD52LUQ a2-1792(SP), Z16, Z8
+	VPMADD52HUQ a2-1792(SP), Z16, Z9
+	VPMADD52LUQ a3-1728(SP), Z16, Z9
+	VPMADD52HUQ a3-1728(SP), Z16, Z10
+	VPMADD52LUQ a4-1664(SP), Z16, Z10
+	VPMADD52HUQ a4-1664(SP), Z16, Z11
+	VPMADD52LUQ a5-1600(SP), Z16, Z11
+	VPMADD52HUQ a5-1600(SP), Z16, Z12
+	VPMADD52LUQ a6-1536(SP), Z16, Z12
+	VPMADD52HUQ a6-1536(SP), Z16, Z13
+	VPMADD52LUQ a7-1472(SP), Z16, Z13
+	VPMADD52HUQ a7-1472(SP), Z16, Z14
+	VPMADD52LUQ a8-1408(SP), Z16, Z14
+	VPMADD52HUQ a8-1408(SP), Z16, Z15
+	VPMADD52LUQ a9-1344(SP), Z16, Z15
+	VPMADD52HUQ a9-1344(SP), Z16, Z0
+	VPMADD52LUQ a10-1280(SP), Z16, Z0
+	VPMADD52HUQ a10-1280(SP), Z16, Z1
+	VPMADD52LUQ a11-1216(SP), Z16, Z1
+	VPMADD52HUQ a11-1216(SP), Z16, Z2
+	VPMADD52LUQ a12-1152(SP), Z16, Z2
+	VPMADD52HUQ a12-1152(SP), Z16, Z3
+	VPMADD52LUQ a13-1088(SP), Z16, Z3
+	VPMADD52HUQ a13-1088(SP), Z16, Z4
+	VPMADD52LUQ a14-1024(SP), Z16, Z4
+	VPMADD52HUQ a14-1024(SP), Z16, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z6, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z6, Z18
+	VPADDQ Z18, Z7, Z7
+	VPXORQ Z6, Z6, Z6
+
+	// t += a * b[7]
+	VMOVDQU64   b7-512(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z7
+	VPMADD52HUQ a0-1920(SP), Z16, Z8
+	VPMADD52LUQ a1-1856(SP), Z16, Z8
+	VPMADD52HUQ a1-1856(SP), Z16, Z9
+	VPMADD52LUQ a2-1792(SP), Z16, Z9
+	VPMADD52HUQ a2-1792(SP), Z16, Z10
+	VPMADD52LUQ a3-1728(SP), Z16, Z10
+	VPMADD52HUQ a3-1728(SP), Z16, Z11
+	VPMADD52LUQ a4-1664(SP), Z16, Z11
+	VPMADD52HUQ a4-1664(SP), Z16, Z12
+	VPMADD52LUQ a5-1600(SP), Z16, Z12
+	VPMADD52HUQ a5-1600(SP), Z16, Z13
+	VPMADD52LUQ a6-1536(SP), Z16, Z13
+	VPMADD52HUQ a6-1536(SP), Z16, Z14
+	VPMADD52LUQ a7-1472(SP), Z16, Z14
+	VPMADD52HUQ a7-1472(SP), Z16, Z15
+	VPMADD52LUQ a8-1408(SP), Z16, Z15
+	VPMADD52HUQ a8-1408(SP), Z16, Z0
+	VPMADD52LUQ a9-1344(SP), Z16, Z0
+	VPMADD52HUQ a9-1344(SP), Z16, Z1
+	VPMADD52LUQ a10-1280(SP), Z16, Z1
+	VPMADD52HUQ a10-1280(SP), Z16, Z2
+	VPMADD52LUQ a11-1216(SP), Z16, Z2
+	VPMADD52HUQ a11-1216(SP), Z16, Z3
+	VPMADD52LUQ a12-1152(SP), Z16, Z3
+	VPMADD52HUQ a12-1152(SP), Z16, Z4
+	VPMADD52LUQ a13-1088(SP), Z16, Z4
+	VPMADD52HUQ a13-1088(SP), Z16, Z5
+	VPMADD52LUQ a14-1024(SP), Z16, Z5
+	VPMADD52HUQ a14-1024(SP), Z16, Z6
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z7, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z6
+
+	// t >>= 52
+	VPSRLQ $52, Z7, Z18
+	VPADDQ Z18, Z8, Z8
+	VPXORQ Z7, Z7, Z7
+
+	// t += a * b[8]
+	VMOVDQU64   b8-448(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z8
+	VPMADD52HUQ a0-1920(SP), Z16, Z9
+	VPMADD52LUQ a1-1856(SP), Z16, Z9
+	VPMADD52HUQ a1-1856(SP), Z16, Z10
+	VPMADD52LUQ a2-1792(SP), Z16, Z10
+	VPMADD52HUQ a2-1792(SP), Z16, Z11
+	VPMADD52LUQ a3-1728(SP), Z16, Z11
+	VPMADD52HUQ a3-1728(SP), Z16, Z12
+	VPMADD52LUQ a4-1664(SP), Z16, Z12
+	VPMADD52HUQ a4-1664(SP), Z16, Z13
+	VPMADD52LUQ a5-1600(SP), Z16, Z13
+	VPMADD52HUQ a5-1600(SP), Z16, Z14
+	VPMADD52LUQ a6-1536(SP), Z16, Z14
+	VPMADD52HUQ a6-1536(SP), Z16, Z15
+	VPMADD52LUQ a7-1472(SP), Z16, Z15
+	VPMADD52HUQ a7-1472(SP), Z16, Z0
+	VPMADD52LUQ a8-1408(SP), Z16, Z0
+	VPMADD52HUQ a8-1408(SP), Z16, Z1
+	VPMADD52LUQ a9-1344(SP), Z16, Z1
+	VPMADD52HUQ a9-1344(SP), Z16, Z2
+	VPMADD52LUQ a10-1280(SP), Z16, Z2
+	VPMADD52HUQ a10-1280(SP), Z16, Z3
+	VPMADD52LUQ a11-1216(SP), Z16, Z3
+	VPMADD52HUQ a11-1216(SP), Z16, Z4
+	VPMADD52LUQ a12-1152(SP), Z16, Z4
+	VPMADD52HUQ a12-1152(SP), Z16, Z5
+	VPMADD52LUQ a13-1088(SP), Z16, Z5
+	VPMADD52HUQ a13-1088(SP), Z16, Z6
+	VPMADD52LUQ a14-1024(SP), Z16, Z6
+	VPMADD52HUQ a14-1024(SP), Z16, Z7
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z8, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z7
+
+	// t >>= 52
+	VPSRLQ $52, Z8, Z18
+	VPADDQ Z18, Z9, Z9
+	VPXORQ Z8, Z8, Z8
+
+	// t += a * b[9]
+	VMOVDQU64   b9-384(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z9
+	VPMADD52HUQ a0-1920(SP), Z16, Z10
+	VPMADD52LUQ a1-1856(SP), Z16, Z10
+	VPMADD52HUQ a1-1856(SP), Z16, Z11
+	VPMADD52LUQ a2-1792(SP), Z16, Z11
+	VPMADD52HUQ a2-1792(SP), Z16, Z12
+	VPMADD52LUQ a3-1728(SP), Z16, Z12
+	VPMADD52HUQ a3-1728(SP), Z16, Z13
+	VPMADD52LUQ a4-1664(SP), Z16, Z13
+	VPMADD52HUQ a4-1664(SP), Z16, Z14
+	VPMADD52LUQ a5-1600(SP), Z16, Z14
+	VPMADD52HUQ a5-1600(SP), Z16, Z15
+	VPMADD52LUQ a6-1536(SP), Z16, Z15
+	VPMADD52HUQ a6-1536(SP), Z16, Z0
+	VPMADD52LUQ a7-1472(SP), Z16, Z0
+	VPMADD52HUQ a7-1472(SP), Z16, Z1
+	VPMADD52LUQ a8-1408(SP), Z16, Z1
+	VPMADD52HUQ a8-1408(SP), Z16, Z2
+	VPMADD52LUQ a9-1344(SP), Z16, Z2
+	VPMADD52HUQ a9-1344(SP), Z16, Z3
+	VPMADD52LUQ a10-1280(SP), Z16, Z3
+	VPMADD52HUQ a10-1280(SP), Z16, Z4
+	VPMADD52LUQ a11-1216(SP), Z16, Z4
+	VPMADD52HUQ a11-1216(SP), Z16, Z5
+	VPMADD52LUQ a12-1152(SP), Z16, Z5
+	VPMADD52HUQ a12-1152(SP), Z16, Z6
+	VPMADD52LUQ a13-1088(SP), Z16, Z6
+	VPMADD52HUQ a13-1088(SP), Z16, Z7
+	VPMADD52LUQ a14-1024(SP), Z16, Z7
+	VPMADD52HUQ a14-1024(SP), Z16, Z8
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z9, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z8
+
+	// t >>= 52
+	VPSRLQ $52, Z9, Z18
+	VPADDQ Z18, Z10, Z10
+	VPXORQ Z9, Z9, Z9
+
+	// t += a * b[10]
+	VMOVDQU64   b10-320(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z10
+	VPMADD52HUQ a0-1920(SP), Z16, Z11
+	VPMADD52LUQ a1-1856(SP), Z16, Z11
+	VPMADD52HUQ a1-1856(SP), Z16, Z12
+	VPMADD52LUQ a2-1792(SP), Z16, Z12
+	VPMADD52HUQ a2-1792(SP), Z16, Z13
+	VPMADD52LUQ a3-1728(SP), Z16, Z13
+	VPMADD52HUQ a3-1728(SP), Z16, Z14
+	VPMADD52LUQ a4-1664(SP), Z16, Z14
+	VPMADD52HUQ a4-1664(SP), Z16, Z15
+	VPMADD52LUQ a5-1600(SP), Z16, Z15
+	VPMADD52HUQ a5-1600(SP), Z16, Z0
+	VPMADD52LUQ a6-1536(SP), Z16, Z0
+	VPMADD52HUQ a6-1536(SP), Z16, Z1
+	VPMADD52LUQ a7-1472(SP), Z16, Z1
+	VPMADD52HUQ a7-1472(SP), Z16, Z2
+	VPMADD52LUQ a8-1408(SP), Z16, Z2
+	VPMADD52HUQ a8-1408(SP), Z16, Z3
+	VPMADD52LUQ a9-1344(SP), Z16, Z3
+	VPMADD52HUQ a9-1344(SP), Z16, Z4
+	VPMADD52LUQ a10-1280(SP), Z16, Z4
+	VPMADD52HUQ a10-1280(SP), Z16, Z5
+	VPMADD52LUQ a11-1216(SP), Z16, Z5
+	VPMADD52HUQ a11-1216(SP), Z16, Z6
+	VPMADD52LUQ a12-1152(SP), Z16, Z6
+	VPMADD52HUQ a12-1152(SP), Z16, Z7
+	VPMADD52LUQ a13-1088(SP), Z16, Z7
+	VPMADD52HUQ a13-1088(SP), Z16, Z8
+	VPMADD52LUQ a14-1024(SP), Z16, Z8
+	VPMADD52HUQ a14-1024(SP), Z16, Z9
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z10, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z9
+
+	// t >>= 52
+	VPSRLQ $52, Z10, Z18
+	VPADDQ Z18, Z11, Z11
+	VPXORQ Z10, Z10, Z10
+
+	// t += a * b[11]
+	VMOVDQU64   b11-256(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z11
+	VPMADD52HUQ a0-1920(SP), Z16, Z12
+	VPMADD52LUQ a1-1856(SP), Z16, Z12
+	VPMADD52HUQ a1-1856(SP), Z16, Z13
+	VPMADD52LUQ a2-1792(SP), Z16, Z13
+	VPMADD52HUQ a2-1792(SP), Z16, Z14
+	VPMADD52LUQ a3-1728(SP), Z16, Z14
+	VPMADD52HUQ a3-1728(SP), Z16, Z15
+	VPMADD52LUQ a4-1664(SP), Z16, Z15
+	VPMADD52HUQ a4-1664(SP), Z16, Z0
+	VPMADD52LUQ a5-1600(SP), Z16, Z0
+	VPMADD52HUQ a5-1600(SP), Z16, Z1
+	VPMADD52LUQ a6-1536(SP), Z16, Z1
+	VPMADD52HUQ a6-1536(SP), Z16, Z2
+	VPMADD52LUQ a7-1472(SP), Z16, Z2
+	VPMADD52HUQ a7-1472(SP), Z16, Z3
+	VPMADD52LUQ a8-1408(SP), Z16, Z3
+	VPMADD52HUQ a8-1408(SP), Z16, Z4
+	VPMADD52LUQ a9-1344(SP), Z16, Z4
+	VPMADD52HUQ a9-1344(SP), Z16, Z5
+	VPMADD52LUQ a10-1280(SP), Z16, Z5
+	VPMADD52HUQ a10-1280(SP), Z16, Z6
+	VPMADD52LUQ a11-1216(SP), Z16, Z6
+	VPMADD52HUQ a11-1216(SP), Z16, Z7
+	VPMADD52LUQ a12-1152(SP), Z16, Z7
+	VPMADD52HUQ a12-1152(SP), Z16, Z8
+	VPMADD52LUQ a13-1088(SP), Z16, Z8
+	VPMADD52HUQ a13-1088(SP), Z16, Z9
+	VPMADD52LUQ a14-1024(SP), Z16, Z9
+	VPMADD52HUQ a14-1024(SP), Z16, Z10
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z11, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z10
+
+	// t >>= 52
+	VPSRLQ $52, Z11, Z18
+	VPADDQ Z18, Z12, Z12
+	VPXORQ Z11, Z11, Z11
+
+	// t += a * b[12]
+	VMOVDQU64   b12-192(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z12
+	VPMADD52HUQ a0-1920(SP), Z16, Z13
+	VPMADD52LUQ a1-1856(SP), Z16, Z13
+	VPMADD52HUQ a1-1856(SP), Z16, Z14
+	VPMADD52LUQ a2-1792(SP), Z16, Z14
+	VPMADD52HUQ a2-1792(SP), Z16, Z15
+	VPMADD52LUQ a3-1728(SP), Z16, Z15
+	VPMADD52HUQ a3-1728(SP), Z16, Z0
+	VPMADD52LUQ a4-1664(SP), Z16, Z0
+	VPMADD52HUQ a4-1664(SP), Z16, Z1
+	VPMADD52LUQ a5-1600(SP), Z16, Z1
+	VPMADD52HUQ a5-1600(SP), Z16, Z2
+	VPMADD52LUQ a6-1536(SP), Z16, Z2
+	VPMADD52HUQ a6-1536(SP), Z16, Z3
+	VPMADD52LUQ a7-1472(SP), Z16, Z3
+	VPMADD52HUQ a7-1472(SP), Z16, Z4
+	VPMADD52LUQ a8-1408(SP), Z16, Z4
+	VPMADD52HUQ a8-1408(SP), Z16, Z5
+	VPMADD52LUQ a9-1344(SP), Z16, Z5
+	VPMADD52HUQ a9-1344(SP), Z16, Z6
+	VPMADD52LUQ a10-1280(SP), Z16, Z6
+	VPMADD52HUQ a10-1280(SP), Z16, Z7
+	VPMADD52LUQ a11-1216(SP), Z16, Z7
+	VPMADD52HUQ a11-1216(SP), Z16, Z8
+	VPMADD52LUQ a12-1152(SP), Z16, Z8
+	VPMADD52HUQ a12-1152(SP), Z16, Z9
+	VPMADD52LUQ a13-1088(SP), Z16, Z9
+	VPMADD52HUQ a13-1088(SP), Z16, Z10
+	VPMADD52LUQ a14-1024(SP), Z16, Z10
+	VPMADD52HUQ a14-1024(SP), Z16, Z11
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z12, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z13
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z11
+
+	// t >>= 52
+	VPSRLQ $52, Z12, Z18
+	VPADDQ Z18, Z13, Z13
+	VPXORQ Z12, Z12, Z12
+
+	// t += a * b[13]
+	VMOVDQU64   b13-128(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z13
+	VPMADD52HUQ a0-1920(SP), Z16, Z14
+	VPMADD52LUQ a1-1856(SP), Z16, Z14
+	VPMADD52HUQ a1-1856(SP), Z16, Z15
+	VPMADD52LUQ a2-1792(SP), Z16, Z15
+	VPMADD52HUQ a2-1792(SP), Z16, Z0
+	VPMADD52LUQ a3-1728(SP), Z16, Z0
+	VPMADD52HUQ a3-1728(SP), Z16, Z1
+	VPMADD52LUQ a4-1664(SP), Z16, Z1
+	VPMADD52HUQ a4-1664(SP), Z16, Z2
+	VPMADD52LUQ a5-1600(SP), Z16, Z2
+	VPMADD52HUQ a5-1600(SP), Z16, Z3
+	VPMADD52LUQ a6-1536(SP), Z16, Z3
+	VPMADD52HUQ a6-1536(SP), Z16, Z4
+	VPMADD52LUQ a7-1472(SP), Z16, Z4
+	VPMADD52HUQ a7-1472(SP), Z16, Z5
+	VPMADD52LUQ a8-1408(SP), Z16, Z5
+	VPMADD52HUQ a8-1408(SP), Z16, Z6
+	VPMADD52LUQ a9-1344(SP), Z16, Z6
+	VPMADD52HUQ a9-1344(SP), Z16, Z7
+	VPMADD52LUQ a10-1280(SP), Z16, Z7
+	VPMADD52HUQ a10-1280(SP), Z16, Z8
+	VPMADD52LUQ a11-1216(SP), Z16, Z8
+	VPMADD52HUQ a11-1216(SP), Z16, Z9
+	VPMADD52LUQ a12-1152(SP), Z16, Z9
+	VPMADD52HUQ a12-1152(SP), Z16, Z10
+	VPMADD52LUQ a13-1088(SP), Z16, Z10
+	VPMADD52HUQ a13-1088(SP), Z16, Z11
+	VPMADD52LUQ a14-1024(SP), Z16, Z11
+	VPMADD52HUQ a14-1024(SP), Z16, Z12
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z13, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z13
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z14
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z12
+
+	// t >>= 52
+	VPSRLQ $52, Z13, Z18
+	VPADDQ Z18, Z14, Z14
+	VPXORQ Z13, Z13, Z13
+
+	// t += a * b[14]
+	VMOVDQU64   b14-64(SP), Z16
+	VPMADD52LUQ a0-1920(SP), Z16, Z14
+	VPMADD52HUQ a0-1920(SP), Z16, Z15
+	VPMADD52LUQ a1-1856(SP), Z16, Z15
+	VPMADD52HUQ a1-1856(SP), Z16, Z0
+	VPMADD52LUQ a2-1792(SP), Z16, Z0
+	VPMADD52HUQ a2-1792(SP), Z16, Z1
+	VPMADD52LUQ a3-1728(SP), Z16, Z1
+	VPMADD52HUQ a3-1728(SP), Z16, Z2
+	VPMADD52LUQ a4-1664(SP), Z16, Z2
+	VPMADD52HUQ a4-1664(SP), Z16, Z3
+	VPMADD52LUQ a5-1600(SP), Z16, Z3
+	VPMADD52HUQ a5-1600(SP), Z16, Z4
+	VPMADD52LUQ a6-1536(SP), Z16, Z4
+	VPMADD52HUQ a6-1536(SP), Z16, Z5
+	VPMADD52LUQ a7-1472(SP), Z16, Z5
+	VPMADD52HUQ a7-1472(SP), Z16, Z6
+	VPMADD52LUQ a8-1408(SP), Z16, Z6
+	VPMADD52HUQ a8-1408(SP), Z16, Z7
+	VPMADD52LUQ a9-1344(SP), Z16, Z7
+	VPMADD52HUQ a9-1344(SP), Z16, Z8
+	VPMADD52LUQ a10-1280(SP), Z16, Z8
+	VPMADD52HUQ a10-1280(SP), Z16, Z9
+	VPMADD52LUQ a11-1216(SP), Z16, Z9
+	VPMADD52HUQ a11-1216(SP), Z16, Z10
+	VPMADD52LUQ a12-1152(SP), Z16, Z10
+	VPMADD52HUQ a12-1152(SP), Z16, Z11
+	VPMADD52LUQ a13-1088(SP), Z16, Z11
+	VPMADD52HUQ a13-1088(SP), Z16, Z12
+	VPMADD52LUQ a14-1024(SP), Z16, Z12
+	VPMADD52HUQ a14-1024(SP), Z16, Z13
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z17, Z17, Z17
+	VPMADD52LUQ Z19, Z14, Z17
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z17, Z14
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z17, Z15
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z17, Z15
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST qLimbs52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST qLimbs52<>+64(SB), Z17, Z7
+	VPMADD52LUQ.BCST qLimbs52<>+72(SB), Z17, Z7
+	VPMADD52HUQ.BCST qLimbs52<>+72(SB), Z17, Z8
+	VPMADD52LUQ.BCST qLimbs52<>+80(SB), Z17, Z8
+	VPMADD52HUQ.BCST qLimbs52<>+80(SB), Z17, Z9
+	VPMADD52LUQ.BCST qLimbs52<>+88(SB), Z17, Z9
+	VPMADD52HUQ.BCST qLimbs52<>+88(SB), Z17, Z10
+	VPMADD52LUQ.BCST qLimbs52<>+96(SB), Z17, Z10
+	VPMADD52HUQ.BCST qLimbs52<>+96(SB), Z17, Z11
+	VPMADD52LUQ.BCST qLimbs52<>+104(SB), Z17, Z11
+	VPMADD52HUQ.BCST qLimbs52<>+104(SB), Z17, Z12
+	VPMADD52LUQ.BCST qLimbs52<>+112(SB), Z17, Z12
+	VPMADD52HUQ.BCST qLimbs52<>+112(SB), Z17, Z13
+
+	// t >>= 52
+	VPSRLQ $52, Z14, Z18
+	VPADDQ Z18, Z15, Z15
+	VPXORQ Z14, Z14, Z14
+
+	// propagate the carries
+	VPSRLQ $52, Z15, Z18
+	VPADDQ Z18, Z0, Z0
+	VPANDQ Z20, Z15, Z15
+	VPSRLQ $52, Z0, Z18
+	VPADDQ Z18, Z1, Z1
+	VPANDQ Z20, Z0, Z0
+	VPSRLQ $52, Z1, Z18
+	VPADDQ Z18, Z2, Z2
+	VPANDQ Z20, Z1, Z1
+	VPSRLQ $52, Z2, Z18
+	VPADDQ Z18, Z3, Z3
+	VPANDQ Z20, Z2, Z2
+	VPSRLQ $52, Z3, Z18
+	VPADDQ Z18, Z4, Z4
+	VPANDQ Z20, Z3, Z3
+	VPSRLQ $52, Z4, Z18
+	VPADDQ Z18, Z5, Z5
+	VPANDQ Z20, Z4, Z4
+	VPSRLQ $52, Z5, Z18
+	VPADDQ Z18, Z6, Z6
+	VPANDQ Z20, Z5, Z5
+	VPSRLQ $52, Z6, Z18
+	VPADDQ Z18, Z7, Z7
+	VPANDQ Z20, Z6, Z6
+	VPSRLQ $52, Z7, Z18
+	VPADDQ Z18, Z8, Z8
+	VPANDQ Z20, Z7, Z7
+	VPSRLQ $52, Z8, Z18
+	VPADDQ Z18, Z9, Z9
+	VPANDQ Z20, Z8, Z8
+	VPSRLQ $52, Z9, Z18
+	VPADDQ Z18, Z10, Z10
+	VPANDQ Z20, Z9, Z9
+	VPSRLQ $52, Z10, Z18
+	VPADDQ Z18, Z11, Z11
+	VPANDQ Z20, Z10, Z10
+	VPSRLQ $52, Z11, Z18
+	VPADDQ Z18, Z12, Z12
+	VPANDQ Z20, Z11, Z11
+	VPSRLQ $52, Z12, Z18
+	VPADDQ Z18, Z13, Z13
+	VPANDQ Z20, Z12, Z12
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z15, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a0-1920(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a1-1856(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a2-1792(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a3-1728(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a4-1664(SP)
+	VPSUBQ.BCST qLimbs52<>+40(SB), Z4, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a5-1600(SP)
+	VPSUBQ.BCST qLimbs52<>+48(SB), Z5, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a6-1536(SP)
+	VPSUBQ.BCST qLimbs52<>+56(SB), Z6, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a7-1472(SP)
+	VPSUBQ.BCST qLimbs52<>+64(SB), Z7, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a8-1408(SP)
+	VPSUBQ.BCST qLimbs52<>+72(SB), Z8, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a9-1344(SP)
+	VPSUBQ.BCST qLimbs52<>+80(SB), Z9, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a10-1280(SP)
+	VPSUBQ.BCST qLimbs52<>+88(SB), Z10, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a11-1216(SP)
+	VPSUBQ.BCST qLimbs52<>+96(SB), Z11, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a12-1152(SP)
+	VPSUBQ.BCST qLimbs52<>+104(SB), Z12, Z16
+	VPADDQ      Z18, Z16, Z16
+	VPSRAQ      $52, Z16, Z18
+	VPANDQ      Z20, Z16, Z16
+	VMOVDQU64   Z16, a13-1088(SP)
+	VPSUBQ.BCST qLimbs52<>+112(SB), Z13, Z16
+	VPADDQ      Z18, Z16, Z16
+	VMOVDQU64   Z16, a14-1024(SP)
+	VPMOVQ2M    Z16, K2
+	VMOVDQU64   Z15, K2, a0-1920(SP)
+	VMOVDQU64   Z0, K2, a1-1856(SP)
+	VMOVDQU64   Z1, K2, a2-1792(SP)
+	VMOVDQU64   Z2, K2, a3-1728(SP)
+	VMOVDQU64   Z3, K2, a4-1664(SP)
+	VMOVDQU64   Z4, K2, a5-1600(SP)
+	VMOVDQU64   Z5, K2, a6-1536(SP)
+	VMOVDQU64   Z6, K2, a7-1472(SP)
+	VMOVDQU64   Z7, K2, a8-1408(SP)
+	VMOVDQU64   Z8, K2, a9-1344(SP)
+	VMOVDQU64   Z9, K2, a10-1280(SP)
+	VMOVDQU64   Z10, K2, a11-1216(SP)
+	VMOVDQU64   Z11, K2, a12-1152(SP)
+	VMOVDQU64   Z12, K2, a13-1088(SP)
+	VMOVDQU64   Z13, K2, a14-1024(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-1920(SP), Z15
+	VPSLLQ      $52, a1-1856(SP), Z18
+	VPORQ       Z18, Z15, Z15
+	VPSRLQ      $12, a1-1856(SP), Z0
+	VPSLLQ      $40, a2-1792(SP), Z18
+	VPORQ       Z18, Z0, Z0
+	VPSRLQ      $24, a2-1792(SP), Z1
+	VPSLLQ      $28, a3-1728(SP), Z18
+	VPORQ       Z18, Z1, Z1
+	VPSRLQ      $36, a3-1728(SP), Z2
+	VPSLLQ      $16, a4-1664(SP), Z18
+	VPORQ       Z18, Z2, Z2
+	VPSRLQ      $48, a4-1664(SP), Z3
+	VPSLLQ      $4, a5-1600(SP), Z18
+	VPORQ       Z18, Z3, Z3
+	VPSLLQ      $56, a6-1536(SP), Z18
+	VPORQ       Z18, Z3, Z3
+	VPSRLQ      $8, a6-1536(SP), Z4
+	VPSLLQ      $44, a7-1472(SP), Z18
+	VPORQ       Z18, Z4, Z4
+	VPSRLQ      $20, a7-1472(SP), Z5
+	VPSLLQ      $32, a8-1408(SP), Z18
+	VPORQ       Z18, Z5, Z5
+	VPSRLQ      $32, a8-1408(SP), Z6
+	VPSLLQ      $20, a9-1344(SP), Z18
+	VPORQ       Z18, Z6, Z6
+	VPSRLQ      $44, a9-1344(SP), Z7
+	VPSLLQ      $8, a10-1280(SP), Z18
+	VPORQ       Z18, Z7, Z7
+	VPSLLQ      $60, a11-1216(SP), Z18
+	VPORQ       Z18, Z7, Z7
+	VPSRLQ      $4, a11-1216(SP), Z8
+	VPSLLQ      $48, a12-1152(SP), Z18
+	VPORQ       Z18, Z8, Z8
+	VPSRLQ      $16, a12-1152(SP), Z9
+	VPSLLQ      $36, a13-1088(SP), Z18
+	VPORQ       Z18, Z9, Z9
+	VPSRLQ      $28, a13-1088(SP), Z10
+	VPSLLQ      $24, a14-1024(SP), Z18
+	VPORQ       Z18, Z10, Z10
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z15, K1, 0(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z3, K1, 32(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z4, K1, 40(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 48(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z6, K1, 56(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z7, K1, 64(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z8, K1, 72(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z9, K1, 80(AX)(Z21*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z10, K1, 88(AX)(Z21*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000300, AX
+	ADDQ $0x0000000000000300, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
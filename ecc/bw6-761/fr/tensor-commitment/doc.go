@@ -0,0 +1,23 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package tensorcommitment provides a Ligero-like commitment to polynomials laid out as
+// a matrix (https://eprint.iacr.org/2021/1043.pdf), whose rows are encoded with a
+// Reed Solomon code and whose columns are hashed.
+//
+// The polynomials appended to a TensorCommitment can be opened as polynomial.MultiLin
+// with OpenMultilinear, and the proofs checked with VerifyMultilinear.
+package tensorcommitment
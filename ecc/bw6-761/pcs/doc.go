@@ -0,0 +1,23 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package pcs defines a common interface for the polynomial commitment schemes on fr,
+// and adapts the kzg, fri and tensor-commitment packages to it.
+//
+// Protocols written against PolynomialCommitmentScheme can swap one scheme for another
+// without changes: commitments and proofs are opaque values, serialized with WriteTo and
+// decoded with ReadFrom into the empty values returned by the scheme.
+package pcs
@@ -0,0 +1,72 @@
+package twistededwards
+
+// Regression vectors for EncodeToCurve and HashToCurve.
+//
+// RFC 9380 does not define a suite for this curve: these vectors were computed with this
+// implementation, on the messages of the RFC 9380 test vectors (appendix J), to detect changes
+// of its output. They are not independent test vectors.
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6761_EDWARDS_XMD:SHA-256_ELL2_NU_"),
+		cases: []encodeTestCase{
+			{
+				msg: "", P: point{"0x69b8760e306c231942be69034508451a0a797a5da0b9f978c0ab179870539608d2a440db3073f574b7c8a9b36f4e75", "0x1189eede560e20fa7d1def29635412cdedc803a4cdeebd0c0c3a130a0f4cbbfc5732146f1bf2750f9f089b39d68241"},
+				Q: point{"0x8cec124b381c7f29a76728edcf6d8da44ae2aa5ea5f3089d419a77409bcf223ebe4a25506c367279df91c8b4d8ef84", "0x1aac701cc990fa6e14f186a69a545f654018bda5e7c13c39125a0812a3d15b43faaea50cfaa91fc1fe968c361cce46f"},
+				u: "0xe9d4f1fc122f54de0f4a9ea9d95eb74d42fa657de773c38164713356bf629ef84546129995fa6ed678aba91cf965c2",
+			},
+			{
+				msg: "abc", P: point{"0x8b3c84a3768863c5f0855c3ca1b1e62be4308ad225f86f191cfce9525001449bf9b057f4070fc5810844a2488dfe5a", "0x893466c372ecb6b65d14cb50badddd25b673fd2e0625708578122c5e830f4934de546535fed5faa49c38ea1e7e49f2"},
+				Q: point{"0x1159dd1a5558579279a438a9983131c31aea5af5e6ac93d75a5610827cc6002974708fb7688f57f6cff1ea0cf63125b", "0x17902a317433f485a9758197863b7f9fcd9c0e447aeaf169cc99d1d0dcd48875a85d3685e0b3c7355636c6422482bdc"},
+				u: "0x192efea23064559975bde9a600bcd987ca1d32a91874e3c74c1456efe92a7ec2982cd849734f65925760957d1c869ca",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0x17ead410d348e27de674d52f26124526d79e7349c7cb81045ffb239f2f055242f8960a7b7cd804cb1b5d428980bb1d5", "0x5b05f82822e58a81358459a93b0f5d44a6de1aca62975383523ae730eaef97d3c1bd50a8b92ff3597f98dc97f46f24"},
+				Q: point{"0x145c5a38176aa04c4f6f79aa6e2b621d60553c6b74d62219e5b242f442d9b3e6f279139eb4b0424a861773577f333e6", "0x4ca6515af1777bbdd6dd9cb45f29228902538544639c527cab175180cf74104b174a2f7d6a3db5f0d52eeb21232fa7"},
+				u: "0x15027ee6effbe22aa299a5b65e161b4c547686057c5dfc69ed3e0a8f208527075f4fe583c6977a0d2d55892d4ae1d54",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0xb76fa77b73f4f9850b9d4818f366d49e65e441cd29a3fa671475bbe9e7cfda6ef42ad44faf882df5fc2a22319d84b9", "0x9c7478f8e6542acdf1470a40cd112ceb4ec792b878edaf40a743dccc4ceacb98dc3d4d491ae0fd540be76209f7478f"},
+				Q: point{"0x10c9bc51b0847f2192a77d30f84a552cbbae8e520a384bc26e478c30efd51a80f57327d9cddb6ca3942cb1eaf4d7ede", "0xe7dc61a676df6e3f6f50170198f04f1b777a2e2628e589d84b289c8863092a6d4ee985fcaede1e5b51b768e7e8e73a"},
+				u: "0x109ac038fa9edc7588ea0b91c068d90d66b2c2d51e57e8c5e890efa5b104e0c298bba7a379af05cfda3e01237a9030b",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x43dd62c7f3f1a39ceac500546a51cdd453679fdad05576d954a11017b0cee831d6cfe8e8c01d5ca930aaa2e623696f", "0x19fc5e510aca509dad3f9d398b573c48baf5986c911ed4458ba3c71281bd1ce5ea96de7664f7cdcb8bc88df8c0fdfdd"},
+				Q: point{"0x10b15eca5e97c8977aa5baae3140ece9fef8ed5008076ccb21b0628a0a86b1e3de0946d8e1220958f8bac5c00d63c2f", "0xc79af96770949b70adb6da414101a847e279c231285e8b68cbf1c614402e661951cdaf0e0f35dcd4ffb25317074b32"},
+				u: "0x1776340fa934f2de997fd93b30e60a559cc3b5148a75d51ba5a09c4420d814cbdf57c33bacfcf02c1080f3d71cd3d6d",
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("gnark-crypto regression vectors: BW6761_EDWARDS_XMD:SHA-256_ELL2_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x88af8669e1d630092c95af9707b2158cf99ac4d8700160f01bd9825876d1103cee1766732988024af4e8ccba9d5a56", "0x240cbd6642e82a0fee6b350b7c7f3c3b63b2d9abe989ca50d2da25934b8e282aa03a5d05c28915762c3c116e67e645"},
+				Q0: point{"0x147b2c1606f217df305688d0098d02171c1f438f7eded3498c4f240e68f41f0064e902a7d6ecf034d184686b0a4b860", "0x12140b9d2d342e5d299605213a253112fe532105e7d0304555423e890c5beb72b0959e76aebadb99a5ebb10126bf1a5"},
+				Q1: point{"0x779c9dd21a8d97b70fc89b3bc3235c4e4a434185980f700d361c55102886364e8fce91f75e8570342e97f8e47370f", "0x8708677c93dfc7152c73dff185a85e4c8196bea284310e34785b4fb704182adee09150e451c61e9c3288fcb8d58103"},
+				u0: "0x9ab3883499d05737a21a3a06c3d5bf52d7a15a08ff8385267056946d1fdc0da955f7c45e6103d5d18903e912f3a911", u1: "0x1a441a16ef11579313313477cf3441656989dd89e090e2445ef7235bfade91d91c9c9cc14e25bb4230160c271679c36",
+			},
+			{
+				msg: "abc", P: point{"0xb5df7cd0e8448a4ee3c1de90d48f991ca4cf8c9da03f2577c9ff63160fc2b65fda1fbcc97923ea3c587933aab86d75", "0xda4031f7c196b0d8f9f19a84245b62cf8d8dfd65378be4a761c2121bdb7ddea7c81a36f3d1774b2fa786beb664e48e"},
+				Q0: point{"0x18be9ccfd4f604ac2e2ccf3dee3fddf294e6830cb8d77de54e1409d621fc9f8e872afb271830629499fc7c6b37454d", "0x19dc02d1cfc8e1caca76b5ea4dbb81c4b0974bbf4334a805cb1973b0fd1e813097310c8cc36b902faeca318e5aa054"},
+				Q1: point{"0xe57edc8eba891f128f4fb292204173201e8c1524c3177dfdaad5b755cf660fe95d009b8be13b820dae12b7aee7cd43", "0x14001c0a96d0e00d8fb5d4e7e08b500c615d2be0de60876da0e5160850ad8edd7a1e6272755687d72c767c7e893e719"},
+				u0: "0x10c635ddc0c9e0a3767c93f3642281871bc5fdeead46f1585c2842916b2c960aa16c1458b1cb18e5cbe1d95ac3a2631", u1: "0x166a0b12d9f49496f78afa5f17fb8bc788e8e59ba2b60a708c270ddf16623d91dd6db7724a162f43cf38085e35e2bf6",
+			},
+			{
+				msg: "abcdef0123456789", P: point{"0xda09677bd3df8533089529b5ac1ed172468fa3bfc70820f9d3f3186772b513479a9119711085d6bda544c41a00ed5a", "0x579155643c23e44cffbe43a9054eae9595222fdc44826314bac54e3525e122171e88c3c70145684ebd98fcd0b545e6"},
+				Q0: point{"0x349ae91e6eec631614628276e5425416e5f454e0b7b118872f7b69c170d092b56d741b6120d2b292ce5d7e258020bd", "0x57a6377db458a617918cbd37b41d66b4db7a3aa187d4f9bfa59b8dd996838cdfb894f46dac3567dc537218d37012fc"},
+				Q1: point{"0x1757f7fe40bb8b18bbdbf3f6c7ff02ea98594f4b810d68914a234a8245633b16d5e29ef14e06b2eab868e4894957cea", "0x1a39aa60befcaf6a07513bba41112b64563d1639eedcb443414f75051aefc833bc58aac104f8d0e72d9234b07b211ee"},
+				u0: "0x1809ebea4855f8d78a8acfa6041eb49f2a41aeb014855f82be715583d9dc686ea4610cdcc719336f48df5e9b3738a1", u1: "0x1091c67fb6e7105b367df862c4e8f1e307a8904ec570159d0bb01d8f5d4e33c4ad41c4988a6d84b32def0c142e8d90f",
+			},
+			{
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0xba5cf63583367f58fdde52fa74aad7e9c11dd0a6a5ed90f4865de05f08cde3f400deb9782e52ecedae17faa92f314", "0x1643e4688cde4b2f084f4c22fcfba18911eeb85a1c986dea606700d1d54882d3f6fecbb4618a7391de005025c882737"},
+				Q0: point{"0x11b7436667a9f5ba62a079f5e23080c5fe53195b001b375b8b9af1a3e8fcb82c467782d636244cdce7f8381a3f84e66", "0xb9ed26080e1d27b98418ead9c32488f12bafa5ef07eb5526d0b9b92940ebdd53d3e245f128c9dd0e2fae4fa61455f9"},
+				Q1: point{"0x3ae1de14df8003b0eed493239992f6c66ad7ca8be1da8fd975ce968533c199b6e61fc213e6d76d1e6badd1bd93d6da", "0x1032decd0985136ca7fdebfae47465fd2a7a5102f225078e40137f9899df793b721736f778ff5e789db6f3d5d5d17c1"},
+				u0: "0x1958eb6a5def645b85ab37f5dc66d641d216b97c6009c81802d9920517ce6ed2b65fc2fde49967fdecf8e4adbd7d33", u1: "0x13cc7fefafcf50d11aa65903a440cb9f99cb2aa1a3144e8fa189b000f57e60444fe9e5eecbe2834c17e57da4613edcb",
+			},
+			{
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x3a8f358cfff6713b4ab46d1f86d0f161926999a82e7e271bf75f356605ade0ccecc4bfea66d49105112d394d08d2e9", "0x10042062eed3f4147694efb22f11ffc55f6cfab9611985adf3a6b8b4e0c9c3e7f4c1d58022514a1a155573c2bbc468a"},
+				Q0: point{"0x20220412097a264d6cffd68922c25f220c8c19982e77bc0f6cdf0e9d9c7efde52e0e7f77f559d27983d25bdcc1d192", "0x13c599400da12307b1d7a2d042fed1234103abc5d7c9c7804ed11f6912e9c0b36b8a19ab842f092d29eb3cfaf57892e"},
+				Q1: point{"0xb2bc7bf72be04f5245f41593ae5899c5683cb8a8c47ff57c10b4b2046277dc204b070b91af34d3e961158f8c6eba56", "0xd6fb0e7e7a48e111fa13fdb6558932c657277eae67e5fd049d405488a1ebfcc39e8fd0cc452d8dd2389c9bfa25dc30"},
+				u0: "0x1266547eb143414e99631d02bd73db96e1d36dedebaa9cf938a184db1d41e2cb3080ff7e6c5ef7e235fa42731d4e472", u1: "0xd6266b1ee8746a5a196a8499b906249731b49e8efaa54df50937552d96a671f9de8e3110c384da18f1ae1c6029096c",
+			},
+		}}
+}
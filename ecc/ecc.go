@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // ID represent a unique ID for a curve
@@ -125,5 +126,6 @@ func modulus(c *config.Curve, scalarField bool) *big.Int {
 
 // MultiExpConfig enables to set optional configuration attribute to a call to MultiExp
 type MultiExpConfig struct {
-	NbTasks int // go routines to be used in the multiexp. can be larger than num cpus.
+	NbTasks   int                 // go routines to be used in the multiexp. can be larger than num cpus.
+	Scheduler scheduler.Scheduler // spawns the go routines of the multiexp; if nil, scheduler.Default() is used.
 }
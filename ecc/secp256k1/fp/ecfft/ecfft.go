@@ -0,0 +1,588 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+)
+
+// The curve E: y² = x³ + a·x + 28145370 has a point g of order 2^logTwoOrder and a point q such that
+// 2q is not in the subgroup generated by g; the evaluation sets are x-coordinates of q + <g>.
+const logTwoOrder = 18
+
+// MaxCardinality is the largest size of a Domain
+const MaxCardinality = 1 << (logTwoOrder - 1)
+
+var (
+	curveA fp.Element
+	g, q   point
+	three  fp.Element
+)
+
+func init() {
+	three.SetUint64(3)
+	curveA.SetString("-215131")
+	g.x.SetString("100034370303713033240271241031244595231321061183086630993600769364022029489927")
+	g.y.SetString("32325713673505136652791942006597189461406174763268583916754842154840166772593")
+	q.x.SetString("1")
+	q.y.SetString("52051205106377329805035242879294495201613297476039435639928375609105385885856")
+}
+
+// below this size, the recursive calls are not run in parallel
+const minParallelSize = 1 << 9
+
+// below this size, polynomials are multiplied with the schoolbook algorithm
+const karatsubaThreshold = 32
+
+// Domain holds the precomputed values to work with polynomials of degree < Cardinality
+// on the ECFFT sets S and S', both of size Cardinality.
+//
+// Let L be the x-coordinates of q + j·g', j < 2·Cardinality, where g' has order 2·Cardinality;
+// then S = (L[2j]) and S' = (L[2j+1]). The sets Sₖ used by the recursion are the elements
+// of S of index multiple of Cardinality/k, and S'ₖ the other elements of S₂ₖ.
+type Domain struct {
+	Cardinality uint64
+
+	l [][]fp.Element // l[i] is the image of L[:len(L)>>i] by the first i isogenies
+
+	// extend[i] is used to extend a polynomial of degree < k = 2ⁱ from Sₖ to S'ₖ (or back)
+	extend []extendTable
+
+	// powers[i][j] = Sₖ[j]^(k/2) where k = 2ⁱ, the monomial splitting the polynomials of degree < k
+	powers [][]fp.Element
+
+	// the vanishing polynomials are only needed by Exit
+	exitOnce sync.Once
+	// vanishing[i] holds the coefficients of Z(Sₖ) - Xᵏ where k = 2ⁱ and Z(Sₖ) vanishes on Sₖ
+	vanishing [][]fp.Element
+	// vanishingInv[i][j] = 1 / Z(Sₖ)(S'ₖ[j])
+	vanishingInv [][]fp.Element
+}
+
+// extendTable holds, for each level of the recursion, the precomputed values to go
+// from the evaluations of a polynomial on S to its evaluations on S' (or back)
+type extendTable struct {
+	levels []extendLevel
+}
+
+type extendLevel struct {
+	s, sPrime extendSet
+}
+
+// extendSet stores a set of size k whose elements i and i + k/2 have the same image
+// by the isogeny ψ(X) = X + t/v(X), with v(X) = X - x₀
+type extendSet struct {
+	points  []fp.Element
+	vPow    []fp.Element // v(points[i])^(k/2 - 1)
+	vPowInv []fp.Element // 1 / vPow[i]
+	delta   []fp.Element // 1 / (points[i + k/2] - points[i]), i < k/2
+}
+
+// NewDomain returns a Domain of the given cardinality, which must be a power of two
+// not greater than MaxCardinality.
+func NewDomain(cardinality uint64) (*Domain, error) {
+	if cardinality == 0 || cardinality&(cardinality-1) != 0 {
+		return nil, errors.New("ecfft: the cardinality must be a power of two")
+	}
+	if cardinality > MaxCardinality {
+		return nil, errors.New("ecfft: the cardinality exceeds MaxCardinality")
+	}
+	d := &Domain{Cardinality: cardinality}
+	logN := bits.TrailingZeros64(cardinality)
+
+	// doubles[i] = 2ⁱ·g
+	doubles := make([]point, logTwoOrder)
+	doubles[0] = g
+	for i := 1; i < logTwoOrder; i++ {
+		doubles[i].double(&doubles[i-1])
+	}
+
+	// L = x(q + <2^(logTwoOrder-logN-1)·g>)
+	top, err := coset(doubles[logTwoOrder-logN-1:])
+	if err != nil {
+		return nil, err
+	}
+
+	// the isogeny ψᵢ has kernel generated by the image of 2^(logTwoOrder-1-i)·g
+	// by the previous isogenies; it maps L[j] and L[j + len(L)/2] to the same point.
+	d.l = make([][]fp.Element, logN+1)
+	d.l[0] = top
+	x0 := make([]fp.Element, logN)
+	t := make([]fp.Element, logN)
+	a := curveA
+	var five fp.Element
+	five.SetUint64(5)
+	for i := 0; i < logN; i++ {
+		x0[i] = doubles[logTwoOrder-1-i].x
+		for j := 0; j < i; j++ {
+			x0[i] = isogeny(&x0[j], &t[j], x0[i:i+1])[0]
+		}
+		// Vélu's formulas for the kernel {O, (x₀, 0)}: ψ(X) = X + t/(X - x₀) where t = 3x₀² + a,
+		// and the next curve has a' = a - 5t
+		t[i].Square(&x0[i]).Mul(&t[i], &three).Add(&t[i], &a)
+		var tmp fp.Element
+		tmp.Mul(&t[i], &five)
+		a.Sub(&a, &tmp)
+		d.l[i+1] = isogeny(&x0[i], &t[i], d.l[i][:len(d.l[i])/2])
+	}
+
+	d.extend = make([]extendTable, logN+1)
+	for i := 1; i <= logN; i++ {
+		d.extend[i] = d.newExtendTable(i, x0)
+	}
+
+	d.powers = make([][]fp.Element, logN+1)
+	for i := 1; i <= logN; i++ {
+		sk := d.set(1 << i)
+		d.powers[i] = make([]fp.Element, len(sk))
+		e := big.NewInt(int64(len(sk) / 2))
+		for j := range sk {
+			d.powers[i][j].Exp(sk[j], e)
+		}
+	}
+
+	return d, nil
+}
+
+// isogeny returns ψ(xs[i]) = xs[i] + t / (xs[i] - x₀)
+func isogeny(x0, t *fp.Element, xs []fp.Element) []fp.Element {
+	res := make([]fp.Element, len(xs))
+	for i := range xs {
+		res[i].Sub(&xs[i], x0)
+	}
+	res = fp.BatchInvert(res)
+	for i := range res {
+		res[i].Mul(&res[i], t)
+		res[i].Add(&res[i], &xs[i])
+	}
+	return res
+}
+
+// set returns Sₖ, the elements of S of index multiple of Cardinality/k, in a new slice
+func (d *Domain) set(k int) []fp.Element {
+	stride := 2 * int(d.Cardinality) / k
+	res := make([]fp.Element, k)
+	for i := range res {
+		res[i] = d.l[0][i*stride]
+	}
+	return res
+}
+
+// subset returns the elements of l[level] of index multiple of Cardinality/k, in a new slice.
+// For level 0, they are S₂ₖ = (Sₖ[0], S'ₖ[0], Sₖ[1], S'ₖ[1], ...).
+func (d *Domain) subset(level, k int) []fp.Element {
+	stride := int(d.Cardinality) / k
+	res := make([]fp.Element, len(d.l[level])/stride)
+	for i := range res {
+		res[i] = d.l[level][i*stride]
+	}
+	return res
+}
+
+// newExtendTable returns the table to extend the polynomials of degree < k = 2^logK from Sₖ to S'ₖ.
+// At level i of the recursion, the sets are the even and odd elements of L = ψᵢ₋₁(…ψ₀(S₂ₖ)),
+// which has 2k/2ⁱ elements.
+func (d *Domain) newExtendTable(logK int, x0 []fp.Element) extendTable {
+	k := 1 << logK
+	t := extendTable{levels: make([]extendLevel, logK)}
+	for level := range t.levels {
+		l := d.subset(level, k)
+		half := len(l) / 2
+		s := make([]fp.Element, half)
+		sPrime := make([]fp.Element, half)
+		for i := 0; i < half; i++ {
+			s[i] = l[2*i]
+			sPrime[i] = l[2*i+1]
+		}
+		t.levels[level].s = newExtendSet(s, &x0[level])
+		t.levels[level].sPrime = newExtendSet(sPrime, &x0[level])
+	}
+	return t
+}
+
+func newExtendSet(points []fp.Element, x0 *fp.Element) extendSet {
+	k := len(points)
+	res := extendSet{
+		points: points,
+		vPow:   make([]fp.Element, k),
+		delta:  make([]fp.Element, k/2),
+	}
+	e := big.NewInt(int64(k/2 - 1))
+	for i := range points {
+		res.vPow[i].Sub(&points[i], x0)
+		res.vPow[i].Exp(res.vPow[i], e)
+	}
+	for i := range res.delta {
+		res.delta[i].Sub(&points[i+k/2], &points[i])
+	}
+	res.vPowInv = fp.BatchInvert(res.vPow)
+	res.delta = fp.BatchInvert(res.delta)
+	return res
+}
+
+// S returns the evaluation set of Enter and Exit, in the order of the evaluations.
+func (d *Domain) S() []fp.Element {
+	return d.set(int(d.Cardinality))
+}
+
+// SPrime returns the evaluation set S', disjoint from S, in the order of the output of Extend.
+func (d *Domain) SPrime() []fp.Element {
+	l := d.l[0]
+	res := make([]fp.Element, len(l)/2)
+	for i := range res {
+		res[i] = l[2*i+1]
+	}
+	return res
+}
+
+// Extend returns the evaluations on S' of the polynomial of degree < Cardinality
+// whose evaluations on S are given, with O(n·log(n)) operations.
+// It panics if len(evaluations) != Cardinality.
+func (d *Domain) Extend(evaluations []fp.Element) []fp.Element {
+	if uint64(len(evaluations)) != d.Cardinality {
+		panic("ecfft: invalid number of evaluations")
+	}
+	res := make([]fp.Element, len(evaluations))
+	d.extendTo(res, evaluations, false)
+	return res
+}
+
+// Enter returns the evaluations on S of the polynomial of degree < Cardinality with the given
+// coefficients, with O(n·log²(n)) operations.
+// It panics if len(coefficients) != Cardinality.
+func (d *Domain) Enter(coefficients []fp.Element) []fp.Element {
+	if uint64(len(coefficients)) != d.Cardinality {
+		panic("ecfft: invalid number of coefficients")
+	}
+	res := make([]fp.Element, len(coefficients))
+	d.enter(res, coefficients)
+	return res
+}
+
+// Exit returns the coefficients of the polynomial of degree < Cardinality whose evaluations on S
+// are given; it is the inverse of Enter.
+// The quotients by the vanishing polynomials are multiplied back with FFTs in larger fields
+// (see mul), for O(n·log²(n)) operations; the vanishing polynomials are computed on the first call.
+// It panics if len(evaluations) != Cardinality.
+func (d *Domain) Exit(evaluations []fp.Element) []fp.Element {
+	if uint64(len(evaluations)) != d.Cardinality {
+		panic("ecfft: invalid number of evaluations")
+	}
+	d.exitOnce.Do(d.preComputeVanishing)
+	res := make([]fp.Element, len(evaluations))
+	d.exit(res, evaluations)
+	return res
+}
+
+// extendTo sets res to the evaluations on S'ₖ of the polynomial with evaluations p on Sₖ,
+// where k = len(p); if reverse is set, it goes from S'ₖ to Sₖ instead.
+func (d *Domain) extendTo(res, p []fp.Element, reverse bool) {
+	t := &d.extend[bits.TrailingZeros(uint(len(p)))]
+	t.extend(res, p, 0, reverse)
+}
+
+// extend writes P = v^(k/2-1)·(P₀(ψ) + X·P₁(ψ)) where deg(P₀), deg(P₁) < k/2, computes
+// P₀ and P₁ on ψ(S) from the values of P on the pairs of S with the same image, extends
+// them recursively to ψ(S'), and evaluates the decomposition on S'.
+func (t *extendTable) extend(res, p []fp.Element, level int, reverse bool) {
+	k := len(p)
+	if k == 1 {
+		res[0] = p[0]
+		return
+	}
+	src, dst := &t.levels[level].s, &t.levels[level].sPrime
+	if reverse {
+		src, dst = dst, src
+	}
+	half := k / 2
+
+	// p0 and p1 on ψ(src)
+	p0 := make([]fp.Element, half)
+	p1 := make([]fp.Element, half)
+	var alpha, beta fp.Element
+	for i := 0; i < half; i++ {
+		alpha.Mul(&p[i], &src.vPowInv[i])
+		beta.Mul(&p[i+half], &src.vPowInv[i+half])
+		p1[i].Sub(&beta, &alpha).Mul(&p1[i], &src.delta[i])
+		beta.Mul(&src.points[i], &p1[i])
+		p0[i].Sub(&alpha, &beta)
+	}
+
+	// p0 and p1 on ψ(dst)
+	q0 := make([]fp.Element, half)
+	q1 := make([]fp.Element, half)
+	parallel(k, func() {
+		t.extend(q0, p0, level+1, reverse)
+	}, func() {
+		t.extend(q1, p1, level+1, reverse)
+	})
+
+	var tmp fp.Element
+	for i := 0; i < half; i++ {
+		tmp.Mul(&dst.points[i], &q1[i])
+		res[i].Add(&q0[i], &tmp).Mul(&res[i], &dst.vPow[i])
+		tmp.Mul(&dst.points[i+half], &q1[i])
+		res[i+half].Add(&q0[i], &tmp).Mul(&res[i+half], &dst.vPow[i+half])
+	}
+}
+
+// enter sets res to the evaluations on Sₖ of the polynomial with coefficients c, k = len(c).
+// Writing c = low + X^(k/2)·high, low and high are evaluated on Sₖ/₂ recursively, then
+// extended to S'ₖ/₂, which together form Sₖ.
+func (d *Domain) enter(res, c []fp.Element) {
+	k := len(c)
+	if k == 1 {
+		res[0] = c[0]
+		return
+	}
+	half := k / 2
+	low := make([]fp.Element, k)
+	high := make([]fp.Element, k)
+	parallel(k, func() {
+		d.enter(low[:half], c[:half])
+		d.extendTo(low[half:], low[:half], false)
+	}, func() {
+		d.enter(high[:half], c[half:])
+		d.extendTo(high[half:], high[:half], false)
+	})
+
+	powers := d.powers[bits.TrailingZeros(uint(k))]
+	for i := 0; i < half; i++ {
+		res[2*i].Mul(&high[i], &powers[2*i]).Add(&res[2*i], &low[i])
+		res[2*i+1].Mul(&high[half+i], &powers[2*i+1]).Add(&res[2*i+1], &low[half+i])
+	}
+}
+
+// exit sets res to the coefficients of the polynomial P with evaluations e on Sₖ, k = len(e).
+// Writing P = R + Z·Q where Z vanishes on Sₖ/₂ and deg(R), deg(Q) < k/2, R is given on Sₖ/₂
+// and extended to S'ₖ/₂, which gives Q on S'ₖ/₂; the coefficients of R and Q are then
+// computed recursively.
+func (d *Domain) exit(res, e []fp.Element) {
+	k := len(e)
+	if k == 1 {
+		res[0] = e[0]
+		return
+	}
+	half := k / 2
+	logHalf := bits.TrailingZeros(uint(half))
+
+	r := make([]fp.Element, k)
+	for i := 0; i < half; i++ {
+		r[i] = e[2*i]
+	}
+	d.extendTo(r[half:], r[:half], false)
+
+	// Q = (P - R) / Z on S'ₖ/₂, extended back to Sₖ/₂
+	qPrime := r[half:]
+	for i := 0; i < half; i++ {
+		qPrime[i].Sub(&e[2*i+1], &qPrime[i]).Mul(&qPrime[i], &d.vanishingInv[logHalf][i])
+	}
+	q := make([]fp.Element, half)
+	d.extendTo(q, qPrime, true)
+
+	cQ := make([]fp.Element, half)
+	parallel(k, func() {
+		d.exit(res[:half], r[:half])
+	}, func() {
+		d.exit(cQ, q)
+	})
+
+	// res = R + (X^(k/2) + z)·Q
+	for i := k / 2; i < k; i++ {
+		res[i].SetZero()
+	}
+	prod := mul(cQ, d.vanishing[logHalf])
+	for i := range prod {
+		res[i].Add(&res[i], &prod[i])
+	}
+	for i := range cQ {
+		res[half+i].Add(&res[half+i], &cQ[i])
+	}
+}
+
+// preComputeVanishing computes, for every k < Cardinality, the coefficients of the
+// vanishing polynomial of Sₖ and its inverse values on S'ₖ.
+func (d *Domain) preComputeVanishing() {
+	logN := bits.TrailingZeros64(d.Cardinality)
+	d.vanishing = make([][]fp.Element, logN)
+	d.vanishingInv = make([][]fp.Element, logN)
+	if logN == 0 {
+		return
+	}
+
+	s1 := d.set(1)
+	d.vanishing[0] = []fp.Element{s1[0]}
+	d.vanishing[0][0].Neg(&d.vanishing[0][0])
+	for i := 1; i < logN; i++ {
+		// Z(S₂ₖ) = Z(Sₖ)·Z(S'ₖ)
+		s2k := d.set(1 << i)
+		sPrime := make([]fp.Element, len(s2k)/2)
+		for j := range sPrime {
+			sPrime[j] = s2k[2*j+1]
+		}
+		d.vanishing[i] = mulMonic(d.vanishing[i-1], vanishing(sPrime))
+	}
+
+	// Z(Sₖ) = Xᵏ + z where z = -Xᵏ on Sₖ and deg(z) < k, so z is extended to S'ₖ
+	for i := 0; i < logN; i++ {
+		k := 1 << i
+		powers := d.powers[i+1]
+		z := make([]fp.Element, 2*k)
+		for j := 0; j < k; j++ {
+			z[j].Neg(&powers[2*j])
+		}
+		d.extendTo(z[k:], z[:k], false)
+		for j := 0; j < k; j++ {
+			z[k+j].Add(&z[k+j], &powers[2*j+1])
+		}
+		d.vanishingInv[i] = fp.BatchInvert(z[k:])
+	}
+}
+
+// vanishing returns the coefficients of ∏ᵢ(X - points[i]) - X^len(points),
+// for a number of points that is a power of two
+func vanishing(points []fp.Element) []fp.Element {
+	if len(points) == 1 {
+		res := make([]fp.Element, 1)
+		res[0].Neg(&points[0])
+		return res
+	}
+	half := len(points) / 2
+	return mulMonic(vanishing(points[:half]), vanishing(points[half:]))
+}
+
+// mulMonic returns the low coefficients of (Xᵏ + a)·(Xᵏ + b) = X²ᵏ + Xᵏ·(a + b) + a·b,
+// where k = len(a) = len(b)
+func mulMonic(a, b []fp.Element) []fp.Element {
+	k := len(a)
+	res := make([]fp.Element, 2*k)
+	copy(res, mul(a, b))
+	var tmp fp.Element
+	for i := 0; i < k; i++ {
+		tmp.Add(&a[i], &b[i])
+		res[k+i].Add(&res[k+i], &tmp)
+	}
+	return res
+}
+
+// karatsuba returns the 2k-1 coefficients of a·b, where k = len(a) = len(b) is a power of two
+func karatsuba(a, b []fp.Element) []fp.Element {
+	k := len(a)
+	res := make([]fp.Element, 2*k-1)
+	if k <= karatsubaThreshold {
+		var tmp fp.Element
+		for i := range a {
+			for j := range b {
+				tmp.Mul(&a[i], &b[j])
+				res[i+j].Add(&res[i+j], &tmp)
+			}
+		}
+		return res
+	}
+
+	half := k / 2
+	z0 := karatsuba(a[:half], b[:half])
+	z2 := karatsuba(a[half:], b[half:])
+	sa := make([]fp.Element, half)
+	sb := make([]fp.Element, half)
+	for i := 0; i < half; i++ {
+		sa[i].Add(&a[i], &a[half+i])
+		sb[i].Add(&b[i], &b[half+i])
+	}
+	z1 := karatsuba(sa, sb)
+	for i := range z1 {
+		z1[i].Sub(&z1[i], &z0[i]).Sub(&z1[i], &z2[i])
+	}
+
+	copy(res, z0)
+	copy(res[k:], z2)
+	for i := range z1 {
+		res[half+i].Add(&res[half+i], &z1[i])
+	}
+	return res
+}
+
+// parallel runs f0 and f1, concurrently if size is large enough, and returns when both are done
+func parallel(size int, f0, f1 func()) {
+	if size < minParallelSize {
+		f0()
+		f1()
+		return
+	}
+	chDone := make(chan struct{})
+	scheduler.Go(nil, func() {
+		f0()
+		close(chDone)
+	})
+	f1()
+	<-chDone
+}
+
+// point is an affine point of E
+type point struct {
+	x, y fp.Element
+}
+
+func (p *point) double(p1 *point) *point {
+	// λ = (3x² + a) / 2y
+	var lambda, den, x fp.Element
+	lambda.Square(&p1.x).Mul(&lambda, &three).Add(&lambda, &curveA)
+	den.Double(&p1.y).Inverse(&den)
+	lambda.Mul(&lambda, &den)
+	x.Square(&lambda).Sub(&x, &p1.x).Sub(&x, &p1.x)
+	p.y.Sub(&p1.x, &x).Mul(&p.y, &lambda).Sub(&p.y, &p1.y)
+	p.x = x
+	return p
+}
+
+// coset returns the x-coordinates of q + j·doubles[0], j < 2^len(doubles),
+// where doubles[i] = 2ⁱ·doubles[0]
+func coset(doubles []point) ([]fp.Element, error) {
+	points := make([]point, 1, 1<<len(doubles))
+	points[0] = q
+	for i := range doubles {
+		// q + j·g + 2ⁱ·g, for j < 2ⁱ, with one inversion
+		m := len(points)
+		den := make([]fp.Element, m)
+		for j := 0; j < m; j++ {
+			den[j].Sub(&points[j].x, &doubles[i].x)
+			if den[j].IsZero() {
+				return nil, errors.New("ecfft: invalid curve parameters")
+			}
+		}
+		den = fp.BatchInvert(den)
+		for j := 0; j < m; j++ {
+			// λ = (y - y') / (x - x')
+			var lambda, x, y fp.Element
+			lambda.Sub(&points[j].y, &doubles[i].y).Mul(&lambda, &den[j])
+			x.Square(&lambda).Sub(&x, &points[j].x).Sub(&x, &doubles[i].x)
+			y.Sub(&points[j].x, &x).Mul(&y, &lambda).Sub(&y, &points[j].y)
+			points = append(points, point{x, y})
+		}
+	}
+	res := make([]fp.Element, len(points))
+	for i := range points {
+		res[i] = points[i].x
+	}
+	return res, nil
+}
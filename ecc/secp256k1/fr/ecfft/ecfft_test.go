@@ -0,0 +1,160 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+func TestNewDomain(t *testing.T) {
+	for _, n := range []uint64{0, 3, 6, 2 * MaxCardinality} {
+		if _, err := NewDomain(n); err == nil {
+			t.Fatalf("n=%d: expected an error", n)
+		}
+	}
+
+	// S and S' are disjoint sets
+	d, err := NewDomain(1 << 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[fr.Element]struct{})
+	for _, set := range [][]fr.Element{d.S(), d.SPrime()} {
+		for _, x := range set {
+			if _, ok := seen[x]; ok {
+				t.Fatal("S ∪ S' has repeated elements")
+			}
+			seen[x] = struct{}{}
+		}
+	}
+}
+
+func TestEnterExit(t *testing.T) {
+	for _, n := range []uint64{1, 2, 4, 32, 128, 1 << 10} {
+		d, err := NewDomain(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := randomVector(int(n))
+
+		evaluations := d.Enter(c)
+		assertEqualVectors(t, evaluateAll(c, d.S()), evaluations, "Enter", n)
+
+		coefficients := d.Exit(evaluations)
+		assertEqualVectors(t, c, coefficients, "Exit(Enter)", n)
+	}
+}
+
+func TestExtend(t *testing.T) {
+	for _, n := range []uint64{1, 2, 8, 64, 1 << 10} {
+		d, err := NewDomain(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := randomVector(int(n))
+		extended := d.Extend(evaluateAll(c, d.S()))
+		assertEqualVectors(t, evaluateAll(c, d.SPrime()), extended, "Extend", n)
+	}
+}
+
+func TestKaratsuba(t *testing.T) {
+	for _, k := range []int{1, 32, 64, 256} {
+		a, b := randomVector(k), randomVector(k)
+		expected := make([]fr.Element, 2*k-1)
+		var tmp fr.Element
+		for i := range a {
+			for j := range b {
+				tmp.Mul(&a[i], &b[j])
+				expected[i+j].Add(&expected[i+j], &tmp)
+			}
+		}
+		assertEqualVectors(t, expected, karatsuba(a, b), "karatsuba", uint64(k))
+	}
+}
+
+func TestMul(t *testing.T) {
+	for _, k := range []int{mulFFTThreshold / 2, mulFFTThreshold, 4 * mulFFTThreshold} {
+		a, b := randomVector(k), randomVector(k)
+		// the largest coefficients maximize the integer product
+		for i := 0; i < k; i += 3 {
+			a[i].SetOne().Neg(&a[i])
+			b[i].SetOne().Neg(&b[i])
+		}
+		assertEqualVectors(t, karatsuba(a, b), mul(a, b), "mul", uint64(k))
+	}
+}
+
+func randomVector(n int) []fr.Element {
+	v := make([]fr.Element, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+// evaluateAll evaluates the polynomial with coefficients c on points, with Horner's method
+func evaluateAll(c, points []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(points))
+	for i := range points {
+		for j := len(c) - 1; j >= 0; j-- {
+			res[i].Mul(&res[i], &points[i]).Add(&res[i], &c[j])
+		}
+	}
+	return res
+}
+
+func assertEqualVectors(t *testing.T, expected, got []fr.Element, name string, n uint64) {
+	t.Helper()
+	for i := range expected {
+		if !expected[i].Equal(&got[i]) {
+			t.Fatalf("%s (n=%d): mismatch at index %d", name, n, i)
+		}
+	}
+}
+
+func BenchmarkEnter(b *testing.B) {
+	const size = 1 << 12
+	d, _ := NewDomain(size)
+	c := randomVector(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Enter(c)
+	}
+}
+
+func BenchmarkExtend(b *testing.B) {
+	const size = 1 << 12
+	d, _ := NewDomain(size)
+	e := randomVector(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Extend(e)
+	}
+}
+
+func BenchmarkExit(b *testing.B) {
+	const size = 1 << 12
+	d, _ := NewDomain(size)
+	e := randomVector(size)
+	d.Exit(e)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Exit(e)
+	}
+}
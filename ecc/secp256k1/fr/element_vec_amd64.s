@@ -0,0 +1,2448 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xbfd25e8cd0364141
+DATA q<>+8(SB)/8, $0xbaaedce6af48a03b
+DATA q<>+16(SB)/8, $0xfffffffffffffffe
+DATA q<>+24(SB)/8, $0xffffffffffffffff
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x4b0dff665588b13f
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, rb0, rb1, rb2, rb3) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $4
+DATA indexGatherScatter<>+16(SB)/8, $8
+DATA indexGatherScatter<>+24(SB)/8, $12
+DATA indexGatherScatter<>+32(SB)/8, $16
+DATA indexGatherScatter<>+40(SB)/8, $20
+DATA indexGatherScatter<>+48(SB)/8, $24
+DATA indexGatherScatter<>+56(SB)/8, $28
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0x25e8cd0364141
+DATA qLimbs52<>+8(SB)/8, $0xe6af48a03bbfd
+DATA qLimbs52<>+16(SB)/8, $0xffffffebaaedc
+DATA qLimbs52<>+24(SB)/8, $0xfffffffffffff
+DATA qLimbs52<>+32(SB)/8, $0xffffffffffff
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $40
+
+// limbs of 29 bits of q, repeated in the 4 lanes of a register, used by mulVecAVX2
+DATA qLimbs29<>+0(SB)/8, $0x10364141
+DATA qLimbs29<>+8(SB)/8, $0x10364141
+DATA qLimbs29<>+16(SB)/8, $0x10364141
+DATA qLimbs29<>+24(SB)/8, $0x10364141
+DATA qLimbs29<>+32(SB)/8, $0x1e92f466
+DATA qLimbs29<>+40(SB)/8, $0x1e92f466
+DATA qLimbs29<>+48(SB)/8, $0x1e92f466
+DATA qLimbs29<>+56(SB)/8, $0x1e92f466
+DATA qLimbs29<>+64(SB)/8, $0x12280eef
+DATA qLimbs29<>+72(SB)/8, $0x12280eef
+DATA qLimbs29<>+80(SB)/8, $0x12280eef
+DATA qLimbs29<>+88(SB)/8, $0x12280eef
+DATA qLimbs29<>+96(SB)/8, $0x1db9cd5e
+DATA qLimbs29<>+104(SB)/8, $0x1db9cd5e
+DATA qLimbs29<>+112(SB)/8, $0x1db9cd5e
+DATA qLimbs29<>+120(SB)/8, $0x1db9cd5e
+DATA qLimbs29<>+128(SB)/8, $0x1fffebaa
+DATA qLimbs29<>+136(SB)/8, $0x1fffebaa
+DATA qLimbs29<>+144(SB)/8, $0x1fffebaa
+DATA qLimbs29<>+152(SB)/8, $0x1fffebaa
+DATA qLimbs29<>+160(SB)/8, $0x1fffffff
+DATA qLimbs29<>+168(SB)/8, $0x1fffffff
+DATA qLimbs29<>+176(SB)/8, $0x1fffffff
+DATA qLimbs29<>+184(SB)/8, $0x1fffffff
+DATA qLimbs29<>+192(SB)/8, $0x1fffffff
+DATA qLimbs29<>+200(SB)/8, $0x1fffffff
+DATA qLimbs29<>+208(SB)/8, $0x1fffffff
+DATA qLimbs29<>+216(SB)/8, $0x1fffffff
+DATA qLimbs29<>+224(SB)/8, $0x1fffffff
+DATA qLimbs29<>+232(SB)/8, $0x1fffffff
+DATA qLimbs29<>+240(SB)/8, $0x1fffffff
+DATA qLimbs29<>+248(SB)/8, $0x1fffffff
+DATA qLimbs29<>+256(SB)/8, $0xffffff
+DATA qLimbs29<>+264(SB)/8, $0xffffff
+DATA qLimbs29<>+272(SB)/8, $0xffffff
+DATA qLimbs29<>+280(SB)/8, $0xffffff
+GLOBL qLimbs29<>(SB), (RODATA+NOPTR), $288
+
+// -q⁻¹ mod 2²⁹, repeated in the 4 lanes of a register, used by mulVecAVX2
+DATA qInvNeg29<>+0(SB)/8, $0x1588b13f
+DATA qInvNeg29<>+8(SB)/8, $0x1588b13f
+DATA qInvNeg29<>+16(SB)/8, $0x1588b13f
+DATA qInvNeg29<>+24(SB)/8, $0x1588b13f
+GLOBL qInvNeg29<>(SB), (RODATA+NOPTR), $32
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// t = a[i] + b[i]
+	MOVQ 0(DX), DI
+	MOVQ 8(DX), R8
+	MOVQ 16(DX), R9
+	MOVQ 24(DX), R10
+	ADDQ 0(CX), DI
+	ADCQ 8(CX), R8
+	ADCQ 16(CX), R9
+	ADCQ 24(CX), R10
+	MOVQ $0, SI
+	ADCQ $0, SI      // c = carry
+
+	// u = t - q
+	MOVQ DI, R11
+	MOVQ R8, R12
+	MOVQ R9, R13
+	MOVQ R10, R14
+	SUBQ q<>+0(SB), R11
+	SBBQ q<>+8(SB), R12
+	SBBQ q<>+16(SB), R13
+	SBBQ q<>+24(SB), R14
+	SBBQ $0, SI          // borrow iff carry < borrow, i.e. t < q
+
+	// res[i] = t if t < q, u otherwise
+	CMOVQCS DI, R11
+	CMOVQCS R8, R12
+	CMOVQCS R9, R13
+	CMOVQCS R10, R14
+	MOVQ    R11, 0(AX)
+	MOVQ    R12, 8(AX)
+	MOVQ    R13, 16(AX)
+	MOVQ    R14, 24(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	ADDQ $0x0000000000000020, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+	XORQ SI, SI
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// a[i] - b[i]
+	MOVQ 0(DX), DI
+	MOVQ 8(DX), R8
+	MOVQ 16(DX), R9
+	MOVQ 24(DX), R10
+	SUBQ 0(CX), DI
+	SBBQ 8(CX), R8
+	SBBQ 16(CX), R9
+	SBBQ 24(CX), R10
+
+	// reduce (a[i] - b[i])
+	MOVQ    $0xbfd25e8cd0364141, R11
+	MOVQ    $0xbaaedce6af48a03b, R12
+	MOVQ    $0xfffffffffffffffe, R13
+	MOVQ    $0xffffffffffffffff, R14
+	CMOVQCC SI, R11
+	CMOVQCC SI, R12
+	CMOVQCC SI, R13
+	CMOVQCC SI, R14
+
+	// add registers (q or 0) to t, and set to result
+	ADDQ R11, DI
+	ADCQ R12, R8
+	ADCQ R13, R9
+	ADCQ R14, R10
+
+	// res[i] = a[i] - b[i]
+	MOVQ DI, 0(AX)
+	MOVQ R8, 8(AX)
+	MOVQ R9, 16(AX)
+	MOVQ R10, 24(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	ADDQ $0x0000000000000020, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[8]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $128-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $1, DX                  // we load 2 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6          // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z4, Z4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000040, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-128(SP)
+	VMOVDQU64 Z4, acc1-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-128(SP), BX
+	ADDQ lane4-96(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-120(SP), BX
+	ADDQ lane5-88(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-112(SP), BX
+	ADDQ lane6-80(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-104(SP), BX
+	ADDQ lane7-72(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane8-64(SP), BX
+	ADDQ lane12-32(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane9-56(SP), BX
+	ADDQ lane13-24(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane10-48(SP), BX
+	ADDQ lane14-16(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane11-40(SP), BX
+	ADDQ lane15-8(SP), BX
+	MOVQ BX, 56(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[8]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $64-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8          // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y4, Y4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000020, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-64(SP)
+	VMOVDQU Y4, acc1-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-64(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane1-56(SP), BX
+	MOVQ BX, 8(CX)
+	MOVQ lane2-48(SP), BX
+	MOVQ BX, 16(CX)
+	MOVQ lane3-40(SP), BX
+	MOVQ BX, 24(CX)
+	MOVQ lane4-32(SP), BX
+	MOVQ BX, 32(CX)
+	MOVQ lane5-24(SP), BX
+	MOVQ BX, 40(CX)
+	MOVQ lane6-16(SP), BX
+	MOVQ BX, 48(CX)
+	MOVQ lane7-8(SP), BX
+	MOVQ BX, 56(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $640-32
+	MOVQ         $0x000dff665588b13f, SI
+	VPBROADCASTQ SI, Z9
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z10
+	VMOVDQU64    indexGatherScatter<>(SB), Z11
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z11*8), K1, Z3
+	VMOVDQU64  Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a0-640(SP)
+	VPSRLQ     $52, Z0, Z8
+	VPSLLQ     $12, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a1-576(SP)
+	VPSRLQ     $40, Z1, Z8
+	VPSLLQ     $24, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a2-512(SP)
+	VPSRLQ     $28, Z2, Z8
+	VPSLLQ     $36, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a3-448(SP)
+	VPSRLQ     $16, Z3, Z8
+	VMOVDQU64  Z8, a4-384(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 4 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(CX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(CX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(CX)(Z11*8), K1, Z3
+	VPSLLQ     $4, Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b0-320(SP)
+	VPSRLQ     $48, Z0, Z8
+	VPSLLQ     $16, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b1-256(SP)
+	VPSRLQ     $36, Z1, Z8
+	VPSLLQ     $28, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b2-192(SP)
+	VPSRLQ     $24, Z2, Z8
+	VPSLLQ     $40, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, b3-128(SP)
+	VPSRLQ     $12, Z3, Z8
+	VMOVDQU64  Z8, b4-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+
+	// t += a * b[0]
+	VMOVDQU64   b0-320(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z0
+	VPMADD52HUQ a0-640(SP), Z6, Z1
+	VPMADD52LUQ a1-576(SP), Z6, Z1
+	VPMADD52HUQ a1-576(SP), Z6, Z2
+	VPMADD52LUQ a2-512(SP), Z6, Z2
+	VPMADD52HUQ a2-512(SP), Z6, Z3
+	VPMADD52LUQ a3-448(SP), Z6, Z3
+	VPMADD52HUQ a3-448(SP), Z6, Z4
+	VPMADD52LUQ a4-384(SP), Z6, Z4
+	VPMADD52HUQ a4-384(SP), Z6, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z0, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-256(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z1
+	VPMADD52HUQ a0-640(SP), Z6, Z2
+	VPMADD52LUQ a1-576(SP), Z6, Z2
+	VPMADD52HUQ a1-576(SP), Z6, Z3
+	VPMADD52LUQ a2-512(SP), Z6, Z3
+	VPMADD52HUQ a2-512(SP), Z6, Z4
+	VPMADD52LUQ a3-448(SP), Z6, Z4
+	VPMADD52HUQ a3-448(SP), Z6, Z5
+	VPMADD52LUQ a4-384(SP), Z6, Z5
+	VPMADD52HUQ a4-384(SP), Z6, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z1, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-192(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z2
+	VPMADD52HUQ a0-640(SP), Z6, Z3
+	VPMADD52LUQ a1-576(SP), Z6, Z3
+	VPMADD52HUQ a1-576(SP), Z6, Z4
+	VPMADD52LUQ a2-512(SP), Z6, Z4
+	VPMADD52HUQ a2-512(SP), Z6, Z5
+	VPMADD52LUQ a3-448(SP), Z6, Z5
+	VPMADD52HUQ a3-448(SP), Z6, Z0
+	VPMADD52LUQ a4-384(SP), Z6, Z0
+	VPMADD52HUQ a4-384(SP), Z6, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z2, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-128(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z3
+	VPMADD52HUQ a0-640(SP), Z6, Z4
+	VPMADD52LUQ a1-576(SP), Z6, Z4
+	VPMADD52HUQ a1-576(SP), Z6, Z5
+	VPMADD52LUQ a2-512(SP), Z6, Z5
+	VPMADD52HUQ a2-512(SP), Z6, Z0
+	VPMADD52LUQ a3-448(SP), Z6, Z0
+	VPMADD52HUQ a3-448(SP), Z6, Z1
+	VPMADD52LUQ a4-384(SP), Z6, Z1
+	VPMADD52HUQ a4-384(SP), Z6, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z3, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z8
+	VPADDQ Z8, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-64(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z4
+	VPMADD52HUQ a0-640(SP), Z6, Z5
+	VPMADD52LUQ a1-576(SP), Z6, Z5
+	VPMADD52HUQ a1-576(SP), Z6, Z0
+	VPMADD52LUQ a2-512(SP), Z6, Z0
+	VPMADD52HUQ a2-512(SP), Z6, Z1
+	VPMADD52LUQ a3-448(SP), Z6, Z1
+	VPMADD52HUQ a3-448(SP), Z6, Z2
+	VPMADD52LUQ a4-384(SP), Z6, Z2
+	VPMADD52HUQ a4-384(SP), Z6, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z4, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z8
+	VPADDQ Z8, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// propagate the carries
+	VPSRLQ $52, Z5, Z8
+	VPADDQ Z8, Z0, Z0
+	VPANDQ Z10, Z5, Z5
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPANDQ Z10, Z0, Z0
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPANDQ Z10, Z1, Z1
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPANDQ Z10, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z5, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a0-640(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a1-576(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a2-512(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a3-448(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z6
+	VPADDQ      Z8, Z6, Z6
+	VMOVDQU64   Z6, a4-384(SP)
+	VPMOVQ2M    Z6, K2
+	VMOVDQU64   Z5, K2, a0-640(SP)
+	VMOVDQU64   Z0, K2, a1-576(SP)
+	VMOVDQU64   Z1, K2, a2-512(SP)
+	VMOVDQU64   Z2, K2, a3-448(SP)
+	VMOVDQU64   Z3, K2, a4-384(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-640(SP), Z5
+	VPSLLQ      $52, a1-576(SP), Z8
+	VPORQ       Z8, Z5, Z5
+	VPSRLQ      $12, a1-576(SP), Z0
+	VPSLLQ      $40, a2-512(SP), Z8
+	VPORQ       Z8, Z0, Z0
+	VPSRLQ      $24, a2-512(SP), Z1
+	VPSLLQ      $28, a3-448(SP), Z8
+	VPORQ       Z8, Z1, Z1
+	VPSRLQ      $36, a3-448(SP), Z2
+	VPSLLQ      $16, a4-384(SP), Z8
+	VPORQ       Z8, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 0(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z11*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000100, AX
+	ADDQ $0x0000000000000100, DX
+	ADDQ $0x0000000000000100, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $640-32
+	MOVQ         $0x000dff665588b13f, SI
+	VPBROADCASTQ SI, Z9
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z10
+	VMOVDQU64    indexGatherScatter<>(SB), Z11
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPBROADCASTQ 8(CX), Z1
+	VPBROADCASTQ 16(CX), Z2
+	VPBROADCASTQ 24(CX), Z3
+	VPSLLQ       $4, Z0, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b0-320(SP)
+	VPSRLQ       $48, Z0, Z8
+	VPSLLQ       $16, Z1, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b1-256(SP)
+	VPSRLQ       $36, Z1, Z8
+	VPSLLQ       $28, Z2, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b2-192(SP)
+	VPSRLQ       $24, Z2, Z8
+	VPSLLQ       $40, Z3, Z6
+	VPORQ        Z6, Z8, Z8
+	VPANDQ       Z10, Z8, Z8
+	VMOVDQU64    Z8, b3-128(SP)
+	VPSRLQ       $12, Z3, Z8
+	VMOVDQU64    Z8, b4-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z11*8), K1, Z0
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 8(DX)(Z11*8), K1, Z1
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 16(DX)(Z11*8), K1, Z2
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 24(DX)(Z11*8), K1, Z3
+	VMOVDQU64  Z0, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a0-640(SP)
+	VPSRLQ     $52, Z0, Z8
+	VPSLLQ     $12, Z1, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a1-576(SP)
+	VPSRLQ     $40, Z1, Z8
+	VPSLLQ     $24, Z2, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a2-512(SP)
+	VPSRLQ     $28, Z2, Z8
+	VPSLLQ     $36, Z3, Z6
+	VPORQ      Z6, Z8, Z8
+	VPANDQ     Z10, Z8, Z8
+	VMOVDQU64  Z8, a3-448(SP)
+	VPSRLQ     $16, Z3, Z8
+	VMOVDQU64  Z8, a4-384(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+
+	// t += a * b[0]
+	VMOVDQU64   b0-320(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z0
+	VPMADD52HUQ a0-640(SP), Z6, Z1
+	VPMADD52LUQ a1-576(SP), Z6, Z1
+	VPMADD52HUQ a1-576(SP), Z6, Z2
+	VPMADD52LUQ a2-512(SP), Z6, Z2
+	VPMADD52HUQ a2-512(SP), Z6, Z3
+	VPMADD52LUQ a3-448(SP), Z6, Z3
+	VPMADD52HUQ a3-448(SP), Z6, Z4
+	VPMADD52LUQ a4-384(SP), Z6, Z4
+	VPMADD52HUQ a4-384(SP), Z6, Z5
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z0, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-256(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z1
+	VPMADD52HUQ a0-640(SP), Z6, Z2
+	VPMADD52LUQ a1-576(SP), Z6, Z2
+	VPMADD52HUQ a1-576(SP), Z6, Z3
+	VPMADD52LUQ a2-512(SP), Z6, Z3
+	VPMADD52HUQ a2-512(SP), Z6, Z4
+	VPMADD52LUQ a3-448(SP), Z6, Z4
+	VPMADD52HUQ a3-448(SP), Z6, Z5
+	VPMADD52LUQ a4-384(SP), Z6, Z5
+	VPMADD52HUQ a4-384(SP), Z6, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z1, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// t += a * b[2]
+	VMOVDQU64   b2-192(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z2
+	VPMADD52HUQ a0-640(SP), Z6, Z3
+	VPMADD52LUQ a1-576(SP), Z6, Z3
+	VPMADD52HUQ a1-576(SP), Z6, Z4
+	VPMADD52LUQ a2-512(SP), Z6, Z4
+	VPMADD52HUQ a2-512(SP), Z6, Z5
+	VPMADD52LUQ a3-448(SP), Z6, Z5
+	VPMADD52HUQ a3-448(SP), Z6, Z0
+	VPMADD52LUQ a4-384(SP), Z6, Z0
+	VPMADD52HUQ a4-384(SP), Z6, Z1
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z2, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+
+	// t >>= 52
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPXORQ Z2, Z2, Z2
+
+	// t += a * b[3]
+	VMOVDQU64   b3-128(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z3
+	VPMADD52HUQ a0-640(SP), Z6, Z4
+	VPMADD52LUQ a1-576(SP), Z6, Z4
+	VPMADD52HUQ a1-576(SP), Z6, Z5
+	VPMADD52LUQ a2-512(SP), Z6, Z5
+	VPMADD52HUQ a2-512(SP), Z6, Z0
+	VPMADD52LUQ a3-448(SP), Z6, Z0
+	VPMADD52HUQ a3-448(SP), Z6, Z1
+	VPMADD52LUQ a4-384(SP), Z6, Z1
+	VPMADD52HUQ a4-384(SP), Z6, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z3, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z3
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z3, Z8
+	VPADDQ Z8, Z4, Z4
+	VPXORQ Z3, Z3, Z3
+
+	// t += a * b[4]
+	VMOVDQU64   b4-64(SP), Z6
+	VPMADD52LUQ a0-640(SP), Z6, Z4
+	VPMADD52HUQ a0-640(SP), Z6, Z5
+	VPMADD52LUQ a1-576(SP), Z6, Z5
+	VPMADD52HUQ a1-576(SP), Z6, Z0
+	VPMADD52LUQ a2-512(SP), Z6, Z0
+	VPMADD52HUQ a2-512(SP), Z6, Z1
+	VPMADD52LUQ a3-448(SP), Z6, Z1
+	VPMADD52HUQ a3-448(SP), Z6, Z2
+	VPMADD52LUQ a4-384(SP), Z6, Z2
+	VPMADD52HUQ a4-384(SP), Z6, Z3
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z7, Z7, Z7
+	VPMADD52LUQ Z9, Z4, Z7
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z7, Z4
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z7, Z5
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z7, Z5
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z7, Z0
+	VPMADD52LUQ.BCST qLimbs52<>+16(SB), Z7, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+16(SB), Z7, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+24(SB), Z7, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+24(SB), Z7, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+32(SB), Z7, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+32(SB), Z7, Z3
+
+	// t >>= 52
+	VPSRLQ $52, Z4, Z8
+	VPADDQ Z8, Z5, Z5
+	VPXORQ Z4, Z4, Z4
+
+	// propagate the carries
+	VPSRLQ $52, Z5, Z8
+	VPADDQ Z8, Z0, Z0
+	VPANDQ Z10, Z5, Z5
+	VPSRLQ $52, Z0, Z8
+	VPADDQ Z8, Z1, Z1
+	VPANDQ Z10, Z0, Z0
+	VPSRLQ $52, Z1, Z8
+	VPADDQ Z8, Z2, Z2
+	VPANDQ Z10, Z1, Z1
+	VPSRLQ $52, Z2, Z8
+	VPADDQ Z8, Z3, Z3
+	VPANDQ Z10, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z5, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a0-640(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a1-576(SP)
+	VPSUBQ.BCST qLimbs52<>+16(SB), Z1, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a2-512(SP)
+	VPSUBQ.BCST qLimbs52<>+24(SB), Z2, Z6
+	VPADDQ      Z8, Z6, Z6
+	VPSRAQ      $52, Z6, Z8
+	VPANDQ      Z10, Z6, Z6
+	VMOVDQU64   Z6, a3-448(SP)
+	VPSUBQ.BCST qLimbs52<>+32(SB), Z3, Z6
+	VPADDQ      Z8, Z6, Z6
+	VMOVDQU64   Z6, a4-384(SP)
+	VPMOVQ2M    Z6, K2
+	VMOVDQU64   Z5, K2, a0-640(SP)
+	VMOVDQU64   Z0, K2, a1-576(SP)
+	VMOVDQU64   Z1, K2, a2-512(SP)
+	VMOVDQU64   Z2, K2, a3-448(SP)
+	VMOVDQU64   Z3, K2, a4-384(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-640(SP), Z5
+	VPSLLQ      $52, a1-576(SP), Z8
+	VPORQ       Z8, Z5, Z5
+	VPSRLQ      $12, a1-576(SP), Z0
+	VPSLLQ      $40, a2-512(SP), Z8
+	VPORQ       Z8, Z0, Z0
+	VPSRLQ      $24, a2-512(SP), Z1
+	VPSLLQ      $28, a3-448(SP), Z8
+	VPORQ       Z8, Z1, Z1
+	VPSRLQ      $36, a3-448(SP), Z2
+	VPSLLQ      $16, a4-384(SP), Z8
+	VPORQ       Z8, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z5, K1, 0(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z0, K1, 8(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z1, K1, 16(AX)(Z11*8)
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 24(AX)(Z11*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000100, AX
+	ADDQ $0x0000000000000100, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
+
+// mulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b[0...4n]
+TEXT ·mulVecAVX2(SB), $864-32
+	MOVQ         $0x000000001fffffff, SI
+	MOVQ         SI, X0
+	VPBROADCASTQ X0, Y0
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l13:
+	TESTQ BX, BX
+	JEQ   l14    // n == 0, we are done
+
+	// load 4 elements of a and convert them to 29 bits limbs
+	VMOVQ       0(DX), X12
+	VPINSRQ     $1, 32(DX), X12, X12
+	VMOVQ       64(DX), X1
+	VPINSRQ     $1, 96(DX), X1, X1
+	VINSERTI128 $1, X1, Y12, Y12
+	VMOVQ       8(DX), X13
+	VPINSRQ     $1, 40(DX), X13, X13
+	VMOVQ       72(DX), X1
+	VPINSRQ     $1, 104(DX), X1, X1
+	VINSERTI128 $1, X1, Y13, Y13
+	VMOVQ       16(DX), X14
+	VPINSRQ     $1, 48(DX), X14, X14
+	VMOVQ       80(DX), X1
+	VPINSRQ     $1, 112(DX), X1, X1
+	VINSERTI128 $1, X1, Y14, Y14
+	VMOVQ       24(DX), X15
+	VPINSRQ     $1, 56(DX), X15, X15
+	VMOVQ       88(DX), X1
+	VPINSRQ     $1, 120(DX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VMOVDQU     Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a0-864(SP)
+	VPSRLQ      $29, Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a1-832(SP)
+	VPSRLQ      $58, Y12, Y2
+	VPSLLQ      $6, Y13, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a2-800(SP)
+	VPSRLQ      $23, Y13, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a3-768(SP)
+	VPSRLQ      $52, Y13, Y2
+	VPSLLQ      $12, Y14, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a4-736(SP)
+	VPSRLQ      $17, Y14, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a5-704(SP)
+	VPSRLQ      $46, Y14, Y2
+	VPSLLQ      $18, Y15, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a6-672(SP)
+	VPSRLQ      $11, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a7-640(SP)
+	VPSRLQ      $40, Y15, Y2
+	VMOVDQU     Y2, a8-608(SP)
+
+	// load 4 elements of b and convert them to 29 bits limbs, shifted by 5 bits
+	VMOVQ       0(CX), X12
+	VPINSRQ     $1, 32(CX), X12, X12
+	VMOVQ       64(CX), X1
+	VPINSRQ     $1, 96(CX), X1, X1
+	VINSERTI128 $1, X1, Y12, Y12
+	VMOVQ       8(CX), X13
+	VPINSRQ     $1, 40(CX), X13, X13
+	VMOVQ       72(CX), X1
+	VPINSRQ     $1, 104(CX), X1, X1
+	VINSERTI128 $1, X1, Y13, Y13
+	VMOVQ       16(CX), X14
+	VPINSRQ     $1, 48(CX), X14, X14
+	VMOVQ       80(CX), X1
+	VPINSRQ     $1, 112(CX), X1, X1
+	VINSERTI128 $1, X1, Y14, Y14
+	VMOVQ       24(CX), X15
+	VPINSRQ     $1, 56(CX), X15, X15
+	VMOVQ       88(CX), X1
+	VPINSRQ     $1, 120(CX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VPSLLQ      $5, Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b0-576(SP)
+	VPSRLQ      $24, Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b1-544(SP)
+	VPSRLQ      $53, Y12, Y2
+	VPSLLQ      $11, Y13, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b2-512(SP)
+	VPSRLQ      $18, Y13, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b3-480(SP)
+	VPSRLQ      $47, Y13, Y2
+	VPSLLQ      $17, Y14, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b4-448(SP)
+	VPSRLQ      $12, Y14, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b5-416(SP)
+	VPSRLQ      $41, Y14, Y2
+	VPSLLQ      $23, Y15, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b6-384(SP)
+	VPSRLQ      $6, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b7-352(SP)
+	VPSRLQ      $35, Y15, Y2
+	VMOVDQU     Y2, b8-320(SP)
+
+	// t = (t + a * b[0] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b0-576(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[1] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b1-544(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[2] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b2-512(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[3] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b3-480(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[4] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b4-448(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[5] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b5-416(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[6] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b6-384(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[7] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b7-352(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[8] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b8-320(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// propagate the carries, and compute u = t - q in a
+	VMOVDQU t0-288(SP), Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t0-288(SP)
+	VPSUBQ  qLimbs29<>+0(SB), Y5, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a0-864(SP)
+	VMOVDQU t1-256(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t1-256(SP)
+	VPSUBQ  qLimbs29<>+32(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a1-832(SP)
+	VMOVDQU t2-224(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t2-224(SP)
+	VPSUBQ  qLimbs29<>+64(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a2-800(SP)
+	VMOVDQU t3-192(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t3-192(SP)
+	VPSUBQ  qLimbs29<>+96(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a3-768(SP)
+	VMOVDQU t4-160(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t4-160(SP)
+	VPSUBQ  qLimbs29<>+128(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a4-736(SP)
+	VMOVDQU t5-128(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t5-128(SP)
+	VPSUBQ  qLimbs29<>+160(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a5-704(SP)
+	VMOVDQU t6-96(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t6-96(SP)
+	VPSUBQ  qLimbs29<>+192(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a6-672(SP)
+	VMOVDQU t7-64(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t7-64(SP)
+	VPSUBQ  qLimbs29<>+224(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a7-640(SP)
+	VMOVDQU Y6, t8-32(SP)
+	VPSUBQ  qLimbs29<>+256(SB), Y6, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VMOVDQU Y2, a8-608(SP)
+
+	// keep t if u is negative
+	VPXOR     Y3, Y3, Y3
+	VPCMPGTQ  Y2, Y3, Y4
+	VMOVDQU   a0-864(SP), Y2
+	VPBLENDVB Y4, t0-288(SP), Y2, Y2
+	VMOVDQU   Y2, a0-864(SP)
+	VMOVDQU   a1-832(SP), Y2
+	VPBLENDVB Y4, t1-256(SP), Y2, Y2
+	VMOVDQU   Y2, a1-832(SP)
+	VMOVDQU   a2-800(SP), Y2
+	VPBLENDVB Y4, t2-224(SP), Y2, Y2
+	VMOVDQU   Y2, a2-800(SP)
+	VMOVDQU   a3-768(SP), Y2
+	VPBLENDVB Y4, t3-192(SP), Y2, Y2
+	VMOVDQU   Y2, a3-768(SP)
+	VMOVDQU   a4-736(SP), Y2
+	VPBLENDVB Y4, t4-160(SP), Y2, Y2
+	VMOVDQU   Y2, a4-736(SP)
+	VMOVDQU   a5-704(SP), Y2
+	VPBLENDVB Y4, t5-128(SP), Y2, Y2
+	VMOVDQU   Y2, a5-704(SP)
+	VMOVDQU   a6-672(SP), Y2
+	VPBLENDVB Y4, t6-96(SP), Y2, Y2
+	VMOVDQU   Y2, a6-672(SP)
+	VMOVDQU   a7-640(SP), Y2
+	VPBLENDVB Y4, t7-64(SP), Y2, Y2
+	VMOVDQU   Y2, a7-640(SP)
+	VMOVDQU   a8-608(SP), Y2
+	VPBLENDVB Y4, t8-32(SP), Y2, Y2
+	VMOVDQU   Y2, a8-608(SP)
+
+	// convert the 29 bits limbs back to words and store the 4 results
+	VMOVDQU      a0-864(SP), Y5
+	VMOVDQU      Y5, Y2
+	VMOVDQU      a1-832(SP), Y5
+	VPSLLQ       $29, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a2-800(SP), Y5
+	VPSLLQ       $58, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 0(AX)
+	VPEXTRQ      $1, X2, 32(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 64(AX)
+	VPEXTRQ      $1, X1, 96(AX)
+	VMOVDQU      a2-800(SP), Y5
+	VPSRLQ       $6, Y5, Y2
+	VMOVDQU      a3-768(SP), Y5
+	VPSLLQ       $23, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a4-736(SP), Y5
+	VPSLLQ       $52, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 8(AX)
+	VPEXTRQ      $1, X2, 40(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 72(AX)
+	VPEXTRQ      $1, X1, 104(AX)
+	VMOVDQU      a4-736(SP), Y5
+	VPSRLQ       $12, Y5, Y2
+	VMOVDQU      a5-704(SP), Y5
+	VPSLLQ       $17, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a6-672(SP), Y5
+	VPSLLQ       $46, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 16(AX)
+	VPEXTRQ      $1, X2, 48(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 80(AX)
+	VPEXTRQ      $1, X1, 112(AX)
+	VMOVDQU      a6-672(SP), Y5
+	VPSRLQ       $18, Y5, Y2
+	VMOVDQU      a7-640(SP), Y5
+	VPSLLQ       $11, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a8-608(SP), Y5
+	VPSLLQ       $40, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 24(AX)
+	VPEXTRQ      $1, X2, 56(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 88(AX)
+	VPEXTRQ      $1, X1, 120(AX)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000080, AX
+	ADDQ $0x0000000000000080, DX
+	ADDQ $0x0000000000000080, CX
+	DECQ BX                      // decrement n
+	JMP  l13
+
+l14:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b
+TEXT ·scalarMulVecAVX2(SB), $864-32
+	MOVQ         $0x000000001fffffff, SI
+	MOVQ         SI, X0
+	VPBROADCASTQ X0, Y0
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 29 bits limbs
+	VPBROADCASTQ 0(CX), Y12
+	VPBROADCASTQ 8(CX), Y13
+	VPBROADCASTQ 16(CX), Y14
+	VPBROADCASTQ 24(CX), Y15
+	VPSLLQ       $5, Y12, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b0-576(SP)
+	VPSRLQ       $24, Y12, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b1-544(SP)
+	VPSRLQ       $53, Y12, Y2
+	VPSLLQ       $11, Y13, Y1
+	VPOR         Y1, Y2, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b2-512(SP)
+	VPSRLQ       $18, Y13, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b3-480(SP)
+	VPSRLQ       $47, Y13, Y2
+	VPSLLQ       $17, Y14, Y1
+	VPOR         Y1, Y2, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b4-448(SP)
+	VPSRLQ       $12, Y14, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b5-416(SP)
+	VPSRLQ       $41, Y14, Y2
+	VPSLLQ       $23, Y15, Y1
+	VPOR         Y1, Y2, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b6-384(SP)
+	VPSRLQ       $6, Y15, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b7-352(SP)
+	VPSRLQ       $35, Y15, Y2
+	VMOVDQU      Y2, b8-320(SP)
+
+l15:
+	TESTQ BX, BX
+	JEQ   l16    // n == 0, we are done
+
+	// load 4 elements of a and convert them to 29 bits limbs
+	VMOVQ       0(DX), X12
+	VPINSRQ     $1, 32(DX), X12, X12
+	VMOVQ       64(DX), X1
+	VPINSRQ     $1, 96(DX), X1, X1
+	VINSERTI128 $1, X1, Y12, Y12
+	VMOVQ       8(DX), X13
+	VPINSRQ     $1, 40(DX), X13, X13
+	VMOVQ       72(DX), X1
+	VPINSRQ     $1, 104(DX), X1, X1
+	VINSERTI128 $1, X1, Y13, Y13
+	VMOVQ       16(DX), X14
+	VPINSRQ     $1, 48(DX), X14, X14
+	VMOVQ       80(DX), X1
+	VPINSRQ     $1, 112(DX), X1, X1
+	VINSERTI128 $1, X1, Y14, Y14
+	VMOVQ       24(DX), X15
+	VPINSRQ     $1, 56(DX), X15, X15
+	VMOVQ       88(DX), X1
+	VPINSRQ     $1, 120(DX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VMOVDQU     Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a0-864(SP)
+	VPSRLQ      $29, Y12, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a1-832(SP)
+	VPSRLQ      $58, Y12, Y2
+	VPSLLQ      $6, Y13, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a2-800(SP)
+	VPSRLQ      $23, Y13, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a3-768(SP)
+	VPSRLQ      $52, Y13, Y2
+	VPSLLQ      $12, Y14, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a4-736(SP)
+	VPSRLQ      $17, Y14, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a5-704(SP)
+	VPSRLQ      $46, Y14, Y2
+	VPSLLQ      $18, Y15, Y1
+	VPOR        Y1, Y2, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a6-672(SP)
+	VPSRLQ      $11, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a7-640(SP)
+	VPSRLQ      $40, Y15, Y2
+	VMOVDQU     Y2, a8-608(SP)
+
+	// t = (t + a * b[0] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b0-576(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[1] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b1-544(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[2] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b2-512(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[3] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b3-480(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[4] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b4-448(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[5] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b5-416(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[6] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b6-384(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[7] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b7-352(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// t = (t + a * b[8] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b8-320(SP), Y3
+	VPMULUDQ a0-864(SP), Y3, Y5
+	VPADDQ   t0-288(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-832(SP), Y3, Y5
+	VPADDQ   t1-256(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-288(SP)
+	VPMULUDQ a2-800(SP), Y3, Y5
+	VPADDQ   t2-224(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-256(SP)
+	VPMULUDQ a3-768(SP), Y3, Y5
+	VPADDQ   t3-192(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+96(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t2-224(SP)
+	VPMULUDQ a4-736(SP), Y3, Y5
+	VPADDQ   t4-160(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+128(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t3-192(SP)
+	VPMULUDQ a5-704(SP), Y3, Y5
+	VPADDQ   t5-128(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+160(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t4-160(SP)
+	VPMULUDQ a6-672(SP), Y3, Y5
+	VPADDQ   t6-96(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+192(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t5-128(SP)
+	VPMULUDQ a7-640(SP), Y3, Y5
+	VPADDQ   t7-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+224(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t6-96(SP)
+	VPMULUDQ a8-608(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+256(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t7-64(SP)
+
+	// propagate the carries, and compute u = t - q in a
+	VMOVDQU t0-288(SP), Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t0-288(SP)
+	VPSUBQ  qLimbs29<>+0(SB), Y5, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a0-864(SP)
+	VMOVDQU t1-256(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t1-256(SP)
+	VPSUBQ  qLimbs29<>+32(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a1-832(SP)
+	VMOVDQU t2-224(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t2-224(SP)
+	VPSUBQ  qLimbs29<>+64(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a2-800(SP)
+	VMOVDQU t3-192(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t3-192(SP)
+	VPSUBQ  qLimbs29<>+96(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a3-768(SP)
+	VMOVDQU t4-160(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t4-160(SP)
+	VPSUBQ  qLimbs29<>+128(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a4-736(SP)
+	VMOVDQU t5-128(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t5-128(SP)
+	VPSUBQ  qLimbs29<>+160(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a5-704(SP)
+	VMOVDQU t6-96(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t6-96(SP)
+	VPSUBQ  qLimbs29<>+192(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a6-672(SP)
+	VMOVDQU t7-64(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t7-64(SP)
+	VPSUBQ  qLimbs29<>+224(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a7-640(SP)
+	VMOVDQU Y6, t8-32(SP)
+	VPSUBQ  qLimbs29<>+256(SB), Y6, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VMOVDQU Y2, a8-608(SP)
+
+	// keep t if u is negative
+	VPXOR     Y3, Y3, Y3
+	VPCMPGTQ  Y2, Y3, Y4
+	VMOVDQU   a0-864(SP), Y2
+	VPBLENDVB Y4, t0-288(SP), Y2, Y2
+	VMOVDQU   Y2, a0-864(SP)
+	VMOVDQU   a1-832(SP), Y2
+	VPBLENDVB Y4, t1-256(SP), Y2, Y2
+	VMOVDQU   Y2, a1-832(SP)
+	VMOVDQU   a2-800(SP), Y2
+	VPBLENDVB Y4, t2-224(SP), Y2, Y2
+	VMOVDQU   Y2, a2-800(SP)
+	VMOVDQU   a3-768(SP), Y2
+	VPBLENDVB Y4, t3-192(SP), Y2, Y2
+	VMOVDQU   Y2, a3-768(SP)
+	VMOVDQU   a4-736(SP), Y2
+	VPBLENDVB Y4, t4-160(SP), Y2, Y2
+	VMOVDQU   Y2, a4-736(SP)
+	VMOVDQU   a5-704(SP), Y2
+	VPBLENDVB Y4, t5-128(SP), Y2, Y2
+	VMOVDQU   Y2, a5-704(SP)
+	VMOVDQU   a6-672(SP), Y2
+	VPBLENDVB Y4, t6-96(SP), Y2, Y2
+	VMOVDQU   Y2, a6-672(SP)
+	VMOVDQU   a7-640(SP), Y2
+	VPBLENDVB Y4, t7-64(SP), Y2, Y2
+	VMOVDQU   Y2, a7-640(SP)
+	VMOVDQU   a8-608(SP), Y2
+	VPBLENDVB Y4, t8-32(SP), Y2, Y2
+	VMOVDQU   Y2, a8-608(SP)
+
+	// convert the 29 bits limbs back to words and store the 4 results
+	VMOVDQU      a0-864(SP), Y5
+	VMOVDQU      Y5, Y2
+	VMOVDQU      a1-832(SP), Y5
+	VPSLLQ       $29, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a2-800(SP), Y5
+	VPSLLQ       $58, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 0(AX)
+	VPEXTRQ      $1, X2, 32(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 64(AX)
+	VPEXTRQ      $1, X1, 96(AX)
+	VMOVDQU      a2-800(SP), Y5
+	VPSRLQ       $6, Y5, Y2
+	VMOVDQU      a3-768(SP), Y5
+	VPSLLQ       $23, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a4-736(SP), Y5
+	VPSLLQ       $52, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 8(AX)
+	VPEXTRQ      $1, X2, 40(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 72(AX)
+	VPEXTRQ      $1, X1, 104(AX)
+	VMOVDQU      a4-736(SP), Y5
+	VPSRLQ       $12, Y5, Y2
+	VMOVDQU      a5-704(SP), Y5
+	VPSLLQ       $17, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a6-672(SP), Y5
+	VPSLLQ       $46, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 16(AX)
+	VPEXTRQ      $1, X2, 48(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 80(AX)
+	VPEXTRQ      $1, X1, 112(AX)
+	VMOVDQU      a6-672(SP), Y5
+	VPSRLQ       $18, Y5, Y2
+	VMOVDQU      a7-640(SP), Y5
+	VPSLLQ       $11, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a8-608(SP), Y5
+	VPSLLQ       $40, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 24(AX)
+	VPEXTRQ      $1, X2, 56(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 88(AX)
+	VPEXTRQ      $1, X1, 120(AX)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000080, AX
+	ADDQ $0x0000000000000080, DX
+	DECQ BX                      // decrement n
+	JMP  l15
+
+l16:
+	VZEROUPPER
+	RET
@@ -0,0 +1,206 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	xmd "github.com/consensys/gnark-crypto/field/hash"
+)
+
+const contextString = "FROST-secp256k1-SHA256-v1"
+
+const (
+	sizeScalar    = fr.Bytes
+	sizeElement   = 1 + fp.Bytes
+	sizeSignature = sizeElement + sizeScalar
+)
+
+var (
+	errIdentity       = errors.New("identity element")
+	errInvalidElement = errors.New("invalid group element")
+	errInvalidScalar  = errors.New("invalid scalar encoding")
+)
+
+// order of the group
+var order = fr.Modulus()
+
+// generator of the group
+var _, generator = secp256k1.Generators()
+
+// size in bytes of the uniform strings reduced into scalars, cf RFC 9380 section 5
+var hashToScalarSize = (order.BitLen() + 128 + 7) / 8
+
+// randomScalar returns a uniform non-zero scalar read from r.
+func randomScalar(r io.Reader) (big.Int, error) {
+	var k fr.Element
+	var res big.Int
+	b := make([]byte, hashToScalarSize)
+	for k.IsZero() {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return res, err
+		}
+		k.SetBytesConstantTime(b)
+	}
+	k.BigInt(&res)
+	return res, nil
+}
+
+// The secret scalars (shares, nonces, polynomial coefficients) are combined with
+// the constant-time arithmetic of fr.Element rather than with big.Int.
+
+// toScalar returns s mod the group order, without branching on the value of s.
+func toScalar(s *big.Int) fr.Element {
+	var res fr.Element
+	res.SetBytesConstantTime(s.Bytes())
+	return res
+}
+
+// fromScalar returns s as a big.Int.
+func fromScalar(s *fr.Element) big.Int {
+	var res big.Int
+	s.BigInt(&res)
+	return res
+}
+
+// scalarBaseMult returns s*G. The sequence of operations does not depend on s,
+// which is secret for the nonces and the shares.
+func scalarBaseMult(s *big.Int) secp256k1.G1Affine {
+	var res secp256k1.G1Affine
+	e := toScalar(s)
+	res.ScalarMultiplicationBaseElementConstantTime(&e)
+	return res
+}
+
+// validateElement checks that p, received from another participant, is an element
+// of the prime order group other than the identity, cf RFC 9591 section 4.
+func validateElement(p *secp256k1.G1Affine) error {
+	if !p.IsOnCurve() {
+		return errInvalidElement
+	}
+	if isIdentity(p) {
+		return errIdentity
+	}
+	if !p.IsInSubGroup() {
+		return errInvalidElement
+	}
+	return nil
+}
+
+// isIdentity returns true if p is the neutral element of the group
+func isIdentity(p *secp256k1.G1Affine) bool {
+	return p.IsInfinity()
+}
+
+// serializeScalar encodes s as a big endian integer on sizeScalar bytes.
+func serializeScalar(s *big.Int) []byte {
+	var res [sizeScalar]byte
+	s.FillBytes(res[:])
+	return res[:]
+}
+
+// deserializeScalar decodes a big endian integer smaller than the group order.
+func deserializeScalar(buf []byte) (big.Int, error) {
+	var s big.Int
+	if len(buf) != sizeScalar {
+		return s, errInvalidScalar
+	}
+	s.SetBytes(buf)
+	if s.Cmp(order) >= 0 {
+		return s, errInvalidScalar
+	}
+	return s, nil
+}
+
+// serializeElement encodes p as a SEC1 compressed point.
+// The identity cannot be serialized.
+func serializeElement(p *secp256k1.G1Affine) ([]byte, error) {
+	if isIdentity(p) {
+		return nil, errIdentity
+	}
+	var res [sizeElement]byte
+	x, y := p.X.Bytes(), p.Y.Bytes()
+	res[0] = 0x02 | (y[fp.Bytes-1] & 1)
+	copy(res[1:], x[:])
+	return res[:], nil
+}
+
+// deserializeElement decodes a SEC1 compressed point.
+func deserializeElement(buf []byte) (secp256k1.G1Affine, error) {
+	var p secp256k1.G1Affine
+	if len(buf) != sizeElement || (buf[0] != 0x02 && buf[0] != 0x03) {
+		return p, errInvalidElement
+	}
+	if err := p.X.SetBytesCanonical(buf[1:]); err != nil {
+		return p, errInvalidElement
+	}
+
+	// y² = x³ + b
+	_, b := secp256k1.CurveCoefficients()
+	p.Y.Square(&p.X).Mul(&p.Y, &p.X).Add(&p.Y, &b)
+	if p.Y.Sqrt(&p.Y) == nil {
+		return p, errInvalidElement
+	}
+	if y := p.Y.Bytes(); y[fp.Bytes-1]&1 != buf[0]&1 {
+		p.Y.Neg(&p.Y)
+	}
+	return p, validateElement(&p)
+}
+
+// hashToScalar hashes msg to a scalar with expand_message_xmd and SHA-256,
+// cf RFC 9380 section 5.
+func hashToScalar(msg []byte, tag string) (big.Int, error) {
+	b, err := xmd.ExpandMsgXmd(msg, []byte(contextString+tag), hashToScalarSize)
+	if err != nil {
+		return big.Int{}, err
+	}
+	var s fr.Element
+	s.SetBytesConstantTime(b)
+	return fromScalar(&s), nil
+}
+
+// hashToBytes returns SHA-256(contextString || tag || msg)
+func hashToBytes(msg []byte, tag string) []byte {
+	h := sha256.New()
+	h.Write([]byte(contextString + tag))
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// challenge computes H2(R || A || M), cf RFC 9591 section 6.5.
+func challenge(R, A *secp256k1.G1Affine, message []byte) (big.Int, error) {
+	var c big.Int
+	RBin, err := serializeElement(R)
+	if err != nil {
+		return c, err
+	}
+	ABin, err := serializeElement(A)
+	if err != nil {
+		return c, err
+	}
+	msg := make([]byte, 0, 2*sizeElement+len(message))
+	msg = append(msg, RBin...)
+	msg = append(msg, ABin...)
+	msg = append(msg, message...)
+	return hashToScalar(msg, "chal")
+}
@@ -0,0 +1,29 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecfft provides the ECFFT algorithms of Ben-Sasson, Carmon, Kopparty and Levit,
+// for fields without a large multiplicative subgroup of order a power of two.
+//
+// The evaluation sets are the x-coordinates of a coset of a cyclic subgroup of order 2ᵏ
+// of an elliptic curve; a chain of 2-isogenies maps them to sets half their size,
+// the way squaring does for the roots of unity in the multiplicative FFT.
+//
+// Domain.Enter converts the coefficients of a polynomial to its evaluations on S,
+// Domain.Exit converts them back and Domain.Extend converts the evaluations on S to
+// the evaluations on the disjoint set S'.
+//
+// See https://arxiv.org/abs/2107.08473
+package ecfft
@@ -0,0 +1,150 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"math/big"
+	"math/bits"
+	"sync"
+
+	fr756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	fft756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/fft"
+	fr761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	fft761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// The field has no large subgroup of order a power of two, so the large products needed by
+// Exit are computed over the integers: the coefficients, lifted to [0, q), are multiplied
+// with FFTs modulo r₁ and r₂, the scalar fields of BW6-761 and BW6-756, which have such
+// subgroups. A coefficient of the product is less than MaxCardinality·q² < r₁·r₂,
+// so it is recovered from its residues by the Chinese remainder theorem.
+
+// below this size, polynomials are multiplied with Karatsuba's algorithm
+const mulFFTThreshold = 512
+
+var (
+	r1InvModR2 fr756.Element // r₁⁻¹ mod r₂
+	r1ModQ     fp.Element    // r₁ mod q
+	twoTo64    fp.Element    // 2⁶⁴ mod q
+)
+
+func init() {
+	r1 := fr761.Modulus()
+	r1InvModR2.SetBigInt(r1).Inverse(&r1InvModR2)
+	r1ModQ.SetBigInt(r1)
+	twoTo64.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 64))
+}
+
+// mulDomains holds the FFT domains of r₁ and r₂, indexed by the log of their cardinality
+var mulDomains struct {
+	sync.Mutex
+	d761 map[int]*fft761.Domain
+	d756 map[int]*fft756.Domain
+}
+
+// getMulDomains returns the FFT domains of cardinality n, creating them on first use
+func getMulDomains(n int) (*fft761.Domain, *fft756.Domain) {
+	log := bits.TrailingZeros(uint(n))
+	mulDomains.Lock()
+	defer mulDomains.Unlock()
+	if mulDomains.d761 == nil {
+		mulDomains.d761 = make(map[int]*fft761.Domain)
+		mulDomains.d756 = make(map[int]*fft756.Domain)
+	}
+	if _, ok := mulDomains.d761[log]; !ok {
+		mulDomains.d761[log] = fft761.NewDomain(uint64(n))
+		mulDomains.d756[log] = fft756.NewDomain(uint64(n))
+	}
+	return mulDomains.d761[log], mulDomains.d756[log]
+}
+
+// mul returns the 2k-1 coefficients of a·b, where k = len(a) = len(b) is a power of two,
+// with O(k·log(k)) operations in the fields of r₁ and r₂
+func mul(a, b []fp.Element) []fp.Element {
+	k := len(a)
+	if k < mulFFTThreshold {
+		return karatsuba(a, b)
+	}
+	n := 2 * k
+	d761, d756 := getMulDomains(n)
+
+	var c761 []fr761.Element
+	var c756 []fr756.Element
+	parallel(n, func() {
+		c761 = make([]fr761.Element, n)
+		b761 := make([]fr761.Element, n)
+		var buf [fr761.Bytes]byte
+		for i := 0; i < k; i++ {
+			c761[i].SetBytes(lift(buf[:], &a[i]))
+			b761[i].SetBytes(lift(buf[:], &b[i]))
+		}
+		d761.FFT(c761, fft761.DIF)
+		d761.FFT(b761, fft761.DIF)
+		for i := range c761 {
+			c761[i].Mul(&c761[i], &b761[i])
+		}
+		d761.FFTInverse(c761, fft761.DIT)
+	}, func() {
+		c756 = make([]fr756.Element, n)
+		b756 := make([]fr756.Element, n)
+		var buf [fr756.Bytes]byte
+		for i := 0; i < k; i++ {
+			c756[i].SetBytes(lift(buf[:], &a[i]))
+			b756[i].SetBytes(lift(buf[:], &b[i]))
+		}
+		d756.FFT(c756, fft756.DIF)
+		d756.FFT(b756, fft756.DIF)
+		for i := range c756 {
+			c756[i].Mul(&c756[i], &b756[i])
+		}
+		d756.FFTInverse(c756, fft756.DIT)
+	})
+
+	// c = c₁ + r₁·t where c₁ = c mod r₁ and t = (c₂ - c₁)·r₁⁻¹ mod r₂
+	res := make([]fp.Element, n-1)
+	var t fr756.Element
+	var tmp fp.Element
+	for i := range res {
+		// r₁ < r₂, so c₁ is also the canonical value of c₁ mod r₂
+		b := c761[i].Bytes()
+		t.SetBytes(b[:])
+		t.Sub(&c756[i], &t).Mul(&t, &r1InvModR2)
+		res[i] = fromWords(c761[i].Bits())
+		tmp = fromWords(t.Bits())
+		tmp.Mul(&tmp, &r1ModQ)
+		res[i].Add(&res[i], &tmp)
+	}
+	return res
+}
+
+// lift writes the canonical value of x in buf, as a big-endian integer, and returns buf
+func lift(buf []byte, x *fp.Element) []byte {
+	b := x.Bytes()
+	copy(buf[len(buf)-len(b):], b[:])
+	return buf
+}
+
+// fromWords returns the integer with the given little-endian 64-bit words, mod q
+func fromWords(w [6]uint64) fp.Element {
+	var res, tmp fp.Element
+	for i := len(w) - 1; i >= 0; i-- {
+		tmp.SetUint64(w[i])
+		res.Mul(&res, &twoTo64).Add(&res, &tmp)
+	}
+	return res
+}
@@ -0,0 +1,378 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+func genE4() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z E4
+		z.A0.SetUint64(genParams.NextUint64())
+		z.A1.SetUint64(genParams.NextUint64())
+		z.A2.SetUint64(genParams.NextUint64())
+		z.A3.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(z, gopter.NoShrinker)
+	}
+}
+
+func e4TestParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 20
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+	return parameters
+}
+
+// e4ToBig returns the coordinates of z as big.Int
+func e4ToBig(z *E4) []big.Int {
+	res := make([]big.Int, 4)
+	z.A0.BigInt(&res[0])
+	z.A1.BigInt(&res[1])
+	z.A2.BigInt(&res[2])
+	z.A3.BigInt(&res[3])
+	return res
+}
+
+// e4MulReference is a textbook polynomial multiplication modulo u⁴ - α
+func e4MulReference(x, y *E4) E4 {
+	a, b := e4ToBig(x), e4ToBig(y)
+	c := make([]big.Int, 4)
+	alpha := big.NewInt(11)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			var t big.Int
+			t.Mul(&a[i], &b[j])
+			if i+j >= 4 {
+				t.Mul(&t, alpha)
+			}
+			k := (i + j) % 4
+			c[k].Add(&c[k], &t)
+		}
+	}
+	var res E4
+	res.A0.SetBigInt(&c[0])
+	res.A1.SetBigInt(&c[1])
+	res.A2.SetBigInt(&c[2])
+	res.A3.SetBigInt(&c[3])
+	return res
+}
+
+func TestE4Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e4TestParameters())
+	genA := genE4()
+	genB := genE4()
+
+	properties.Property("[E4] Mul should match the reference multiplication", prop.ForAll(
+		func(a, b E4) bool {
+			var c E4
+			c.Mul(&a, &b)
+			ref := e4MulReference(&a, &b)
+			return c.Equal(&ref)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b E4) bool {
+			var c, d E4
+			d.Set(&a)
+			c.Mul(&a, &b)
+			a.Mul(&a, &b)
+			b.Mul(&d, &b)
+			return a.Equal(&b) && a.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Square(x) == Mul(x, x)", prop.ForAll(
+		func(a E4) bool {
+			var b, c E4
+			b.Square(&a)
+			c.Mul(&a, &a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Add and Sub should be inverse operations", prop.ForAll(
+		func(a, b E4) bool {
+			var c, d E4
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Neg(&b).Add(&d, &b)
+			return c.Equal(&a) && d.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Double and Halve should be inverse operations", prop.ForAll(
+		func(a E4) bool {
+			var b, c E4
+			b.Double(&a)
+			c.Add(&a, &a)
+			if !b.Equal(&c) {
+				return false
+			}
+			b.Halve()
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] MulByElement should match Mul", prop.ForAll(
+		func(a, b E4) bool {
+			var c, d, e E4
+			c.MulByElement(&a, &b.A0)
+			e.SetElement(&b.A0)
+			d.Mul(&a, &e)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] x * x⁻¹ == 1", prop.ForAll(
+		func(a E4) bool {
+			if a.IsZero() {
+				return true
+			}
+			var b E4
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E4] (x / y) * y == x", prop.ForAll(
+		func(a, b E4) bool {
+			if b.IsZero() {
+				return true
+			}
+			var c E4
+			c.Div(&a, &b).Mul(&c, &b)
+			return c.Equal(&a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Exp should match repeated multiplications", prop.ForAll(
+		func(a E4, k uint8) bool {
+			var b, c E4
+			b.Exp(a, big.NewInt(int64(k)))
+			c.SetOne()
+			for i := 0; i < int(k); i++ {
+				c.Mul(&c, &a)
+			}
+			if !b.Equal(&c) {
+				return false
+			}
+			if a.IsZero() {
+				return true
+			}
+			// negative exponent
+			b.Exp(a, big.NewInt(-int64(k))).Mul(&b, &c)
+			return b.IsOne()
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(uint8(genParams.NextUint64()), gopter.NoShrinker)
+		}),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE4Frobenius(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e4TestParameters())
+	genA := genE4()
+
+	properties.Property("[E4] Frobenius(x) == xᵖ", prop.ForAll(
+		func(a E4) bool {
+			var b, c E4
+			b.Frobenius(&a)
+			c.Exp(a, Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Frobenius should have order 4", prop.ForAll(
+		func(a E4) bool {
+			b := a
+			for i := 0; i < 4; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Norm should be the product of the conjugates", prop.ForAll(
+		func(a E4) bool {
+			var c, f E4
+			c.Set(&a)
+			f.Set(&a)
+			for i := 1; i < 4; i++ {
+				f.Frobenius(&f)
+				c.Mul(&c, &f)
+			}
+			n := a.Norm()
+			var expected E4
+			expected.SetElement(&n)
+			return c.Equal(&expected)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE4Sqrt(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e4TestParameters())
+	genA := genE4()
+
+	properties.Property("[E4] Sqrt(x²)² == x²", prop.ForAll(
+		func(a E4) bool {
+			var b, c E4
+			b.Square(&a)
+			if b.Legendre() == -1 {
+				return false
+			}
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Sqrt should fail on non-squares", prop.ForAll(
+		func(a E4) bool {
+			var b, c E4
+			b.Square(&a).Mul(&b, &e4SqrtG)
+			if a.IsZero() {
+				return true
+			}
+			return b.Legendre() == -1 && c.Sqrt(&b) == nil
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var zero, r E4
+	require.NotNil(t, r.Sqrt(&zero))
+	require.True(t, r.IsZero())
+}
+
+func TestE4Marshal(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b E4
+	_, err := a.SetRandom()
+	assert.NoError(err)
+
+	data, err := a.MarshalBinary()
+	assert.NoError(err)
+	assert.Len(data, BytesE4)
+	assert.NoError(b.UnmarshalBinary(data))
+	assert.True(a.Equal(&b))
+
+	// non canonical coordinate
+	q := Modulus().Bytes()
+	copy(data[Bytes-len(q):Bytes], q)
+	assert.Error(b.UnmarshalBinary(data))
+
+	// wrong length
+	assert.Error(b.SetBytesCanonical(data[1:]))
+}
+
+func TestE4BatchInvert(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	a := make([]E4, 10)
+	for i := range a {
+		if i%3 != 0 {
+			a[i].SetRandom()
+		}
+	}
+	res := BatchInvertE4(a)
+	for i := range a {
+		var expected E4
+		expected.Inverse(&a[i])
+		assert.True(res[i].Equal(&expected))
+	}
+}
+
+func BenchmarkE4Mul(b *testing.B) {
+	var x, y E4
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE4Square(b *testing.B) {
+	var x E4
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var x E4
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkE4Sqrt(b *testing.B) {
+	var x E4
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Sqrt(&x)
+	}
+}
@@ -0,0 +1,520 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// E5 is a degree 5 extension of Element: E5 = Element[u]/(u⁵ - (2))
+//
+// z = A0 + A1*u + A2*u² + A3*u³ + A4*u⁴
+type E5 struct {
+	A0, A1, A2, A3, A4 Element
+}
+
+// BytesE5 is the number of bytes needed to encode an E5
+const BytesE5 = 5 * Bytes
+
+// e5RootOf is α such that u⁵ = α
+var e5RootOf = Element{536870908}
+
+// e5Frobenius[i] = α^(i(p-1)/5), such that (uⁱ)ᵖ = e5Frobenius[i] * uⁱ
+var e5Frobenius = [5]Element{
+	{268435454},
+	{1079828539},
+	{847078768},
+	{1597816133},
+	{233372948},
+}
+
+// e5SqrtG = zˢ where z is a non-square in E5 and p⁵-1 = 2ᵉ * s, s odd
+var e5SqrtG = E5{
+	A0: Element{102734362},
+	A1: Element{0},
+	A2: Element{0},
+	A3: Element{0},
+	A4: Element{0},
+}
+
+// e5SqrtSMinusOneOver2 = (s-1)/2
+var e5SqrtSMinusOneOver2 big.Int
+
+func init() {
+	e5SqrtSMinusOneOver2.SetString("5cb27803dcc500107ac0002328000025", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E5) Equal(x *E5) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2) && z.A3.Equal(&x.A3) && z.A4.Equal(&x.A4)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E5) SetZero() *E5 {
+	*z = E5{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E5) SetOne() *E5 {
+	*z = E5{}
+	z.A0.SetOne()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E5) Set(x *E5) *E5 {
+	*z = *x
+	return z
+}
+
+// SetElement sets z to the base field element x and returns z
+func (z *E5) SetElement(x *Element) *E5 {
+	*z = E5{}
+	z.A0.Set(x)
+	return z
+}
+
+// SetRandom sets z to a uniform random value
+func (z *E5) SetRandom() (*E5, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A3.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A4.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z == 0
+func (z *E5) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero() && z.A4.IsZero()
+}
+
+// IsOne returns true if z == 1
+func (z *E5) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero() && z.A4.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E5) Add(x, y *E5) *E5 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	z.A3.Add(&x.A3, &y.A3)
+	z.A4.Add(&x.A4, &y.A4)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E5) Sub(x, y *E5) *E5 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	z.A3.Sub(&x.A3, &y.A3)
+	z.A4.Sub(&x.A4, &y.A4)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E5) Double(x *E5) *E5 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	z.A3.Double(&x.A3)
+	z.A4.Double(&x.A4)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E5) Neg(x *E5) *E5 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	z.A3.Neg(&x.A3)
+	z.A4.Neg(&x.A4)
+	return z
+}
+
+// Halve sets z = z / 2
+func (z *E5) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+	z.A2.Halve()
+	z.A3.Halve()
+	z.A4.Halve()
+}
+
+// MulByElement sets z = x * y where y is in the base field and returns z
+func (z *E5) MulByElement(x *E5, y *Element) *E5 {
+	var yCopy Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	z.A3.Mul(&x.A3, &yCopy)
+	z.A4.Mul(&x.A4, &yCopy)
+	return z
+}
+
+// e5MulByRootOf sets z = α * x
+func e5MulByRootOf(z, x *Element) {
+	z.Mul(x, &e5RootOf)
+}
+
+// Mul sets z = x * y and returns z
+func (z *E5) Mul(x, y *E5) *E5 {
+	// schoolbook multiplication, terms of degree ⩾ 5 are reduced with u⁵ = α
+	var t Element
+	var c E5
+
+	// coefficient of u⁰
+	c.A0.Mul(&x.A1, &y.A4)
+	t.Mul(&x.A2, &y.A3)
+	c.A0.Add(&c.A0, &t)
+	t.Mul(&x.A3, &y.A2)
+	c.A0.Add(&c.A0, &t)
+	t.Mul(&x.A4, &y.A1)
+	c.A0.Add(&c.A0, &t)
+	e5MulByRootOf(&c.A0, &c.A0)
+	t.Mul(&x.A0, &y.A0)
+	c.A0.Add(&c.A0, &t)
+
+	// coefficient of u¹
+	c.A1.Mul(&x.A2, &y.A4)
+	t.Mul(&x.A3, &y.A3)
+	c.A1.Add(&c.A1, &t)
+	t.Mul(&x.A4, &y.A2)
+	c.A1.Add(&c.A1, &t)
+	e5MulByRootOf(&c.A1, &c.A1)
+	t.Mul(&x.A0, &y.A1)
+	c.A1.Add(&c.A1, &t)
+	t.Mul(&x.A1, &y.A0)
+	c.A1.Add(&c.A1, &t)
+
+	// coefficient of u²
+	c.A2.Mul(&x.A3, &y.A4)
+	t.Mul(&x.A4, &y.A3)
+	c.A2.Add(&c.A2, &t)
+	e5MulByRootOf(&c.A2, &c.A2)
+	t.Mul(&x.A0, &y.A2)
+	c.A2.Add(&c.A2, &t)
+	t.Mul(&x.A1, &y.A1)
+	c.A2.Add(&c.A2, &t)
+	t.Mul(&x.A2, &y.A0)
+	c.A2.Add(&c.A2, &t)
+
+	// coefficient of u³
+	c.A3.Mul(&x.A4, &y.A4)
+	e5MulByRootOf(&c.A3, &c.A3)
+	t.Mul(&x.A0, &y.A3)
+	c.A3.Add(&c.A3, &t)
+	t.Mul(&x.A1, &y.A2)
+	c.A3.Add(&c.A3, &t)
+	t.Mul(&x.A2, &y.A1)
+	c.A3.Add(&c.A3, &t)
+	t.Mul(&x.A3, &y.A0)
+	c.A3.Add(&c.A3, &t)
+
+	// coefficient of u⁴
+	c.A4.Mul(&x.A0, &y.A4)
+	t.Mul(&x.A1, &y.A3)
+	c.A4.Add(&c.A4, &t)
+	t.Mul(&x.A2, &y.A2)
+	c.A4.Add(&c.A4, &t)
+	t.Mul(&x.A3, &y.A1)
+	c.A4.Add(&c.A4, &t)
+	t.Mul(&x.A4, &y.A0)
+	c.A4.Add(&c.A4, &t)
+
+	*z = c
+	return z
+}
+
+// Square sets z = x * x and returns z
+func (z *E5) Square(x *E5) *E5 {
+	return z.Mul(x, x)
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E5) Frobenius(x *E5) *E5 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &e5Frobenius[1])
+	z.A2.Mul(&x.A2, &e5Frobenius[2])
+	z.A3.Mul(&x.A3, &e5Frobenius[3])
+	z.A4.Mul(&x.A4, &e5Frobenius[4])
+	return z
+}
+
+// Norm returns the norm of z, that is the product of its conjugates z * zᵖ * ... * z^(p⁴)
+func (z *E5) Norm() Element {
+	var c, n E5
+	c.conjugatesProduct(z)
+	n.Mul(&c, z)
+	return n.A0
+}
+
+// conjugatesProduct sets z = xᵖ * ... * x^(p⁴), such that x * z = Norm(x)
+func (z *E5) conjugatesProduct(x *E5) *E5 {
+	var c, f E5
+	f.Frobenius(x)
+	c.Set(&f)
+	for i := 2; i < 5; i++ {
+		f.Frobenius(&f)
+		c.Mul(&c, &f)
+	}
+	return z.Set(&c)
+}
+
+// Inverse sets z = x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E5) Inverse(x *E5) *E5 {
+	// x⁻¹ = (xᵖ * ... * x^(p⁴)) / Norm(x)
+	var c, n E5
+	c.conjugatesProduct(x)
+	n.Mul(&c, x)
+	n.A0.Inverse(&n.A0)
+	return z.MulByElement(&c, &n.A0)
+}
+
+// Div sets z = x / y and returns z
+func (z *E5) Div(x, y *E5) *E5 {
+	var r E5
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// BatchInvertE5 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE5(a []E5) []E5 {
+	res := make([]E5, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E5
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Exp sets z=xᵏ (mod p⁵) and returns it
+func (z *E5) Exp(x E5, k *big.Int) *E5 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod p⁵) == (x⁻¹)ᵏ (mod p⁵)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E5 if and only if its norm is a square in Element
+func (z *E5) Legendre() int {
+	n := z.Norm()
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E5
+// if the square root doesn't exist (x is not a square)
+// Sqrt leaves z unchanged and returns nil
+func (z *E5) Sqrt(x *E5) *E5 {
+	if x.Legendre() == -1 {
+		return nil
+	}
+
+	// Tonelli-Shanks
+	// see modSqrtTonelliShanks in math/big/int.go
+	var y, b, t, w E5
+	// w = x^((s-1)/2))
+	w.Exp(*x, &e5SqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	g := e5SqrtG
+	r := uint64(27)
+
+	if b.IsZero() {
+		return z.SetZero()
+	}
+
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E5) Select(c int, x0 *E5, x1 *E5) *E5 {
+	z.A0.Select(c, &x0.A0, &x1.A0)
+	z.A1.Select(c, &x0.A1, &x1.A1)
+	z.A2.Select(c, &x0.A2, &x1.A2)
+	z.A3.Select(c, &x0.A3, &x1.A3)
+	z.A4.Select(c, &x0.A4, &x1.A4)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E5) String() string {
+	var sbb strings.Builder
+	sbb.WriteString(z.A0.String())
+	sbb.WriteString("+" + z.A1.String() + "*u")
+	sbb.WriteString("+" + z.A2.String() + "*u²")
+	sbb.WriteString("+" + z.A3.String() + "*u³")
+	sbb.WriteString("+" + z.A4.String() + "*u⁴")
+	return sbb.String()
+}
+
+// Bytes returns the big-endian encoding of the coordinates of z, A0 first
+func (z *E5) Bytes() (res [BytesE5]byte) {
+	BigEndian.PutElement((*[Bytes]byte)(res[0*Bytes:1*Bytes]), z.A0)
+	BigEndian.PutElement((*[Bytes]byte)(res[1*Bytes:2*Bytes]), z.A1)
+	BigEndian.PutElement((*[Bytes]byte)(res[2*Bytes:3*Bytes]), z.A2)
+	BigEndian.PutElement((*[Bytes]byte)(res[3*Bytes:4*Bytes]), z.A3)
+	BigEndian.PutElement((*[Bytes]byte)(res[4*Bytes:5*Bytes]), z.A4)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *E5) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes.
+// It returns an error if e has the wrong length or a coordinate is not canonical.
+func (z *E5) SetBytesCanonical(e []byte) error {
+	if len(e) != BytesE5 {
+		return errors.New("invalid babybear.E5 encoding")
+	}
+	var r E5
+	if err := r.A0.SetBytesCanonical(e[0*Bytes : 1*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A1.SetBytesCanonical(e[1*Bytes : 2*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A2.SetBytesCanonical(e[2*Bytes : 3*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A3.SetBytesCanonical(e[3*Bytes : 4*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A4.SetBytesCanonical(e[4*Bytes : 5*Bytes]); err != nil {
+		return err
+	}
+	*z = r
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (z *E5) MarshalBinary() ([]byte, error) {
+	return z.Marshal(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (z *E5) UnmarshalBinary(data []byte) error {
+	return z.SetBytesCanonical(data)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x78000001"
+	babybear, err := config.NewFieldConfig32("babybear", "Element", modulus)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(babybear, "../"); err != nil {
+		panic(err)
+	}
+	e4, err := config.NewExtension(babybear, 4, 11)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateExtension(babybear, e4, "../"); err != nil {
+		panic(err)
+	}
+	e5, err := config.NewExtension(babybear, 5, 2)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateExtension(babybear, e5, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated babybear field")
+}
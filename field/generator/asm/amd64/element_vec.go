@@ -0,0 +1,1109 @@
+// Copyright 2026 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/bavard/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// The vector operations are generated for any number of words (see FieldConfig.ASMVector):
+//   - addVec and subVec use the general purpose registers;
+//   - sumVec and sumVecAVX2 accumulate the 32 bit halves of the words with AVX-512 and AVX2;
+//   - mulVec and scalarMulVec use AVX-512 IFMA, with 8 elements at a time and limbs of 52 bits;
+//   - mulVecAVX2 and scalarMulVecAVX2 use AVX2, with 4 elements at a time and limbs of 29 bits.
+//
+// The generated Go code picks the variant supported by the CPU.
+
+// GenerateVec generates the assembly of the Vector arithmetic
+func GenerateVec(w io.Writer, F *config.FieldConfig) error {
+	f := NewFFAmd64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	f.GenerateDefines()
+	f.generateVecDefines()
+
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateSumVec(avx512)
+	f.generateSumVec(avx2)
+	f.generateMulVecIFMA(false)
+	f.generateMulVecIFMA(true)
+	if F.ASMVectorAVX2Mul {
+		f.generateMulVecAVX2(false)
+		f.generateMulVecAVX2(true)
+	}
+
+	return nil
+}
+
+// simd describes the registers and the instructions of AVX2 or AVX-512 used by the vector operations
+type simd struct {
+	name              string
+	lanes             int    // number of 64 bit lanes of a register
+	nbRegisters       int    // number of registers
+	reg               string // register prefix
+	mov, and, or, xor string
+	suffix            string // suffix of the functions names
+}
+
+var (
+	avx2   = simd{name: "AVX2", lanes: 4, nbRegisters: 16, reg: "Y", mov: "VMOVDQU", and: "VPAND", or: "VPOR", xor: "VPXOR", suffix: "AVX2"}
+	avx512 = simd{name: "AVX-512", lanes: 8, nbRegisters: 32, reg: "Z", mov: "VMOVDQU64", and: "VPANDQ", or: "VPORQ", xor: "VPXORQ"}
+)
+
+// registers returns the registers [from, from+nb)
+func (s simd) registers(from, nb int) []string {
+	r := make([]string, nb)
+	for i := range r {
+		r[i] = fmt.Sprintf("%s%d", s.reg, from+i)
+	}
+	return r
+}
+
+// size returns the size of a register in bytes
+func (s simd) size() int {
+	return s.lanes * 8
+}
+
+// frameSlots returns the operands of nb consecutive slots of size bytes in a stack frame of frameSize bytes,
+// starting at offset from the bottom of the frame; they are addressed from the pseudo register SP.
+func frameSlots(name string, frameSize, offset, nb, size int) []string {
+	slots := make([]string, nb)
+	for i := range slots {
+		slots[i] = fmt.Sprintf("%s%d-%d(SP)", name, i, frameSize-offset-i*size)
+	}
+	return slots
+}
+
+// limbsParams returns the number of limbs of limbSize bits of an element, and the shift such that
+// limbSize * nbLimbs = 64 * NbWords + shift
+func (f *FFAmd64) limbsParams(limbSize int) (nbLimbs, shift int) {
+	nbLimbs = (64*f.NbWords + limbSize - 1) / limbSize
+	shift = limbSize*nbLimbs - 64*f.NbWords
+	return
+}
+
+// toLimbs splits the words (one element per lane) in limbs of limbSize bits, after shifting them by
+// shift bits to the left; store is called with the register holding the i-th limb.
+func (f *FFAmd64) toLimbs(s simd, words []string, limbSize, shift int, mask, tmp, dst string, store func(i int)) {
+	nbLimbs := (64*len(words) + shift + limbSize - 1) / limbSize
+	for i := 0; i < nbLimbs; i++ {
+		// bits [limbSize*i - shift, limbSize*(i+1) - shift) of the words
+		lo := limbSize*i - shift
+		w, o := 0, lo
+		if lo >= 0 {
+			w, o = lo/64, lo%64
+		}
+		switch {
+		case o < 0:
+			f.WriteLn(fmt.Sprintf("VPSLLQ $%d, %s, %s", -o, words[w], dst))
+		case o == 0:
+			f.WriteLn(fmt.Sprintf("%s %s, %s", s.mov, words[w], dst))
+		default:
+			f.WriteLn(fmt.Sprintf("VPSRLQ $%d, %s, %s", o, words[w], dst))
+		}
+		if o > 64-limbSize && w < len(words)-1 {
+			// the limb spans 2 words
+			f.WriteLn(fmt.Sprintf("VPSLLQ $%d, %s, %s", 64-o, words[w+1], tmp))
+			f.WriteLn(fmt.Sprintf("%s %s, %s, %s", s.or, tmp, dst, dst))
+		}
+		if i != nbLimbs-1 {
+			// the last limb ends with the last word
+			f.WriteLn(fmt.Sprintf("%s %s, %s, %s", s.and, mask, dst, dst))
+		}
+		store(i)
+	}
+}
+
+// fromLimbs sets dst to the j-th word of the normalized limbs of limbSize bits; limb returns the
+// operand of the i-th limb.
+func (f *FFAmd64) fromLimbs(s simd, limb func(i int) string, nbLimbs, limbSize, j int, dst, tmp string) {
+	// bits [64j, 64j + 64) of the result
+	first := 64 * j / limbSize
+	last := (64*j + 63) / limbSize
+	if last >= nbLimbs {
+		last = nbLimbs - 1
+	}
+	for i := first; i <= last; i++ {
+		o := limbSize*i - 64*j
+		l := limb(i)
+		switch {
+		case i == first && o == 0:
+			f.WriteLn(fmt.Sprintf("%s %s, %s", s.mov, l, dst))
+		case i == first:
+			f.WriteLn(fmt.Sprintf("VPSRLQ $%d, %s, %s", -o, l, dst))
+		default:
+			f.WriteLn(fmt.Sprintf("VPSLLQ $%d, %s, %s", o, l, tmp))
+			f.WriteLn(fmt.Sprintf("%s %s, %s, %s", s.or, tmp, dst, dst))
+		}
+	}
+}
+
+// generateAddVec generates
+//
+//	func addVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] + b[i] for i < n
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	// the sum is reduced in registers with the REDUCE macro if it can't overflow and fits
+	if !f.NoCarry {
+		if 5+2*f.NbWords <= amd64.NbRegisters {
+			f.generateAddVecWithCarry()
+		} else {
+			f.generateAddVecFromMemory()
+		}
+		return
+	}
+	if 4+2*f.NbWords > amd64.NbRegisters {
+		f.generateAddVecFromMemory()
+		return
+	}
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(4+f.NbWords*2, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	t := f.PopN(&registers)
+	scratch := f.PopN(&registers)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("a[i] + b[i]")
+	f.Mov(a, t)
+	f.Add(b, t)
+
+	f.ReduceElement(t, scratch)
+
+	f.Comment("res[i] = a[i] + b[i]")
+	f.Mov(t, res)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(f.NbWords*8, res)
+	f.ADDQ(f.NbWords*8, a)
+	f.ADDQ(f.NbWords*8, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, scratch...)
+	f.Push(&registers, t...)
+	f.Push(&registers, res, a, b, n)
+}
+
+// generateAddVecWithCarry generates addVec for the moduli whose sum can overflow:
+// the carry of the sum is kept to select the result.
+func (f *FFAmd64) generateAddVecWithCarry() {
+	const argSize = 4 * 8
+	registers := f.FnHeader("addVec", 0, argSize)
+
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	c := f.Pop(&registers)
+	t := f.PopN(&registers)
+	u := f.PopN(&registers)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("t = a[i] + b[i]")
+	f.Mov(a, t)
+	f.Add(b, t)
+	f.MOVQ(0, c)
+	f.ADCQ(0, c, "c = carry")
+
+	f.Comment("u = t - q")
+	f.Mov(t, u)
+	for i := 0; i < f.NbWords; i++ {
+		if i == 0 {
+			f.SUBQ(f.qAt(i), u[i])
+		} else {
+			f.SBBQ(f.qAt(i), u[i])
+		}
+	}
+	f.SBBQ(0, c, "borrow iff carry < borrow, i.e. t < q")
+
+	f.Comment("res[i] = t if t < q, u otherwise")
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQCS(t[i], u[i])
+	}
+	f.Mov(u, res)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(f.NbWords*8, res)
+	f.ADDQ(f.NbWords*8, a)
+	f.ADDQ(f.NbWords*8, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, u...)
+	f.Push(&registers, t...)
+	f.Push(&registers, res, a, b, n, c)
+}
+
+// generateAddVecFromMemory generates addVec for the moduli with too many words to hold
+// the sum in registers: the words are processed one at a time, from memory.
+func (f *FFAmd64) generateAddVecFromMemory() {
+	const argSize = 4 * 8
+	stackSize := f.NbWords * 8
+	registers := f.FnHeader("addVec", stackSize, argSize)
+
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	x := f.Pop(&registers)
+	c := f.Pop(&registers)
+	t := frameSlots("t", stackSize, 0, f.NbWords, 8)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("t = a[i] + b[i], the MOVQ don't change the carry flag")
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(a.At(i), x)
+		if i == 0 {
+			f.ADDQ(b.At(i), x)
+		} else {
+			f.ADCQ(b.At(i), x)
+		}
+		f.MOVQ(x, t[i])
+	}
+	if !f.NoCarry {
+		f.MOVQ(0, c)
+		f.ADCQ(0, c, "c = carry")
+	}
+
+	f.Comment("res[i] = t - q")
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(t[i], x)
+		if i == 0 {
+			f.SUBQ(f.qAt(i), x)
+		} else {
+			f.SBBQ(f.qAt(i), x)
+		}
+		f.MOVQ(x, res.At(i))
+	}
+	if !f.NoCarry {
+		f.SBBQ(0, c, "borrow iff carry < borrow, i.e. t < q")
+	}
+
+	f.Comment("res[i] = t if t < q")
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(res.At(i), x)
+		f.CMOVQCS(t[i], x)
+		f.MOVQ(x, res.At(i))
+	}
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(f.NbWords*8, res)
+	f.ADDQ(f.NbWords*8, a)
+	f.ADDQ(f.NbWords*8, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, res, a, b, n, x, c)
+}
+
+// generateSubVec generates
+//
+//	func subVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] - b[i] for i < n
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	// the difference and q are kept in registers if they fit
+	if 5+2*f.NbWords > amd64.NbRegisters {
+		f.generateSubVecFromMemory()
+		return
+	}
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(5+f.NbWords*2, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	zero := f.Pop(&registers)
+	t := f.PopN(&registers)
+	q := f.PopN(&registers)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+	f.XORQ(zero, zero)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("a[i] - b[i]")
+	f.Mov(a, t)
+	f.Sub(b, t)
+
+	f.Comment("reduce (a[i] - b[i])")
+	f.Mov(f.Q, q)
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQCC(zero, q[i])
+	}
+	f.Comment("add registers (q or 0) to t, and set to result")
+	f.Add(q, t)
+
+	f.Comment("res[i] = a[i] - b[i]")
+	f.Mov(t, res)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(f.NbWords*8, res)
+	f.ADDQ(f.NbWords*8, a)
+	f.ADDQ(f.NbWords*8, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, q...)
+	f.Push(&registers, t...)
+	f.Push(&registers, res, a, b, n, zero)
+}
+
+// generateSubVecFromMemory generates subVec for the moduli with too many words to hold
+// the difference and q in registers: the words are processed one at a time, from memory.
+func (f *FFAmd64) generateSubVecFromMemory() {
+	const argSize = 4 * 8
+	stackSize := f.NbWords * 8
+	registers := f.FnHeader("subVec", stackSize, argSize)
+
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	x := f.Pop(&registers)
+	mask := f.Pop(&registers)
+	q := frameSlots("q", stackSize, 0, f.NbWords, 8)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("res[i] = a[i] - b[i], the MOVQ don't change the carry flag")
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(a.At(i), x)
+		if i == 0 {
+			f.SUBQ(b.At(i), x)
+		} else {
+			f.SBBQ(b.At(i), x)
+		}
+		f.MOVQ(x, res.At(i))
+	}
+	f.SBBQ(mask, mask, "mask = -borrow")
+
+	f.Comment("res[i] += q if a[i] < b[i]")
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(f.qAt(i), x)
+		f.ANDQ(mask, x)
+		f.MOVQ(x, q[i])
+	}
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVQ(q[i], x)
+		if i == 0 {
+			f.ADDQ(x, res.At(i))
+		} else {
+			f.ADCQ(x, res.At(i))
+		}
+	}
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(f.NbWords*8, res)
+	f.ADDQ(f.NbWords*8, a)
+	f.ADDQ(f.NbWords*8, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, res, a, b, n, x, mask)
+}
+
+// sumVecBlock returns the number of elements summed by an iteration of sumVec, such that they
+// fill a whole number of registers.
+func (f *FFAmd64) sumVecBlock(s simd) int {
+	g := s.lanes
+	for f.NbWords%g != 0 {
+		g /= 2
+	}
+	return s.lanes / g
+}
+
+// generateSumVec generates
+//
+//	func sumVec(res *[2*NbWords]uint64, a *Element, n uint64)
+//
+// with AVX-512 instructions, or sumVecAVX2 with AVX2 instructions; n must be a multiple of
+// the number of elements processed by an iteration (see sumVecBlock).
+//
+// The 64 bit words of the elements are split in 32 bit halves, which are accumulated
+// without reduction in 64 bit lanes. Then res[j] is set to the sum of the low halves
+// of the j-th words and res[NbWords+j] to the sum of their high halves.
+// The caller is responsible for reducing the result; with n < 2³¹ the sums can't overflow.
+func (f *FFAmd64) generateSumVec(s simd) {
+	fnName := "sumVec" + s.suffix
+	f.Comment(fmt.Sprintf("%s(res *[%d]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with %s", fnName, 2*f.NbWords, s.name))
+
+	block := f.sumVecBlock(s)
+	nbAcc := block * f.NbWords / s.lanes
+
+	// the accumulators are spilled to the stack if there are not enough registers;
+	// the stack is also used to sum their lanes at the end.
+	const argSize = 3 * 8
+	stackSize := 2 * nbAcc * s.size()
+	registers := f.FnHeader(fnName, stackSize, argSize)
+
+	a := f.Pop(&registers)
+	n := f.Pop(&registers)
+	r := f.Pop(&registers)
+	x := f.Pop(&registers)
+
+	slots := frameSlots("acc", stackSize, 0, 2*nbAcc, s.size())
+	v := s.registers(0, 3)
+	mask32, y, yLo := v[0], v[1], v[2]
+	inRegisters := 3+2*nbAcc <= s.nbRegisters
+	acc := slots
+	if inRegisters {
+		acc = s.registers(3, 2*nbAcc)
+	}
+	accLo, accHi := acc[:nbAcc], acc[nbAcc:]
+
+	// addTo adds the register y to the accumulator at, y is modified
+	addTo := func(at, y string) {
+		if inRegisters {
+			f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", y, at))
+		} else {
+			f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", at, y))
+			f.WriteLn(fmt.Sprintf("%s %s, %s", s.mov, y, at))
+		}
+	}
+
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("n+16(FP)", n)
+	if block > 1 {
+		f.SHRQ(fmt.Sprintf("$%d", log2(block)), n, fmt.Sprintf("we load %d elements at a time", block))
+	}
+
+	f.MOVQ(uint64(0xffffffff), r)
+	f.broadcast(s, r, mask32)
+	f.WriteLn(fmt.Sprintf("%[1]s %[2]s, %[2]s, %[2]s", s.xor, y))
+	for i := range acc {
+		if inRegisters {
+			f.WriteLn(fmt.Sprintf("%[1]s %[2]s, %[2]s, %[2]s", s.xor, acc[i]))
+		} else {
+			f.WriteLn(fmt.Sprintf("%s %s, %s", s.mov, y, acc[i]))
+		}
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	for i := 0; i < nbAcc; i++ {
+		f.WriteLn(fmt.Sprintf("%s %d(%s), %s", s.mov, i*s.size(), a, y))
+		f.WriteLn(fmt.Sprintf("%s %s, %s, %s", s.and, mask32, y, yLo))
+		addTo(accLo[i], yLo)
+		f.WriteLn(fmt.Sprintf("VPSRLQ $32, %[1]s, %[1]s", y))
+		addTo(accHi[i], y)
+	}
+
+	f.Comment("increment pointers to visit next elements")
+	f.ADDQ(block*f.NbWords*8, a)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	if inRegisters {
+		for i := range acc {
+			f.WriteLn(fmt.Sprintf("%s %s, %s", s.mov, acc[i], slots[i]))
+		}
+	}
+
+	f.Comment("sum the lanes holding the same words")
+	lanes := frameSlots("lane", stackSize, 0, 2*nbAcc*s.lanes, 8)
+	f.MOVQ("res+0(FP)", r)
+	for half := 0; half < 2; half++ {
+		for j := 0; j < f.NbWords; j++ {
+			// the lane i of the low (resp. high) accumulators holds the words i mod NbWords
+			first := true
+			for i := j; i < nbAcc*s.lanes; i += f.NbWords {
+				l := lanes[half*nbAcc*s.lanes+i]
+				if first {
+					f.MOVQ(l, x)
+					first = false
+				} else {
+					f.ADDQ(l, x)
+				}
+			}
+			f.MOVQ(x, r.At(half*f.NbWords+j))
+		}
+	}
+	f.WriteLn("VZEROUPPER")
+	f.RET()
+
+	f.Push(&registers, a, n, r, x)
+}
+
+// broadcast sets the lanes of the vector register dst to the general purpose register r
+func (f *FFAmd64) broadcast(s simd, r amd64.Register, dst string) {
+	if s.lanes == avx512.lanes {
+		f.WriteLn(fmt.Sprintf("VPBROADCASTQ %s, %s", r, dst))
+		return
+	}
+	// AVX2 can only broadcast from a xmm register or memory
+	x := "X" + dst[1:]
+	f.MOVQ(r, x)
+	f.WriteLn(fmt.Sprintf("VPBROADCASTQ %s, %s", x, dst))
+}
+
+// generateMulVecIFMA generates
+//
+//	func mulVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b[i] for i < 8*n, or if scalarMul is set,
+//
+//	func scalarMulVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b for i < 8*n.
+//
+// It uses AVX-512 IFMA instructions and processes 8 elements at a time, one per 64 bit lane.
+// The elements are converted to k limbs of 52 bits and multiplied using the almost Montgomery
+// multiplication with a radix 2⁵² (see "Software Implementation of Modular Exponentiation,
+// Using Advanced Vector Instructions Architectures", Gueron, Krasnov).
+// The Montgomery constant is then 2^(52k) instead of r = 2^(64*NbWords): the difference is absorbed
+// by shifting b to the left when converting it, such that the result is in Montgomery form with r.
+// As a, b < q and 2q < 2^(52k), the result is < 2q and needs a single final subtraction.
+// The limbs of a and b are stored on the stack; the result is accumulated in registers.
+func (f *FFAmd64) generateMulVecIFMA(scalarMul bool) {
+	const limbSize = 52
+	k, shift := f.limbsParams(limbSize)
+	if k+1+6 > avx512.nbRegisters {
+		panic("mulVec: too many words")
+	}
+	fnName := "mulVec"
+	if scalarMul {
+		fnName = "scalarMulVec"
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]")
+	}
+
+	const argSize = 4 * 8
+	s := avx512
+	stackSize := 2 * k * s.size()
+	registers := f.FnHeader(fnName, stackSize, argSize)
+
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	r := f.Pop(&registers)
+
+	aLimbs := frameSlots("a", stackSize, 0, k, s.size())
+	bLimbs := frameSlots("b", stackSize, k*s.size(), k, s.size())
+	qLimb := func(j int) string {
+		return fmt.Sprintf("qLimbs52<>+%d(SB)", j*8)
+	}
+
+	z := s.registers(0, k+7)
+	t, z := z[:k+1], z[k+1:]
+	bi, m, tmp, qInv, mask, index := z[0], z[1], z[2], z[3], z[4], z[5]
+	// the words are loaded in the registers of t, which are not used at that time
+	words := t[:f.NbWords]
+
+	// constants
+	f.MOVQ(f.qInvNeg(limbSize), r)
+	f.broadcast(s, r, qInv)
+	f.MOVQ(uint64(1)<<limbSize-1, r)
+	f.broadcast(s, r, mask)
+	f.WriteLn(fmt.Sprintf("VMOVDQU64 %s, %s", "indexGatherScatter<>(SB)", index))
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	// gather loads the words of 8 consecutive elements, words[j] holding the j-th words.
+	gather := func(src amd64.Register) {
+		for j := range words {
+			f.WriteLn("KXNORW K0, K0, K1")
+			f.WriteLn(fmt.Sprintf("VPGATHERQQ %d(%s)(%s*8), K1, %s", j*8, src, index, words[j]))
+		}
+	}
+	storeTo := func(limbs []string) func(i int) {
+		return func(i int) {
+			f.WriteLn(fmt.Sprintf("VMOVDQU64 %s, %s", tmp, limbs[i]))
+		}
+	}
+
+	if scalarMul {
+		f.Comment("broadcast b and convert it to 52 bits limbs")
+		for j := range words {
+			f.WriteLn(fmt.Sprintf("VPBROADCASTQ %d(%s), %s", j*8, b, words[j]))
+		}
+		f.toLimbs(s, words, limbSize, shift, mask, bi, tmp, storeTo(bLimbs))
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("load 8 elements of a and convert them to 52 bits limbs")
+	gather(a)
+	f.toLimbs(s, words, limbSize, 0, mask, bi, tmp, storeTo(aLimbs))
+
+	if !scalarMul {
+		f.Comment(fmt.Sprintf("load 8 elements of b and convert them to 52 bits limbs, shifted by %d bits", shift))
+		gather(b)
+		f.toLimbs(s, words, limbSize, shift, mask, bi, tmp, storeTo(bLimbs))
+	}
+
+	for i := range t {
+		f.WriteLn(fmt.Sprintf("VPXORQ %[1]s, %[1]s, %[1]s", t[i]))
+	}
+
+	for i := 0; i < k; i++ {
+		f.Comment(fmt.Sprintf("t += a * b[%d]", i))
+		f.WriteLn(fmt.Sprintf("VMOVDQU64 %s, %s", bLimbs[i], bi))
+		for j := 0; j < k; j++ {
+			f.WriteLn(fmt.Sprintf("VPMADD52LUQ %s, %s, %s", aLimbs[j], bi, t[j]))
+			f.WriteLn(fmt.Sprintf("VPMADD52HUQ %s, %s, %s", aLimbs[j], bi, t[j+1]))
+		}
+
+		f.Comment("m = t[0] * qInv mod 2⁵²")
+		f.WriteLn(fmt.Sprintf("VPXORQ %[1]s, %[1]s, %[1]s", m))
+		f.WriteLn(fmt.Sprintf("VPMADD52LUQ %s, %s, %s", qInv, t[0], m))
+
+		f.Comment("t += m * q")
+		for j := 0; j < k; j++ {
+			f.WriteLn(fmt.Sprintf("VPMADD52LUQ.BCST %s, %s, %s", qLimb(j), m, t[j]))
+			f.WriteLn(fmt.Sprintf("VPMADD52HUQ.BCST %s, %s, %s", qLimb(j), m, t[j+1]))
+		}
+
+		f.Comment("t >>= 52")
+		f.WriteLn(fmt.Sprintf("VPSRLQ $52, %s, %s", t[0], tmp))
+		f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", tmp, t[1]))
+		f.WriteLn(fmt.Sprintf("VPXORQ %[1]s, %[1]s, %[1]s", t[0]))
+		t = append(append([]string{}, t[1:]...), t[0])
+	}
+
+	f.Comment("propagate the carries")
+	for j := 0; j < k-1; j++ {
+		f.WriteLn(fmt.Sprintf("VPSRLQ $52, %s, %s", t[j], tmp))
+		f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", tmp, t[j+1]))
+		f.WriteLn(fmt.Sprintf("VPANDQ %[1]s, %[2]s, %[2]s", mask, t[j]))
+	}
+
+	f.Comment("t < 2q, compute u = t - q and keep t if u is negative")
+	u := bi
+	for j := 0; j < k; j++ {
+		f.WriteLn(fmt.Sprintf("VPSUBQ.BCST %s, %s, %s", qLimb(j), t[j], u))
+		if j != 0 {
+			f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", tmp, u))
+		}
+		if j != k-1 {
+			f.WriteLn(fmt.Sprintf("VPSRAQ $52, %s, %s", u, tmp))
+			f.WriteLn(fmt.Sprintf("VPANDQ %[1]s, %[2]s, %[2]s", mask, u))
+		}
+		f.WriteLn(fmt.Sprintf("VMOVDQU64 %s, %s", u, aLimbs[j]))
+	}
+	f.WriteLn(fmt.Sprintf("VPMOVQ2M %s, K2", u))
+	for j := 0; j < k; j++ {
+		f.WriteLn(fmt.Sprintf("VMOVDQU64 %s, K2, %s", t[j], aLimbs[j]))
+	}
+
+	f.Comment("convert the 52 bits limbs back to words and store the 8 results")
+	words = t[:f.NbWords]
+	for j := range words {
+		f.fromLimbs(s, func(i int) string { return aLimbs[i] }, k, limbSize, j, words[j], tmp)
+	}
+	for j := range words {
+		f.WriteLn("KXNORW K0, K0, K1")
+		f.WriteLn(fmt.Sprintf("VPSCATTERQQ %s, K1, %d(%s)(%s*8)", words[j], j*8, res, index))
+	}
+
+	f.Comment("increment pointers to visit next elements")
+	f.ADDQ(f.NbWords*8*8, res)
+	f.ADDQ(f.NbWords*8*8, a)
+	if !scalarMul {
+		f.ADDQ(f.NbWords*8*8, b)
+	}
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.WriteLn("VZEROUPPER")
+	f.RET()
+
+	f.Push(&registers, res, a, b, n, r)
+}
+
+// generateMulVecAVX2 generates
+//
+//	func mulVecAVX2(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b[i] for i < 4*n, or if scalarMul is set,
+//
+//	func scalarMulVecAVX2(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b for i < 4*n.
+//
+// It uses AVX2 instructions and processes 4 elements at a time, one per 64 bit lane.
+// AVX2 only multiplies 32 bit integers, so the elements are converted to k limbs of 29 bits,
+// and the Montgomery multiplication accumulates the 58 bit products without propagating
+// the carries: a lane holds at most 2k products, which is less than 2⁶⁴ for k < 32.
+// As in mulVec, the Montgomery constant is 2^(29k), and b is shifted when converting it;
+// the result is < 2q and needs a single final subtraction.
+// The limbs and the accumulator are stored on the stack.
+func (f *FFAmd64) generateMulVecAVX2(scalarMul bool) {
+	const limbSize = 29
+	k, shift := f.limbsParams(limbSize)
+	if k >= 32 || f.NbWords+3 > avx2.nbRegisters {
+		panic("mulVecAVX2: too many words")
+	}
+	fnName := "mulVecAVX2"
+	if scalarMul {
+		fnName = "scalarMulVecAVX2"
+		f.Comment("scalarMulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b")
+	} else {
+		f.Comment("mulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b[0...4n]")
+	}
+
+	const argSize = 4 * 8
+	s := avx2
+	stackSize := 3 * k * s.size()
+	registers := f.FnHeader(fnName, stackSize, argSize)
+
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	r := f.Pop(&registers)
+
+	aLimbs := frameSlots("a", stackSize, 0, k, s.size())
+	bLimbs := frameSlots("b", stackSize, k*s.size(), k, s.size())
+	tLimbs := frameSlots("t", stackSize, 2*k*s.size(), k, s.size())
+	qLimb := func(j int) string {
+		return fmt.Sprintf("qLimbs29<>+%d(SB)", j*s.size())
+	}
+
+	y := s.registers(0, s.nbRegisters)
+	mask, tmp, dst := y[0], y[1], y[2]
+	bi, m, x, c := y[3], y[4], y[5], y[6]
+	// the words are only used by the conversions, they may share the registers of the multiplication
+	words := y[s.nbRegisters-f.NbWords:]
+	xmm := func(r string) string {
+		return "X" + r[1:]
+	}
+
+	// constants
+	f.MOVQ(uint64(1)<<limbSize-1, r)
+	f.broadcast(s, r, mask)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	// load sets words[j] to the j-th words of 4 consecutive elements
+	load := func(src amd64.Register) {
+		for j := range words {
+			w, t := xmm(words[j]), xmm(tmp)
+			f.WriteLn(fmt.Sprintf("VMOVQ %d(%s), %s", j*8, src, w))
+			f.WriteLn(fmt.Sprintf("VPINSRQ $1, %d(%s), %s, %s", (f.NbWords+j)*8, src, w, w))
+			f.WriteLn(fmt.Sprintf("VMOVQ %d(%s), %s", (2*f.NbWords+j)*8, src, t))
+			f.WriteLn(fmt.Sprintf("VPINSRQ $1, %d(%s), %s, %s", (3*f.NbWords+j)*8, src, t, t))
+			f.WriteLn(fmt.Sprintf("VINSERTI128 $1, %s, %s, %s", t, words[j], words[j]))
+		}
+	}
+	storeTo := func(limbs []string) func(i int) {
+		return func(i int) {
+			f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", dst, limbs[i]))
+		}
+	}
+
+	if scalarMul {
+		f.Comment("broadcast b and convert it to 29 bits limbs")
+		for j := range words {
+			f.WriteLn(fmt.Sprintf("VPBROADCASTQ %d(%s), %s", j*8, b, words[j]))
+		}
+		f.toLimbs(s, words, limbSize, shift, mask, tmp, dst, storeTo(bLimbs))
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("load 4 elements of a and convert them to 29 bits limbs")
+	load(a)
+	f.toLimbs(s, words, limbSize, 0, mask, tmp, dst, storeTo(aLimbs))
+
+	if !scalarMul {
+		f.Comment(fmt.Sprintf("load 4 elements of b and convert them to 29 bits limbs, shifted by %d bits", shift))
+		load(b)
+		f.toLimbs(s, words, limbSize, shift, mask, tmp, dst, storeTo(bLimbs))
+	}
+
+	// t[k-1] is always 0 at the start of an iteration, and t is 0 before the first one
+	for i := 0; i < k; i++ {
+		f.Comment(fmt.Sprintf("t = (t + a * b[%d] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹", i))
+		f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", bLimbs[i], bi))
+		f.WriteLn(fmt.Sprintf("VPMULUDQ %s, %s, %s", aLimbs[0], bi, x))
+		if i != 0 {
+			f.WriteLn(fmt.Sprintf("VPADDQ %s, %s, %s", tLimbs[0], x, x))
+		}
+		f.WriteLn(fmt.Sprintf("VPMULUDQ %s, %s, %s", "qInvNeg29<>(SB)", x, m))
+		f.WriteLn(fmt.Sprintf("VPAND %[1]s, %[2]s, %[2]s", mask, m))
+		f.WriteLn(fmt.Sprintf("VPMULUDQ %s, %s, %s", qLimb(0), m, tmp))
+		f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", tmp, x))
+		f.WriteLn(fmt.Sprintf("VPSRLQ $29, %s, %s", x, c))
+		for j := 1; j < k; j++ {
+			f.WriteLn(fmt.Sprintf("VPMULUDQ %s, %s, %s", aLimbs[j], bi, x))
+			if i != 0 && j != k-1 {
+				f.WriteLn(fmt.Sprintf("VPADDQ %s, %s, %s", tLimbs[j], x, x))
+			}
+			f.WriteLn(fmt.Sprintf("VPMULUDQ %s, %s, %s", qLimb(j), m, tmp))
+			f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", tmp, x))
+			if j == 1 {
+				f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", c, x))
+			}
+			f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", x, tLimbs[j-1]))
+		}
+		if k == 1 {
+			f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", c, tLimbs[0]))
+		}
+	}
+
+	f.Comment("propagate the carries, and compute u = t - q in a")
+	// c is the carry of t, and m the borrow of u
+	for j := 0; j < k; j++ {
+		if j != k-1 {
+			f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", tLimbs[j], x))
+			if j != 0 {
+				f.WriteLn(fmt.Sprintf("VPADDQ %[1]s, %[2]s, %[2]s", c, x))
+			}
+			f.WriteLn(fmt.Sprintf("VPSRLQ $29, %s, %s", x, c))
+			f.WriteLn(fmt.Sprintf("VPAND %[1]s, %[2]s, %[2]s", mask, x))
+		} else {
+			// t < 2q < 2^(29k), the last limb is the last carry
+			x = c
+		}
+		f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", x, tLimbs[j]))
+		f.WriteLn(fmt.Sprintf("VPSUBQ %s, %s, %s", qLimb(j), x, dst))
+		if j != 0 {
+			f.WriteLn(fmt.Sprintf("VPSUBQ %[1]s, %[2]s, %[2]s", m, dst))
+		}
+		if j != k-1 {
+			f.WriteLn(fmt.Sprintf("VPSRLQ $63, %s, %s", dst, m))
+			f.WriteLn(fmt.Sprintf("VPAND %[1]s, %[2]s, %[2]s", mask, dst))
+		}
+		f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", dst, aLimbs[j]))
+	}
+	x = y[5]
+
+	f.Comment("keep t if u is negative")
+	zero := bi
+	f.WriteLn(fmt.Sprintf("VPXOR %[1]s, %[1]s, %[1]s", zero))
+	f.WriteLn(fmt.Sprintf("VPCMPGTQ %s, %s, %s", dst, zero, m))
+	for j := 0; j < k; j++ {
+		f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", aLimbs[j], dst))
+		f.WriteLn(fmt.Sprintf("VPBLENDVB %s, %s, %s, %s", m, tLimbs[j], dst, dst))
+		f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", dst, aLimbs[j]))
+	}
+
+	f.Comment("convert the 29 bits limbs back to words and store the 4 results")
+	for j := 0; j < f.NbWords; j++ {
+		f.fromLimbs(s, func(i int) string {
+			f.WriteLn(fmt.Sprintf("VMOVDQU %s, %s", aLimbs[i], x))
+			return x
+		}, k, limbSize, j, dst, tmp)
+		d, t := xmm(dst), xmm(tmp)
+		f.WriteLn(fmt.Sprintf("VMOVQ %s, %d(%s)", d, j*8, res))
+		f.WriteLn(fmt.Sprintf("VPEXTRQ $1, %s, %d(%s)", d, (f.NbWords+j)*8, res))
+		f.WriteLn(fmt.Sprintf("VEXTRACTI128 $1, %s, %s", dst, t))
+		f.WriteLn(fmt.Sprintf("VMOVQ %s, %d(%s)", t, (2*f.NbWords+j)*8, res))
+		f.WriteLn(fmt.Sprintf("VPEXTRQ $1, %s, %d(%s)", t, (3*f.NbWords+j)*8, res))
+	}
+
+	f.Comment("increment pointers to visit next elements")
+	f.ADDQ(f.NbWords*8*4, res)
+	f.ADDQ(f.NbWords*8*4, a)
+	if !scalarMul {
+		f.ADDQ(f.NbWords*8*4, b)
+	}
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.WriteLn("VZEROUPPER")
+	f.RET()
+
+	f.Push(&registers, res, a, b, n, r)
+}
+
+// qInvNeg returns -q⁻¹ mod 2^limbSize
+func (f *FFAmd64) qInvNeg(limbSize int) uint64 {
+	r := new(big.Int).Lsh(big.NewInt(1), uint(limbSize))
+	qInv := new(big.Int).ModInverse(f.ModulusBig, r)
+	return qInv.Sub(r, qInv).Uint64()
+}
+
+// qLimbs returns the limbs of limbSize bits of q
+func (f *FFAmd64) qLimbs(limbSize int) []uint64 {
+	k, _ := f.limbsParams(limbSize)
+	mask := new(big.Int).SetUint64(uint64(1)<<limbSize - 1)
+	limbs := make([]uint64, k)
+	var limb big.Int
+	for i := range limbs {
+		limb.Rsh(f.ModulusBig, uint(limbSize*i)).And(&limb, mask)
+		limbs[i] = limb.Uint64()
+	}
+	return limbs
+}
+
+// generateVecDefines generates the constants used by the vector operations
+func (f *FFAmd64) generateVecDefines() {
+	f.Comment("indexes of the words of 8 consecutive elements, used to gather or scatter them")
+	for i := 0; i < 8; i++ {
+		f.WriteLn(fmt.Sprintf("DATA indexGatherScatter<>+%d(SB)/8, $%d", i*8, i*f.NbWords))
+	}
+	f.WriteLn("GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64")
+	f.WriteLn("")
+
+	f.Comment("limbs of 52 bits of q, used by mulVec")
+	limbs := f.qLimbs(52)
+	for i, l := range limbs {
+		f.WriteLn(fmt.Sprintf("DATA qLimbs52<>+%d(SB)/8, $%#x", i*8, l))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $%d", len(limbs)*8))
+	f.WriteLn("")
+
+	if f.ASMVectorAVX2Mul {
+		f.Comment("limbs of 29 bits of q, repeated in the 4 lanes of a register, used by mulVecAVX2")
+		limbs := f.qLimbs(29)
+		for i, l := range limbs {
+			for j := 0; j < avx2.lanes; j++ {
+				f.WriteLn(fmt.Sprintf("DATA qLimbs29<>+%d(SB)/8, $%#x", (i*avx2.lanes+j)*8, l))
+			}
+		}
+		f.WriteLn(fmt.Sprintf("GLOBL qLimbs29<>(SB), (RODATA+NOPTR), $%d", len(limbs)*avx2.size()))
+		f.WriteLn("")
+
+		f.Comment("-q⁻¹ mod 2²⁹, repeated in the 4 lanes of a register, used by mulVecAVX2")
+		for j := 0; j < avx2.lanes; j++ {
+			f.WriteLn(fmt.Sprintf("DATA qInvNeg29<>+%d(SB)/8, $%#x", j*8, f.qInvNeg(29)))
+		}
+		f.WriteLn(fmt.Sprintf("GLOBL qInvNeg29<>(SB), (RODATA+NOPTR), $%d", avx2.size()))
+		f.WriteLn("")
+	}
+}
+
+// log2 returns the base 2 logarithm of a power of 2
+func log2(n int) int {
+	l := 0
+	for n > 1 {
+		n >>= 1
+		l++
+	}
+	return l
+}
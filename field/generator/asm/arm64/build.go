@@ -0,0 +1,188 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arm64 contains syntactic sugar to generate arm64 assembly code
+package arm64
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/consensys/bavard"
+
+	"github.com/consensys/bavard/arm64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// zr is the zero register
+const zr = "ZR"
+
+// REGTMP is used by the Go assembler to materialize constants;
+// R18 (platform register) is already removed by bavard.
+const regTmp = arm64.Register(27)
+
+func NewFFArm64(w io.Writer, F *config.FieldConfig) *FFArm64 {
+	return &FFArm64{F, arm64.NewArm64(w), 0}
+}
+
+type FFArm64 struct {
+	*config.FieldConfig
+	*arm64.Arm64
+	labelCounter int
+}
+
+// Generate generates assembly code for the base field provided to goff
+// see internal/templates/ops*
+func Generate(w io.Writer, F *config.FieldConfig) error {
+	f := NewFFArm64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	// mul
+	f.generateMul()
+
+	// fft butterflies
+	f.generateButterfly()
+
+	// vector operations
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateMulVec(false)
+	f.generateMulVec(true)
+
+	return nil
+}
+
+// fnHeader writes the function header and returns the available registers
+func (f *FFArm64) fnHeader(funcName string, argSize int) arm64.Registers {
+	return f.FnHeader(funcName, 0, argSize, regTmp)
+}
+
+func (f *FFArm64) newLabel() string {
+	f.labelCounter++
+	return fmt.Sprintf("l%d", f.labelCounter)
+}
+
+func (f *FFArm64) label(l string) {
+	f.WriteLn(l + ":")
+}
+
+func (f *FFArm64) popN(registers *arm64.Registers) []arm64.Register {
+	return registers.PopN(f.NbWords)
+}
+
+// loadQ sets q in registers
+func (f *FFArm64) loadQ(q []arm64.Register) {
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVD(f.Q[i], q[i])
+	}
+}
+
+// load loads the NbWords words at address base into t.
+// if postIncrement is set, base is incremented by NbWords*8.
+func (f *FFArm64) load(base arm64.Register, t []arm64.Register, postIncrement bool) {
+	ldp, movd, offset := "LDP", "MOVD", 16
+	if postIncrement {
+		ldp, movd = "LDP.P", "MOVD.P"
+	}
+	i := 0
+	for ; i+1 < len(t); i += 2 {
+		addr := fmt.Sprintf("%d(%s)", i*8, base.Name())
+		if postIncrement {
+			addr = fmt.Sprintf("%d(%s)", offset, base.Name())
+		}
+		f.WriteLn(fmt.Sprintf("    %s %s, (%s, %s)", ldp, addr, t[i].Name(), t[i+1].Name()))
+	}
+	if i < len(t) {
+		addr := fmt.Sprintf("%d(%s)", i*8, base.Name())
+		if postIncrement {
+			addr = fmt.Sprintf("%d(%s)", 8, base.Name())
+		}
+		f.WriteLn(fmt.Sprintf("    %s %s, %s", movd, addr, t[i].Name()))
+	}
+}
+
+// store stores t at address base.
+// if postIncrement is set, base is incremented by NbWords*8.
+func (f *FFArm64) store(t []arm64.Register, base arm64.Register, postIncrement bool) {
+	stp, movd, offset := "STP", "MOVD", 16
+	if postIncrement {
+		stp, movd = "STP.P", "MOVD.P"
+	}
+	i := 0
+	for ; i+1 < len(t); i += 2 {
+		addr := fmt.Sprintf("%d(%s)", i*8, base.Name())
+		if postIncrement {
+			addr = fmt.Sprintf("%d(%s)", offset, base.Name())
+		}
+		f.WriteLn(fmt.Sprintf("    %s (%s, %s), %s", stp, t[i].Name(), t[i+1].Name(), addr))
+	}
+	if i < len(t) {
+		addr := fmt.Sprintf("%d(%s)", i*8, base.Name())
+		if postIncrement {
+			addr = fmt.Sprintf("%d(%s)", 8, base.Name())
+		}
+		f.WriteLn(fmt.Sprintf("    %s %s, %s", movd, t[i].Name(), addr))
+	}
+}
+
+// add sets t = a + b, setting the carry flag
+func (f *FFArm64) add(a, b, t []arm64.Register) {
+	f.ADDS(b[0], a[0], t[0])
+	for i := 1; i < f.NbWords; i++ {
+		f.ADCS(b[i], a[i], t[i])
+	}
+}
+
+// sub sets t = a - b; the carry flag is cleared if there is a borrow
+func (f *FFArm64) sub(a, b, t []arm64.Register) {
+	f.SUBS(b[0], a[0], t[0])
+	for i := 1; i < f.NbWords; i++ {
+		f.SBCS(b[i], a[i], t[i])
+	}
+}
+
+// reduce sets t = t - q if t ⩾ q, using scratch registers.
+// q may be nil, in which case its words are set in scratch
+func (f *FFArm64) reduce(t, scratch, q []arm64.Register) {
+	if q == nil {
+		f.loadQ(scratch)
+		q = scratch
+	}
+	f.sub(t, q, scratch)
+	for i := 0; i < f.NbWords; i++ {
+		f.CSEL("CS", scratch[i], t[i], t[i])
+	}
+}
+
+// instructions not provided by bavard/arm64
+
+func (f *FFArm64) ADD(op1, op2, dst interface{}) {
+	f.WriteLn(fmt.Sprintf("    ADD %s, %s, %s", arm64.Operand(op1), arm64.Operand(op2), arm64.Operand(dst)))
+}
+
+func (f *FFArm64) SUB(op1, op2, dst interface{}) {
+	f.WriteLn(fmt.Sprintf("    SUB %s, %s, %s", arm64.Operand(op1), arm64.Operand(op2), arm64.Operand(dst)))
+}
+
+func (f *FFArm64) CBZ(r arm64.Register, label string) {
+	f.WriteLn(fmt.Sprintf("    CBZ %s, %s", r.Name(), label))
+}
+
+func (f *FFArm64) JMP(label string) {
+	f.WriteLn("    JMP " + label)
+}
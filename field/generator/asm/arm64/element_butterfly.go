@@ -0,0 +1,180 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+// Butterfly sets
+// a = a + b
+// b = a - b
+//
+//	func Butterfly(a, b *{{.ElementName}}) {
+//		t := *a
+//		a.Add(a, b)
+//		b.Sub(&t, b)
+//	}
+func (f *FFArm64) generateButterfly() {
+	f.Comment("Butterfly(a, b *Element) sets a = a + b; b = a - b")
+
+	registers := f.fnHeader("Butterfly", 16)
+
+	// registers
+	pa := registers.Pop()
+	pb := registers.Pop()
+	a := f.popN(&registers)
+	b := f.popN(&registers)
+	t := f.popN(&registers)
+	q := f.popN(&registers)
+
+	f.MOVD("a+0(FP)", pa)
+	f.load(pa, a, false)
+	f.MOVD("b+8(FP)", pb)
+	f.load(pb, b, false)
+	f.loadQ(q)
+
+	f.Comment("t = a + b")
+	f.add(a, b, t)
+
+	f.Comment("a = a - b")
+	f.sub(a, b, a)
+
+	f.Comment("b = q if a - b underflowed, 0 otherwise")
+	for i := 0; i < f.NbWords; i++ {
+		f.CSEL("CC", q[i], zr, b[i])
+	}
+
+	f.Comment("b = (a - b) mod q")
+	f.add(a, b, b)
+	f.store(b, pb, false)
+
+	f.Comment("a = (a + b) mod q")
+	f.reduce(t, a, q)
+	f.store(t, pa, false)
+
+	f.RET()
+
+	registers.Push(pa, pb)
+	registers.Push(a...)
+	registers.Push(b...)
+	registers.Push(t...)
+	registers.Push(q...)
+}
+
+// generateAddVec generates
+//
+//	func addVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] + b[i] for i < n
+func (f *FFArm64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	registers := f.fnHeader("addVec", 32)
+
+	// registers
+	res := registers.Pop()
+	pa := registers.Pop()
+	pb := registers.Pop()
+	n := registers.Pop()
+	a := f.popN(&registers)
+	b := f.popN(&registers)
+	q := f.popN(&registers)
+
+	f.loadQ(q)
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", pa)
+	f.MOVD("b+16(FP)", pb)
+	f.MOVD("n+24(FP)", n)
+
+	loop := f.newLabel()
+	done := f.newLabel()
+
+	f.label(loop)
+	f.CBZ(n, done)
+
+	f.load(pa, a, true)
+	f.load(pb, b, true)
+
+	f.Comment("a[i] + b[i]")
+	f.add(a, b, a)
+	f.reduce(a, b, q)
+
+	f.store(a, res, true)
+
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.label(done)
+	f.RET()
+
+	registers.Push(res, pa, pb, n)
+	registers.Push(a...)
+	registers.Push(b...)
+	registers.Push(q...)
+}
+
+// generateSubVec generates
+//
+//	func subVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] - b[i] for i < n
+func (f *FFArm64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	registers := f.fnHeader("subVec", 32)
+
+	// registers
+	res := registers.Pop()
+	pa := registers.Pop()
+	pb := registers.Pop()
+	n := registers.Pop()
+	a := f.popN(&registers)
+	b := f.popN(&registers)
+	q := f.popN(&registers)
+
+	f.loadQ(q)
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", pa)
+	f.MOVD("b+16(FP)", pb)
+	f.MOVD("n+24(FP)", n)
+
+	loop := f.newLabel()
+	done := f.newLabel()
+
+	f.label(loop)
+	f.CBZ(n, done)
+
+	f.load(pa, a, true)
+	f.load(pb, b, true)
+
+	f.Comment("a[i] - b[i]")
+	f.sub(a, b, a)
+
+	f.Comment("add q if a[i] - b[i] underflowed")
+	for i := 0; i < f.NbWords; i++ {
+		f.CSEL("CC", q[i], zr, b[i])
+	}
+	f.add(a, b, a)
+
+	f.store(a, res, true)
+
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.label(done)
+	f.RET()
+
+	registers.Push(res, pa, pb, n)
+	registers.Push(a...)
+	registers.Push(b...)
+	registers.Push(q...)
+}
@@ -0,0 +1,222 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/arm64"
+)
+
+// mulRegisters holds the registers used by mulNoCarry
+type mulRegisters struct {
+	x, t, q             []arm64.Register // q may be nil
+	yi, m, A, C, lo, hi arm64.Register
+}
+
+func (f *FFArm64) popMulRegisters(registers *arm64.Registers) mulRegisters {
+	var r mulRegisters
+	r.x = f.popN(registers)
+	r.t = f.popN(registers)
+	r.yi, r.m, r.A, r.C, r.lo, r.hi = registers.Pop(), registers.Pop(), registers.Pop(), registers.Pop(), registers.Pop(), registers.Pop()
+	// keep q in registers if we can
+	if registers.Available() >= f.NbWords {
+		r.q = f.popN(registers)
+	}
+	return r
+}
+
+func (f *FFArm64) pushMulRegisters(registers *arm64.Registers, r mulRegisters) {
+	registers.Push(r.x...)
+	registers.Push(r.t...)
+	registers.Push(r.q...)
+	registers.Push(r.yi, r.m, r.A, r.C, r.lo, r.hi)
+}
+
+// qAt returns a register set to q[i]; scratch is used if q is not in registers
+func (f *FFArm64) qAt(r mulRegisters, i int, scratch arm64.Register) arm64.Register {
+	if r.q != nil {
+		return r.q[i]
+	}
+	f.MOVD(f.Q[i], scratch)
+	return scratch
+}
+
+// mulNoCarry sets t = x * y * R⁻¹ (mod q), fully reduced, x being in registers;
+// loadY(i, r) sets y[i] in r.
+//
+// It implements the CIOS multiplication with the "no carry" optimization,
+// see the element templates (mul_nocarry) for the algorithm:
+//
+//	for i=0 to N-1
+//		(A,t[0]) := t[0] + x[0]*y[i]
+//		m := t[0]*q'[0] mod W
+//		C,_ := t[0] + m*q[0]
+//		for j=1 to N-1
+//			(A,t[j])  := t[j] + x[j]*y[i] + A
+//			(C,t[j-1]) := t[j] + m*q[j] + C
+//
+//		t[N-1] = C + A
+//
+// The x registers are clobbered.
+func (f *FFArm64) mulNoCarry(r mulRegisters, loadY func(i int, dst arm64.Register)) {
+	x, t := r.x, r.t
+	for i := 0; i < f.NbWords; i++ {
+		loadY(i, r.yi)
+
+		f.Comment(fmt.Sprintf("(A,t[0]) := t[0] + x[0]*y[%d]", i))
+		if i == 0 {
+			f.MUL(x[0], r.yi, t[0])
+			f.UMULH(x[0], r.yi, r.A)
+		} else {
+			f.MUL(x[0], r.yi, r.lo)
+			f.UMULH(x[0], r.yi, r.hi)
+			f.ADDS(r.lo, t[0], t[0])
+			f.ADC(zr, r.hi, r.A)
+		}
+
+		f.Comment("m := t[0]*q'[0] mod W")
+		f.MOVD(f.QInverse[0], r.m)
+		f.MUL(r.m, t[0], r.m)
+
+		f.Comment("C,_ := t[0] + m*q[0]")
+		q0 := f.qAt(r, 0, r.hi)
+		f.MUL(r.m, q0, r.lo)
+		f.UMULH(r.m, q0, r.C)
+		f.ADDS(t[0], r.lo, r.lo)
+		f.ADC(zr, r.C, r.C)
+
+		for j := 1; j < f.NbWords; j++ {
+			f.Comment(fmt.Sprintf("(A,t[%[1]d]) := t[%[1]d] + x[%[1]d]*y[%[2]d] + A", j, i))
+			if i == 0 {
+				f.MUL(x[j], r.yi, t[j])
+				f.UMULH(x[j], r.yi, r.hi)
+				f.ADDS(r.A, t[j], t[j])
+				f.ADC(zr, r.hi, r.A)
+			} else {
+				f.MUL(x[j], r.yi, r.lo)
+				f.UMULH(x[j], r.yi, r.hi)
+				f.ADDS(r.A, r.lo, r.lo)
+				f.ADC(zr, r.hi, r.hi)
+				f.ADDS(r.lo, t[j], t[j])
+				f.ADC(zr, r.hi, r.A)
+			}
+
+			f.Comment(fmt.Sprintf("(C,t[%d]) := t[%d] + m*q[%d] + C", j-1, j, j))
+			qj := f.qAt(r, j, r.hi)
+			f.MUL(r.m, qj, r.lo)
+			f.UMULH(r.m, qj, r.hi)
+			f.ADDS(r.C, r.lo, r.lo)
+			f.ADC(zr, r.hi, r.hi)
+			f.ADDS(t[j], r.lo, t[j-1])
+			f.ADC(zr, r.hi, r.C)
+		}
+
+		f.Comment(fmt.Sprintf("t[%d] = C + A", f.NbWords-1))
+		f.ADD(r.C, r.A, t[f.NbWords-1])
+	}
+
+	f.Comment("reduce if necessary")
+	f.reduce(t, x, r.q)
+}
+
+// generateMul generates
+//
+//	func mul(res, x, y *Element)
+func (f *FFArm64) generateMul() {
+	f.Comment("mul(res, x, y *Element)")
+
+	registers := f.fnHeader("mul", 24)
+
+	ptr := registers.Pop()
+	r := f.popMulRegisters(&registers)
+	if r.q != nil {
+		f.loadQ(r.q)
+	}
+
+	f.MOVD("x+8(FP)", ptr)
+	f.load(ptr, r.x, false)
+	f.MOVD("y+16(FP)", ptr)
+
+	f.mulNoCarry(r, func(i int, dst arm64.Register) {
+		f.MOVD(fmt.Sprintf("%d(%s)", i*8, ptr.Name()), dst)
+	})
+
+	f.MOVD("res+0(FP)", ptr)
+	f.store(r.t, ptr, false)
+	f.RET()
+
+	f.pushMulRegisters(&registers, r)
+	registers.Push(ptr)
+}
+
+// generateMulVec generates
+//
+//	func mulVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b[i] for i < n, or if scalarMul is set
+//
+//	func scalarMulVec(res, a, b *Element, n uint64)
+//
+// which sets res[i] = a[i] * b for i < n
+func (f *FFArm64) generateMulVec(scalarMul bool) {
+	name := "mulVec"
+	if scalarMul {
+		name = "scalarMulVec"
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]")
+	}
+
+	registers := f.fnHeader(name, 32)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	r := f.popMulRegisters(&registers)
+	if r.q != nil {
+		f.loadQ(r.q)
+	}
+
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", a)
+	f.MOVD("b+16(FP)", b)
+	f.MOVD("n+24(FP)", n)
+
+	loop := f.newLabel()
+	done := f.newLabel()
+
+	f.label(loop)
+	f.CBZ(n, done)
+
+	f.load(a, r.x, true)
+	f.mulNoCarry(r, func(i int, dst arm64.Register) {
+		f.MOVD(fmt.Sprintf("%d(%s)", i*8, b.Name()), dst)
+	})
+	f.store(r.t, res, true)
+
+	if !scalarMul {
+		f.ADD(f.NbWords*8, b, b)
+	}
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.label(done)
+	f.RET()
+
+	f.pushMulRegisters(&registers, r)
+	registers.Push(res, a, b, n)
+}
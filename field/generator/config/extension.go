@@ -1,6 +1,10 @@
 package config
 
-import "math/big"
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
 
 type Element []big.Int
 
@@ -242,3 +246,38 @@ func NewElement(s []string) []big.Int {
 	}
 	return res
 }
+
+// NewExtension returns the binomial extension Fp[u]/(uⁿ - α) of base.
+//
+// It returns an error if uⁿ - α is not irreducible over Fp, or if n doesn't divide p-1
+// (in which case the Frobenius map is not a simple scaling of the coordinates).
+func NewExtension(base *FieldConfig, degree uint8, rootOf int64) (Extension, error) {
+	if degree < 2 {
+		return Extension{}, fmt.Errorf("extension degree must be at least 2, got %d", degree)
+	}
+	n := big.NewInt(int64(degree))
+	pMinusOne := new(big.Int).Sub(base.ModulusBig, big.NewInt(1))
+	if new(big.Int).Mod(pMinusOne, n).Sign() != 0 {
+		return Extension{}, fmt.Errorf("extension degree %d must divide p-1", degree)
+	}
+
+	alpha := new(big.Int).Mod(big.NewInt(rootOf), base.ModulusBig)
+	if alpha.Sign() == 0 {
+		return Extension{}, errors.New("extension non-residue must be non-zero")
+	}
+
+	// since n | p-1, uⁿ - α is irreducible iff α is not an r-th power for every prime r | n
+	// (the extra condition for 4 | n is implied, -4 being a 4th power when p ≡ 1 mod 4)
+	for r := int64(2); r <= int64(degree); r++ {
+		if int64(degree)%r != 0 || !big.NewInt(r).ProbablyPrime(0) {
+			continue
+		}
+		var e, t big.Int
+		e.Div(pMinusOne, big.NewInt(r))
+		if t.Exp(alpha, &e, base.ModulusBig).Cmp(big.NewInt(1)) == 0 {
+			return Extension{}, fmt.Errorf("u^%d - (%d) is not irreducible: %d is a %d-th power", degree, rootOf, rootOf, r)
+		}
+	}
+
+	return NewTower(base, degree, rootOf), nil
+}
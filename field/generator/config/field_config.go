@@ -52,6 +52,9 @@ type FieldConfig struct {
 	QInverse                  []uint64
 	QMinusOneHalvedP          []uint64 // ((q-1) / 2 ) + 1
 	ASM                       bool
+	ASMVector                 bool // generate amd64 assembly for the Vector arithmetic
+	ASMVectorAVX2Mul          bool // use the AVX2 assembly for the Vector multiplication, if AVX-512 IFMA is not supported
+	ASMArm64                  bool // generate arm64 assembly for the multiplication, butterfly and Vector arithmetic
 	RSquare                   []uint64
 	One, Thirteen             []uint64
 	LegendreExponent          string // big.Int to base16 string
@@ -72,6 +75,15 @@ type FieldConfig struct {
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+
+	// 32 bit fields (see NewFieldConfig32)
+	F31        bool   // q < 2³¹, element stored on a single uint32
+	Mersenne31 bool   // q = 2³¹-1, canonical form with special reduction instead of Montgomery form
+	Q32        uint32 // q
+	QInvNeg32  uint32 // -q⁻¹ mod 2³²
+	RSquare32  uint32 // 2⁶⁴ mod q
+	One32      uint32 // 1 in internal form
+	SqrtG32    uint32 // NonResidue ^ SqrtS in internal form
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
@@ -261,6 +273,16 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// asm code generation for moduli with more than 6 words can be optimized further
 	F.ASM = F.NoCarry && F.NbWords <= 12 && F.NbWords > 1
 
+	// the vector operations are implemented in amd64 assembly for any number of words,
+	// with AVX-512 (IFMA) or AVX2 for Mul, ScalarMul and Sum.
+	// The AVX2 multiplication is slower than the scalar ADX one, so it is only used
+	// for the moduli with a pure Go Mul.
+	F.ASMVector = F.NbWords <= 12
+	F.ASMVectorAVX2Mul = F.ASMVector && !F.ASM
+
+	// the arm64 multiplication keeps its operands in registers, which bounds the number of words.
+	F.ASMArm64 = F.ASM && F.NbWords <= 6
+
 	return F, nil
 }
 
@@ -329,6 +351,18 @@ func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	return mont
 }
 
+// InternalForm returns the words of the internal representation of x, that is
+// x in Montgomery form, or x mod q for the Mersenne-31 field.
+func (f *FieldConfig) InternalForm(x *big.Int) []uint64 {
+	if f.F31 {
+		return toUint64Slice(f.toRepr32(x), 1)
+	}
+	var v big.Int
+	v.Mod(x, f.ModulusBig)
+	v = f.ToMont(v)
+	return toUint64Slice(&v, f.NbWords)
+}
+
 func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 
 	if f.NbWords == 0 {
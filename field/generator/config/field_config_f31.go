@@ -0,0 +1,143 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errModulusTooLarge = errors.New("modulus must be smaller than 2³¹")
+
+// NewFieldConfig32 returns a data structure with needed information to generate apis for a field element
+// stored on a single 32 bit word (q < 2³¹).
+//
+// Elements are in Montgomery form (r = 2³²), except for the Mersenne prime 2³¹-1 for which a special
+// reduction is used and elements are stored in canonical form.
+//
+// See field/generator package
+func NewFieldConfig32(packageName, elementName, modulus string) (*FieldConfig, error) {
+	// parse modulus
+	var bModulus big.Int
+	if _, ok := bModulus.SetString(modulus, 0); !ok {
+		return nil, errParseModulus
+	}
+	if bModulus.Sign() <= 0 || bModulus.BitLen() > 31 {
+		return nil, errModulusTooLarge
+	}
+	if bModulus.Bit(0) == 0 || bModulus.Cmp(big.NewInt(2)) <= 0 {
+		return nil, errParseModulus
+	}
+
+	// field info
+	F := &FieldConfig{
+		PackageName: packageName,
+		ElementName: elementName,
+		Modulus:     bModulus.Text(10),
+		ModulusHex:  bModulus.Text(16),
+		ModulusBig:  new(big.Int).Set(&bModulus),
+		F31:         true,
+	}
+
+	var mersenne big.Int
+	mersenne.SetUint64((1 << 31) - 1)
+	F.Mersenne31 = bModulus.Cmp(&mersenne) == 0
+
+	// pre compute field constants
+	F.NbBits = bModulus.BitLen()
+	F.NbWords = 1
+	F.NbBytes = 4
+	F.NbWordsLastIndex = 0
+	F.NbWordsIndexesFull = []int{0}
+	F.NbWordsIndexesNoZero = []int{}
+
+	F.Q = toUint64Slice(&bModulus, 1)
+	F.Q32 = uint32(F.Q[0])
+
+	_qHalved := big.NewInt(0)
+	bOne := new(big.Int).SetUint64(1)
+	_qHalved.Sub(&bModulus, bOne).Rsh(_qHalved, 1).Add(_qHalved, bOne)
+	F.QMinusOneHalvedP = toUint64Slice(_qHalved, 1)
+
+	// qInvNeg = -q⁻¹ mod 2³²
+	r := new(big.Int).Lsh(bOne, 32)
+	qInv := new(big.Int).ModInverse(&bModulus, r)
+	qInv.Sub(r, qInv)
+	F.QInvNeg32 = uint32(qInv.Uint64())
+
+	// the representation of 1 and r² mod q
+	F.One32 = uint32(F.toRepr32(big.NewInt(1)).Uint64())
+	rSquare := new(big.Int).Lsh(bOne, 64)
+	rSquare.Mod(rSquare, &bModulus)
+	F.RSquare32 = uint32(rSquare.Uint64())
+
+	// Legendre exponent (p-1)/2
+	var legendreExponent big.Int
+	legendreExponent.Sub(&bModulus, bOne).Rsh(&legendreExponent, 1)
+	F.LegendreExponent = legendreExponent.Text(16)
+
+	// Sqrt pre computes
+	var qMod big.Int
+	if qMod.Mod(&bModulus, big.NewInt(4)).Cmp(big.NewInt(3)) == 0 {
+		// q ≡ 3 (mod 4)
+		F.SqrtQ3Mod4 = true
+		var sqrtExponent big.Int
+		sqrtExponent.Add(&bModulus, bOne).Rsh(&sqrtExponent, 2)
+		F.SqrtQ3Mod4Exponent = sqrtExponent.Text(16)
+	} else if qMod.Mod(&bModulus, big.NewInt(8)).Cmp(big.NewInt(5)) == 0 {
+		// q ≡ 5 (mod 8)
+		F.SqrtAtkin = true
+		e := new(big.Int).Rsh(&bModulus, 3) // e = (q - 5) / 8
+		F.SqrtAtkinExponent = e.Text(16)
+	} else {
+		F.SqrtTonelliShanks = true
+
+		// Write q-1 =2ᵉ * s , s odd
+		var s big.Int
+		s.Sub(&bModulus, bOne)
+		e := s.TrailingZeroBits()
+		s.Rsh(&s, e)
+		F.SqrtE = uint64(e)
+		F.SqrtS = toUint64Slice(&s)
+
+		// find non residue
+		nonResidue := big.NewInt(2)
+		for big.Jacobi(nonResidue, &bModulus) != -1 {
+			nonResidue.Add(nonResidue, bOne)
+		}
+
+		// g = nonresidue ^ s
+		var g big.Int
+		g.Exp(nonResidue, &s, &bModulus)
+		F.SqrtG32 = uint32(F.toRepr32(&g).Uint64())
+		F.NonResidue = *F.toRepr32(nonResidue)
+
+		// (s-1) /2
+		s.Sub(&s, bOne).Rsh(&s, 1)
+		F.SqrtSMinusOneOver2 = s.Text(16)
+	}
+
+	return F, nil
+}
+
+// toRepr32 returns the internal representation of x for a 32 bit field:
+// x * 2³² mod q in Montgomery form, or x mod q for the Mersenne prime.
+func (f *FieldConfig) toRepr32(x *big.Int) *big.Int {
+	res := new(big.Int).Set(x)
+	if !f.Mersenne31 {
+		res.Lsh(res, 32)
+	}
+	return res.Mod(res, f.ModulusBig)
+}
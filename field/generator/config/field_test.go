@@ -258,3 +258,25 @@ func bigIntMatchUint64Slice(aInt *big.Int, a []uint64) error {
 
 	return nil
 }
+
+func TestNewExtension(t *testing.T) {
+	t.Parallel()
+
+	babybear, err := NewFieldConfig32("babybear", "Element", "2013265921")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// u⁴ - 11 is the quartic extension used by most BabyBear STARKs
+	if _, err := NewExtension(babybear, 4, 11); err != nil {
+		t.Fatal(err)
+	}
+	// 4 is a square
+	if _, err := NewExtension(babybear, 4, 4); err == nil {
+		t.Fatal("expected u⁴ - 4 to be reducible")
+	}
+	// 7 doesn't divide p-1
+	if _, err := NewExtension(babybear, 7, 11); err == nil {
+		t.Fatal("expected an error for a degree not dividing p-1")
+	}
+}
@@ -0,0 +1,149 @@
+package generator
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
+)
+
+// GenerateExtension generates the binomial extension ext of the field F in outputDir,
+// which must be the package generated by GenerateFF for F.
+//
+// The type is named E<degree> (e.g. E2, E4) and its coordinates are A0, ..., A<degree-1>,
+// that is z = A0 + A1*u + ... with uⁿ = ext.RootOf.
+//
+// Example usage
+//
+//	ext, _ := config.NewExtension(babybear, 4, 11)
+//	generator.GenerateExtension(babybear, ext, "../")
+func GenerateExtension(F *config.FieldConfig, ext config.Extension, outputDir string) error {
+	data, err := newExtensionData(F, ext)
+	if err != nil {
+		return err
+	}
+
+	funcs := template.FuncMap{}
+	funcs["ltu64"] = func(a, b uint64) bool {
+		return a < b
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.Funcs(funcs),
+	}
+
+	eName := strings.ToLower(data.Name)
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+".go"), []string{element.Extension}, data, bavardOpts...); err != nil {
+		return err
+	}
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+"_test.go"), []string{element.TestExtension}, data, bavardOpts...); err != nil {
+		return err
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// extensionData precomputed values used in template for code generation of extension field APIs
+type extensionData struct {
+	*config.FieldConfig
+	Name                  string     // E<degree>
+	Degree                int        // n
+	RootOf                int64      // α such that uⁿ = α
+	RootOfWords           []uint64   // α in internal form
+	Indexes               []int      // 0, ..., n-1
+	MulTerms              []mulTerms // schoolbook products contributing to each coordinate
+	FrobeniusWords        [][]uint64 // α^(i(p-1)/n) in internal form, for i = 0, ..., n-1
+	ExtSqrtE              uint64     // pⁿ-1 = 2ᵉ * s, s odd
+	ExtSqrtSMinusOneOver2 string     // (s-1)/2 in base16
+	ExtSqrtGWords         [][]uint64 // g = zˢ for a non-square z, coordinates in internal form
+}
+
+// mulTerms lists the products x.Ai * y.Aj contributing to a coordinate of x * y.
+// High terms (i + j ⩾ n) are multiplied by α.
+type mulTerms struct {
+	Low  [][2]int
+	High [][2]int
+}
+
+func newExtensionData(F *config.FieldConfig, ext config.Extension) (*extensionData, error) {
+	if ext.Base == nil || ext.Base.ModulusBig.Cmp(F.ModulusBig) != 0 {
+		return nil, fmt.Errorf("extension base field doesn't match %s.%s", F.PackageName, F.ElementName)
+	}
+	n := ext.Degree
+	p := F.ModulusBig
+
+	data := &extensionData{
+		FieldConfig: F,
+		Name:        fmt.Sprintf("E%d", n),
+		Degree:      n,
+		RootOf:      ext.RootOf,
+		RootOfWords: F.InternalForm(big.NewInt(ext.RootOf)),
+	}
+
+	data.Indexes = make([]int, n)
+	data.MulTerms = make([]mulTerms, n)
+	for k := 0; k < n; k++ {
+		data.Indexes[k] = k
+		for i := 0; i <= k; i++ {
+			data.MulTerms[k].Low = append(data.MulTerms[k].Low, [2]int{i, k - i})
+		}
+		for i := k + 1; i < n; i++ {
+			data.MulTerms[k].High = append(data.MulTerms[k].High, [2]int{i, k + n - i})
+		}
+	}
+
+	// Frobenius: (uⁱ)ᵖ = uⁱ * α^(i(p-1)/n)
+	alpha := new(big.Int).Mod(big.NewInt(ext.RootOf), p)
+	e := new(big.Int).Sub(p, big.NewInt(1))
+	e.Div(e, big.NewInt(int64(n)))
+	gamma := new(big.Int).Exp(alpha, e, p)
+	coeff := big.NewInt(1)
+	for i := 0; i < n; i++ {
+		data.FrobeniusWords = append(data.FrobeniusWords, F.InternalForm(coeff))
+		coeff = new(big.Int).Mul(coeff, gamma)
+		coeff.Mod(coeff, p)
+	}
+
+	// Tonelli-Shanks in Fpⁿ: write pⁿ-1 = 2ᵉ * s
+	var s big.Int
+	s.Sub(&ext.Size, big.NewInt(1))
+	tz := s.TrailingZeroBits()
+	s.Rsh(&s, tz)
+	data.ExtSqrtE = uint64(tz)
+	data.ExtSqrtSMinusOneOver2 = new(big.Int).Rsh(&s, 1).Text(16)
+
+	// z = c + u is a square iff its norm (-1)ⁿ((-c)ⁿ - α) is a square in Fp
+	for c := int64(0); ; c++ {
+		norm := new(big.Int).Exp(big.NewInt(-c), big.NewInt(int64(n)), nil)
+		norm.Sub(norm, big.NewInt(ext.RootOf))
+		if n%2 == 1 {
+			norm.Neg(norm)
+		}
+		norm.Mod(norm, p)
+		if norm.Sign() == 0 || big.Jacobi(norm, p) != -1 {
+			continue
+		}
+		z := ext.FromInt64(c, 1)
+		g := ext.Exp(z, &s)
+		for i := 0; i < n; i++ {
+			data.ExtSqrtGWords = append(data.ExtSqrtGWords, F.InternalForm(&g[i]))
+		}
+		break
+	}
+
+	return data, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/asm/arm64"
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/consensys/gnark-crypto/field/generator/internal/addchain"
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
@@ -23,6 +24,10 @@ import (
 //	fp, _ = config.NewField("fp", "Element", fpModulus")
 //	generator.GenerateFF(fp, filepath.Join(baseDir, "fp"))
 func GenerateFF(F *config.FieldConfig, outputDir string) error {
+	if F.F31 {
+		return generateF31(F, outputDir)
+	}
+
 	// source file templates
 	sourceFiles := []string{
 		element.Base,
@@ -34,6 +39,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.MulNoCarry,
 		element.Sqrt,
 		element.Inverse,
+		element.ConstantTime,
 		element.BigNum,
 	}
 
@@ -103,6 +109,41 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	// generate vector arithmetic
+	if F.ASMVector {
+		pathSrc := filepath.Join(outputDir, "vector_amd64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsAmd64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, "vector_amd64.go"))
+	}
+	if F.ASMArm64 {
+		pathSrc := filepath.Join(outputDir, "vector_arm64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsArm64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, "vector_arm64.go"))
+	}
+	{
+		pathSrc := filepath.Join(outputDir, "vector_purego.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		if tag := pureGoBuildTag(F.ASMVector, F.ASMArm64); tag != "" {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag(tag))
+		}
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsPureGo}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	// generate arithmetics source file
 	if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
 		return err
@@ -124,6 +165,18 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	if F.ASMVector {
+		pathTest := filepath.Join(outputDir, "vector_amd64_test.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathTest, []string{element.TestVectorAmd64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, "vector_amd64_test.go"))
+	}
+
 	// if we generate assembly code
 	if F.ASM {
 		// generate ops.s
@@ -181,6 +234,80 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 
 	}
 
+	if F.ASMVector {
+		// generate vec_amd64.s
+		pathSrc := filepath.Join(outputDir, eName+"_vec_amd64.s")
+		fmt.Println("generating", pathSrc)
+		f, err := os.Create(pathSrc)
+		if err != nil {
+			return err
+		}
+
+		_, _ = io.WriteString(f, "// +build !purego\n")
+
+		if err := amd64.GenerateVec(f, F); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_ = f.Close()
+
+		// run asmfmt
+		cmd := exec.Command("asmfmt", "-w", pathSrc)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, eName+"_vec_amd64.s"))
+	}
+
+	if F.ASMArm64 {
+		// generate ops_arm64.s
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.s")
+		fmt.Println("generating", pathSrc)
+		f, err := os.Create(pathSrc)
+		if err != nil {
+			return err
+		}
+
+		_, _ = io.WriteString(f, "// +build !purego\n")
+
+		if err := arm64.Generate(f, F); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_ = f.Close()
+
+		// run asmfmt
+		cmd := exec.Command("asmfmt", "-w", pathSrc)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, eName+"_ops_arm64.s"))
+	}
+
+	if F.ASMArm64 {
+		// generate ops_arm64.go
+		src := []string{
+			element.MulDoc,
+			element.MulByConstants,
+			element.OpsARM64,
+		}
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(filepath.Join(outputDir, eName+"_ops_arm64.go"))
+	}
+
 	if F.ASM {
 		// generate ops_amd64.go
 		src := []string{
@@ -202,6 +329,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		// generate ops.go
 		src := []string{
 			element.OpsNoAsm,
+			element.MulByConstants,
 			element.MulCIOS,
 			element.MulNoCarry,
 			element.Reduce,
@@ -210,8 +338,8 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		pathSrc := filepath.Join(outputDir, eName+"_ops_purego.go")
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
 		copy(bavardOptsCpy, bavardOpts)
-		if F.ASM {
-			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
+		if tag := pureGoBuildTag(F.ASM, F.ASMArm64); tag != "" {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag(tag))
 		}
 		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
 			return err
@@ -229,7 +357,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		}
 	}
 
-	if F.ASM {
+	if F.ASM || F.ASMVector {
 		// generate asm.go and asm_noadx.go
 		src := []string{
 			element.Asm,
@@ -242,7 +370,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			return err
 		}
 	}
-	if F.ASM {
+	if F.ASM || F.ASMVector {
 		// generate asm.go and asm_noadx.go
 		src := []string{
 			element.AsmNoAdx,
@@ -267,6 +395,66 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	return nil
 }
 
+// pureGoBuildTag returns the build tag of the pure Go fallback of a file
+// which has assembly implementations for amd64 and / or arm64.
+func pureGoBuildTag(amd64, arm64 bool) string {
+	var archs []string
+	if amd64 {
+		archs = append(archs, "!amd64")
+	}
+	if arm64 {
+		archs = append(archs, "!arm64")
+	}
+	if len(archs) == 0 {
+		return ""
+	}
+	return strings.Join(archs, ",") + " purego"
+}
+
+// generateF31 generates the pure Go code for a field element stored on a single 32 bit word.
+//
+// See config.NewFieldConfig32
+func generateF31(F *config.FieldConfig, outputDir string) error {
+	eName := strings.ToLower(F.ElementName)
+
+	funcs := template.FuncMap{}
+	funcs["shorten"] = shorten
+	funcs["ltu64"] = func(a, b uint64) bool {
+		return a < b
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.Funcs(funcs),
+	}
+
+	files := []struct {
+		path      string
+		templates []string
+	}{
+		{filepath.Join(outputDir, eName+".go"), []string{element.BaseF31}},
+		{filepath.Join(outputDir, "vector.go"), []string{element.Vector}},
+		{filepath.Join(outputDir, "vector_purego.go"), []string{element.VectorOpsPureGo}},
+		{filepath.Join(outputDir, eName+"_test.go"), []string{element.TestF31}},
+		{filepath.Join(outputDir, "vector_test.go"), []string{element.TestVector}},
+		{filepath.Join(outputDir, "doc.go"), []string{element.Doc}},
+	}
+
+	for _, f := range files {
+		if err := bavard.GenerateFromString(f.path, f.templates, F, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
 func shorten(input string) string {
 	const maxLen = 15
 	if len(input) > maxLen {
@@ -86,6 +86,25 @@ func TestIntegration(t *testing.T) {
 		}
 	}
 
+	// 32 bit fields
+	moduli32 := map[string]string{
+		"f31_babybear":   "2013265921",
+		"f31_koalabear":  "2130706433",
+		"f31_mersenne31": "2147483647",
+		"f31_atkin":      "2147483629", // q ≡ 5 (mod 8)
+		"f31_small":      "47",
+	}
+	for elementName, modulus := range moduli32 {
+		childDir := filepath.Join(rootDir, elementName)
+		fIntegration, err := field.NewFieldConfig32("integration", elementName, modulus)
+		if err != nil {
+			t.Fatal(elementName, err)
+		}
+		if err = GenerateFF(fIntegration, childDir); err != nil {
+			t.Fatal(elementName, err)
+		}
+	}
+
 	// run go test
 	wd, err := os.Getwd()
 	if err != nil {
@@ -7,6 +7,11 @@ import 	"golang.org/x/sys/cpu"
 var (
 	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
 	_ = supportAdx
+	{{- if .ASMVector}}
+	supportAvx2 = supportAdx && cpu.X86.HasAVX2
+	supportAvx512 = supportAdx && cpu.X86.HasAVX512
+	supportAvx512IFMA = supportAvx512 && cpu.X86.HasAVX512IFMA && cpu.X86.HasAVX512DQ
+	{{- end}}
 )
 `
 
@@ -19,5 +24,10 @@ const AsmNoAdx = `
 var (
 	supportAdx = false
 	_ = supportAdx
+	{{- if .ASMVector}}
+	supportAvx2 = false
+	supportAvx512 = false
+	supportAvx512IFMA = false
+	{{- end}}
 )
 `
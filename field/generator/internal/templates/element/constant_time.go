@@ -0,0 +1,189 @@
+package element
+
+// ConstantTime defines variants of the arithmetic operations which don't branch on their inputs.
+//
+// Add, Sub, Mul, ... skip their final reduction when it is not needed, which is faster but makes their
+// timing depend on the values; the *ConstantTime variants always compute it and select the result with
+// masks. They are only meant for the computations on secrets (signing, key generation).
+const ConstantTime = `
+
+// AddConstantTime z = x + y (mod q)
+//
+// Unlike Add, it does not branch on x, y or the result; it should be used when they are secret.
+func (z *{{.ElementName}}) AddConstantTime(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var carry uint64
+	{{- range $i := iterate 0 $.NbWords}}
+		z[{{$i}}], carry = bits.Add64(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
+	{{- end}}
+	{{ template "reduce_constant_time" dict "all" . "Carry" true }}
+	return z
+}
+
+// DoubleConstantTime z = x + x (mod q), see AddConstantTime
+func (z *{{.ElementName}}) DoubleConstantTime(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.AddConstantTime(x, x)
+}
+
+// SubConstantTime z = x - y (mod q)
+//
+// Unlike Sub, it does not branch on x, y or the result; it should be used when they are secret.
+func (z *{{.ElementName}}) SubConstantTime(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+		z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], b)
+	{{- end}}
+	// if x < y → z += q
+	mask := -b
+	{{- if eq .NbWords 1}}
+		z[0] += q & mask
+	{{- else}}
+		var c uint64
+		z[0], c = bits.Add64(z[0], q0&mask, 0)
+		{{- range $i := .NbWordsIndexesNoZero}}
+			{{- if eq $i $.NbWordsLastIndex}}
+				z[{{$i}}], _ = bits.Add64(z[{{$i}}], q{{$i}}&mask, c)
+			{{- else}}
+				z[{{$i}}], c = bits.Add64(z[{{$i}}], q{{$i}}&mask, c)
+			{{- end}}
+		{{- end}}
+	{{- end}}
+	return z
+}
+
+// NegConstantTime z = q - x
+//
+// Unlike Neg, it does not branch on x; it should be used when x is secret.
+func (z *{{.ElementName}}) NegConstantTime(x *{{.ElementName}}) *{{.ElementName}} {
+	// mask == 0 iff x == 0
+	acc := x[0] {{- range $i := .NbWordsIndexesNoZero}} | x[{{$i}}] {{- end}}
+	mask := -((acc | -acc) >> 63)
+	{{- if eq .NbWords 1}}
+		z[0] = (q - x[0]) & mask
+	{{- else}}
+		var borrow uint64
+		z[0], borrow = bits.Sub64(q0, x[0], 0)
+		{{- range $i := .NbWordsIndexesNoZero}}
+			{{- if eq $i $.NbWordsLastIndex}}
+				z[{{$i}}], _ = bits.Sub64(q{{$i}}, x[{{$i}}], borrow)
+			{{- else}}
+				z[{{$i}}], borrow = bits.Sub64(q{{$i}}, x[{{$i}}], borrow)
+			{{- end}}
+		{{- end}}
+		{{- range $i := .NbWordsIndexesFull}}
+			z[{{$i}}] &= mask
+		{{- end}}
+	{{- end}}
+	return z
+}
+
+// MulConstantTime z = x * y (mod q)
+//
+// Unlike Mul, it does not branch on x, y or the result, and is never dispatched to assembly;
+// it should be used when they are secret.
+{{- if $.NoCarry}}
+//
+// x and y must be less than q
+{{- end }}
+func (z *{{.ElementName}}) MulConstantTime(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if eq $.NbWords 1}}
+		// see Mul for the algorithm
+		hi, lo := bits.Mul64(x[0], y[0])
+		hi += (lo | -lo) >> 63 // hi++ iff lo != 0
+		m := lo * qInvNeg
+		hi2, _ := bits.Mul64(m, q)
+		var carry uint64
+		z[0], carry = bits.Add64(hi2, hi, 0)
+		{{ template "reduce_constant_time" dict "all" . "Carry" true }}
+	{{- else }}
+		// see Mul for the algorithm
+		{{- if $.NoCarry}}
+			{{ template "mul_nocarry" dict "all" . "V1" "x" "V2" "y"}}
+		{{- else}}
+			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y" "ConstantTime" true}}
+		{{- end}}
+		{{ template "reduce_constant_time" dict "all" . }}
+	{{- end }}
+	return z
+}
+
+// SquareConstantTime z = x * x (mod q), see MulConstantTime
+func (z *{{.ElementName}}) SquareConstantTime(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.MulConstantTime(x, x)
+}
+
+// InverseConstantTime z = x⁻¹ (mod q) = x**(q-2) (mod q)
+//
+// Unlike Inverse, the sequence of operations does not depend on x;
+// it should be used when x is secret. If x == 0, sets and returns z = x.
+func (z *{{.ElementName}}) InverseConstantTime(x *{{.ElementName}}) *{{.ElementName}} {
+	// e == q-2 is public
+	e := Modulus()
+	e.Sub(e, big.NewInt(2))
+
+	var res {{.ElementName}}
+	res.Set(x)
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		res.SquareConstantTime(&res)
+		if e.Bit(i) == 1 {
+			res.MulConstantTime(&res, x)
+		}
+	}
+
+	return z.Set(&res)
+}
+
+// BytesConstantTime returns the value of z as a big-endian byte array.
+//
+// Unlike Bytes, it does not branch on z; it should be used when z is secret.
+func (z *{{.ElementName}}) BytesConstantTime() (res [Bytes]byte) {
+	// the Montgomery multiplication by 1 converts z out of Montgomery form,
+	// without the conditional subtraction of fromMont
+	var _z {{.ElementName}}
+	_z.MulConstantTime(z, &{{.ElementName}}{1})
+	{{- range $i := reverse .NbWordsIndexesFull}}
+		{{- $j := mul $i 8}}
+		{{- $k := sub $.NbWords 1}}
+		{{- $k := sub $k $i}}
+		{{- $jj := add $j 8}}
+		binary.BigEndian.PutUint64(res[{{$j}}:{{$jj}}], _z[{{$k}}])
+	{{- end}}
+	return
+}
+
+// SetBytesConstantTime interprets e as the bytes of a big-endian unsigned integer of any length,
+// sets z to that value (mod q), and returns z.
+//
+// Unlike SetBytes, it only branches on the length of e, not on its content;
+// it should be used when e is secret.
+func (z *{{.ElementName}}) SetBytesConstantTime(e []byte) *{{.ElementName}} {
+	// the chunks c of e are accumulated as if they were Montgomery forms (i.e. as c ⋅ R⁻¹),
+	// and the result is multiplied by R at the end.
+	{{- if eq .NbWords 1}}
+	const chunkSize = 1 // a chunk must be smaller than q
+	shift := {{.ElementName}}{1 << 8}
+	{{- else}}
+	const chunkSize = 8
+	shift := {{.ElementName}}{0, 1}
+	{{- end}}
+	shift.Mul(&shift, &rSquare) // 2^(8*chunkSize), public
+
+	var res, c {{.ElementName}}
+	for len(e) > 0 {
+		n := len(e) % chunkSize
+		if n == 0 {
+			n = chunkSize
+		}
+		var w uint64
+		for _, b := range e[:n] {
+			w = w<<8 | uint64(b)
+		}
+		e = e[n:]
+		c[0] = w
+		res.MulConstantTime(&res, &shift).AddConstantTime(&res, &c)
+	}
+
+	return z.MulConstantTime(&res, &rSquare)
+}
+
+`
@@ -7,8 +7,18 @@ const Doc = `
 // 
 // The modulus is hardcoded in all the operations.
 // 
+{{- if .Mersenne31}}
+// Field elements are represented as an array, and assumed to be in canonical form in all methods:
+// 	type {{.ElementName}} [1]uint32
+//
+// The modulus is the Mersenne prime 2³¹-1; reduction uses 2³¹ ≡ 1 (mod q) instead of Montgomery reduction.
+{{- else if .F31}}
+// Field elements are represented as an array, and assumed to be in Montgomery form (r = 2³²) in all methods:
+// 	type {{.ElementName}} [1]uint32
+{{- else}}
 // Field elements are represented as an array, and assumed to be in Montgomery form in all methods:
 // 	type {{.ElementName}} [{{.NbWords}}]uint64
+{{- end}}
 //
 // Usage
 //
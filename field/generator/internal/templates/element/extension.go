@@ -0,0 +1,496 @@
+package element
+
+// Extension is the template for a binomial extension Fp[u]/(uⁿ - α) of a generated field.
+const Extension = `
+{{- $e := toLower .Name}}
+{{- $n := .Degree}}
+{{- $last := sub .Degree 1}}
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// {{.Name}} is a degree {{.Degree}} extension of {{.ElementName}}: {{.Name}} = {{.ElementName}}[u]/(u{{supScr .Degree}} - ({{.RootOf}}))
+//
+// z = A0{{range $i := .Indexes}}{{if $i}} + A{{$i}}*u{{if gt $i 1}}{{supScr $i}}{{end}}{{end}}{{end}}
+type {{.Name}} struct {
+	{{range $i := .Indexes}}A{{$i}}{{if lt $i $last}}, {{end}}{{end}} {{.ElementName}}
+}
+
+// Bytes{{.Name}} is the number of bytes needed to encode an {{.Name}}
+const Bytes{{.Name}} = {{.Degree}} * Bytes
+
+// {{$e}}RootOf is α such that u{{supScr .Degree}} = α
+var {{$e}}RootOf = {{.ElementName}}{ {{- range $w := .RootOfWords}}{{$w}},{{end}} }
+
+// {{$e}}Frobenius[i] = α^(i(p-1)/{{.Degree}}), such that (uⁱ)ᵖ = {{$e}}Frobenius[i] * uⁱ
+var {{$e}}Frobenius = [{{.Degree}}]{{.ElementName}}{
+	{{- range $c := .FrobeniusWords}}
+	{ {{- range $w := $c}}{{$w}},{{end}} },
+	{{- end}}
+}
+
+// {{$e}}SqrtG = zˢ where z is a non-square in {{.Name}} and p{{supScr .Degree}}-1 = 2ᵉ * s, s odd
+var {{$e}}SqrtG = {{.Name}}{
+	{{- range $i, $c := .ExtSqrtGWords}}
+	A{{$i}}: {{$.ElementName}}{ {{- range $w := $c}}{{$w}},{{end}} },
+	{{- end}}
+}
+
+// {{$e}}SqrtSMinusOneOver2 = (s-1)/2
+var {{$e}}SqrtSMinusOneOver2 big.Int
+
+func init() {
+	{{$e}}SqrtSMinusOneOver2.SetString("{{.ExtSqrtSMinusOneOver2}}", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{.Name}}) Equal(x *{{.Name}}) bool {
+	return {{range $i := .Indexes}}{{if $i}} && {{end}}z.A{{$i}}.Equal(&x.A{{$i}}){{end}}
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{.Name}}) SetZero() *{{.Name}} {
+	*z = {{.Name}}{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{.Name}}) SetOne() *{{.Name}} {
+	*z = {{.Name}}{}
+	z.A0.SetOne()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *{{.Name}}) Set(x *{{.Name}}) *{{.Name}} {
+	*z = *x
+	return z
+}
+
+// SetElement sets z to the base field element x and returns z
+func (z *{{.Name}}) SetElement(x *{{.ElementName}}) *{{.Name}} {
+	*z = {{.Name}}{}
+	z.A0.Set(x)
+	return z
+}
+
+// SetRandom sets z to a uniform random value
+func (z *{{.Name}}) SetRandom() (*{{.Name}}, error) {
+	{{- range $i := .Indexes}}
+	if _, err := z.A{{$i}}.SetRandom(); err != nil {
+		return nil, err
+	}
+	{{- end}}
+	return z, nil
+}
+
+// IsZero returns true if z == 0
+func (z *{{.Name}}) IsZero() bool {
+	return {{range $i := .Indexes}}{{if $i}} && {{end}}z.A{{$i}}.IsZero(){{end}}
+}
+
+// IsOne returns true if z == 1
+func (z *{{.Name}}) IsOne() bool {
+	return z.A0.IsOne(){{range $i := .Indexes}}{{if $i}} && z.A{{$i}}.IsZero(){{end}}{{end}}
+}
+
+// Add sets z = x + y and returns z
+func (z *{{.Name}}) Add(x, y *{{.Name}}) *{{.Name}} {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Add(&x.A{{$i}}, &y.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *{{.Name}}) Sub(x, y *{{.Name}}) *{{.Name}} {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Sub(&x.A{{$i}}, &y.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *{{.Name}}) Double(x *{{.Name}}) *{{.Name}} {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Double(&x.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *{{.Name}}) Neg(x *{{.Name}}) *{{.Name}} {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Neg(&x.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Halve sets z = z / 2
+func (z *{{.Name}}) Halve() {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Halve()
+	{{- end}}
+}
+
+// MulByElement sets z = x * y where y is in the base field and returns z
+func (z *{{.Name}}) MulByElement(x *{{.Name}}, y *{{.ElementName}}) *{{.Name}} {
+	var yCopy {{.ElementName}}
+	yCopy.Set(y)
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Mul(&x.A{{$i}}, &yCopy)
+	{{- end}}
+	return z
+}
+
+// {{$e}}MulByRootOf sets z = α * x
+func {{$e}}MulByRootOf(z, x *{{.ElementName}}) {
+	{{- if eq .RootOf -1}}
+	z.Neg(x)
+	{{- else}}
+	z.Mul(x, &{{$e}}RootOf)
+	{{- end}}
+}
+
+// Mul sets z = x * y and returns z
+func (z *{{.Name}}) Mul(x, y *{{.Name}}) *{{.Name}} {
+	{{- if eq .Degree 2}}
+	// Karatsuba
+	var a, b, v0, v1 {{.ElementName}}
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	v0.Mul(&x.A0, &y.A0)
+	v1.Mul(&x.A1, &y.A1)
+	z.A1.Mul(&a, &b).Sub(&z.A1, &v0).Sub(&z.A1, &v1)
+	{{$e}}MulByRootOf(&v1, &v1)
+	z.A0.Add(&v0, &v1)
+	{{- else}}
+	// schoolbook multiplication, terms of degree ⩾ {{.Degree}} are reduced with u{{supScr .Degree}} = α
+	var t {{.ElementName}}
+	var c {{.Name}}
+	{{- range $k, $m := .MulTerms}}
+
+	// coefficient of u{{supScr $k}}
+	{{- if $m.High}}
+	{{- range $j, $p := $m.High}}
+	{{- if eq $j 0}}
+	c.A{{$k}}.Mul(&x.A{{index $p 0}}, &y.A{{index $p 1}})
+	{{- else}}
+	t.Mul(&x.A{{index $p 0}}, &y.A{{index $p 1}})
+	c.A{{$k}}.Add(&c.A{{$k}}, &t)
+	{{- end}}
+	{{- end}}
+	{{$e}}MulByRootOf(&c.A{{$k}}, &c.A{{$k}})
+	{{- range $p := $m.Low}}
+	t.Mul(&x.A{{index $p 0}}, &y.A{{index $p 1}})
+	c.A{{$k}}.Add(&c.A{{$k}}, &t)
+	{{- end}}
+	{{- else}}
+	{{- range $j, $p := $m.Low}}
+	{{- if eq $j 0}}
+	c.A{{$k}}.Mul(&x.A{{index $p 0}}, &y.A{{index $p 1}})
+	{{- else}}
+	t.Mul(&x.A{{index $p 0}}, &y.A{{index $p 1}})
+	c.A{{$k}}.Add(&c.A{{$k}}, &t)
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+
+	*z = c
+	{{- end}}
+	return z
+}
+
+// Square sets z = x * x and returns z
+func (z *{{.Name}}) Square(x *{{.Name}}) *{{.Name}} {
+	{{- if eq .Degree 2}}
+	// (a0 + a1*u)² = a0² + α*a1² + 2*a0*a1*u
+	var t0, t1 {{.ElementName}}
+	t0.Mul(&x.A0, &x.A1)
+	t1.Square(&x.A1)
+	{{$e}}MulByRootOf(&t1, &t1)
+	z.A0.Square(&x.A0).Add(&z.A0, &t1)
+	z.A1.Double(&t0)
+	return z
+	{{- else}}
+	return z.Mul(x, x)
+	{{- end}}
+}
+
+{{- if eq .Degree 2}}
+
+// Conjugate sets z to the conjugate of x (a0 - a1*u) and returns z
+func (z *{{.Name}}) Conjugate(x *{{.Name}}) *{{.Name}} {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+{{- end}}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *{{.Name}}) Frobenius(x *{{.Name}}) *{{.Name}} {
+	z.A0 = x.A0
+	{{- range $i := .Indexes}}{{if $i}}
+	z.A{{$i}}.Mul(&x.A{{$i}}, &{{$e}}Frobenius[{{$i}}])
+	{{- end}}{{end}}
+	return z
+}
+
+// Norm returns the norm of z, that is the product of its conjugates z * zᵖ * ... * z^(p{{supScr $last}})
+func (z *{{.Name}}) Norm() {{.ElementName}} {
+	{{- if eq .Degree 2}}
+	// a0² - α*a1²
+	var n, t {{.ElementName}}
+	t.Square(&z.A1)
+	{{$e}}MulByRootOf(&t, &t)
+	n.Square(&z.A0).Sub(&n, &t)
+	return n
+	{{- else}}
+	var c, n {{.Name}}
+	c.conjugatesProduct(z)
+	n.Mul(&c, z)
+	return n.A0
+	{{- end}}
+}
+
+{{- if ne .Degree 2}}
+
+// conjugatesProduct sets z = xᵖ * ... * x^(p{{supScr $last}}), such that x * z = Norm(x)
+func (z *{{.Name}}) conjugatesProduct(x *{{.Name}}) *{{.Name}} {
+	var c, f {{.Name}}
+	f.Frobenius(x)
+	c.Set(&f)
+	for i := 2; i < {{.Degree}}; i++ {
+		f.Frobenius(&f)
+		c.Mul(&c, &f)
+	}
+	return z.Set(&c)
+}
+{{- end}}
+
+// Inverse sets z = x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{.Name}}) Inverse(x *{{.Name}}) *{{.Name}} {
+	{{- if eq .Degree 2}}
+	// (a0 + a1*u)⁻¹ = (a0 - a1*u) / (a0² - α*a1²)
+	n := x.Norm()
+	n.Inverse(&n)
+	z.A0.Mul(&x.A0, &n)
+	z.A1.Mul(&x.A1, &n).Neg(&z.A1)
+	return z
+	{{- else}}
+	// x⁻¹ = (xᵖ * ... * x^(p{{supScr $last}})) / Norm(x)
+	var c, n {{.Name}}
+	c.conjugatesProduct(x)
+	n.Mul(&c, x)
+	n.A0.Inverse(&n.A0)
+	return z.MulByElement(&c, &n.A0)
+	{{- end}}
+}
+
+// Div sets z = x / y and returns z
+func (z *{{.Name}}) Div(x, y *{{.Name}}) *{{.Name}} {
+	var r {{.Name}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// BatchInvert{{.Name}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{.Name}}(a []{{.Name}}) []{{.Name}} {
+	res := make([]{{.Name}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{.Name}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Exp sets z=xᵏ (mod p{{supScr .Degree}}) and returns it
+func (z *{{.Name}}) Exp(x {{.Name}}, k *big.Int) *{{.Name}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod p{{supScr .Degree}}) == (x⁻¹)ᵏ (mod p{{supScr .Degree}})
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in {{.Name}} if and only if its norm is a square in {{.ElementName}}
+func (z *{{.Name}}) Legendre() int {
+	n := z.Norm()
+	return n.Legendre()
+}
+
+// Sqrt z = √x in {{.Name}}
+// if the square root doesn't exist (x is not a square)
+// Sqrt leaves z unchanged and returns nil
+func (z *{{.Name}}) Sqrt(x *{{.Name}}) *{{.Name}} {
+	if x.Legendre() == -1 {
+		return nil
+	}
+
+	// Tonelli-Shanks
+	// see modSqrtTonelliShanks in math/big/int.go
+	var y, b, t, w {{.Name}}
+	// w = x^((s-1)/2))
+	w.Exp(*x, &{{$e}}SqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	g := {{$e}}SqrtG
+	r := uint64({{.ExtSqrtE}})
+
+	if b.IsZero() {
+		return z.SetZero()
+	}
+
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *{{.Name}}) Select(c int, x0 *{{.Name}}, x1 *{{.Name}}) *{{.Name}} {
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.Select(c, &x0.A{{$i}}, &x1.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{.Name}}) String() string {
+	var sbb strings.Builder
+	sbb.WriteString(z.A0.String())
+	{{- range $i := .Indexes}}{{if $i}}
+	sbb.WriteString("+" + z.A{{$i}}.String() + "*u{{if gt $i 1}}{{supScr $i}}{{end}}")
+	{{- end}}{{end}}
+	return sbb.String()
+}
+
+// Bytes returns the big-endian encoding of the coordinates of z, A0 first
+func (z *{{.Name}}) Bytes() (res [Bytes{{.Name}}]byte) {
+	{{- range $i := .Indexes}}
+	BigEndian.PutElement((*[Bytes]byte)(res[{{$i}}*Bytes:{{add $i 1}}*Bytes]), z.A{{$i}})
+	{{- end}}
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *{{.Name}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes.
+// It returns an error if e has the wrong length or a coordinate is not canonical.
+func (z *{{.Name}}) SetBytesCanonical(e []byte) error {
+	if len(e) != Bytes{{.Name}} {
+		return errors.New("invalid {{.PackageName}}.{{.Name}} encoding")
+	}
+	var r {{.Name}}
+	{{- range $i := .Indexes}}
+	if err := r.A{{$i}}.SetBytesCanonical(e[{{$i}}*Bytes : {{add $i 1}}*Bytes]); err != nil {
+		return err
+	}
+	{{- end}}
+	*z = r
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (z *{{.Name}}) MarshalBinary() ([]byte, error) {
+	return z.Marshal(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (z *{{.Name}}) UnmarshalBinary(data []byte) error {
+	return z.SetBytesCanonical(data)
+}
+`
@@ -71,6 +71,22 @@ const MulCIOS = `
 	{{- end}}
 
 
+	{{ if $.ConstantTime}}
+	// if we have a result on {{add 1 $.all.NbWords}} words, we need to subtract q;
+	// t[{{$.all.NbWords}}] is 0 or 1, we don't branch on it
+	mask := -t[{{$.all.NbWords}}]
+	{{- if gt $.all.NbWords 1}}
+	var b uint64
+	{{- end}}
+	z[0], {{- if gt $.all.NbWords 1}}b{{- else}}_{{- end}} = bits.Sub64(t[0], q0&mask, 0)
+	{{- range $i := .all.NbWordsIndexesNoZero}}
+		{{-  if eq $i $.all.NbWordsLastIndex}}
+			z[{{$i}}], _ = bits.Sub64(t[{{$i}}], q{{$i}}&mask, b)
+		{{-  else  }}
+			z[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}&mask, b)
+		{{- end}}
+	{{- end}}
+	{{- else}}
 	if t[{{$.all.NbWords}}] != 0 {
 		// we need to reduce, we have a result on {{add 1 $.all.NbWords}} words
 		{{- if gt $.all.NbWords 1}}
@@ -91,6 +107,7 @@ const MulCIOS = `
 	{{- range $i := $.all.NbWordsIndexesFull}}
 		z[{{$i}}] = t[{{$i}}]
 	{{- end}}
+	{{- end}}
 
 {{ end }}
 
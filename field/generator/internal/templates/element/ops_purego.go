@@ -4,35 +4,7 @@ const OpsNoAsm = `
 
 import "math/bits"
 
-{{ $mulConsts := list 3 5 13 }}
-{{- range $i := $mulConsts }}
-
-// MulBy{{$i}} x *= {{$i}} (mod q)
-func MulBy{{$i}}(x *{{$.ElementName}}) {
-	{{- if eq 1 $.NbWords}}
-	var y {{$.ElementName}}
-	y.SetUint64({{$i}})
-	x.Mul(x, &y)
-	{{- else}}
-		{{- if eq $i 3}}
-			_x := *x
-			x.Double(x).Add(x, &_x)
-		{{- else if eq $i 5}}
-			_x := *x
-			x.Double(x).Double(x).Add(x, &_x)
-		{{- else if eq $i 13}}
-			var y = {{$.ElementName}}{
-				{{- range $i := $.Thirteen}}
-				{{$i}},{{end}}
-			}
-			x.Mul(x, &y)
-		{{- else }}
-			NOT IMPLEMENTED
-		{{- end}}
-	{{- end}}
-}
-
-{{- end}}
+{{ template "mul_by_constants" . }}
 
 // Butterfly sets
 //  a = a + b (mod q)
@@ -93,3 +65,38 @@ func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
 }
 
 `
+
+// MulByConstants is included in the pure Go and arm64 builds; it defines the MulBy3, MulBy5 and MulBy13 functions.
+const MulByConstants = `
+{{ define "mul_by_constants" }}
+{{ $mulConsts := list 3 5 13 }}
+{{- range $i := $mulConsts }}
+
+// MulBy{{$i}} x *= {{$i}} (mod q)
+func MulBy{{$i}}(x *{{$.ElementName}}) {
+	{{- if eq 1 $.NbWords}}
+	var y {{$.ElementName}}
+	y.SetUint64({{$i}})
+	x.Mul(x, &y)
+	{{- else}}
+		{{- if eq $i 3}}
+			_x := *x
+			x.Double(x).Add(x, &_x)
+		{{- else if eq $i 5}}
+			_x := *x
+			x.Double(x).Double(x).Add(x, &_x)
+		{{- else if eq $i 13}}
+			var y = {{$.ElementName}}{
+				{{- range $i := $.Thirteen}}
+				{{$i}},{{end}}
+			}
+			x.Mul(x, &y)
+		{{- else }}
+			NOT IMPLEMENTED
+		{{- end}}
+	{{- end}}
+}
+
+{{- end}}
+{{ end }}
+`
@@ -21,4 +21,22 @@ if !z.smallerThanModulus() {
 
 {{-  end }}
 
+{{ define "reduce_constant_time" }}
+// if {{- if .Carry}} the last addition overflowed or{{- end}} z ⩾ q → z -= q, without branching on z
+{
+	var b uint64
+	var t {{.all.ElementName}}
+	t[0], b = bits.Sub64(z[0], q0, 0)
+	{{- range $i := .all.NbWordsIndexesNoZero}}
+		t[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, b)
+	{{- end}}
+	// keep z iff {{- if .Carry}} carry == 0 and{{- end}} z < q
+	mask := -(b {{- if .Carry}} &^ carry{{- end}})
+	{{- range $i := .all.NbWordsIndexesFull}}
+		z[{{$i}}] = t[{{$i}}] ^ (mask & (t[{{$i}}] ^ z[{{$i}}]))
+	{{- end}}
+}
+
+{{-  end }}
+
 `
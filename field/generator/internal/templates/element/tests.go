@@ -474,6 +474,23 @@ func Test{{toTitle .ElementName}}Bytes(t *testing.T) {
 		genA,
 	))
 
+	properties.Property("BytesConstantTime should match Bytes", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			return a.element.BytesConstantTime() == a.element.Bytes()
+		},
+		genA,
+	))
+
+	properties.Property("SetBytesConstantTime should match SetBytes on inputs of any length", prop.ForAll(
+		func(bytes []byte) bool {
+			var a, b {{.ElementName}}
+			a.SetBytes(bytes)
+			b.SetBytesConstantTime(bytes)
+			return a.Equal(&b)
+		},
+		ggen.SliceOf(ggen.UInt8()),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -734,14 +751,23 @@ func Test{{toTitle .ElementName}}LexicographicallyLargest(t *testing.T) {
 {{template "testBinaryOp" dict "all" . "Op" "Mul" "GenericOp" "_mulGeneric"}}
 {{template "testBinaryOp" dict "all" . "Op" "Div"}}
 {{template "testBinaryOp" dict "all" . "Op" "Exp"}}
+{{template "testBinaryOp" dict "all" . "Op" "AddConstantTime" "BaseOp" "Add"}}
+{{template "testBinaryOp" dict "all" . "Op" "SubConstantTime" "BaseOp" "Sub"}}
+{{template "testBinaryOp" dict "all" . "Op" "MulConstantTime" "BaseOp" "Mul" "GenericOp" "_mulGeneric"}}
 
 {{template "testUnaryOp" dict "all" . "Op" "Square" }}
 {{template "testUnaryOp" dict "all" . "Op" "Inverse"}}
 {{template "testUnaryOp" dict "all" . "Op" "Sqrt"}}
 {{template "testUnaryOp" dict "all" . "Op" "Double"}}
 {{template "testUnaryOp" dict "all" . "Op" "Neg" }}
+{{template "testUnaryOp" dict "all" . "Op" "SquareConstantTime" "BaseOp" "Square"}}
+{{template "testUnaryOp" dict "all" . "Op" "InverseConstantTime" "BaseOp" "Inverse"}}
+{{template "testUnaryOp" dict "all" . "Op" "DoubleConstantTime" "BaseOp" "Double"}}
+{{template "testUnaryOp" dict "all" . "Op" "NegConstantTime" "BaseOp" "Neg"}}
 
 {{ define "testBinaryOp" }}
+{{- $baseOp := .Op}}
+{{- if .BaseOp}}{{- $baseOp = .BaseOp}}{{- end}}
 
 func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 	t.Parallel()
@@ -762,7 +788,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 		func(a, b testPair{{.all.ElementName}}) bool {
 			var c, d {{.all.ElementName}}
 			d.Set(&a.element)
-			{{if eq .Op "Exp"}}
+			{{if eq $baseOp "Exp"}}
 				c.{{.Op}}(a.element, &b.bigint)
 				a.element.{{.Op}}(a.element, &b.bigint)
 				b.element.{{.Op}}(d, &b.bigint)
@@ -781,19 +807,19 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 		func(a, b testPair{{.all.ElementName}}) bool {
 			{
 				var c {{.all.ElementName}}
-				{{if eq .Op "Exp"}}
+				{{if eq $baseOp "Exp"}}
 					c.{{.Op}}(a.element, &b.bigint)
 				{{else}}
 					c.{{.Op}}(&a.element, &b.element)
 				{{end}}
 				var d, e big.Int 
-				{{- if eq .Op "Div"}}
+				{{- if eq $baseOp "Div"}}
 					d.ModInverse(&b.bigint, Modulus())
 					d.Mul(&d, &a.bigint).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if eq $baseOp "Exp"}}
 					d.Exp(&a.bigint, &b.bigint, Modulus())
 				{{- else}}
-					d.{{.Op}}(&a.bigint, &b.bigint).Mod(&d, Modulus())
+					d.{{$baseOp}}(&a.bigint, &b.bigint).Mod(&d, Modulus())
 				{{- end }}
 
 
@@ -814,16 +840,16 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 				r.BigInt(&rb) 
 
 				var c {{.all.ElementName}}
-				{{- if eq .Op "Div"}}
+				{{- if eq $baseOp "Div"}}
 					c.{{.Op}}(&a.element, &r)
 					d.ModInverse(&rb, Modulus())
 					d.Mul(&d, &a.bigint).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if eq $baseOp "Exp"}}
 					c.{{.Op}}(a.element, &rb)
 					d.Exp(&a.bigint, &rb, Modulus())
 				{{- else}}
 					c.{{.Op}}(&a.element, &r)
-					d.{{.Op}}(&a.bigint, &rb).Mod(&d, Modulus())
+					d.{{$baseOp}}(&a.bigint, &rb).Mod(&d, Modulus())
 				{{- end }}
 
 				{{if .GenericOp}}
@@ -849,7 +875,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 	properties.Property("{{.Op}}: operation result must be smaller than modulus", prop.ForAll(
 		func(a, b testPair{{.all.ElementName}}) bool {
 			var c {{.all.ElementName}}
-			{{if eq .Op "Exp"}}
+			{{if eq $baseOp "Exp"}}
 				c.{{.Op}}(a.element, &b.bigint)
 			{{else}}
 				c.{{.Op}}(&a.element, &b.element)
@@ -893,16 +919,16 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 				
 
 
-				{{- if eq .Op "Div"}}
+				{{- if eq $baseOp "Div"}}
 					c.{{.Op}}(&a, &b)
 					d.ModInverse(&bBig, Modulus())
 					d.Mul(&d, &aBig).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if eq $baseOp "Exp"}}
 					c.{{.Op}}(a, &bBig)
 					d.Exp(&aBig, &bBig, Modulus())
 				{{- else}}
 					c.{{.Op}}(&a, &b)
-					d.{{.Op}}(&aBig, &bBig).Mod(&d, Modulus())
+					d.{{$baseOp}}(&aBig, &bBig).Mod(&d, Modulus())
 				{{- end }}
 	
 				{{if .GenericOp}}
@@ -932,6 +958,8 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 
 
 {{ define "testUnaryOp" }}
+{{- $baseOp := .Op}}
+{{- if .BaseOp}}{{- $baseOp = .BaseOp}}{{- end}}
 
 func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 	t.Parallel()
@@ -948,7 +976,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 
 	properties.Property("{{.Op}}: having the receiver as operand should output the same result", prop.ForAll(
 		func(a testPair{{.all.ElementName}}) bool {
-			{{if eq .Op "Sqrt"}}
+			{{if eq $baseOp "Sqrt"}}
 			b := a.element
 			{{else}}
 			var b {{.all.ElementName}}
@@ -966,15 +994,15 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 			c.{{.Op}}(&a.element)
 
 			var d, e big.Int 
-			{{- if eq .Op "Square"}}
+			{{- if eq $baseOp "Square"}}
 				d.Mul(&a.bigint, &a.bigint).Mod(&d, Modulus())
-			{{- else if eq .Op "Inverse"}}
+			{{- else if eq $baseOp "Inverse"}}
 				d.ModInverse(&a.bigint, Modulus())
-			{{- else if eq .Op "Sqrt"}}
+			{{- else if eq $baseOp "Sqrt"}}
 				d.ModSqrt(&a.bigint, Modulus())
-			{{- else if eq .Op "Double"}}
+			{{- else if eq $baseOp "Double"}}
 				d.Lsh(&a.bigint, 1).Mod(&d, Modulus())
-			{{- else if eq .Op "Neg"}}
+			{{- else if eq $baseOp "Neg"}}
 				d.Neg(&a.bigint).Mod(&d, Modulus())
 			{{- end }}
 
@@ -1020,15 +1048,15 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 			c.{{.Op}}(&a)
 
 			var  d, e big.Int 
-			{{- if eq .Op "Square"}}
+			{{- if eq $baseOp "Square"}}
 				d.Mul(&aBig, &aBig).Mod(&d, Modulus())
-			{{- else if eq .Op "Inverse"}}
+			{{- else if eq $baseOp "Inverse"}}
 				d.ModInverse(&aBig, Modulus())
-			{{- else if eq .Op "Sqrt"}}
+			{{- else if eq $baseOp "Sqrt"}}
 				d.ModSqrt(&aBig, Modulus())
-			{{- else if eq .Op "Double"}}
+			{{- else if eq $baseOp "Double"}}
 				d.Lsh(&aBig, 1).Mod(&d, Modulus())
-			{{- else if eq .Op "Neg"}}
+			{{- else if eq $baseOp "Neg"}}
 				d.Neg(&aBig).Mod(&d, Modulus())
 			{{- end }}
 
@@ -0,0 +1,364 @@
+package element
+
+// TestExtension is the test template matching Extension.
+const TestExtension = `
+{{- $e := toLower .Name}}
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+func gen{{.Name}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z {{.Name}}
+		{{- range $i := .Indexes}}
+		z.A{{$i}}.SetUint64(genParams.NextUint64())
+		{{- end}}
+		return gopter.NewGenResult(z, gopter.NoShrinker)
+	}
+}
+
+func {{$e}}TestParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 20
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+	return parameters
+}
+
+// {{$e}}ToBig returns the coordinates of z as big.Int
+func {{$e}}ToBig(z *{{.Name}}) []big.Int {
+	res := make([]big.Int, {{.Degree}})
+	{{- range $i := .Indexes}}
+	z.A{{$i}}.BigInt(&res[{{$i}}])
+	{{- end}}
+	return res
+}
+
+// {{$e}}MulReference is a textbook polynomial multiplication modulo u{{supScr .Degree}} - α
+func {{$e}}MulReference(x, y *{{.Name}}) {{.Name}} {
+	a, b := {{$e}}ToBig(x), {{$e}}ToBig(y)
+	c := make([]big.Int, {{.Degree}})
+	alpha := big.NewInt({{.RootOf}})
+	for i := 0; i < {{.Degree}}; i++ {
+		for j := 0; j < {{.Degree}}; j++ {
+			var t big.Int
+			t.Mul(&a[i], &b[j])
+			if i+j >= {{.Degree}} {
+				t.Mul(&t, alpha)
+			}
+			k := (i + j) % {{.Degree}}
+			c[k].Add(&c[k], &t)
+		}
+	}
+	var res {{.Name}}
+	{{- range $i := .Indexes}}
+	res.A{{$i}}.SetBigInt(&c[{{$i}}])
+	{{- end}}
+	return res
+}
+
+func Test{{.Name}}Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties({{$e}}TestParameters())
+	genA := gen{{.Name}}()
+	genB := gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] Mul should match the reference multiplication", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c {{.Name}}
+			c.Mul(&a, &b)
+			ref := {{$e}}MulReference(&a, &b)
+			return c.Equal(&ref)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c, d {{.Name}}
+			d.Set(&a)
+			c.Mul(&a, &b)
+			a.Mul(&a, &b)
+			b.Mul(&d, &b)
+			return a.Equal(&b) && a.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Square(x) == Mul(x, x)", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Square(&a)
+			c.Mul(&a, &a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Add and Sub should be inverse operations", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c, d {{.Name}}
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Neg(&b).Add(&d, &b)
+			return c.Equal(&a) && d.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Double and Halve should be inverse operations", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Double(&a)
+			c.Add(&a, &a)
+			if !b.Equal(&c) {
+				return false
+			}
+			b.Halve()
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] MulByElement should match Mul", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c, d, e {{.Name}}
+			c.MulByElement(&a, &b.A0)
+			e.SetElement(&b.A0)
+			d.Mul(&a, &e)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] x * x⁻¹ == 1", prop.ForAll(
+		func(a {{.Name}}) bool {
+			if a.IsZero() {
+				return true
+			}
+			var b {{.Name}}
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] (x / y) * y == x", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			if b.IsZero() {
+				return true
+			}
+			var c {{.Name}}
+			c.Div(&a, &b).Mul(&c, &b)
+			return c.Equal(&a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Exp should match repeated multiplications", prop.ForAll(
+		func(a {{.Name}}, k uint8) bool {
+			var b, c {{.Name}}
+			b.Exp(a, big.NewInt(int64(k)))
+			c.SetOne()
+			for i := 0; i < int(k); i++ {
+				c.Mul(&c, &a)
+			}
+			if !b.Equal(&c) {
+				return false
+			}
+			if a.IsZero() {
+				return true
+			}
+			// negative exponent
+			b.Exp(a, big.NewInt(-int64(k))).Mul(&b, &c)
+			return b.IsOne()
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(uint8(genParams.NextUint64()), gopter.NoShrinker)
+		}),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{.Name}}Frobenius(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties({{$e}}TestParameters())
+	genA := gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] Frobenius(x) == xᵖ", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Frobenius(&a)
+			c.Exp(a, Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Frobenius should have order {{.Degree}}", prop.ForAll(
+		func(a {{.Name}}) bool {
+			b := a
+			for i := 0; i < {{.Degree}}; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Norm should be the product of the conjugates", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var c, f {{.Name}}
+			c.Set(&a)
+			f.Set(&a)
+			for i := 1; i < {{.Degree}}; i++ {
+				f.Frobenius(&f)
+				c.Mul(&c, &f)
+			}
+			n := a.Norm()
+			var expected {{.Name}}
+			expected.SetElement(&n)
+			return c.Equal(&expected)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{.Name}}Sqrt(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties({{$e}}TestParameters())
+	genA := gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] Sqrt(x²)² == x²", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Square(&a)
+			if b.Legendre() == -1 {
+				return false
+			}
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Sqrt should fail on non-squares", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Square(&a).Mul(&b, &{{$e}}SqrtG)
+			if a.IsZero() {
+				return true
+			}
+			return b.Legendre() == -1 && c.Sqrt(&b) == nil
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var zero, r {{.Name}}
+	require.NotNil(t, r.Sqrt(&zero))
+	require.True(t, r.IsZero())
+}
+
+func Test{{.Name}}Marshal(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b {{.Name}}
+	_, err := a.SetRandom()
+	assert.NoError(err)
+
+	data, err := a.MarshalBinary()
+	assert.NoError(err)
+	assert.Len(data, Bytes{{.Name}})
+	assert.NoError(b.UnmarshalBinary(data))
+	assert.True(a.Equal(&b))
+
+	// non canonical coordinate
+	q := Modulus().Bytes()
+	copy(data[Bytes-len(q):Bytes], q)
+	assert.Error(b.UnmarshalBinary(data))
+
+	// wrong length
+	assert.Error(b.SetBytesCanonical(data[1:]))
+}
+
+func Test{{.Name}}BatchInvert(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	a := make([]{{.Name}}, 10)
+	for i := range a {
+		if i%3 != 0 {
+			a[i].SetRandom()
+		}
+	}
+	res := BatchInvert{{.Name}}(a)
+	for i := range a {
+		var expected {{.Name}}
+		expected.Inverse(&a[i])
+		assert.True(res[i].Equal(&expected))
+	}
+}
+
+func Benchmark{{.Name}}Mul(b *testing.B) {
+	var x, y {{.Name}}
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func Benchmark{{.Name}}Square(b *testing.B) {
+	var x {{.Name}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func Benchmark{{.Name}}Inverse(b *testing.B) {
+	var x {{.Name}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func Benchmark{{.Name}}Sqrt(b *testing.B) {
+	var x {{.Name}}
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Sqrt(&x)
+	}
+}
+`
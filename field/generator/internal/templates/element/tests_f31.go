@@ -0,0 +1,435 @@
+package element
+
+// TestF31 is the test template matching BaseF31.
+const TestF31 = `
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+// most benchmarks are rudimentary and should sample a large number of random inputs
+// or be run multiple times to ensure it didn't measure the fastest path of the function
+
+var benchRes{{.ElementName}} {{.ElementName}}
+
+func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
+	x := {{.ElementName}}{ {{.RSquare32}} }
+	benchRes{{.ElementName}}.SetOne()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Mul(&benchRes{{.ElementName}}, &x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Square(b *testing.B) {
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Square(&benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Add(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Add(&x, &benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Sub(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Sub(&x, &benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Inverse(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Sqrt(&a)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+// special values to be tested and combined with random values
+var staticTestValues []{{.ElementName}}
+
+func init() {
+	staticTestValues = append(staticTestValues, {{.ElementName}}{}) // zero
+	staticTestValues = append(staticTestValues, One())               // one
+	staticTestValues = append(staticTestValues, rSquare{{.ElementName}}())
+	for i := uint64(2); i <= 8; i++ {
+		var e {{.ElementName}}
+		e.SetUint64(i)
+		staticTestValues = append(staticTestValues, e)
+	}
+	for i := uint64(1); i <= 8; i++ {
+		var e {{.ElementName}}
+		e.SetUint64(uint64(q) - i)
+		staticTestValues = append(staticTestValues, e)
+	}
+	var e {{.ElementName}}
+	e.SetUint64(uint64(q) >> 1)
+	staticTestValues = append(staticTestValues, e)
+	e.SetUint64(uint64(q)>>1 + 1)
+	staticTestValues = append(staticTestValues, e)
+}
+
+// rSquare{{.ElementName}} is a value with a large internal representation
+func rSquare{{.ElementName}}() {{.ElementName}} {
+	return {{.ElementName}}{ {{.RSquare32}} }
+}
+
+func testParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	return parameters
+}
+
+type testPair{{.ElementName}} struct {
+	element {{.ElementName}}
+	bigint  big.Int
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPair{{.ElementName}}
+
+		g.element = {{.ElementName}}{uint32(genParams.NextUint64() % uint64(q))}
+		g.element.BigInt(&g.bigint)
+		return gopter.NewGenResult(g, gopter.NoShrinker)
+	}
+}
+
+// checkBinary checks op against the big.Int reference on random and static values
+func checkBinary(t *testing.T, name string, op func(z, x, y *{{.ElementName}}), ref func(z, x, y *big.Int)) {
+	t.Helper()
+
+	check := func(a, b *{{.ElementName}}) bool {
+		var c {{.ElementName}}
+		op(&c, a, b)
+
+		var ba, bb, bc, got big.Int
+		a.BigInt(&ba)
+		b.BigInt(&bb)
+		ref(&bc, &ba, &bb)
+		bc.Mod(&bc, Modulus())
+		return c.smallerThanModulus() && c.BigInt(&got).Cmp(&bc) == 0
+	}
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property(name+": must match big.Int result", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			return check(&a.element, &b.element)
+		},
+		gen(), gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			if !check(&staticTestValues[i], &staticTestValues[j]) {
+				t.Fatalf("%s failed on static values %s, %s", name, staticTestValues[i].String(), staticTestValues[j].String())
+			}
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}Add(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Add", func(z, x, y *{{.ElementName}}) { z.Add(x, y) }, func(z, x, y *big.Int) { z.Add(x, y) })
+}
+
+func Test{{toTitle .ElementName}}Sub(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Sub", func(z, x, y *{{.ElementName}}) { z.Sub(x, y) }, func(z, x, y *big.Int) { z.Sub(x, y) })
+}
+
+func Test{{toTitle .ElementName}}Mul(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Mul", func(z, x, y *{{.ElementName}}) { z.Mul(x, y) }, func(z, x, y *big.Int) { z.Mul(x, y) })
+}
+
+func Test{{toTitle .ElementName}}Div(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Div", func(z, x, y *{{.ElementName}}) { z.Div(x, y) }, func(z, x, y *big.Int) {
+		if y.Sign() == 0 {
+			z.SetUint64(0)
+			return
+		}
+		z.ModInverse(y, Modulus()).Mul(z, x)
+	})
+}
+
+func Test{{toTitle .ElementName}}Exp(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Exp", func(z, x, y *{{.ElementName}}) {
+		var e big.Int
+		y.BigInt(&e)
+		z.Exp(*x, &e)
+	}, func(z, x, y *big.Int) { z.Exp(x, y, Modulus()) })
+}
+
+func Test{{toTitle .ElementName}}Unary(t *testing.T) {
+	t.Parallel()
+	ignore := func(f func(z, x *{{.ElementName}})) func(z, x, y *{{.ElementName}}) {
+		return func(z, x, _ *{{.ElementName}}) { f(z, x) }
+	}
+	ignoreBig := func(f func(z, x *big.Int)) func(z, x, y *big.Int) {
+		return func(z, x, _ *big.Int) { f(z, x) }
+	}
+
+	checkBinary(t, "Square", ignore(func(z, x *{{.ElementName}}) { z.Square(x) }), ignoreBig(func(z, x *big.Int) { z.Mul(x, x) }))
+	checkBinary(t, "Double", ignore(func(z, x *{{.ElementName}}) { z.Double(x) }), ignoreBig(func(z, x *big.Int) { z.Lsh(x, 1) }))
+	checkBinary(t, "Neg", ignore(func(z, x *{{.ElementName}}) { z.Neg(x) }), ignoreBig(func(z, x *big.Int) { z.Neg(x) }))
+	checkBinary(t, "Halve", ignore(func(z, x *{{.ElementName}}) {
+		z.Set(x)
+		z.Halve()
+	}), ignoreBig(func(z, x *big.Int) { z.ModInverse(big.NewInt(2), Modulus()).Mul(z, x) }))
+	checkBinary(t, "Inverse", ignore(func(z, x *{{.ElementName}}) { z.Inverse(x) }), ignoreBig(func(z, x *big.Int) {
+		if x.Sign() == 0 {
+			z.SetUint64(0)
+			return
+		}
+		z.ModInverse(x, Modulus())
+	}))
+	checkBinary(t, "MulBy3", ignore(func(z, x *{{.ElementName}}) {
+		z.Set(x)
+		MulBy3(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(3)) }))
+	checkBinary(t, "MulBy5", ignore(func(z, x *{{.ElementName}}) {
+		z.Set(x)
+		MulBy5(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(5)) }))
+	checkBinary(t, "MulBy13", ignore(func(z, x *{{.ElementName}}) {
+		z.Set(x)
+		MulBy13(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(13)) }))
+}
+
+func Test{{toTitle .ElementName}}Butterfly(t *testing.T) {
+	t.Parallel()
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			a, b := staticTestValues[i], staticTestValues[j]
+			var sum, diff {{.ElementName}}
+			sum.Add(&a, &b)
+			diff.Sub(&a, &b)
+			Butterfly(&a, &b)
+			if !a.Equal(&sum) || !b.Equal(&diff) {
+				t.Fatal("butterfly doesn't match add/sub")
+			}
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}SqrtLegendre(t *testing.T) {
+	t.Parallel()
+
+	check := func(a *{{.ElementName}}) bool {
+		var ba big.Int
+		a.BigInt(&ba)
+		if a.Legendre() != big.Jacobi(&ba, Modulus()) {
+			return false
+		}
+		var s, square {{.ElementName}}
+		if s.Sqrt(a) == nil {
+			return a.Legendre() == -1
+		}
+		return square.Square(&s).Equal(a)
+	}
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property("Sqrt and Legendre must be consistent with big.Int", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var square {{.ElementName}}
+			square.Square(&a.element)
+			return check(&a.element) && check(&square)
+		},
+		gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		if !check(&staticTestValues[i]) {
+			t.Fatal("Sqrt failed on static value", staticTestValues[i].String())
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}Bytes(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	for _, a := range staticTestValues {
+		b := a.Bytes()
+		var c {{.ElementName}}
+		assert.NoError(c.SetBytesCanonical(b[:]))
+		assert.True(c.Equal(&a))
+
+		var le [Bytes]byte
+		LittleEndian.PutElement(&le, a)
+		d, err := LittleEndian.Element(&le)
+		assert.NoError(err)
+		assert.True(d.Equal(&a))
+
+		var bi big.Int
+		a.BigInt(&bi)
+		assert.Equal(bi.String(), new(big.Int).SetBytes(b[:]).String())
+	}
+
+	// q is not a canonical encoding
+	qBytes := Modulus().Bytes()
+	var c {{.ElementName}}
+	assert.Error(c.SetBytesCanonical(qBytes))
+
+	// SetBytes reduces
+	c.SetBytes(qBytes)
+	assert.True(c.IsZero())
+}
+
+func Test{{toTitle .ElementName}}SetInt64(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	for _, v := range []int64{0, 1, -1, 42, -42, 1 << 40, -(1 << 40), 1<<63 - 1, -1 << 63} {
+		var a {{.ElementName}}
+		a.SetInt64(v)
+
+		var expected, got big.Int
+		expected.SetInt64(v).Mod(&expected, Modulus())
+		assert.Equal(expected.String(), a.BigInt(&got).String(), "SetInt64(%d)", v)
+	}
+}
+
+func Test{{toTitle .ElementName}}SetString(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b {{.ElementName}}
+	_, err := a.SetString("0x10")
+	assert.NoError(err)
+	b.SetUint64(16)
+	assert.True(a.Equal(&b))
+	assert.Equal("10", a.Text(16))
+
+	{{- if not (ltu64 (index $.Q 0) 1000000)}}
+	b.SetInt64(-3)
+	assert.Equal("-3", b.String())
+	{{- end}}
+
+	_, err = a.SetString("not a number")
+	assert.Error(err)
+}
+
+func Test{{toTitle .ElementName}}JSON(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	type S struct {
+		A {{.ElementName}}
+		B [3]{{.ElementName}}
+		C *{{.ElementName}}
+		D *{{.ElementName}}
+	}
+
+	var s S
+	s.A.SetString("-1")
+	s.B[2].SetUint64(42)
+	s.D = new({{.ElementName}}).SetUint64(8000)
+
+	encoded, err := json.Marshal(&s)
+	assert.NoError(err)
+
+	var decoded S
+	assert.NoError(json.Unmarshal(encoded, &decoded))
+	assert.Equal(s, decoded)
+}
+
+func Test{{toTitle .ElementName}}BatchInvert(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	res := BatchInvert(staticTestValues)
+	for i := range staticTestValues {
+		var expected {{.ElementName}}
+		expected.Inverse(&staticTestValues[i])
+		assert.True(res[i].Equal(&expected))
+	}
+}
+
+func Test{{toTitle .ElementName}}LexicographicallyLargest(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property("element.Cmp should match LexicographicallyLargest output", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var negA {{.ElementName}}
+			negA.Neg(&a.element)
+
+			cmpResult := a.element.Cmp(&negA)
+			lResult := a.element.LexicographicallyLargest()
+
+			if lResult && cmpResult == 1 {
+				return true
+			}
+			if !lResult && cmpResult != 1 {
+				return true
+			}
+			return false
+		},
+		gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}Select(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b, c {{.ElementName}}
+	a.SetUint64(1)
+	b.SetUint64(2)
+	assert.True(c.Select(0, &a, &b).Equal(&a))
+	assert.True(c.Select(1, &a, &b).Equal(&b))
+	assert.True(c.Select(-7, &a, &b).Equal(&b))
+}
+`
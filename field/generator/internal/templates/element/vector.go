@@ -6,11 +6,12 @@ import (
 	"encoding/binary"
 	"strings"
 	"bytes"
-	"runtime"
 	"unsafe"
-	"sync"
 	"sync/atomic"
 	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // Vector represents a slice of {{.ElementName}}.
@@ -22,6 +23,25 @@ import (
 //	- encoding.BinaryMarshaler
 //	- encoding.BinaryUnmarshaler
 //	- sort.Interface
+//
+// The arithmetic methods (Add, Sub, ScalarMul, Mul, Sum, InnerProduct) use assembly where it
+// is available, and the pure Go implementation otherwise:
+{{- if .ASMVector}}
+//	- amd64: Add and Sub in assembly; Mul, ScalarMul and InnerProduct with AVX-512 IFMA
+{{- if .ASMVectorAVX2Mul}}
+//	  or AVX2, and Sum with AVX-512 or AVX2, if the CPU supports them.
+{{- else}}
+//	  and Sum with AVX-512 or AVX2, if the CPU supports them. Without IFMA, Mul and ScalarMul
+//	  use the scalar assembly multiplication, which is faster than the AVX2 one.
+{{- end}}
+{{- else}}
+//	- amd64: none.
+{{- end}}
+{{- if .ASMArm64}}
+//	- arm64: Add, Sub, ScalarMul and Mul in assembly.
+{{- else}}
+//	- arm64: none.
+{{- end}}
 type Vector []{{.ElementName}}
 
 // MarshalBinary implements encoding.BinaryMarshaler
@@ -69,7 +89,7 @@ func (vector *Vector) WriteTo(w io.Writer) (int64, error) {
 // It consumes the needed bytes from the reader and returns the number of bytes read and an error if any.
 // It also returns a channel that will be closed when the validation is done.
 // The validation consist of checking that the elements are smaller than the modulus, and
-// converting them to montgomery form.
+// converting them to {{if .Mersenne31}}the internal representation{{else}}montgomery form{{end}}.
 func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 	chErr := make(chan error, 1)
 	var buf [Bytes]byte 
@@ -98,7 +118,7 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 	go func() {
 		var cptErrors uint64
 		// process the elements in parallel
-		execute(int(sliceLen), func(start, end int) {
+		scheduler.Execute(nil, int(sliceLen), func(start, end int) {
 			
 			var z {{.ElementName}}
 			for i:=start; i < end; i++ {
@@ -106,6 +126,9 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 				bstart := i*Bytes
 				bend := bstart + Bytes
 				b := bSlice[bstart:bend]
+				{{- if .F31}}
+				z[0] = binary.BigEndian.Uint32(b[0:4])
+				{{- else}}
 				{{- range $i := reverse .NbWordsIndexesFull}}
 					{{- $j := mul $i 8}}
 					{{- $k := sub $.NbWords 1}}
@@ -113,6 +136,7 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 					{{- $jj := add $j 8}}
 					z[{{$k}}] = binary.BigEndian.Uint64(b[{{$j}}:{{$jj}}])
 				{{- end}}
+				{{- end}}
 
 				if !z.smallerThanModulus() {
 					atomic.AddUint64(&cptErrors, 1)
@@ -191,59 +215,80 @@ func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
 
-
-// TODO @gbotrel make a public package out of that.
-// execute executes the work function in parallel.
-// this is copy paste from internal/parallel/parallel.go
-// as we don't want to generate code importing internal/ 
-func execute(nbIterations int, work func(int, int), maxCpus ...int) {
-
-	nbTasks := runtime.NumCPU()
-	if len(maxCpus) == 1 {
-		nbTasks = maxCpus[0]
-		if nbTasks < 1 {
-			nbTasks = 1
-		} else if nbTasks > 512 {
-			nbTasks = 512
+// Exp sets vector[i] = a[i]ᵏ for all i.
+// If k is negative, the elements are inverted first (the inverse of 0 being 0).
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Exp(a Vector, k int64) {
+	N := len(a)
+	if N != len(*vector) {
+		panic("vector.Exp: vectors don't have the same length")
+	}
+	if N == 0 {
+		return
+	}
+	if k == 0 {
+		for i := range *vector {
+			(*vector)[i].SetOne()
 		}
+		return
 	}
 
-	if nbTasks == 1 {
-		// no go routines
-		work(0, nbIterations)
-		return
+	e := uint64(k)
+	var base Vector
+	if k < 0 {
+		e = -e
+		base = BatchInvert(a)
+	} else {
+		base = make(Vector, N)
+		copy(base, a)
 	}
 
-	nbIterationsPerCpus := nbIterations / nbTasks
+	// left to right square and multiply
+	copy(*vector, base)
+	for i := bits.Len64(e) - 2; i >= 0; i-- {
+		vector.Mul(*vector, *vector)
+		if (e>>uint(i))&1 == 1 {
+			vector.Mul(*vector, base)
+		}
+	}
+}
 
-	// more CPUs than tasks: a CPU will work on exactly one iteration
-	if nbIterationsPerCpus < 1 {
-		nbIterationsPerCpus = 1
-		nbTasks = nbIterations
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
 	}
+}
 
-	var wg sync.WaitGroup
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
 
-	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
-	extraTasksOffset := 0
+func scalarMulVecGeneric(res, a Vector, b *{{.ElementName}}) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
 
-	for i := 0; i < nbTasks; i++ {
-		wg.Add(1)
-		_start := i*nbIterationsPerCpus + extraTasksOffset
-		_end := _start + nbIterationsPerCpus
-		if extraTasks > 0 {
-			_end++
-			extraTasks--
-			extraTasksOffset++
-		}
-		go func() {
-			work(_start, _end)
-			wg.Done()
-		}()
+func sumVecGeneric(res *{{.ElementName}}, a Vector) {
+	for i := 0; i < len(a); i++ {
+		res.Add(res, &a[i])
 	}
+}
 
-	wg.Wait()
+func innerProductVecGeneric(res *{{.ElementName}}, a, b Vector) {
+	var tmp {{.ElementName}}
+	for i := 0; i < len(a); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(res, &tmp)
+	}
 }
 
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
 
 `
@@ -17,5 +17,6 @@ package cmd
 import "errors"
 
 var (
-	errMissingArgument = errors.New("missing argument")
+	errMissingArgument  = errors.New("missing argument")
+	errInvalidExtension = errors.New("invalid extension, expected degree:non-residue")
 )
@@ -20,6 +20,7 @@ import (
 	"math/bits"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/consensys/gnark-crypto/field/generator"
@@ -40,6 +41,8 @@ var (
 	fOutputDir   string
 	fPackageName string
 	fElementName string
+	fWord32      bool
+	fExtensions  []string
 )
 
 func init() {
@@ -48,6 +51,8 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&fModulus, "modulus", "m", "", "field modulus (base 10)")
 	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
 	rootCmd.PersistentFlags().StringVarP(&fPackageName, "package", "p", "", "package name in generated files")
+	rootCmd.PersistentFlags().StringSliceVarP(&fExtensions, "extension", "x", nil, "binomial extensions to generate, as degree:non-residue (e.g. 4:11 for E4 = Fp[u]/(u⁴-11))")
+	rootCmd.PersistentFlags().BoolVar(&fWord32, "word32", false, "generate a single 32 bit word element (modulus < 2³¹, e.g. BabyBear, KoalaBear or Mersenne-31)")
 	if bits.UintSize != 64 {
 		panic("goff only supports 64bits architectures")
 	}
@@ -66,7 +71,13 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 	}
 
 	// generate code
-	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
+	var F *field.FieldConfig
+	var err error
+	if fWord32 {
+		F, err = field.NewFieldConfig32(fPackageName, fElementName, fModulus)
+	} else {
+		F, err = field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
+	}
 	if err != nil {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
@@ -75,6 +86,34 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
+	for _, e := range fExtensions {
+		ext, err := parseExtension(F, e)
+		if err != nil {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+		if err := generator.GenerateExtension(F, ext, fOutputDir); err != nil {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+	}
+}
+
+// parseExtension parses an extension description degree:non-residue
+func parseExtension(F *field.FieldConfig, s string) (field.Extension, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return field.Extension{}, fmt.Errorf("%w: %q", errInvalidExtension, s)
+	}
+	degree, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return field.Extension{}, fmt.Errorf("%w: %q", errInvalidExtension, s)
+	}
+	rootOf, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return field.Extension{}, fmt.Errorf("%w: %q", errInvalidExtension, s)
+	}
+	return field.NewExtension(F, uint8(degree), rootOf)
 }
 
 func parseFlags(cmd *cobra.Command) error {
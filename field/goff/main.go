@@ -20,6 +20,10 @@
 //
 //	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element
 //
+// Small fields (q < 2³¹) use a single 32 bit word, and binomial extensions can be generated alongside:
+//
+//	goff -m 2013265921 -o ./babybear/ -p babybear -e Element --word32 -x 4:11
+//
 // # Warning
 //
 // The generated code has not been audited for all moduli (only bn254 and bls12-381) and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -0,0 +1,415 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// E2 is a degree 2 extension of Element: E2 = Element[u]/(u² - (7))
+//
+// z = A0 + A1*u
+type E2 struct {
+	A0, A1 Element
+}
+
+// BytesE2 is the number of bytes needed to encode an E2
+const BytesE2 = 2 * Bytes
+
+// e2RootOf is α such that u² = α
+var e2RootOf = Element{30064771065}
+
+// e2Frobenius[i] = α^(i(p-1)/2), such that (uⁱ)ᵖ = e2Frobenius[i] * uⁱ
+var e2Frobenius = [2]Element{
+	{4294967295},
+	{18446744065119617026},
+}
+
+// e2SqrtG = zˢ where z is a non-square in E2 and p²-1 = 2ᵉ * s, s odd
+var e2SqrtG = E2{
+	A0: Element{0},
+	A1: Element{5882816312994834096},
+}
+
+// e2SqrtSMinusOneOver2 = (s-1)/2
+var e2SqrtSMinusOneOver2 big.Int
+
+func init() {
+	e2SqrtSMinusOneOver2.SetString("3fffffff80000000bfffffff", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	*z = E2{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	*z = E2{}
+	z.A0.SetOne()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetElement sets z to the base field element x and returns z
+func (z *E2) SetElement(x *Element) *E2 {
+	*z = E2{}
+	z.A0.Set(x)
+	return z
+}
+
+// SetRandom sets z to a uniform random value
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z == 0
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z == 1
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Halve sets z = z / 2
+func (z *E2) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+}
+
+// MulByElement sets z = x * y where y is in the base field and returns z
+func (z *E2) MulByElement(x *E2, y *Element) *E2 {
+	var yCopy Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	return z
+}
+
+// e2MulByRootOf sets z = α * x
+func e2MulByRootOf(z, x *Element) {
+	z.Mul(x, &e2RootOf)
+}
+
+// Mul sets z = x * y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	// Karatsuba
+	var a, b, v0, v1 Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	v0.Mul(&x.A0, &y.A0)
+	v1.Mul(&x.A1, &y.A1)
+	z.A1.Mul(&a, &b).Sub(&z.A1, &v0).Sub(&z.A1, &v1)
+	e2MulByRootOf(&v1, &v1)
+	z.A0.Add(&v0, &v1)
+	return z
+}
+
+// Square sets z = x * x and returns z
+func (z *E2) Square(x *E2) *E2 {
+	// (a0 + a1*u)² = a0² + α*a1² + 2*a0*a1*u
+	var t0, t1 Element
+	t0.Mul(&x.A0, &x.A1)
+	t1.Square(&x.A1)
+	e2MulByRootOf(&t1, &t1)
+	z.A0.Square(&x.A0).Add(&z.A0, &t1)
+	z.A1.Double(&t0)
+	return z
+}
+
+// Conjugate sets z to the conjugate of x (a0 - a1*u) and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E2) Frobenius(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &e2Frobenius[1])
+	return z
+}
+
+// Norm returns the norm of z, that is the product of its conjugates z * zᵖ * ... * z^(p¹)
+func (z *E2) Norm() Element {
+	// a0² - α*a1²
+	var n, t Element
+	t.Square(&z.A1)
+	e2MulByRootOf(&t, &t)
+	n.Square(&z.A0).Sub(&n, &t)
+	return n
+}
+
+// Inverse sets z = x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) Inverse(x *E2) *E2 {
+	// (a0 + a1*u)⁻¹ = (a0 - a1*u) / (a0² - α*a1²)
+	n := x.Norm()
+	n.Inverse(&n)
+	z.A0.Mul(&x.A0, &n)
+	z.A1.Mul(&x.A1, &n).Neg(&z.A1)
+	return z
+}
+
+// Div sets z = x / y and returns z
+func (z *E2) Div(x, y *E2) *E2 {
+	var r E2
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// BatchInvertE2 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E2
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Exp sets z=xᵏ (mod p²) and returns it
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod p²) == (x⁻¹)ᵏ (mod p²)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E2 if and only if its norm is a square in Element
+func (z *E2) Legendre() int {
+	n := z.Norm()
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E2
+// if the square root doesn't exist (x is not a square)
+// Sqrt leaves z unchanged and returns nil
+func (z *E2) Sqrt(x *E2) *E2 {
+	if x.Legendre() == -1 {
+		return nil
+	}
+
+	// Tonelli-Shanks
+	// see modSqrtTonelliShanks in math/big/int.go
+	var y, b, t, w E2
+	// w = x^((s-1)/2))
+	w.Exp(*x, &e2SqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	g := e2SqrtG
+	r := uint64(33)
+
+	if b.IsZero() {
+		return z.SetZero()
+	}
+
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E2) Select(c int, x0 *E2, x1 *E2) *E2 {
+	z.A0.Select(c, &x0.A0, &x1.A0)
+	z.A1.Select(c, &x0.A1, &x1.A1)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E2) String() string {
+	var sbb strings.Builder
+	sbb.WriteString(z.A0.String())
+	sbb.WriteString("+" + z.A1.String() + "*u")
+	return sbb.String()
+}
+
+// Bytes returns the big-endian encoding of the coordinates of z, A0 first
+func (z *E2) Bytes() (res [BytesE2]byte) {
+	BigEndian.PutElement((*[Bytes]byte)(res[0*Bytes:1*Bytes]), z.A0)
+	BigEndian.PutElement((*[Bytes]byte)(res[1*Bytes:2*Bytes]), z.A1)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *E2) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes.
+// It returns an error if e has the wrong length or a coordinate is not canonical.
+func (z *E2) SetBytesCanonical(e []byte) error {
+	if len(e) != BytesE2 {
+		return errors.New("invalid goldilocks.E2 encoding")
+	}
+	var r E2
+	if err := r.A0.SetBytesCanonical(e[0*Bytes : 1*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A1.SetBytesCanonical(e[1*Bytes : 2*Bytes]); err != nil {
+		return err
+	}
+	*z = r
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (z *E2) MarshalBinary() ([]byte, error) {
+	return z.Marshal(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (z *E2) UnmarshalBinary(data []byte) error {
+	return z.SetBytesCanonical(data)
+}
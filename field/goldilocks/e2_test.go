@@ -0,0 +1,372 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+func genE2() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z E2
+		z.A0.SetUint64(genParams.NextUint64())
+		z.A1.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(z, gopter.NoShrinker)
+	}
+}
+
+func e2TestParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 20
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+	return parameters
+}
+
+// e2ToBig returns the coordinates of z as big.Int
+func e2ToBig(z *E2) []big.Int {
+	res := make([]big.Int, 2)
+	z.A0.BigInt(&res[0])
+	z.A1.BigInt(&res[1])
+	return res
+}
+
+// e2MulReference is a textbook polynomial multiplication modulo u² - α
+func e2MulReference(x, y *E2) E2 {
+	a, b := e2ToBig(x), e2ToBig(y)
+	c := make([]big.Int, 2)
+	alpha := big.NewInt(7)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			var t big.Int
+			t.Mul(&a[i], &b[j])
+			if i+j >= 2 {
+				t.Mul(&t, alpha)
+			}
+			k := (i + j) % 2
+			c[k].Add(&c[k], &t)
+		}
+	}
+	var res E2
+	res.A0.SetBigInt(&c[0])
+	res.A1.SetBigInt(&c[1])
+	return res
+}
+
+func TestE2Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e2TestParameters())
+	genA := genE2()
+	genB := genE2()
+
+	properties.Property("[E2] Mul should match the reference multiplication", prop.ForAll(
+		func(a, b E2) bool {
+			var c E2
+			c.Mul(&a, &b)
+			ref := e2MulReference(&a, &b)
+			return c.Equal(&ref)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b E2) bool {
+			var c, d E2
+			d.Set(&a)
+			c.Mul(&a, &b)
+			a.Mul(&a, &b)
+			b.Mul(&d, &b)
+			return a.Equal(&b) && a.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Square(x) == Mul(x, x)", prop.ForAll(
+		func(a E2) bool {
+			var b, c E2
+			b.Square(&a)
+			c.Mul(&a, &a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Add and Sub should be inverse operations", prop.ForAll(
+		func(a, b E2) bool {
+			var c, d E2
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Neg(&b).Add(&d, &b)
+			return c.Equal(&a) && d.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Double and Halve should be inverse operations", prop.ForAll(
+		func(a E2) bool {
+			var b, c E2
+			b.Double(&a)
+			c.Add(&a, &a)
+			if !b.Equal(&c) {
+				return false
+			}
+			b.Halve()
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] MulByElement should match Mul", prop.ForAll(
+		func(a, b E2) bool {
+			var c, d, e E2
+			c.MulByElement(&a, &b.A0)
+			e.SetElement(&b.A0)
+			d.Mul(&a, &e)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] x * x⁻¹ == 1", prop.ForAll(
+		func(a E2) bool {
+			if a.IsZero() {
+				return true
+			}
+			var b E2
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E2] (x / y) * y == x", prop.ForAll(
+		func(a, b E2) bool {
+			if b.IsZero() {
+				return true
+			}
+			var c E2
+			c.Div(&a, &b).Mul(&c, &b)
+			return c.Equal(&a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Exp should match repeated multiplications", prop.ForAll(
+		func(a E2, k uint8) bool {
+			var b, c E2
+			b.Exp(a, big.NewInt(int64(k)))
+			c.SetOne()
+			for i := 0; i < int(k); i++ {
+				c.Mul(&c, &a)
+			}
+			if !b.Equal(&c) {
+				return false
+			}
+			if a.IsZero() {
+				return true
+			}
+			// negative exponent
+			b.Exp(a, big.NewInt(-int64(k))).Mul(&b, &c)
+			return b.IsOne()
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(uint8(genParams.NextUint64()), gopter.NoShrinker)
+		}),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE2Frobenius(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e2TestParameters())
+	genA := genE2()
+
+	properties.Property("[E2] Frobenius(x) == xᵖ", prop.ForAll(
+		func(a E2) bool {
+			var b, c E2
+			b.Frobenius(&a)
+			c.Exp(a, Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Frobenius should have order 2", prop.ForAll(
+		func(a E2) bool {
+			b := a
+			for i := 0; i < 2; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Norm should be the product of the conjugates", prop.ForAll(
+		func(a E2) bool {
+			var c, f E2
+			c.Set(&a)
+			f.Set(&a)
+			for i := 1; i < 2; i++ {
+				f.Frobenius(&f)
+				c.Mul(&c, &f)
+			}
+			n := a.Norm()
+			var expected E2
+			expected.SetElement(&n)
+			return c.Equal(&expected)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE2Sqrt(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(e2TestParameters())
+	genA := genE2()
+
+	properties.Property("[E2] Sqrt(x²)² == x²", prop.ForAll(
+		func(a E2) bool {
+			var b, c E2
+			b.Square(&a)
+			if b.Legendre() == -1 {
+				return false
+			}
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Sqrt should fail on non-squares", prop.ForAll(
+		func(a E2) bool {
+			var b, c E2
+			b.Square(&a).Mul(&b, &e2SqrtG)
+			if a.IsZero() {
+				return true
+			}
+			return b.Legendre() == -1 && c.Sqrt(&b) == nil
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var zero, r E2
+	require.NotNil(t, r.Sqrt(&zero))
+	require.True(t, r.IsZero())
+}
+
+func TestE2Marshal(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b E2
+	_, err := a.SetRandom()
+	assert.NoError(err)
+
+	data, err := a.MarshalBinary()
+	assert.NoError(err)
+	assert.Len(data, BytesE2)
+	assert.NoError(b.UnmarshalBinary(data))
+	assert.True(a.Equal(&b))
+
+	// non canonical coordinate
+	q := Modulus().Bytes()
+	copy(data[Bytes-len(q):Bytes], q)
+	assert.Error(b.UnmarshalBinary(data))
+
+	// wrong length
+	assert.Error(b.SetBytesCanonical(data[1:]))
+}
+
+func TestE2BatchInvert(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	a := make([]E2, 10)
+	for i := range a {
+		if i%3 != 0 {
+			a[i].SetRandom()
+		}
+	}
+	res := BatchInvertE2(a)
+	for i := range a {
+		var expected E2
+		expected.Inverse(&a[i])
+		assert.True(res[i].Equal(&expected))
+	}
+}
+
+func BenchmarkE2Mul(b *testing.B) {
+	var x, y E2
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE2Square(b *testing.B) {
+	var x E2
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var x E2
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkE2Sqrt(b *testing.B) {
+	var x E2
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Sqrt(&x)
+	}
+}
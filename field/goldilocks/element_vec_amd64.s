@@ -0,0 +1,793 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xffffffff00000001
+GLOBL q<>(SB), (RODATA+NOPTR), $8
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0xfffffffeffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, rb0) \
+	MOVQ    ra0, rb0;     \
+	SUBQ    q<>(SB), ra0; \
+	CMOVQCS rb0, ra0;     \
+
+// indexes of the words of 8 consecutive elements, used to gather or scatter them
+DATA indexGatherScatter<>+0(SB)/8, $0
+DATA indexGatherScatter<>+8(SB)/8, $1
+DATA indexGatherScatter<>+16(SB)/8, $2
+DATA indexGatherScatter<>+24(SB)/8, $3
+DATA indexGatherScatter<>+32(SB)/8, $4
+DATA indexGatherScatter<>+40(SB)/8, $5
+DATA indexGatherScatter<>+48(SB)/8, $6
+DATA indexGatherScatter<>+56(SB)/8, $7
+GLOBL indexGatherScatter<>(SB), (RODATA+NOPTR), $64
+
+// limbs of 52 bits of q, used by mulVec
+DATA qLimbs52<>+0(SB)/8, $0xfffff00000001
+DATA qLimbs52<>+8(SB)/8, $0xfff
+GLOBL qLimbs52<>(SB), (RODATA+NOPTR), $16
+
+// limbs of 29 bits of q, repeated in the 4 lanes of a register, used by mulVecAVX2
+DATA qLimbs29<>+0(SB)/8, $0x1
+DATA qLimbs29<>+8(SB)/8, $0x1
+DATA qLimbs29<>+16(SB)/8, $0x1
+DATA qLimbs29<>+24(SB)/8, $0x1
+DATA qLimbs29<>+32(SB)/8, $0x1ffffff8
+DATA qLimbs29<>+40(SB)/8, $0x1ffffff8
+DATA qLimbs29<>+48(SB)/8, $0x1ffffff8
+DATA qLimbs29<>+56(SB)/8, $0x1ffffff8
+DATA qLimbs29<>+64(SB)/8, $0x3f
+DATA qLimbs29<>+72(SB)/8, $0x3f
+DATA qLimbs29<>+80(SB)/8, $0x3f
+DATA qLimbs29<>+88(SB)/8, $0x3f
+GLOBL qLimbs29<>(SB), (RODATA+NOPTR), $96
+
+// -q⁻¹ mod 2²⁹, repeated in the 4 lanes of a register, used by mulVecAVX2
+DATA qInvNeg29<>+0(SB)/8, $0x1fffffff
+DATA qInvNeg29<>+8(SB)/8, $0x1fffffff
+DATA qInvNeg29<>+16(SB)/8, $0x1fffffff
+DATA qInvNeg29<>+24(SB)/8, $0x1fffffff
+GLOBL qInvNeg29<>(SB), (RODATA+NOPTR), $32
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2     // n == 0, we are done
+
+	// t = a[i] + b[i]
+	MOVQ 0(DX), DI
+	ADDQ 0(CX), DI
+	MOVQ $0, SI
+	ADCQ $0, SI    // c = carry
+
+	// u = t - q
+	MOVQ DI, R8
+	SUBQ q<>+0(SB), R8
+	SBBQ $0, SI        // borrow iff carry < borrow, i.e. t < q
+
+	// res[i] = t if t < q, u otherwise
+	CMOVQCS DI, R8
+	MOVQ    R8, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000008, AX
+	ADDQ $0x0000000000000008, DX
+	ADDQ $0x0000000000000008, CX
+	DECQ BX                      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+	XORQ SI, SI
+
+l3:
+	TESTQ BX, BX
+	JEQ   l4     // n == 0, we are done
+
+	// a[i] - b[i]
+	MOVQ 0(DX), DI
+	SUBQ 0(CX), DI
+
+	// reduce (a[i] - b[i])
+	MOVQ    $0xffffffff00000001, R8
+	CMOVQCC SI, R8
+
+	// add registers (q or 0) to t, and set to result
+	ADDQ R8, DI
+
+	// res[i] = a[i] - b[i]
+	MOVQ DI, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $0x0000000000000008, AX
+	ADDQ $0x0000000000000008, DX
+	ADDQ $0x0000000000000008, CX
+	DECQ BX                      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[2]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX-512
+TEXT ·sumVec(SB), $128-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $3, DX                  // we load 8 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	VPBROADCASTQ CX, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6          // n == 0, we are done
+	VMOVDQU64 0(AX), Z1
+	VPANDQ    Z0, Z1, Z2
+	VPADDQ    Z2, Z3, Z3
+	VPSRLQ    $32, Z1, Z1
+	VPADDQ    Z1, Z4, Z4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000040, AX
+	DECQ DX                      // decrement n
+	JMP  l5
+
+l6:
+	VMOVDQU64 Z3, acc0-128(SP)
+	VMOVDQU64 Z4, acc1-64(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-128(SP), BX
+	ADDQ lane1-120(SP), BX
+	ADDQ lane2-112(SP), BX
+	ADDQ lane3-104(SP), BX
+	ADDQ lane4-96(SP), BX
+	ADDQ lane5-88(SP), BX
+	ADDQ lane6-80(SP), BX
+	ADDQ lane7-72(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane8-64(SP), BX
+	ADDQ lane9-56(SP), BX
+	ADDQ lane10-48(SP), BX
+	ADDQ lane11-40(SP), BX
+	ADDQ lane12-32(SP), BX
+	ADDQ lane13-24(SP), BX
+	ADDQ lane14-16(SP), BX
+	ADDQ lane15-8(SP), BX
+	MOVQ BX, 8(CX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(res *[2]uint64, a *Element, n uint64) accumulates the 32 bit halves of the words of a[0...n] in res, with AVX2
+TEXT ·sumVecAVX2(SB), $64-24
+	MOVQ         a+8(FP), AX
+	MOVQ         n+16(FP), DX
+	SHRQ         $2, DX                  // we load 4 elements at a time
+	MOVQ         $0x00000000ffffffff, CX
+	MOVQ         CX, X0
+	VPBROADCASTQ X0, Y0
+	VPXOR        Y1, Y1, Y1
+	VPXOR        Y3, Y3, Y3
+	VPXOR        Y4, Y4, Y4
+
+l7:
+	TESTQ   DX, DX
+	JEQ     l8          // n == 0, we are done
+	VMOVDQU 0(AX), Y1
+	VPAND   Y0, Y1, Y2
+	VPADDQ  Y2, Y3, Y3
+	VPSRLQ  $32, Y1, Y1
+	VPADDQ  Y1, Y4, Y4
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000020, AX
+	DECQ DX                      // decrement n
+	JMP  l7
+
+l8:
+	VMOVDQU Y3, acc0-64(SP)
+	VMOVDQU Y4, acc1-32(SP)
+
+	// sum the lanes holding the same words
+	MOVQ res+0(FP), CX
+	MOVQ lane0-64(SP), BX
+	ADDQ lane1-56(SP), BX
+	ADDQ lane2-48(SP), BX
+	ADDQ lane3-40(SP), BX
+	MOVQ BX, 0(CX)
+	MOVQ lane4-32(SP), BX
+	ADDQ lane5-24(SP), BX
+	ADDQ lane6-16(SP), BX
+	ADDQ lane7-8(SP), BX
+	MOVQ BX, 8(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+TEXT ·mulVec(SB), $256-32
+	MOVQ         $0x000ffffeffffffff, SI
+	VPBROADCASTQ SI, Z6
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z7
+	VMOVDQU64    indexGatherScatter<>(SB), Z8
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z8*8), K1, Z0
+	VMOVDQU64  Z0, Z5
+	VPANDQ     Z7, Z5, Z5
+	VMOVDQU64  Z5, a0-256(SP)
+	VPSRLQ     $52, Z0, Z5
+	VMOVDQU64  Z5, a1-192(SP)
+
+	// load 8 elements of b and convert them to 52 bits limbs, shifted by 40 bits
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(CX)(Z8*8), K1, Z0
+	VPSLLQ     $40, Z0, Z5
+	VPANDQ     Z7, Z5, Z5
+	VMOVDQU64  Z5, b0-128(SP)
+	VPSRLQ     $12, Z0, Z5
+	VMOVDQU64  Z5, b1-64(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+
+	// t += a * b[0]
+	VMOVDQU64   b0-128(SP), Z3
+	VPMADD52LUQ a0-256(SP), Z3, Z0
+	VPMADD52HUQ a0-256(SP), Z3, Z1
+	VPMADD52LUQ a1-192(SP), Z3, Z1
+	VPMADD52HUQ a1-192(SP), Z3, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z4, Z4, Z4
+	VPMADD52LUQ Z6, Z0, Z4
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z4, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z4, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z4, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z4, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z5
+	VPADDQ Z5, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-64(SP), Z3
+	VPMADD52LUQ a0-256(SP), Z3, Z1
+	VPMADD52HUQ a0-256(SP), Z3, Z2
+	VPMADD52LUQ a1-192(SP), Z3, Z2
+	VPMADD52HUQ a1-192(SP), Z3, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z4, Z4, Z4
+	VPMADD52LUQ Z6, Z1, Z4
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z4, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z4, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z4, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z4, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z5
+	VPADDQ Z5, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// propagate the carries
+	VPSRLQ $52, Z2, Z5
+	VPADDQ Z5, Z0, Z0
+	VPANDQ Z7, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z2, Z3
+	VPSRAQ      $52, Z3, Z5
+	VPANDQ      Z7, Z3, Z3
+	VMOVDQU64   Z3, a0-256(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z3
+	VPADDQ      Z5, Z3, Z3
+	VMOVDQU64   Z3, a1-192(SP)
+	VPMOVQ2M    Z3, K2
+	VMOVDQU64   Z2, K2, a0-256(SP)
+	VMOVDQU64   Z0, K2, a1-192(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-256(SP), Z2
+	VPSLLQ      $52, a1-192(SP), Z5
+	VPORQ       Z5, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 0(AX)(Z8*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000040, AX
+	ADDQ $0x0000000000000040, DX
+	ADDQ $0x0000000000000040, CX
+	DECQ BX                      // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+TEXT ·scalarMulVec(SB), $256-32
+	MOVQ         $0x000ffffeffffffff, SI
+	VPBROADCASTQ SI, Z6
+	MOVQ         $0x000fffffffffffff, SI
+	VPBROADCASTQ SI, Z7
+	VMOVDQU64    indexGatherScatter<>(SB), Z8
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 52 bits limbs
+	VPBROADCASTQ 0(CX), Z0
+	VPSLLQ       $40, Z0, Z5
+	VPANDQ       Z7, Z5, Z5
+	VMOVDQU64    Z5, b0-128(SP)
+	VPSRLQ       $12, Z0, Z5
+	VMOVDQU64    Z5, b1-64(SP)
+
+l11:
+	TESTQ BX, BX
+	JEQ   l12    // n == 0, we are done
+
+	// load 8 elements of a and convert them to 52 bits limbs
+	KXNORW     K0, K0, K1
+	VPGATHERQQ 0(DX)(Z8*8), K1, Z0
+	VMOVDQU64  Z0, Z5
+	VPANDQ     Z7, Z5, Z5
+	VMOVDQU64  Z5, a0-256(SP)
+	VPSRLQ     $52, Z0, Z5
+	VMOVDQU64  Z5, a1-192(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+
+	// t += a * b[0]
+	VMOVDQU64   b0-128(SP), Z3
+	VPMADD52LUQ a0-256(SP), Z3, Z0
+	VPMADD52HUQ a0-256(SP), Z3, Z1
+	VPMADD52LUQ a1-192(SP), Z3, Z1
+	VPMADD52HUQ a1-192(SP), Z3, Z2
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z4, Z4, Z4
+	VPMADD52LUQ Z6, Z0, Z4
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z4, Z0
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z4, Z1
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z4, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z4, Z2
+
+	// t >>= 52
+	VPSRLQ $52, Z0, Z5
+	VPADDQ Z5, Z1, Z1
+	VPXORQ Z0, Z0, Z0
+
+	// t += a * b[1]
+	VMOVDQU64   b1-64(SP), Z3
+	VPMADD52LUQ a0-256(SP), Z3, Z1
+	VPMADD52HUQ a0-256(SP), Z3, Z2
+	VPMADD52LUQ a1-192(SP), Z3, Z2
+	VPMADD52HUQ a1-192(SP), Z3, Z0
+
+	// m = t[0] * qInv mod 2⁵²
+	VPXORQ      Z4, Z4, Z4
+	VPMADD52LUQ Z6, Z1, Z4
+
+	// t += m * q
+	VPMADD52LUQ.BCST qLimbs52<>+0(SB), Z4, Z1
+	VPMADD52HUQ.BCST qLimbs52<>+0(SB), Z4, Z2
+	VPMADD52LUQ.BCST qLimbs52<>+8(SB), Z4, Z2
+	VPMADD52HUQ.BCST qLimbs52<>+8(SB), Z4, Z0
+
+	// t >>= 52
+	VPSRLQ $52, Z1, Z5
+	VPADDQ Z5, Z2, Z2
+	VPXORQ Z1, Z1, Z1
+
+	// propagate the carries
+	VPSRLQ $52, Z2, Z5
+	VPADDQ Z5, Z0, Z0
+	VPANDQ Z7, Z2, Z2
+
+	// t < 2q, compute u = t - q and keep t if u is negative
+	VPSUBQ.BCST qLimbs52<>+0(SB), Z2, Z3
+	VPSRAQ      $52, Z3, Z5
+	VPANDQ      Z7, Z3, Z3
+	VMOVDQU64   Z3, a0-256(SP)
+	VPSUBQ.BCST qLimbs52<>+8(SB), Z0, Z3
+	VPADDQ      Z5, Z3, Z3
+	VMOVDQU64   Z3, a1-192(SP)
+	VPMOVQ2M    Z3, K2
+	VMOVDQU64   Z2, K2, a0-256(SP)
+	VMOVDQU64   Z0, K2, a1-192(SP)
+
+	// convert the 52 bits limbs back to words and store the 8 results
+	VMOVDQU64   a0-256(SP), Z2
+	VPSLLQ      $52, a1-192(SP), Z5
+	VPORQ       Z5, Z2, Z2
+	KXNORW      K0, K0, K1
+	VPSCATTERQQ Z2, K1, 0(AX)(Z8*8)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000040, AX
+	ADDQ $0x0000000000000040, DX
+	DECQ BX                      // decrement n
+	JMP  l11
+
+l12:
+	VZEROUPPER
+	RET
+
+// mulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b[0...4n]
+TEXT ·mulVecAVX2(SB), $288-32
+	MOVQ         $0x000000001fffffff, SI
+	MOVQ         SI, X0
+	VPBROADCASTQ X0, Y0
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+l13:
+	TESTQ BX, BX
+	JEQ   l14    // n == 0, we are done
+
+	// load 4 elements of a and convert them to 29 bits limbs
+	VMOVQ       0(DX), X15
+	VPINSRQ     $1, 8(DX), X15, X15
+	VMOVQ       16(DX), X1
+	VPINSRQ     $1, 24(DX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VMOVDQU     Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a0-288(SP)
+	VPSRLQ      $29, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a1-256(SP)
+	VPSRLQ      $58, Y15, Y2
+	VMOVDQU     Y2, a2-224(SP)
+
+	// load 4 elements of b and convert them to 29 bits limbs, shifted by 23 bits
+	VMOVQ       0(CX), X15
+	VPINSRQ     $1, 8(CX), X15, X15
+	VMOVQ       16(CX), X1
+	VPINSRQ     $1, 24(CX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VPSLLQ      $23, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b0-192(SP)
+	VPSRLQ      $6, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, b1-160(SP)
+	VPSRLQ      $35, Y15, Y2
+	VMOVDQU     Y2, b2-128(SP)
+
+	// t = (t + a * b[0] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b0-192(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// t = (t + a * b[1] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b1-160(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPADDQ   t0-96(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPADDQ   t1-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// t = (t + a * b[2] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b2-128(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPADDQ   t0-96(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPADDQ   t1-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// propagate the carries, and compute u = t - q in a
+	VMOVDQU t0-96(SP), Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t0-96(SP)
+	VPSUBQ  qLimbs29<>+0(SB), Y5, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a0-288(SP)
+	VMOVDQU t1-64(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t1-64(SP)
+	VPSUBQ  qLimbs29<>+32(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a1-256(SP)
+	VMOVDQU Y6, t2-32(SP)
+	VPSUBQ  qLimbs29<>+64(SB), Y6, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VMOVDQU Y2, a2-224(SP)
+
+	// keep t if u is negative
+	VPXOR     Y3, Y3, Y3
+	VPCMPGTQ  Y2, Y3, Y4
+	VMOVDQU   a0-288(SP), Y2
+	VPBLENDVB Y4, t0-96(SP), Y2, Y2
+	VMOVDQU   Y2, a0-288(SP)
+	VMOVDQU   a1-256(SP), Y2
+	VPBLENDVB Y4, t1-64(SP), Y2, Y2
+	VMOVDQU   Y2, a1-256(SP)
+	VMOVDQU   a2-224(SP), Y2
+	VPBLENDVB Y4, t2-32(SP), Y2, Y2
+	VMOVDQU   Y2, a2-224(SP)
+
+	// convert the 29 bits limbs back to words and store the 4 results
+	VMOVDQU      a0-288(SP), Y5
+	VMOVDQU      Y5, Y2
+	VMOVDQU      a1-256(SP), Y5
+	VPSLLQ       $29, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a2-224(SP), Y5
+	VPSLLQ       $58, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 0(AX)
+	VPEXTRQ      $1, X2, 8(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 16(AX)
+	VPEXTRQ      $1, X1, 24(AX)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	ADDQ $0x0000000000000020, CX
+	DECQ BX                      // decrement n
+	JMP  l13
+
+l14:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX2(res, a, b *Element, n uint64) res[0...4n] = a[0...4n] * b
+TEXT ·scalarMulVecAVX2(SB), $288-32
+	MOVQ         $0x000000001fffffff, SI
+	MOVQ         SI, X0
+	VPBROADCASTQ X0, Y0
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+	// broadcast b and convert it to 29 bits limbs
+	VPBROADCASTQ 0(CX), Y15
+	VPSLLQ       $23, Y15, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b0-192(SP)
+	VPSRLQ       $6, Y15, Y2
+	VPAND        Y0, Y2, Y2
+	VMOVDQU      Y2, b1-160(SP)
+	VPSRLQ       $35, Y15, Y2
+	VMOVDQU      Y2, b2-128(SP)
+
+l15:
+	TESTQ BX, BX
+	JEQ   l16    // n == 0, we are done
+
+	// load 4 elements of a and convert them to 29 bits limbs
+	VMOVQ       0(DX), X15
+	VPINSRQ     $1, 8(DX), X15, X15
+	VMOVQ       16(DX), X1
+	VPINSRQ     $1, 24(DX), X1, X1
+	VINSERTI128 $1, X1, Y15, Y15
+	VMOVDQU     Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a0-288(SP)
+	VPSRLQ      $29, Y15, Y2
+	VPAND       Y0, Y2, Y2
+	VMOVDQU     Y2, a1-256(SP)
+	VPSRLQ      $58, Y15, Y2
+	VMOVDQU     Y2, a2-224(SP)
+
+	// t = (t + a * b[0] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b0-192(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// t = (t + a * b[1] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b1-160(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPADDQ   t0-96(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPADDQ   t1-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// t = (t + a * b[2] + m * q) / 2²⁹ where m = -t[0] / q mod 2²⁹
+	VMOVDQU  b2-128(SP), Y3
+	VPMULUDQ a0-288(SP), Y3, Y5
+	VPADDQ   t0-96(SP), Y5, Y5
+	VPMULUDQ qInvNeg29<>(SB), Y5, Y4
+	VPAND    Y0, Y4, Y4
+	VPMULUDQ qLimbs29<>+0(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPSRLQ   $29, Y5, Y6
+	VPMULUDQ a1-256(SP), Y3, Y5
+	VPADDQ   t1-64(SP), Y5, Y5
+	VPMULUDQ qLimbs29<>+32(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VPADDQ   Y6, Y5, Y5
+	VMOVDQU  Y5, t0-96(SP)
+	VPMULUDQ a2-224(SP), Y3, Y5
+	VPMULUDQ qLimbs29<>+64(SB), Y4, Y1
+	VPADDQ   Y1, Y5, Y5
+	VMOVDQU  Y5, t1-64(SP)
+
+	// propagate the carries, and compute u = t - q in a
+	VMOVDQU t0-96(SP), Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t0-96(SP)
+	VPSUBQ  qLimbs29<>+0(SB), Y5, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a0-288(SP)
+	VMOVDQU t1-64(SP), Y5
+	VPADDQ  Y6, Y5, Y5
+	VPSRLQ  $29, Y5, Y6
+	VPAND   Y0, Y5, Y5
+	VMOVDQU Y5, t1-64(SP)
+	VPSUBQ  qLimbs29<>+32(SB), Y5, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VPSRLQ  $63, Y2, Y4
+	VPAND   Y0, Y2, Y2
+	VMOVDQU Y2, a1-256(SP)
+	VMOVDQU Y6, t2-32(SP)
+	VPSUBQ  qLimbs29<>+64(SB), Y6, Y2
+	VPSUBQ  Y4, Y2, Y2
+	VMOVDQU Y2, a2-224(SP)
+
+	// keep t if u is negative
+	VPXOR     Y3, Y3, Y3
+	VPCMPGTQ  Y2, Y3, Y4
+	VMOVDQU   a0-288(SP), Y2
+	VPBLENDVB Y4, t0-96(SP), Y2, Y2
+	VMOVDQU   Y2, a0-288(SP)
+	VMOVDQU   a1-256(SP), Y2
+	VPBLENDVB Y4, t1-64(SP), Y2, Y2
+	VMOVDQU   Y2, a1-256(SP)
+	VMOVDQU   a2-224(SP), Y2
+	VPBLENDVB Y4, t2-32(SP), Y2, Y2
+	VMOVDQU   Y2, a2-224(SP)
+
+	// convert the 29 bits limbs back to words and store the 4 results
+	VMOVDQU      a0-288(SP), Y5
+	VMOVDQU      Y5, Y2
+	VMOVDQU      a1-256(SP), Y5
+	VPSLLQ       $29, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVDQU      a2-224(SP), Y5
+	VPSLLQ       $58, Y5, Y1
+	VPOR         Y1, Y2, Y2
+	VMOVQ        X2, 0(AX)
+	VPEXTRQ      $1, X2, 8(AX)
+	VEXTRACTI128 $1, Y2, X1
+	VMOVQ        X1, 16(AX)
+	VPEXTRQ      $1, X1, 24(AX)
+
+	// increment pointers to visit next elements
+	ADDQ $0x0000000000000020, AX
+	ADDQ $0x0000000000000020, DX
+	DECQ BX                      // decrement n
+	JMP  l15
+
+l16:
+	VZEROUPPER
+	RET
@@ -0,0 +1,544 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"context"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// parallelize threshold for a single butterfly op, if the fft stage is not parallelized already
+const butterflyThreshold = 16
+
+// FFT computes (recursively) the discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// on a mixed-radix domain, the bit-reversed order is replaced by the digit-reversed order (see DigitReverse)
+func (domain *Domain) FFT(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+
+	opt := fftOptions(opts...)
+
+	if domain.isMixedRadix() {
+		domain.mixedRadixFFT(a, decimation, false, opt)
+		return
+	}
+
+	domain.fft(a, decimation, domain.fftTables(decimation, false, opt.coset), opt)
+}
+
+// fft is FFT on a power of two domain, with the tables returned by fftTables
+func (domain *Domain) fft(a []goldilocks.Element, decimation Decimation, t fftTables, opt fftConfig) {
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
+	if opt.nbTasks == 1 {
+		maxSplits = -1
+	}
+
+	// if coset != 0, scale by coset table
+	if opt.coset {
+		if decimation == DIT {
+			// scale by coset table (in bit reversed order)
+			cosetTable := t.cosetTable
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+				n := uint64(len(a))
+				nn := uint64(64 - bits.TrailingZeros64(n))
+				for i := start; i < end; i++ {
+					irev := int(bits.Reverse64(uint64(i)) >> nn)
+					a[i].Mul(&a[i], &cosetTable[irev])
+				}
+			}, opt.nbTasks)
+		} else {
+			if domain.withPrecompute {
+				scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+					for i := start; i < end; i++ {
+						a[i].Mul(&a[i], &domain.cosetTable[i])
+					}
+				}, opt.nbTasks)
+			} else {
+				c := domain.FrMultiplicativeGen
+				scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+					var at goldilocks.Element
+					at.Exp(c, big.NewInt(int64(start)))
+					for i := start; i < end; i++ {
+						a[i].Mul(&a[i], &at)
+						at.Mul(&at, &c)
+					}
+				}, opt.nbTasks)
+			}
+
+		}
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, domain.Generator, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
+	case DIT:
+		ditFFT(a, domain.Generator, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+// on a mixed-radix domain, the bit-reversed order is replaced by the digit-reversed order (see DigitReverse)
+func (domain *Domain) FFTInverse(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+
+	if domain.isMixedRadix() {
+		domain.mixedRadixFFT(a, decimation, true, opt)
+		return
+	}
+
+	domain.fftInverse(a, decimation, domain.fftTables(decimation, true, opt.coset), opt)
+}
+
+// fftInverse is FFTInverse on a power of two domain, with the tables returned by fftTables
+func (domain *Domain) fftInverse(a []goldilocks.Element, decimation Decimation, t fftTables, opt fftConfig) {
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
+	if opt.nbTasks == 1 {
+		maxSplits = -1
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, domain.GeneratorInv, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
+	case DIT:
+		ditFFT(a, domain.GeneratorInv, t.twiddles, t.twiddlesStartStage, 0, maxSplits, nil, opt)
+	default:
+		panic("not implemented")
+	}
+
+	if isDone(opt.done) {
+		return
+	}
+
+	// scale by CardinalityInv
+	if !opt.coset {
+		scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i].Mul(&a[i], &domain.CardinalityInv)
+			}
+		}, opt.nbTasks)
+		return
+	}
+
+	if decimation == DIT {
+		if domain.withPrecompute {
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+				for i := start; i < end; i++ {
+					a[i].Mul(&a[i], &domain.cosetTableInv[i]).
+						Mul(&a[i], &domain.CardinalityInv)
+				}
+			}, opt.nbTasks)
+		} else {
+			c := domain.FrMultiplicativeGenInv
+			scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+				var at goldilocks.Element
+				at.Exp(c, big.NewInt(int64(start)))
+				at.Mul(&at, &domain.CardinalityInv)
+				for i := start; i < end; i++ {
+					a[i].Mul(&a[i], &at)
+					at.Mul(&at, &c)
+				}
+			}, opt.nbTasks)
+		}
+		return
+	}
+
+	// decimation == DIF, need to access coset table in bit reversed order.
+	cosetTableInv := t.cosetTable
+	scheduler.Execute(opt.scheduler, len(a), func(start, end int) {
+		n := uint64(len(a))
+		nn := uint64(64 - bits.TrailingZeros64(n))
+		for i := start; i < end; i++ {
+			irev := int(bits.Reverse64(uint64(i)) >> nn)
+			a[i].Mul(&a[i], &cosetTableInv[irev]).
+				Mul(&a[i], &domain.CardinalityInv)
+		}
+	}, opt.nbTasks)
+
+}
+
+// fftTables holds the twiddles, and the coset table accessed in bit reversed order, of an FFT;
+// they are built once for all the columns of FFTBatch when the domain has no precomputed tables.
+type fftTables struct {
+	twiddles           [][]goldilocks.Element
+	twiddlesStartStage int
+	cosetTable         []goldilocks.Element
+}
+
+func (domain *Domain) fftTables(decimation Decimation, inverse, coset bool) fftTables {
+	if domain.withPrecompute {
+		if inverse {
+			return fftTables{twiddles: domain.twiddlesInv, cosetTable: domain.cosetTableInv}
+		}
+		return fftTables{twiddles: domain.twiddles, cosetTable: domain.cosetTable}
+	}
+
+	var t fftTables
+	w, shift := domain.Generator, domain.FrMultiplicativeGen
+	if inverse {
+		w, shift = domain.GeneratorInv, domain.FrMultiplicativeGenInv
+	}
+	t.twiddlesStartStage = 3
+	nbStages := int(bits.TrailingZeros64(domain.Cardinality))
+	t.twiddles = make([][]goldilocks.Element, nbStages-t.twiddlesStartStage)
+	w.Exp(w, big.NewInt(int64(1<<t.twiddlesStartStage)))
+	buildTwiddles(t.twiddles, w, uint64(nbStages-t.twiddlesStartStage))
+
+	// the coset table is only needed when accessed in bit reversed order;
+	// we need to build the full table or do a bit reverse dance.
+	if coset && (decimation == DIT) != inverse {
+		t.cosetTable = make([]goldilocks.Element, domain.Cardinality)
+		BuildExpTable(shift, t.cosetTable)
+	}
+	return t
+}
+
+// FFTBatch computes the FFT of each column, as FFT would, and stores the results in place.
+// The columns must all have the domain's cardinality; the options apply to all of them.
+//
+// The twiddles are shared by all the columns. When there are at least as many columns as tasks,
+// each column is transformed by a single go routine, which keeps it in the cache of one core
+// instead of splitting its stages across cores; otherwise the tasks are divided between the columns.
+func (domain *Domain) FFTBatch(columns [][]goldilocks.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(columns, decimation, false, fftOptions(opts...))
+}
+
+// FFTInverseBatch computes the inverse FFT of each column, as FFTInverse would, and stores the
+// results in place. See FFTBatch.
+func (domain *Domain) FFTInverseBatch(columns [][]goldilocks.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(columns, decimation, true, fftOptions(opts...))
+}
+
+func (domain *Domain) fftBatch(columns [][]goldilocks.Element, decimation Decimation, inverse bool, opt fftConfig) {
+	if len(columns) == 0 {
+		return
+	}
+	for i := range columns {
+		if uint64(len(columns[i])) != domain.Cardinality {
+			panic("fft: all the columns must have the cardinality of the domain")
+		}
+	}
+
+	var t fftTables
+	if !domain.isMixedRadix() {
+		t = domain.fftTables(decimation, inverse, opt.coset)
+	}
+
+	columnOpt := opt
+	columnOpt.nbTasks = opt.nbTasks / len(columns)
+	if columnOpt.nbTasks < 1 {
+		columnOpt.nbTasks = 1
+	}
+
+	scheduler.Execute(opt.scheduler, len(columns), func(start, end int) {
+		for i := start; i < end && !isDone(opt.done); i++ {
+			switch {
+			case domain.isMixedRadix():
+				domain.mixedRadixFFT(columns[i], decimation, inverse, columnOpt)
+			case inverse:
+				domain.fftInverse(columns[i], decimation, t, columnOpt)
+			default:
+				domain.fft(columns[i], decimation, t, columnOpt)
+			}
+		}
+	}, opt.nbTasks)
+}
+
+// FFTCtx is like FFT but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of a is undefined.
+func (domain *Domain) FFTCtx(ctx context.Context, a []goldilocks.Element, decimation Decimation, opts ...Option) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], withDone(ctx.Done()))
+	domain.FFT(a, decimation, opts...)
+	return ctx.Err()
+}
+
+// FFTInverseCtx is like FFTInverse but stops the computation when ctx is done.
+// In that case, ctx.Err() is returned and the content of a is undefined.
+func (domain *Domain) FFTInverseCtx(ctx context.Context, a []goldilocks.Element, decimation Decimation, opts ...Option) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], withDone(ctx.Done()))
+	domain.FFTInverse(a, decimation, opts...)
+	return ctx.Err()
+}
+
+// isDone returns true if the done channel is closed; a nil channel is never done.
+func isDone(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func difFFT(a []goldilocks.Element, w goldilocks.Element, twiddles [][]goldilocks.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	if isDone(opt.done) {
+		return
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 256 && stage >= twiddlesStartStage {
+		kerDIFNP_256(a, twiddles, stage-twiddlesStartStage)
+		return
+	}
+	m := n >> 1
+
+	parallelButterfly := (m > butterflyThreshold) && (stage < maxSplits)
+
+	if stage < twiddlesStartStage {
+		if parallelButterfly {
+			w := w
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
+				if start == 0 {
+					goldilocks.Butterfly(&a[0], &a[m])
+					start++
+				}
+				var at goldilocks.Element
+				at.Exp(w, big.NewInt(int64(start)))
+				innerDIFWithoutTwiddles(a, at, w, start, end, m)
+			}, opt.nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
+		} else {
+			innerDIFWithoutTwiddles(a, w, w, 0, m, m)
+		}
+		// compute next twiddle
+		w.Square(&w)
+	} else {
+		if parallelButterfly {
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
+				innerDIFWithTwiddles(a, twiddles[stage-twiddlesStartStage], start, end, m)
+			}, opt.nbTasks/(1<<(stage)))
+		} else {
+			innerDIFWithTwiddles(a, twiddles[stage-twiddlesStartStage], 0, m, m)
+		}
+	}
+
+	if m == 1 {
+		return
+	}
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		scheduler.Go(opt.scheduler, func() {
+			difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, opt)
+		})
+		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		<-chDone
+	} else {
+		difFFT(a[0:m], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		difFFT(a[m:n], w, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+	}
+
+}
+
+func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if start == 0 {
+		goldilocks.Butterfly(&a[0], &a[m])
+		start++
+	}
+	for i := start; i < end; i++ {
+		goldilocks.Butterfly(&a[i], &a[i+m])
+		a[i+m].Mul(&a[i+m], &twiddles[i])
+	}
+}
+
+func innerDIFWithoutTwiddles(a []goldilocks.Element, at, w goldilocks.Element, start, end, m int) {
+	if start == 0 {
+		goldilocks.Butterfly(&a[0], &a[m])
+		start++
+	}
+	for i := start; i < end; i++ {
+		goldilocks.Butterfly(&a[i], &a[i+m])
+		a[i+m].Mul(&a[i+m], &at)
+		at.Mul(&at, &w)
+	}
+}
+
+func ditFFT(a []goldilocks.Element, w goldilocks.Element, twiddles [][]goldilocks.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, opt fftConfig) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	if isDone(opt.done) {
+		return
+	}
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 256 && stage >= twiddlesStartStage {
+		kerDITNP_256(a, twiddles, stage-twiddlesStartStage)
+		return
+	}
+	m := n >> 1
+
+	nextStage := stage + 1
+	nextW := w
+	nextW.Square(&nextW)
+
+	if stage < maxSplits {
+		// that's the only time we fire go routines
+		chDone := make(chan struct{}, 1)
+		scheduler.Go(opt.scheduler, func() {
+			ditFFT(a[m:], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, chDone, opt)
+		})
+		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		<-chDone
+	} else {
+		ditFFT(a[0:m], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+		ditFFT(a[m:n], nextW, twiddles, twiddlesStartStage, nextStage, maxSplits, nil, opt)
+	}
+	if isDone(opt.done) {
+		return
+	}
+
+	parallelButterfly := (m > butterflyThreshold) && (stage < maxSplits)
+
+	if stage < twiddlesStartStage {
+		// we need to compute the twiddles for this stage on the fly.
+		if parallelButterfly {
+			w := w
+			scheduler.Execute(opt.scheduler, m, func(start, end int) {
+				if start == 0 {
+					goldilocks.Butterfly(&a[0], &a[m])
+					start++
+				}
+				var at goldilocks.Element
+				at.Exp(w, big.NewInt(int64(start)))
+				innerDITWithoutTwiddles(a, at, w, start, end, m)
+			}, opt.nbTasks/(1<<(stage))) // 1 << stage == estimated used CPUs
+
+		} else {
+			innerDITWithoutTwiddles(a, w, w, 0, m, m)
+		}
+		return
+	}
+	if parallelButterfly {
+		scheduler.Execute(opt.scheduler, m, func(start, end int) {
+			innerDITWithTwiddles(a, twiddles[stage-twiddlesStartStage], start, end, m)
+		}, opt.nbTasks/(1<<(stage)))
+	} else {
+		innerDITWithTwiddles(a, twiddles[stage-twiddlesStartStage], 0, m, m)
+	}
+}
+
+func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if start == 0 {
+		goldilocks.Butterfly(&a[0], &a[m])
+		start++
+	}
+	for i := start; i < end; i++ {
+		a[i+m].Mul(&a[i+m], &twiddles[i])
+		goldilocks.Butterfly(&a[i], &a[i+m])
+	}
+}
+
+func innerDITWithoutTwiddles(a []goldilocks.Element, at, w goldilocks.Element, start, end, m int) {
+	if start == 0 {
+		goldilocks.Butterfly(&a[0], &a[m])
+		start++
+	}
+	for i := start; i < end; i++ {
+		a[i+m].Mul(&a[i+m], &at)
+		goldilocks.Butterfly(&a[i], &a[i+m])
+		at.Mul(&at, &w)
+	}
+}
+
+func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	// code unrolled & generated by internal/generator/fft/template/fft.go.tmpl
+
+	innerDIFWithTwiddles(a[:256], twiddles[stage+0], 0, 128, 128)
+	for offset := 0; offset < 256; offset += 128 {
+		innerDIFWithTwiddles(a[offset:offset+128], twiddles[stage+1], 0, 64, 64)
+	}
+	for offset := 0; offset < 256; offset += 64 {
+		innerDIFWithTwiddles(a[offset:offset+64], twiddles[stage+2], 0, 32, 32)
+	}
+	for offset := 0; offset < 256; offset += 32 {
+		innerDIFWithTwiddles(a[offset:offset+32], twiddles[stage+3], 0, 16, 16)
+	}
+	for offset := 0; offset < 256; offset += 16 {
+		innerDIFWithTwiddles(a[offset:offset+16], twiddles[stage+4], 0, 8, 8)
+	}
+	for offset := 0; offset < 256; offset += 8 {
+		innerDIFWithTwiddles(a[offset:offset+8], twiddles[stage+5], 0, 4, 4)
+	}
+	for offset := 0; offset < 256; offset += 4 {
+		innerDIFWithTwiddles(a[offset:offset+4], twiddles[stage+6], 0, 2, 2)
+	}
+	for offset := 0; offset < 256; offset += 2 {
+		goldilocks.Butterfly(&a[offset], &a[offset+1])
+	}
+}
+
+func kerDITNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	// code unrolled & generated by internal/generator/fft/template/fft.go.tmpl
+
+	for offset := 0; offset < 256; offset += 2 {
+		goldilocks.Butterfly(&a[offset], &a[offset+1])
+	}
+	for offset := 0; offset < 256; offset += 4 {
+		innerDITWithTwiddles(a[offset:offset+4], twiddles[stage+6], 0, 2, 2)
+	}
+	for offset := 0; offset < 256; offset += 8 {
+		innerDITWithTwiddles(a[offset:offset+8], twiddles[stage+5], 0, 4, 4)
+	}
+	for offset := 0; offset < 256; offset += 16 {
+		innerDITWithTwiddles(a[offset:offset+16], twiddles[stage+4], 0, 8, 8)
+	}
+	for offset := 0; offset < 256; offset += 32 {
+		innerDITWithTwiddles(a[offset:offset+32], twiddles[stage+3], 0, 16, 16)
+	}
+	for offset := 0; offset < 256; offset += 64 {
+		innerDITWithTwiddles(a[offset:offset+64], twiddles[stage+2], 0, 32, 32)
+	}
+	for offset := 0; offset < 256; offset += 128 {
+		innerDITWithTwiddles(a[offset:offset+128], twiddles[stage+1], 0, 64, 64)
+	}
+	innerDITWithTwiddles(a[:256], twiddles[stage+0], 0, 128, 128)
+}
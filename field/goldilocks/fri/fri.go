@@ -0,0 +1,759 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/accumulator/merkletree"
+	"github.com/consensys/gnark-crypto/ecc"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/fft"
+)
+
+var (
+	ErrLowDegree            = errors.New("the fully folded polynomial does not match the folded evaluations")
+	ErrProximityTestFolding = errors.New("one round of interaction failed")
+	ErrOddSize              = errors.New("the size should be even")
+	ErrMerkleRoot           = errors.New("merkle roots of the opening and the proof of proximity don't coincide")
+	ErrMerklePath           = errors.New("merkle path proof is wrong")
+	ErrRangePosition        = errors.New("the asked opening position is out of range")
+	ErrClaimedValue         = errors.New("the claimed value does not match the opened leaf")
+	ErrProofOfWork          = errors.New("the proof of work is wrong")
+	ErrInvalidProof         = errors.New("the proof does not match the parameters of the iopp")
+	ErrPolynomialSize       = errors.New("the polynomial is too large")
+	ErrFoldingArity         = errors.New("the folding arity must be 2, 4, 8 or 16")
+	ErrRho                  = errors.New("ρ must be a power of 2 larger than 1")
+	ErrNbQueries            = errors.New("either the number of queries or the security level must be positive")
+	ErrGrindingBits         = errors.New("the number of grinding bits must be in [0, 64)")
+	ErrFinalDegree          = errors.New("the final degree must be non negative")
+	ErrSizeTooSmall         = errors.New("the size must be at least the folding arity")
+)
+
+// Digest commitment of a polynomial.
+type Digest []byte
+
+// MerkleProof used to open a polynomial
+type OpeningProof struct {
+
+	// those fields are private since they are only needed for
+	// the verification, which is abstracted in the VerifyOpening
+	// method.
+	merkleRoot []byte
+	ProofSet   [][]byte
+	numLeaves  uint64
+	index      uint64
+
+	// ClaimedValue value of the polynomial at the opened position. This field is exported
+	// because it's needed for protocols using polynomial commitment
+	// schemes (to verify an algebraic relation).
+	ClaimedValue goldilocks.Element
+}
+
+// Query contains the openings, for one query of the verifier, of the successive
+// folded functions on the fibers of the queried point.
+type Query struct {
+
+	// ProofSets[i] stores [leaf ∥ node_1 ∥ ..] for the i-th folded function, where the
+	// leaf is the concatenation of the evaluations on the queried fiber.
+	ProofSets [][][]byte
+}
+
+// ProofOfProximity proof of proximity, attesting that
+// a function is d-close to a low degree polynomial.
+//
+// The prover commits to the evaluations of the successive folded functions, sends the
+// fully folded polynomial and answers the queries of the verifier. The interaction is
+// emulated with Fiat Shamir.
+type ProofOfProximity struct {
+
+	// ID unique ID attached to the proof of proximity. It's needed for
+	// protocols using Fiat Shamir for instance, where challenges are derived
+	// from the proof of proximity.
+	ID []byte
+
+	// Roots of the Merkle trees of the evaluations of the successive folded functions.
+	// Roots[0] commits to the evaluations of the polynomial.
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the fully folded polynomial.
+	FinalPolynomial []goldilocks.Element
+
+	// Nonce solution of the proof of work, if Params.GrindingBits > 0.
+	Nonce uint64
+
+	// Queries openings of the folded functions, one per query of the verifier.
+	Queries []Query
+}
+
+// Iopp interface that an iopp should implement
+type Iopp interface {
+
+	// BuildProofOfProximity creates a proof of proximity that p is d-close to a polynomial
+	// of degree len(p). The proof is built non interactively using Fiat Shamir.
+	BuildProofOfProximity(p []goldilocks.Element) (ProofOfProximity, error)
+
+	// VerifyProofOfProximity verifies the proof of proximity. It returns an error if the
+	// verification fails.
+	VerifyProofOfProximity(proof ProofOfProximity) error
+
+	// Opens a polynomial at gⁱ where i = position.
+	Open(p []goldilocks.Element, position uint64) (OpeningProof, error)
+
+	// Verifies the opening of a polynomial at gⁱ where i = position.
+	VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error
+
+	// Params returns the parameters of the iopp, with the number of queries resolved.
+	Params() Params
+}
+
+// Params parameters of an IOPP.
+type Params struct {
+
+	// FoldingArity number of evaluations folded into one at each step, with the map
+	// x->x^FoldingArity. It must be 2, 4, 8 or 16.
+	FoldingArity int
+
+	// Rho factor ρ = size_code_word/size_polynomial, inverse of the rate of the Reed
+	// Solomon code. It must be a power of 2 larger than 1.
+	Rho int
+
+	// NbQueries number of queries of the verifier. If it is 0, it is derived from
+	// SecurityLevel.
+	NbQueries int
+
+	// SecurityLevel number of bits of security targeted when NbQueries is 0. Following
+	// the usual conjecture on the soundness of FRI, each query brings log₂(ρ) bits, and
+	// the grinding GrindingBits bits.
+	SecurityLevel int
+
+	// GrindingBits number of leading zero bits of the proof of work the prover solves
+	// before the queries are derived. 0 disables the grinding.
+	GrindingBits int
+
+	// FinalDegree the folding stops once the folded polynomial is of degree at most
+	// FinalDegree, or is smaller than FoldingArity. The prover then sends its coefficients.
+	FinalDegree int
+}
+
+// DefaultParams returns the parameters of the radix-2 FRI, with ρ = 8, folding down to a
+// constant, and as many queries as needed for 128 bits of security.
+func DefaultParams() Params {
+	return Params{
+		FoldingArity:  2,
+		Rho:           8,
+		SecurityLevel: 128,
+	}
+}
+
+// resolve checks the parameters, and sets the number of queries if it is 0.
+func (params Params) resolve() (Params, error) {
+	switch params.FoldingArity {
+	case 2, 4, 8, 16:
+	default:
+		return params, ErrFoldingArity
+	}
+	if params.Rho < 2 || params.Rho&(params.Rho-1) != 0 {
+		return params, ErrRho
+	}
+	if params.GrindingBits < 0 || params.GrindingBits >= 64 {
+		return params, ErrGrindingBits
+	}
+	if params.FinalDegree < 0 {
+		return params, ErrFinalDegree
+	}
+	if params.NbQueries < 0 || (params.NbQueries == 0 && params.SecurityLevel <= 0) {
+		return params, ErrNbQueries
+	}
+	if params.NbQueries == 0 {
+		bitsPerQuery := bits.TrailingZeros(uint(params.Rho))
+		nbBits := params.SecurityLevel - params.GrindingBits
+		params.NbQueries = int(math.Ceil(float64(nbBits) / float64(bitsPerQuery)))
+		if params.NbQueries < 1 {
+			params.NbQueries = 1
+		}
+	}
+	return params, nil
+}
+
+// IOPP Interactive Oracle Proof of Proximity
+type IOPP uint
+
+const (
+	// Multiplicative version of FRI, using the map x->xᵏ, where k is the folding arity,
+	// on a power of 2 subgroup of Fr^{*}.
+	MULTIPLICATIVE_FRI IOPP = iota
+
+	// Deprecated: the folding arity is set in Params, use MULTIPLICATIVE_FRI.
+	RADIX_2_FRI = MULTIPLICATIVE_FRI
+)
+
+// New creates a new IOPP capable to handle degree(size) polynomials, configured with params.
+func (iopp IOPP) New(size uint64, h hash.Hash, params Params) (Iopp, error) {
+	switch iopp {
+	case MULTIPLICATIVE_FRI:
+		return newMultiplicativeFri(size, h, params)
+	default:
+		panic("iopp name is not recognized")
+	}
+}
+
+// multiplicativeFri implements the multiplicative FRI, folding the evaluations of
+// the polynomial on the fibers of x->xᵏ, where k is the folding arity.
+type multiplicativeFri struct {
+
+	// hash function that is used for Fiat Shamir and for committing to
+	// the oracles.
+	h hash.Hash
+
+	// parameters of the iopp, with the number of queries resolved
+	params Params
+
+	// nbSteps number of foldings
+	nbSteps int
+
+	// size of the polynomials, and of the fully folded polynomial
+	size, finalSize uint64
+
+	// domain used to build the Reed Solomon code from the given polynomial.
+	// The size of the domain is ρ*size_polynomial.
+	domain *fft.Domain
+
+	// domain on which the fully folded polynomial is evaluated, of size ρ*finalSize
+	finalDomain *fft.Domain
+
+	// omegaInv[j] = ω⁻ʲ, where ω is the primitive k-th root of unity of domain
+	omegaInv []goldilocks.Element
+
+	// k⁻¹
+	arityInv goldilocks.Element
+}
+
+func newMultiplicativeFri(size uint64, h hash.Hash, params Params) (*multiplicativeFri, error) {
+
+	params, err := params.resolve()
+	if err != nil {
+		return nil, err
+	}
+	k := uint64(params.FoldingArity)
+
+	var res multiplicativeFri
+	res.h = h
+	res.params = params
+
+	// computing the number of steps: the polynomial is folded at least once, and
+	// until it is of degree at most FinalDegree
+	res.size = ecc.NextPowerOfTwo(size)
+	if res.size < k {
+		return nil, ErrSizeTooSmall
+	}
+	res.finalSize = res.size / k
+	res.nbSteps = 1
+	for res.finalSize > uint64(params.FinalDegree+1) && res.finalSize >= k {
+		res.finalSize /= k
+		res.nbSteps++
+	}
+
+	// building the domains
+	res.domain = fft.NewDomain(res.size * uint64(params.Rho))
+	res.finalDomain = fft.NewDomain(res.finalSize * uint64(params.Rho))
+
+	// k-th roots of unity, to fold the evaluations on a fiber
+	var omegaInv goldilocks.Element
+	omegaInv.Exp(res.domain.GeneratorInv, new(big.Int).SetUint64(res.domain.Cardinality/k))
+	res.omegaInv = make([]goldilocks.Element, k)
+	res.omegaInv[0].SetOne()
+	for j := 1; j < len(res.omegaInv); j++ {
+		res.omegaInv[j].Mul(&res.omegaInv[j-1], &omegaInv)
+	}
+	res.arityInv.SetUint64(k).Inverse(&res.arityInv)
+
+	return &res, nil
+}
+
+// Params returns the parameters of the iopp, with the number of queries resolved.
+func (s *multiplicativeFri) Params() Params {
+	return s.params
+}
+
+// evaluate returns the evaluations of p on the domain, in natural order
+func (s *multiplicativeFri) evaluate(p []goldilocks.Element) ([]goldilocks.Element, error) {
+	if uint64(len(p)) > s.size {
+		return nil, ErrPolynomialSize
+	}
+	res := make([]goldilocks.Element, s.domain.Cardinality)
+	copy(res, p)
+	s.domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res, nil
+}
+
+// leaves groups the evaluations of a function on a domain of size n (in natural
+// order) by fibers of x->xᵏ: the t-th leaf is the concatenation of the evaluations
+// at g^{t+j*n/k}, for j < k.
+func (s *multiplicativeFri) leaves(evaluations []goldilocks.Element) [][]byte {
+	k := s.params.FoldingArity
+	m := len(evaluations) / k
+	res := make([][]byte, m)
+	for t := range res {
+		res[t] = make([]byte, 0, k*goldilocks.Bytes)
+		for j := 0; j < k; j++ {
+			res[t] = append(res[t], evaluations[t+j*m].Marshal()...)
+		}
+	}
+	return res
+}
+
+// parseLeaf returns the evaluations concatenated in leaf
+func (s *multiplicativeFri) parseLeaf(leaf []byte) ([]goldilocks.Element, error) {
+	if len(leaf) != s.params.FoldingArity*goldilocks.Bytes {
+		return nil, ErrInvalidProof
+	}
+	res := make([]goldilocks.Element, s.params.FoldingArity)
+	for j := range res {
+		if err := res[j].SetBytesCanonical(leaf[j*goldilocks.Bytes : (j+1)*goldilocks.Bytes]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// fold folds f, given by its evaluations on a domain of size n whose generator is g⁻¹ = gInv,
+// into the evaluations of ∑ⱼβʲfⱼ on the domain of size n/k, where f = ∑ⱼXʲfⱼ(Xᵏ).
+func (s *multiplicativeFri) fold(evaluations []goldilocks.Element, gInv, beta goldilocks.Element) []goldilocks.Element {
+	k := s.params.FoldingArity
+	m := len(evaluations) / k
+	res := make([]goldilocks.Element, m)
+	fiber := make([]goldilocks.Element, k)
+	var xInv goldilocks.Element
+	xInv.SetOne()
+	for t := range res {
+		for j := range fiber {
+			fiber[j] = evaluations[t+j*m]
+		}
+		res[t] = s.foldFiber(fiber, xInv, beta)
+		xInv.Mul(&xInv, &gInv)
+	}
+	return res
+}
+
+// foldFiber returns ∑ⱼβʲfⱼ(xᵏ) from vⱼ = f(xωʲ), where f = ∑ⱼXʲfⱼ(Xᵏ).
+//
+// Since vⱼ = ∑ₘωʲᵐ(xᵐfₘ(xᵏ)), the xᵐfₘ(xᵏ) are obtained with an inverse DFT of size k.
+func (s *multiplicativeFri) foldFiber(v []goldilocks.Element, xInv, beta goldilocks.Element) goldilocks.Element {
+	k := len(v)
+	var res, c, tmp, r goldilocks.Element
+
+	// res = ∑ₘ(βx⁻¹)ᵐ(xᵐfₘ(xᵏ))
+	r.Mul(&beta, &xInv)
+	for m := k - 1; m >= 0; m-- {
+		c.SetZero()
+		for j := 0; j < k; j++ {
+			tmp.Mul(&v[j], &s.omegaInv[(j*m)%k])
+			c.Add(&c, &tmp)
+		}
+		res.Mul(&res, &r).Add(&res, &c)
+	}
+	res.Mul(&res, &s.arityInv)
+
+	return res
+}
+
+// Opens a polynomial at gⁱ where i = position.
+func (s *multiplicativeFri) Open(p []goldilocks.Element, position uint64) (OpeningProof, error) {
+
+	// check that position is in the correct range
+	if position >= s.domain.Cardinality {
+		return OpeningProof{}, ErrRangePosition
+	}
+
+	// put p in evaluation form
+	evaluations, err := s.evaluate(p)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// the leaves are the fibers of the first folding, as in the proof of proximity
+	tree := newMerkleTree(s.h, s.leaves(evaluations))
+
+	var res OpeningProof
+	res.numLeaves = s.domain.Cardinality / uint64(s.params.FoldingArity)
+	res.index = position % res.numLeaves
+	res.merkleRoot = tree.root()
+	res.ProofSet = tree.proofSet(res.index)
+	res.ClaimedValue.Set(&evaluations[position])
+
+	return res, nil
+}
+
+// Verifies the opening of a polynomial.
+// * position the point at which the proof is opened (the point is gⁱ where i = position)
+// * openingProof Merkle path proof
+// * pp proof of proximity, needed because before opening Merkle path proof one should be sure that the
+// committed values come from a polynomial. During the verification of the Merkle path proof, the root
+// hash of the Merkle path is compared to the root hash of the first folded function of the proof of
+// proximity, those should be equal, if not an error is raised.
+func (s *multiplicativeFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
+
+	if position >= s.domain.Cardinality {
+		return ErrRangePosition
+	}
+	if len(pp.Roots) == 0 {
+		return ErrInvalidProof
+	}
+
+	// check that the merkle roots coincide
+	if !bytes.Equal(openingProof.merkleRoot, pp.Roots[0]) {
+		return ErrMerkleRoot
+	}
+
+	// check the Merkle proof of the fiber containing position
+	numLeaves := s.domain.Cardinality / uint64(s.params.FoldingArity)
+	if !merkletree.VerifyProof(s.h, pp.Roots[0], openingProof.ProofSet, position%numLeaves, numLeaves) {
+		return ErrMerklePath
+	}
+
+	// check the claimed value
+	fiber, err := s.parseLeaf(openingProof.ProofSet[0])
+	if err != nil {
+		return err
+	}
+	if !fiber[position/numLeaves].Equal(&openingProof.ClaimedValue) {
+		return ErrClaimedValue
+	}
+
+	return nil
+}
+
+// challengesID returns the names of the challenges of the Fiat Shamir transcript: the
+// folding challenges βᵢ, the seed of the proof of work and the seed of the queries
+func (s *multiplicativeFri) challengesID() []string {
+	res := make([]string, s.nbSteps+2)
+	for i := 0; i < s.nbSteps; i++ {
+		res[i] = fmt.Sprintf("beta%d", i)
+	}
+	res[s.nbSteps] = "grinding"
+	res[s.nbSteps+1] = "queries"
+	return res
+}
+
+// deriveBeta binds the root of the i-th folded function to fs, and returns the i-th folding challenge
+func (s *multiplicativeFri) deriveBeta(fs *fiatshamir.Transcript, i int, root []byte) (goldilocks.Element, error) {
+	var beta goldilocks.Element
+	id := fmt.Sprintf("beta%d", i)
+	if err := fs.Bind(id, root); err != nil {
+		return beta, err
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return beta, err
+	}
+	beta.SetBytes(b)
+	return beta, nil
+}
+
+// deriveGrindingSeed binds the final polynomial to fs, and returns the seed of the proof of work
+func (s *multiplicativeFri) deriveGrindingSeed(fs *fiatshamir.Transcript, finalPolynomial []goldilocks.Element) ([]byte, error) {
+	for i := range finalPolynomial {
+		if err := fs.Bind("grinding", finalPolynomial[i].Marshal()); err != nil {
+			return nil, err
+		}
+	}
+	return fs.ComputeChallenge("grinding")
+}
+
+// checkProofOfWork returns true if H(seed ∥ nonce) starts with GrindingBits zero bits
+func (s *multiplicativeFri) checkProofOfWork(seed []byte, nonce uint64) bool {
+	if s.params.GrindingBits == 0 {
+		return true
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], nonce)
+	s.h.Reset()
+	s.h.Write(seed)
+	s.h.Write(buf[:])
+	digest := s.h.Sum(nil)
+
+	nbZeros := 0
+	for _, b := range digest {
+		nbZeros += bits.LeadingZeros8(b)
+		if b != 0 || nbZeros >= s.params.GrindingBits {
+			break
+		}
+	}
+	return nbZeros >= s.params.GrindingBits
+}
+
+// grind returns the smallest nonce solving the proof of work
+func (s *multiplicativeFri) grind(seed []byte) uint64 {
+	var nonce uint64
+	for !s.checkProofOfWork(seed, nonce) {
+		nonce++
+	}
+	return nonce
+}
+
+// deriveQueries binds the nonce to fs, and returns the queried fibers of the first
+// folding: the i-th one is H(seed ∥ i) mod the number of fibers.
+func (s *multiplicativeFri) deriveQueries(fs *fiatshamir.Transcript, nonce uint64) ([]uint64, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], nonce)
+	if err := fs.Bind("queries", buf[:]); err != nil {
+		return nil, err
+	}
+	seed, err := fs.ComputeChallenge("queries")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]uint64, s.params.NbQueries)
+	var bPos, bNbFibers big.Int
+	bNbFibers.SetUint64(s.domain.Cardinality / uint64(s.params.FoldingArity))
+	for i := range res {
+		s.h.Reset()
+		s.h.Write(seed)
+		binary.BigEndian.PutUint64(buf[:], uint64(i))
+		s.h.Write(buf[:])
+		bPos.SetBytes(s.h.Sum(nil))
+		res[i] = bPos.Mod(&bPos, &bNbFibers).Uint64()
+	}
+	return res, nil
+}
+
+// BuildProofOfProximity generates a proof that a function, given as an oracle from
+// the verifier point of view, is in fact δ-close to a polynomial.
+func (s *multiplicativeFri) BuildProofOfProximity(p []goldilocks.Element) (ProofOfProximity, error) {
+
+	var proof ProofOfProximity
+
+	// evaluate p
+	evaluations, err := s.evaluate(p)
+	if err != nil {
+		return proof, err
+	}
+
+	// Fiat Shamir transcript to derive the challenges. The βᵢ are used to fold the
+	// polynomials.
+	// During the i-th step, the prover has a polynomial P of size n. The verifier sends
+	// βᵢ∈ Fᵣ to the prover. The prover expresses P as ∑ⱼXʲPⱼ(Xᵏ) where the Pⱼ are of
+	// size n/k, and folds it into ∑ⱼβᵢʲPⱼ.
+	fs := fiatshamir.NewTranscript(s.h, s.challengesID()...)
+
+	// step 1: commit to the successive folded functions
+	trees := make([]*merkleTree, s.nbSteps)
+	proof.Roots = make([][]byte, s.nbSteps)
+	var gInv goldilocks.Element
+	gInv.Set(&s.domain.GeneratorInv)
+	bArity := big.NewInt(int64(s.params.FoldingArity))
+	for i := 0; i < s.nbSteps; i++ {
+		trees[i] = newMerkleTree(s.h, s.leaves(evaluations))
+		proof.Roots[i] = trees[i].root()
+
+		beta, err := s.deriveBeta(fs, i, proof.Roots[i])
+		if err != nil {
+			return proof, err
+		}
+		evaluations = s.fold(evaluations, gInv, beta)
+
+		// g <- gᵏ
+		gInv.Exp(gInv, bArity)
+	}
+
+	// the fully folded polynomial, in canonical form
+	s.finalDomain.FFTInverse(evaluations, fft.DIF)
+	fft.BitReverse(evaluations)
+	proof.FinalPolynomial = evaluations[:s.finalSize]
+
+	// step 2: proof of work
+	seed, err := s.deriveGrindingSeed(fs, proof.FinalPolynomial)
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = s.grind(seed)
+
+	// step 3: open the folded functions on the queried fibers
+	queries, err := s.deriveQueries(fs, proof.Nonce)
+	if err != nil {
+		return proof, err
+	}
+	k := uint64(s.params.FoldingArity)
+	proof.Queries = make([]Query, len(queries))
+	for q, t := range queries {
+		proof.Queries[q].ProofSets = make([][][]byte, s.nbSteps)
+		n := s.domain.Cardinality / k
+		for i := 0; i < s.nbSteps; i++ {
+			t %= n
+			proof.Queries[q].ProofSets[i] = trees[i].proofSet(t)
+			n /= k
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyProofOfProximity verifies the proof, by replaying the transcript and checking
+// the foldings on each query.
+func (s *multiplicativeFri) VerifyProofOfProximity(proof ProofOfProximity) error {
+
+	if len(proof.Roots) != s.nbSteps ||
+		uint64(len(proof.FinalPolynomial)) != s.finalSize ||
+		len(proof.Queries) != s.params.NbQueries {
+		return ErrInvalidProof
+	}
+
+	// Fiat Shamir transcript to derive the challenges
+	fs := fiatshamir.NewTranscript(s.h, s.challengesID()...)
+	betas := make([]goldilocks.Element, s.nbSteps)
+	for i := range betas {
+		var err error
+		if betas[i], err = s.deriveBeta(fs, i, proof.Roots[i]); err != nil {
+			return err
+		}
+	}
+	seed, err := s.deriveGrindingSeed(fs, proof.FinalPolynomial)
+	if err != nil {
+		return err
+	}
+	if !s.checkProofOfWork(seed, proof.Nonce) {
+		return ErrProofOfWork
+	}
+	queries, err := s.deriveQueries(fs, proof.Nonce)
+	if err != nil {
+		return err
+	}
+
+	for q := range queries {
+		if err := s.verifyQuery(queries[q], &proof.Queries[q], &proof, betas); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyQuery checks the Merkle proofs of the fibers opened by query, that the folded
+// evaluation on a fiber is in the next one, and that the last folded evaluation is
+// consistent with the final polynomial.
+func (s *multiplicativeFri) verifyQuery(t uint64, query *Query, proof *ProofOfProximity, betas []goldilocks.Element) error {
+
+	if len(query.ProofSets) != s.nbSteps {
+		return ErrInvalidProof
+	}
+
+	k := uint64(s.params.FoldingArity)
+	bArity := new(big.Int).SetUint64(k)
+	var gInv, xInv, folded goldilocks.Element
+	gInv.Set(&s.domain.GeneratorInv)
+
+	// number of fibers of the current folded function
+	n := s.domain.Cardinality / k
+	for i := 0; i < s.nbSteps; i++ {
+
+		// the folded evaluation of the previous step is at position t, which is
+		// the j-th entry of the fiber t mod n
+		j := t / n
+		t %= n
+
+		if !merkletree.VerifyProof(s.h, proof.Roots[i], query.ProofSets[i], t, n) {
+			return ErrMerklePath
+		}
+		fiber, err := s.parseLeaf(query.ProofSets[i][0])
+		if err != nil {
+			return err
+		}
+
+		// correctness of the folding
+		if i > 0 && !fiber[j].Equal(&folded) {
+			return ErrProximityTestFolding
+		}
+
+		// fold the fiber of g^t
+		xInv.Exp(gInv, new(big.Int).SetUint64(t))
+		folded = s.foldFiber(fiber, xInv, betas[i])
+
+		// g <- gᵏ
+		gInv.Exp(gInv, bArity)
+		n /= k
+	}
+
+	// last step: the folded evaluation is the one of the final polynomial at g^t
+	var x, y goldilocks.Element
+	x.Exp(s.finalDomain.Generator, new(big.Int).SetUint64(t))
+	for i := len(proof.FinalPolynomial) - 1; i >= 0; i-- {
+		y.Mul(&y, &x).Add(&y, &proof.FinalPolynomial[i])
+	}
+	if !y.Equal(&folded) {
+		return ErrLowDegree
+	}
+
+	return nil
+}
+
+// merkleTree stores all the levels of a Merkle tree with a power of 2 number of leaves,
+// hashed as in the merkletree package, to produce several proofs without rebuilding it.
+type merkleTree struct {
+	leaves [][]byte
+
+	// levels[0] contains the hashes of the leaves, and the last level the root
+	levels [][][]byte
+}
+
+func newMerkleTree(h hash.Hash, leaves [][]byte) *merkleTree {
+	res := merkleTree{leaves: leaves}
+	level := make([][]byte, len(leaves))
+	for i := range leaves {
+		h.Reset()
+		h.Write(leaves[i])
+		level[i] = h.Sum(nil)
+	}
+	res.levels = append(res.levels, level)
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			h.Reset()
+			h.Write(level[2*i])
+			h.Write(level[2*i+1])
+			next[i] = h.Sum(nil)
+		}
+		res.levels = append(res.levels, next)
+		level = next
+	}
+	return &res
+}
+
+func (t *merkleTree) root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// proofSet returns [leaf ∥ node_1 ∥ ..], the Merkle proof of the i-th leaf
+func (t *merkleTree) proofSet(i uint64) [][]byte {
+	res := make([][]byte, 1, len(t.levels))
+	res[0] = t.leaves[i]
+	for l := 0; l < len(t.levels)-1; l++ {
+		res = append(res, t.levels[l][i^1])
+		i >>= 1
+	}
+	return res
+}
@@ -0,0 +1,357 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func randomPolynomial(size uint64, seed int32) []goldilocks.Element {
+	p := make([]goldilocks.Element, size)
+	p[0].SetUint64(uint64(seed))
+	for i := 1; i < len(p); i++ {
+		p[i].Square(&p[i-1])
+	}
+	return p
+}
+
+func newIopp(t testing.TB, size uint64, params Params) Iopp {
+	t.Helper()
+	iop, err := MULTIPLICATIVE_FRI.New(size, sha256.New(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return iop
+}
+
+func TestFRI(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	size := 4096
+	s := newIopp(t, uint64(size), DefaultParams())
+	rho := s.Params().Rho
+
+	properties.Property("verifying wrong opening should fail", prop.ForAll(
+
+		func(m int32) bool {
+
+			p := randomPolynomial(uint64(size), m)
+
+			pos := int64(m % 4096)
+			pp, _ := s.BuildProofOfProximity(p)
+
+			openingProof, err := s.Open(p, uint64(pos))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// check the Merkle path
+			tamperedPosition := pos + 1
+			err = s.VerifyOpening(uint64(tamperedPosition), openingProof, pp)
+
+			return err != nil
+
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
+
+	properties.Property("verifying correct opening should succeed", prop.ForAll(
+
+		func(m int32) bool {
+
+			p := randomPolynomial(uint64(size), m)
+
+			pos := uint64(m % int32(rho*size))
+			pp, _ := s.BuildProofOfProximity(p)
+
+			openingProof, err := s.Open(p, uint64(pos))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// check the Merkle path
+			err = s.VerifyOpening(uint64(pos), openingProof, pp)
+
+			return err == nil
+
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
+
+	properties.Property("The claimed value of a polynomial should match P(x)", prop.ForAll(
+		func(m int32) bool {
+
+			p := randomPolynomial(uint64(size), m)
+
+			// check the opening value
+			var g goldilocks.Element
+			pos := int64(m % 4096)
+			g.Set(&s.(*multiplicativeFri).domain.Generator)
+			g.Exp(g, big.NewInt(pos))
+
+			var val goldilocks.Element
+			for i := len(p) - 1; i >= 0; i-- {
+				val.Mul(&val, &g)
+				val.Add(&p[i], &val)
+			}
+
+			openingProof, err := s.Open(p, uint64(pos))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			return openingProof.ClaimedValue.Equal(&val)
+
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
+
+	properties.Property("verifying a correctly formed proof should succeed", prop.ForAll(
+
+		func(m int32) bool {
+
+			p := randomPolynomial(uint64(size), m)
+
+			proof, err := s.BuildProofOfProximity(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = s.VerifyProofOfProximity(proof)
+			return err == nil
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+}
+
+func TestFRIParams(t *testing.T) {
+	const size = 256
+
+	for _, arity := range []int{2, 4, 8, 16} {
+		for _, rho := range []int{2, 4} {
+			for _, finalDegree := range []int{0, 3} {
+				params := Params{
+					FoldingArity:  arity,
+					Rho:           rho,
+					SecurityLevel: 40,
+					GrindingBits:  4,
+					FinalDegree:   finalDegree,
+				}
+				name := fmt.Sprintf("arity=%d/rho=%d/finalDegree=%d", arity, rho, finalDegree)
+				t.Run(name, func(t *testing.T) {
+					iop := newIopp(t, size, params)
+					// (40 - 4) bits of security, log₂(ρ) bits per query
+					expectedNbQueries := map[int]int{2: 36, 4: 18}[rho]
+					if nbQueries := iop.Params().NbQueries; nbQueries != expectedNbQueries {
+						t.Fatalf("expected %d queries, got %d", expectedNbQueries, nbQueries)
+					}
+
+					var p [size]goldilocks.Element
+					for i := range p {
+						p[i].SetRandom()
+					}
+					proof, err := iop.BuildProofOfProximity(p[:])
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := iop.VerifyProofOfProximity(proof); err != nil {
+						t.Fatal(err)
+					}
+
+					// every fiber opened by the proof has FoldingArity evaluations
+					for _, q := range proof.Queries {
+						for _, proofSet := range q.ProofSets {
+							if len(proofSet[0]) != arity*goldilocks.Bytes {
+								t.Fatal("wrong size of the opened fibers")
+							}
+						}
+					}
+
+					// the final polynomial is of degree at most FinalDegree
+					if len(proof.FinalPolynomial) > finalDegree+1 && len(proof.FinalPolynomial) >= arity {
+						t.Fatal("the final polynomial is too large")
+					}
+
+					// tampered final polynomial
+					proof.FinalPolynomial[0].SetRandom()
+					if err := iop.VerifyProofOfProximity(proof); err == nil {
+						t.Fatal("verifying a tampered proof should fail")
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestFRIErrors(t *testing.T) {
+	for _, params := range []Params{
+		{FoldingArity: 3, Rho: 8, NbQueries: 4},
+		{FoldingArity: 2, Rho: 6, NbQueries: 4},
+		{FoldingArity: 2, Rho: 1, NbQueries: 4},
+		{FoldingArity: 2, Rho: 8},
+		{FoldingArity: 2, Rho: 8, NbQueries: 4, GrindingBits: 64},
+		{FoldingArity: 2, Rho: 8, NbQueries: 4, FinalDegree: -1},
+	} {
+		if _, err := MULTIPLICATIVE_FRI.New(64, sha256.New(), params); err == nil {
+			t.Fatalf("%+v should be rejected", params)
+		}
+	}
+	if _, err := MULTIPLICATIVE_FRI.New(8, sha256.New(), Params{FoldingArity: 16, Rho: 2, NbQueries: 4}); err != ErrSizeTooSmall {
+		t.Fatal("a polynomial smaller than the folding arity should be rejected")
+	}
+
+	iop := newIopp(t, 64, Params{FoldingArity: 4, Rho: 2, NbQueries: 4, GrindingBits: 8})
+	p := randomPolynomial(64, 5)
+	proof, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.Nonce++
+	if err := iop.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying a wrong proof of work should fail")
+	}
+	proof.Nonce--
+
+	// a leaf of an opened fiber is modified
+	proof.Queries[0].ProofSets[1][0][0] ^= 1
+	if err := iop.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying a tampered fiber should fail")
+	}
+
+	// a verifier with other parameters rejects the proof
+	other := newIopp(t, 64, Params{FoldingArity: 2, Rho: 2, NbQueries: 4, GrindingBits: 8})
+	if err := other.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying with other parameters should fail")
+	}
+
+	if _, err := iop.BuildProofOfProximity(randomPolynomial(65, 5)); err != ErrPolynomialSize {
+		t.Fatal("a polynomial larger than the size should be rejected")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	const size = 64
+	iop := newIopp(t, size, Params{FoldingArity: 4, Rho: 4, SecurityLevel: 64, GrindingBits: 4, FinalDegree: 1})
+	p := randomPolynomial(uint64(size), 3)
+
+	pp, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	openingProof, err := iop.Open(p, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := pp.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openingProof.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	written := int64(buf.Len())
+
+	var ppRead ProofOfProximity
+	var openingProofRead OpeningProof
+	n, err := ppRead.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := openingProofRead.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n+m != written {
+		t.Fatalf("read %d bytes, wrote %d", n+m, written)
+	}
+
+	if err := iop.VerifyProofOfProximity(ppRead); err != nil {
+		t.Fatal(err)
+	}
+	if err := iop.VerifyOpening(5, openingProofRead, ppRead); err != nil {
+		t.Fatal(err)
+	}
+	if !openingProofRead.ClaimedValue.Equal(&openingProof.ClaimedValue) {
+		t.Fatal("claimed value mismatch")
+	}
+}
+
+// Benchmarks
+
+func BenchmarkProximityVerification(b *testing.B) {
+
+	baseSize := 16
+
+	for i := 0; i < 10; i++ {
+
+		size := baseSize << i
+		p := make([]goldilocks.Element, size)
+		for k := 0; k < size; k++ {
+			p[k].SetRandom()
+		}
+
+		iop := newIopp(b, uint64(size), DefaultParams())
+		proof, _ := iop.BuildProofOfProximity(p)
+
+		b.Run(fmt.Sprintf("Polynomial size %d", size), func(b *testing.B) {
+			b.ResetTimer()
+			for l := 0; l < b.N; l++ {
+				iop.VerifyProofOfProximity(proof)
+			}
+		})
+
+	}
+}
+
+func BenchmarkProofOfProximity(b *testing.B) {
+
+	const size = 1 << 14
+	p := make([]goldilocks.Element, size)
+	for k := 0; k < size; k++ {
+		p[k].SetRandom()
+	}
+
+	for _, arity := range []int{2, 4, 8, 16} {
+		params := DefaultParams()
+		params.FoldingArity = arity
+		iop := newIopp(b, size, params)
+
+		b.Run(fmt.Sprintf("arity %d", arity), func(b *testing.B) {
+			b.ResetTimer()
+			for l := 0; l < b.N; l++ {
+				_, _ = iop.BuildProofOfProximity(p)
+			}
+		})
+	}
+}
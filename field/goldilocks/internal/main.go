@@ -17,5 +17,12 @@ func main() {
 	if err := generator.GenerateFF(goldilocks, "../"); err != nil {
 		panic(err)
 	}
+	e2, err := config.NewExtension(goldilocks, 2, 7)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateExtension(goldilocks, e2, "../"); err != nil {
+		panic(err)
+	}
 	fmt.Println("successfully generated goldilocks field")
 }
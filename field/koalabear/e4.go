@@ -0,0 +1,482 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package koalabear
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// E4 is a degree 4 extension of Element: E4 = Element[u]/(u⁴ - (3))
+//
+// z = A0 + A1*u + A2*u² + A3*u³
+type E4 struct {
+	A0, A1, A2, A3 Element
+}
+
+// BytesE4 is the number of bytes needed to encode an E4
+const BytesE4 = 4 * Bytes
+
+// e4RootOf is α such that u⁴ = α
+var e4RootOf = Element{100663290}
+
+// e4Frobenius[i] = α^(i(p-1)/4), such that (uⁱ)ᵖ = e4Frobenius[i] * uⁱ
+var e4Frobenius = [4]Element{
+	{33554430},
+	{2063729671},
+	{2097152003},
+	{66976762},
+}
+
+// e4SqrtG = zˢ where z is a non-square in E4 and p⁴-1 = 2ᵉ * s, s odd
+var e4SqrtG = E4{
+	A0: Element{0},
+	A1: Element{0},
+	A2: Element{0},
+	A3: Element{112200240},
+}
+
+// e4SqrtSMinusOneOver2 = (s-1)/2
+var e4SqrtSMinusOneOver2 big.Int
+
+func init() {
+	e4SqrtSMinusOneOver2.SetString("1f02fc02fa0bf802f40c0003f", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2) && z.A3.Equal(&x.A3)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E4) SetZero() *E4 {
+	*z = E4{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E4) SetOne() *E4 {
+	*z = E4{}
+	z.A0.SetOne()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E4) Set(x *E4) *E4 {
+	*z = *x
+	return z
+}
+
+// SetElement sets z to the base field element x and returns z
+func (z *E4) SetElement(x *Element) *E4 {
+	*z = E4{}
+	z.A0.Set(x)
+	return z
+}
+
+// SetRandom sets z to a uniform random value
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A3.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z == 0
+func (z *E4) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero()
+}
+
+// IsOne returns true if z == 1
+func (z *E4) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	z.A3.Add(&x.A3, &y.A3)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	z.A3.Sub(&x.A3, &y.A3)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	z.A3.Double(&x.A3)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E4) Neg(x *E4) *E4 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	z.A3.Neg(&x.A3)
+	return z
+}
+
+// Halve sets z = z / 2
+func (z *E4) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+	z.A2.Halve()
+	z.A3.Halve()
+}
+
+// MulByElement sets z = x * y where y is in the base field and returns z
+func (z *E4) MulByElement(x *E4, y *Element) *E4 {
+	var yCopy Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	z.A3.Mul(&x.A3, &yCopy)
+	return z
+}
+
+// e4MulByRootOf sets z = α * x
+func e4MulByRootOf(z, x *Element) {
+	z.Mul(x, &e4RootOf)
+}
+
+// Mul sets z = x * y and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	// schoolbook multiplication, terms of degree ⩾ 4 are reduced with u⁴ = α
+	var t Element
+	var c E4
+
+	// coefficient of u⁰
+	c.A0.Mul(&x.A1, &y.A3)
+	t.Mul(&x.A2, &y.A2)
+	c.A0.Add(&c.A0, &t)
+	t.Mul(&x.A3, &y.A1)
+	c.A0.Add(&c.A0, &t)
+	e4MulByRootOf(&c.A0, &c.A0)
+	t.Mul(&x.A0, &y.A0)
+	c.A0.Add(&c.A0, &t)
+
+	// coefficient of u¹
+	c.A1.Mul(&x.A2, &y.A3)
+	t.Mul(&x.A3, &y.A2)
+	c.A1.Add(&c.A1, &t)
+	e4MulByRootOf(&c.A1, &c.A1)
+	t.Mul(&x.A0, &y.A1)
+	c.A1.Add(&c.A1, &t)
+	t.Mul(&x.A1, &y.A0)
+	c.A1.Add(&c.A1, &t)
+
+	// coefficient of u²
+	c.A2.Mul(&x.A3, &y.A3)
+	e4MulByRootOf(&c.A2, &c.A2)
+	t.Mul(&x.A0, &y.A2)
+	c.A2.Add(&c.A2, &t)
+	t.Mul(&x.A1, &y.A1)
+	c.A2.Add(&c.A2, &t)
+	t.Mul(&x.A2, &y.A0)
+	c.A2.Add(&c.A2, &t)
+
+	// coefficient of u³
+	c.A3.Mul(&x.A0, &y.A3)
+	t.Mul(&x.A1, &y.A2)
+	c.A3.Add(&c.A3, &t)
+	t.Mul(&x.A2, &y.A1)
+	c.A3.Add(&c.A3, &t)
+	t.Mul(&x.A3, &y.A0)
+	c.A3.Add(&c.A3, &t)
+
+	*z = c
+	return z
+}
+
+// Square sets z = x * x and returns z
+func (z *E4) Square(x *E4) *E4 {
+	return z.Mul(x, x)
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &e4Frobenius[1])
+	z.A2.Mul(&x.A2, &e4Frobenius[2])
+	z.A3.Mul(&x.A3, &e4Frobenius[3])
+	return z
+}
+
+// Norm returns the norm of z, that is the product of its conjugates z * zᵖ * ... * z^(p³)
+func (z *E4) Norm() Element {
+	var c, n E4
+	c.conjugatesProduct(z)
+	n.Mul(&c, z)
+	return n.A0
+}
+
+// conjugatesProduct sets z = xᵖ * ... * x^(p³), such that x * z = Norm(x)
+func (z *E4) conjugatesProduct(x *E4) *E4 {
+	var c, f E4
+	f.Frobenius(x)
+	c.Set(&f)
+	for i := 2; i < 4; i++ {
+		f.Frobenius(&f)
+		c.Mul(&c, &f)
+	}
+	return z.Set(&c)
+}
+
+// Inverse sets z = x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	// x⁻¹ = (xᵖ * ... * x^(p³)) / Norm(x)
+	var c, n E4
+	c.conjugatesProduct(x)
+	n.Mul(&c, x)
+	n.A0.Inverse(&n.A0)
+	return z.MulByElement(&c, &n.A0)
+}
+
+// Div sets z = x / y and returns z
+func (z *E4) Div(x, y *E4) *E4 {
+	var r E4
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// BatchInvertE4 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Exp sets z=xᵏ (mod p⁴) and returns it
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod p⁴) == (x⁻¹)ᵏ (mod p⁴)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E4 if and only if its norm is a square in Element
+func (z *E4) Legendre() int {
+	n := z.Norm()
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E4
+// if the square root doesn't exist (x is not a square)
+// Sqrt leaves z unchanged and returns nil
+func (z *E4) Sqrt(x *E4) *E4 {
+	if x.Legendre() == -1 {
+		return nil
+	}
+
+	// Tonelli-Shanks
+	// see modSqrtTonelliShanks in math/big/int.go
+	var y, b, t, w E4
+	// w = x^((s-1)/2))
+	w.Exp(*x, &e4SqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	g := e4SqrtG
+	r := uint64(26)
+
+	if b.IsZero() {
+		return z.SetZero()
+	}
+
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E4) Select(c int, x0 *E4, x1 *E4) *E4 {
+	z.A0.Select(c, &x0.A0, &x1.A0)
+	z.A1.Select(c, &x0.A1, &x1.A1)
+	z.A2.Select(c, &x0.A2, &x1.A2)
+	z.A3.Select(c, &x0.A3, &x1.A3)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E4) String() string {
+	var sbb strings.Builder
+	sbb.WriteString(z.A0.String())
+	sbb.WriteString("+" + z.A1.String() + "*u")
+	sbb.WriteString("+" + z.A2.String() + "*u²")
+	sbb.WriteString("+" + z.A3.String() + "*u³")
+	return sbb.String()
+}
+
+// Bytes returns the big-endian encoding of the coordinates of z, A0 first
+func (z *E4) Bytes() (res [BytesE4]byte) {
+	BigEndian.PutElement((*[Bytes]byte)(res[0*Bytes:1*Bytes]), z.A0)
+	BigEndian.PutElement((*[Bytes]byte)(res[1*Bytes:2*Bytes]), z.A1)
+	BigEndian.PutElement((*[Bytes]byte)(res[2*Bytes:3*Bytes]), z.A2)
+	BigEndian.PutElement((*[Bytes]byte)(res[3*Bytes:4*Bytes]), z.A3)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *E4) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes.
+// It returns an error if e has the wrong length or a coordinate is not canonical.
+func (z *E4) SetBytesCanonical(e []byte) error {
+	if len(e) != BytesE4 {
+		return errors.New("invalid koalabear.E4 encoding")
+	}
+	var r E4
+	if err := r.A0.SetBytesCanonical(e[0*Bytes : 1*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A1.SetBytesCanonical(e[1*Bytes : 2*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A2.SetBytesCanonical(e[2*Bytes : 3*Bytes]); err != nil {
+		return err
+	}
+	if err := r.A3.SetBytesCanonical(e[3*Bytes : 4*Bytes]); err != nil {
+		return err
+	}
+	*z = r
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (z *E4) MarshalBinary() ([]byte, error) {
+	return z.Marshal(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (z *E4) UnmarshalBinary(data []byte) error {
+	return z.SetBytesCanonical(data)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x7f000001"
+	koalabear, err := config.NewFieldConfig32("koalabear", "Element", modulus)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(koalabear, "../"); err != nil {
+		panic(err)
+	}
+	e4, err := config.NewExtension(koalabear, 4, 3)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateExtension(koalabear, e4, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated koalabear field")
+}
@@ -0,0 +1,445 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mersenne31
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+// most benchmarks are rudimentary and should sample a large number of random inputs
+// or be run multiple times to ensure it didn't measure the fastest path of the function
+
+var benchResElement Element
+
+func BenchmarkElementMul(b *testing.B) {
+	x := Element{4}
+	benchResElement.SetOne()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Mul(&benchResElement, &x)
+	}
+}
+
+func BenchmarkElementSquare(b *testing.B) {
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Square(&benchResElement)
+	}
+}
+
+func BenchmarkElementAdd(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Add(&x, &benchResElement)
+	}
+}
+
+func BenchmarkElementSub(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sub(&x, &benchResElement)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Inverse(&x)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sqrt(&a)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+// special values to be tested and combined with random values
+var staticTestValues []Element
+
+func init() {
+	staticTestValues = append(staticTestValues, Element{}) // zero
+	staticTestValues = append(staticTestValues, One())     // one
+	staticTestValues = append(staticTestValues, rSquareElement())
+	for i := uint64(2); i <= 8; i++ {
+		var e Element
+		e.SetUint64(i)
+		staticTestValues = append(staticTestValues, e)
+	}
+	for i := uint64(1); i <= 8; i++ {
+		var e Element
+		e.SetUint64(uint64(q) - i)
+		staticTestValues = append(staticTestValues, e)
+	}
+	var e Element
+	e.SetUint64(uint64(q) >> 1)
+	staticTestValues = append(staticTestValues, e)
+	e.SetUint64(uint64(q)>>1 + 1)
+	staticTestValues = append(staticTestValues, e)
+}
+
+// rSquareElement is a value with a large internal representation
+func rSquareElement() Element {
+	return Element{4}
+}
+
+func testParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	return parameters
+}
+
+type testPairElement struct {
+	element Element
+	bigint  big.Int
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPairElement
+
+		g.element = Element{uint32(genParams.NextUint64() % uint64(q))}
+		g.element.BigInt(&g.bigint)
+		return gopter.NewGenResult(g, gopter.NoShrinker)
+	}
+}
+
+// checkBinary checks op against the big.Int reference on random and static values
+func checkBinary(t *testing.T, name string, op func(z, x, y *Element), ref func(z, x, y *big.Int)) {
+	t.Helper()
+
+	check := func(a, b *Element) bool {
+		var c Element
+		op(&c, a, b)
+
+		var ba, bb, bc, got big.Int
+		a.BigInt(&ba)
+		b.BigInt(&bb)
+		ref(&bc, &ba, &bb)
+		bc.Mod(&bc, Modulus())
+		return c.smallerThanModulus() && c.BigInt(&got).Cmp(&bc) == 0
+	}
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property(name+": must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			return check(&a.element, &b.element)
+		},
+		gen(), gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			if !check(&staticTestValues[i], &staticTestValues[j]) {
+				t.Fatalf("%s failed on static values %s, %s", name, staticTestValues[i].String(), staticTestValues[j].String())
+			}
+		}
+	}
+}
+
+func TestElementAdd(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Add", func(z, x, y *Element) { z.Add(x, y) }, func(z, x, y *big.Int) { z.Add(x, y) })
+}
+
+func TestElementSub(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Sub", func(z, x, y *Element) { z.Sub(x, y) }, func(z, x, y *big.Int) { z.Sub(x, y) })
+}
+
+func TestElementMul(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Mul", func(z, x, y *Element) { z.Mul(x, y) }, func(z, x, y *big.Int) { z.Mul(x, y) })
+}
+
+func TestElementDiv(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Div", func(z, x, y *Element) { z.Div(x, y) }, func(z, x, y *big.Int) {
+		if y.Sign() == 0 {
+			z.SetUint64(0)
+			return
+		}
+		z.ModInverse(y, Modulus()).Mul(z, x)
+	})
+}
+
+func TestElementExp(t *testing.T) {
+	t.Parallel()
+	checkBinary(t, "Exp", func(z, x, y *Element) {
+		var e big.Int
+		y.BigInt(&e)
+		z.Exp(*x, &e)
+	}, func(z, x, y *big.Int) { z.Exp(x, y, Modulus()) })
+}
+
+func TestElementUnary(t *testing.T) {
+	t.Parallel()
+	ignore := func(f func(z, x *Element)) func(z, x, y *Element) {
+		return func(z, x, _ *Element) { f(z, x) }
+	}
+	ignoreBig := func(f func(z, x *big.Int)) func(z, x, y *big.Int) {
+		return func(z, x, _ *big.Int) { f(z, x) }
+	}
+
+	checkBinary(t, "Square", ignore(func(z, x *Element) { z.Square(x) }), ignoreBig(func(z, x *big.Int) { z.Mul(x, x) }))
+	checkBinary(t, "Double", ignore(func(z, x *Element) { z.Double(x) }), ignoreBig(func(z, x *big.Int) { z.Lsh(x, 1) }))
+	checkBinary(t, "Neg", ignore(func(z, x *Element) { z.Neg(x) }), ignoreBig(func(z, x *big.Int) { z.Neg(x) }))
+	checkBinary(t, "Halve", ignore(func(z, x *Element) {
+		z.Set(x)
+		z.Halve()
+	}), ignoreBig(func(z, x *big.Int) { z.ModInverse(big.NewInt(2), Modulus()).Mul(z, x) }))
+	checkBinary(t, "Inverse", ignore(func(z, x *Element) { z.Inverse(x) }), ignoreBig(func(z, x *big.Int) {
+		if x.Sign() == 0 {
+			z.SetUint64(0)
+			return
+		}
+		z.ModInverse(x, Modulus())
+	}))
+	checkBinary(t, "MulBy3", ignore(func(z, x *Element) {
+		z.Set(x)
+		MulBy3(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(3)) }))
+	checkBinary(t, "MulBy5", ignore(func(z, x *Element) {
+		z.Set(x)
+		MulBy5(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(5)) }))
+	checkBinary(t, "MulBy13", ignore(func(z, x *Element) {
+		z.Set(x)
+		MulBy13(z)
+	}), ignoreBig(func(z, x *big.Int) { z.Mul(x, big.NewInt(13)) }))
+}
+
+func TestElementButterfly(t *testing.T) {
+	t.Parallel()
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			a, b := staticTestValues[i], staticTestValues[j]
+			var sum, diff Element
+			sum.Add(&a, &b)
+			diff.Sub(&a, &b)
+			Butterfly(&a, &b)
+			if !a.Equal(&sum) || !b.Equal(&diff) {
+				t.Fatal("butterfly doesn't match add/sub")
+			}
+		}
+	}
+}
+
+func TestElementSqrtLegendre(t *testing.T) {
+	t.Parallel()
+
+	check := func(a *Element) bool {
+		var ba big.Int
+		a.BigInt(&ba)
+		if a.Legendre() != big.Jacobi(&ba, Modulus()) {
+			return false
+		}
+		var s, square Element
+		if s.Sqrt(a) == nil {
+			return a.Legendre() == -1
+		}
+		return square.Square(&s).Equal(a)
+	}
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property("Sqrt and Legendre must be consistent with big.Int", prop.ForAll(
+		func(a testPairElement) bool {
+			var square Element
+			square.Square(&a.element)
+			return check(&a.element) && check(&square)
+		},
+		gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		if !check(&staticTestValues[i]) {
+			t.Fatal("Sqrt failed on static value", staticTestValues[i].String())
+		}
+	}
+}
+
+func TestElementBytes(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	for _, a := range staticTestValues {
+		b := a.Bytes()
+		var c Element
+		assert.NoError(c.SetBytesCanonical(b[:]))
+		assert.True(c.Equal(&a))
+
+		var le [Bytes]byte
+		LittleEndian.PutElement(&le, a)
+		d, err := LittleEndian.Element(&le)
+		assert.NoError(err)
+		assert.True(d.Equal(&a))
+
+		var bi big.Int
+		a.BigInt(&bi)
+		assert.Equal(bi.String(), new(big.Int).SetBytes(b[:]).String())
+	}
+
+	// q is not a canonical encoding
+	qBytes := Modulus().Bytes()
+	var c Element
+	assert.Error(c.SetBytesCanonical(qBytes))
+
+	// SetBytes reduces
+	c.SetBytes(qBytes)
+	assert.True(c.IsZero())
+}
+
+func TestElementSetInt64(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	for _, v := range []int64{0, 1, -1, 42, -42, 1 << 40, -(1 << 40), 1<<63 - 1, -1 << 63} {
+		var a Element
+		a.SetInt64(v)
+
+		var expected, got big.Int
+		expected.SetInt64(v).Mod(&expected, Modulus())
+		assert.Equal(expected.String(), a.BigInt(&got).String(), "SetInt64(%d)", v)
+	}
+}
+
+func TestElementSetString(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b Element
+	_, err := a.SetString("0x10")
+	assert.NoError(err)
+	b.SetUint64(16)
+	assert.True(a.Equal(&b))
+	assert.Equal("10", a.Text(16))
+	b.SetInt64(-3)
+	assert.Equal("-3", b.String())
+
+	_, err = a.SetString("not a number")
+	assert.Error(err)
+}
+
+func TestElementJSON(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	type S struct {
+		A Element
+		B [3]Element
+		C *Element
+		D *Element
+	}
+
+	var s S
+	s.A.SetString("-1")
+	s.B[2].SetUint64(42)
+	s.D = new(Element).SetUint64(8000)
+
+	encoded, err := json.Marshal(&s)
+	assert.NoError(err)
+
+	var decoded S
+	assert.NoError(json.Unmarshal(encoded, &decoded))
+	assert.Equal(s, decoded)
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	res := BatchInvert(staticTestValues)
+	for i := range staticTestValues {
+		var expected Element
+		expected.Inverse(&staticTestValues[i])
+		assert.True(res[i].Equal(&expected))
+	}
+}
+
+func TestElementLexicographicallyLargest(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property("element.Cmp should match LexicographicallyLargest output", prop.ForAll(
+		func(a testPairElement) bool {
+			var negA Element
+			negA.Neg(&a.element)
+
+			cmpResult := a.element.Cmp(&negA)
+			lResult := a.element.LexicographicallyLargest()
+
+			if lResult && cmpResult == 1 {
+				return true
+			}
+			if !lResult && cmpResult != 1 {
+				return true
+			}
+			return false
+		},
+		gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementSelect(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b, c Element
+	a.SetUint64(1)
+	b.SetUint64(2)
+	assert.True(c.Select(0, &a, &b).Equal(&a))
+	assert.True(c.Select(1, &a, &b).Equal(&b))
+	assert.True(c.Select(-7, &a, &b).Equal(&b))
+}
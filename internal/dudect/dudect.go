@@ -0,0 +1,99 @@
+// Package dudect implements a minimal version of the "dude, is my code constant time?"
+// leakage detection test (https://eprint.iacr.org/2016/1123.pdf).
+//
+// The execution time of a function is measured on two classes of inputs (typically a
+// fixed input and random inputs), interleaved in random order; the two timing
+// distributions are then compared with Welch's t-test. A large |t| indicates that the
+// execution time depends on the input.
+package dudect
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Threshold above which |t| is considered evidence of a timing leak.
+// dudect uses 4.5 on long runs; we use a more conservative bound since the tests run
+// on shared, noisy machines.
+const Threshold = 10
+
+// Measure runs f n times, on inputs of class 0 or 1 picked at random.
+// prepare(class) is called before each measurement, outside of the timed section,
+// and must set the input of f for the given class.
+//
+// It returns the absolute value of Welch's t-statistic between the two classes, after
+// discarding the slowest 10% of the measurements (to remove interrupts and other noise).
+func Measure(n int, prepare func(class int), f func()) float64 {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano())) //#nosec G404 -- the class order needs not be secure
+
+	type sample struct {
+		class int
+		d     float64
+	}
+	samples := make([]sample, n)
+
+	// warm up
+	for i := 0; i < 10; i++ {
+		prepare(i & 1)
+		f()
+	}
+
+	for i := range samples {
+		class := rng.Intn(2)
+		prepare(class)
+		start := time.Now()
+		f()
+		samples[i] = sample{class, float64(time.Since(start))}
+	}
+
+	// crop the slowest measurements
+	sorted := make([]float64, n)
+	for i := range samples {
+		sorted[i] = samples[i].d
+	}
+	sort.Float64s(sorted)
+	cutoff := sorted[n*9/10]
+
+	var acc [2]welford
+	for _, s := range samples {
+		if s.d <= cutoff {
+			acc[s.class].push(s.d)
+		}
+	}
+
+	return math.Abs(tStatistic(&acc[0], &acc[1]))
+}
+
+// welford accumulates the mean and variance of a sample in a single pass
+type welford struct {
+	n        float64
+	mean, m2 float64
+}
+
+func (w *welford) push(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / w.n
+	w.m2 += delta * (x - w.mean)
+}
+
+func (w *welford) variance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / (w.n - 1)
+}
+
+// tStatistic returns Welch's t-statistic of the two samples
+func tStatistic(a, b *welford) float64 {
+	if a.n < 2 || b.n < 2 {
+		return 0
+	}
+	den := math.Sqrt(a.variance()/a.n + b.variance()/b.n)
+	if den == 0 {
+		return 0
+	}
+	return (a.mean - b.mean) / den
+}
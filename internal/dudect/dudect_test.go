@@ -0,0 +1,48 @@
+package dudect
+
+import (
+	"testing"
+)
+
+func TestMeasureDetectsLeak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	// the number of iterations depends on the class
+	var iterations int
+	var sink uint64
+	leaky := func() {
+		for i := 0; i < iterations; i++ {
+			sink = sink*6364136223846793005 + 1442695040888963407
+		}
+	}
+	prepare := func(class int) {
+		iterations = 1000 + class*1000
+	}
+
+	if tt := Measure(2000, prepare, leaky); tt < Threshold {
+		t.Fatalf("timing leak not detected: |t| = %.2f", tt)
+	}
+	_ = sink
+}
+
+func TestTStatistic(t *testing.T) {
+	var a, b welford
+	for _, x := range []float64{1, 2, 3, 4, 5} {
+		a.push(x)
+		b.push(x)
+	}
+	if tt := tStatistic(&a, &b); tt != 0 {
+		t.Fatalf("expected t = 0 for identical samples, got %f", tt)
+	}
+
+	b = welford{}
+	for _, x := range []float64{11, 12, 13, 14, 15} {
+		b.push(x)
+	}
+	// means differ by 10, variances are 2.5: t = -10 / sqrt(2 * 2.5 / 5)
+	if tt := tStatistic(&a, &b); tt != -10 {
+		t.Fatalf("unexpected t-statistic %f", tt)
+	}
+}
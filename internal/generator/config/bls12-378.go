@@ -6,6 +6,11 @@ var BLS12_378 = Curve{
 	EnumID:       "BLS12_378",
 	FrModulus:    "14883435066912132899950318861128167269793560281114003360875131245101026639873",
 	FpModulus:    "605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417",
+	FFT: FFT{
+		GeneratorFullMultiplicativeGroup: 22,
+		GeneratorMaxTwoAdicSubgroup:      "4045585818372166415418670827807793147093034396422209590578257013290761627990",
+		LogTwoOrderMaxTwoAdicSubgroup:    42,
+	},
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
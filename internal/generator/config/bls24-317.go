@@ -6,6 +6,11 @@ var BLS24_317 = Curve{
 	EnumID:       "BLS24_317",
 	FrModulus:    "30869589236456844204538189757527902584594726589286811523515204428962673459201",
 	FpModulus:    "136393071104295911515099765908274057061945112121419593977210139303905973197232025618026156731051",
+	FFT: FFT{
+		GeneratorFullMultiplicativeGroup: 7,
+		GeneratorMaxTwoAdicSubgroup:      "16532287748948254263922689505213135976137839535221842169193829039521719560631",
+		LogTwoOrderMaxTwoAdicSubgroup:    60,
+	},
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
@@ -20,6 +20,9 @@ type Curve struct {
 	FpUnusedBits int
 
 	FpInfo, FrInfo Field
+	FFT            FFT    // parameters of fr used by the fft package
+	FpECFFT        *ECFFT // if set, parameters of fp used by the ecfft package
+	FrECFFT        *ECFFT // if set, parameters of fr used by the ecfft package
 	G1             Point
 	G2             Point
 
@@ -0,0 +1,10 @@
+package config
+
+// ECFFT describes the curve E: y² = x³ + A·x + B over a field, whose points on the coset Q + <G>
+// give the evaluation sets of the ecfft package (x-coordinates), and the 2-isogenies between them.
+type ECFFT struct {
+	A, B        string // coefficients of E, in base 10
+	GX, GY      string // coordinates of G, of order 2^LogTwoOrder
+	QX, QY      string // coordinates of Q, such that 2Q ∉ <G>
+	LogTwoOrder uint64 // log₂ of the order of G
+}
@@ -0,0 +1,8 @@
+package config
+
+// FFT describes the parameters of a field needed to generate the fft package
+type FFT struct {
+	GeneratorFullMultiplicativeGroup uint64 // generator of the multiplicative group 𝔽*
+	GeneratorMaxTwoAdicSubgroup      string // generator of the largest 2-adic subgroup of 𝔽*, in base 10
+	LogTwoOrderMaxTwoAdicSubgroup    uint64 // log₂ of the order of the largest 2-adic subgroup of 𝔽*
+}
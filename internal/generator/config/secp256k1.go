@@ -14,6 +14,26 @@ var SECP256K1 = Curve{
 		CofactorCleaning: false,
 		CRange:           []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
 	},
+	// y² = (x - 145)(x - 374)(x + 519)
+	FpECFFT: &ECFFT{
+		A:           "-215131",
+		B:           "28145370",
+		GX:          "100034370303713033240271241031244595231321061183086630993600769364022029489927",
+		GY:          "32325713673505136652791942006597189461406174763268583916754842154840166772593",
+		QX:          "1",
+		QY:          "52051205106377329805035242879294495201613297476039435639928375609105385885856",
+		LogTwoOrder: 18,
+	},
+	// y² = (x - 169)(x - 561)(x + 730)
+	FrECFFT: &ECFFT{
+		A:           "-438091",
+		B:           "69210570",
+		GX:          "17185573983225386092024443652541908461773259142637726282297977282420262911997",
+		GY:          "70965996620043045662311166877771526109346482684255974526825523193097193045599",
+		QX:          "2",
+		QY:          "20121326991121812453153838469039796500298270204075132599183914445284435340738",
+		LogTwoOrder: 18,
+	},
 	HashE1: &HashSuiteSvdw{
 		z:  []string{"1"},
 		c1: []string{"8"},
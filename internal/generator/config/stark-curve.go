@@ -14,6 +14,16 @@ var STARK_CURVE = Curve{
 		CofactorCleaning: false,
 		CRange:           defaultCRange(),
 	},
+	// y² = (x - 41)(x - 224)(x + 265)
+	FpECFFT: &ECFFT{
+		A:           "-61041",
+		B:           "2433760",
+		GX:          "2329274116912091751800465092788789619490512548833849190325421405518844270041",
+		GY:          "3293794505220057178245040786165492365706374964209259089803323198238161018619",
+		QX:          "2",
+		QY:          "2165402899648677363649445709678752230790008634467090786841725792610998000689",
+		LogTwoOrder: 14,
+	},
 	HashE1: &HashSuiteSvdw{
 		z:  []string{"1"},
 		c1: []string{"3141592653589793238462643383279502884197169399375105820974944592307816406667"},
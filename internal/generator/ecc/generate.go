@@ -182,6 +182,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_stream.go"), Templates: []string{"multiexp_stream.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_stream_test.go"), Templates: []string{"tests/multiexp_stream.go.tmpl"}},
 	}
 
 	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
@@ -0,0 +1,27 @@
+package ecfft
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Config is the data passed to the ecfft templates
+type Config struct {
+	config.FieldDependency
+	config.ECFFT
+}
+
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// fft over the x-coordinates of an elliptic curve coset
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecfft.go"), Templates: []string{"ecfft.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mul.go"), Templates: []string{"mul.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecfft_test.go"), Templates: []string{"ecfft.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, "ecfft", "./ecfft/template/", entries...)
+
+}
@@ -1,6 +1,8 @@
 package edwards
 
 import (
+	"fmt"
+	"math/big"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
@@ -13,7 +15,136 @@ func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.Batc
 		{File: filepath.Join(baseDir, "point_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
 	}
 
-	return bgen.Generate(conf, conf.Package, "./edwards/template", entries...)
+	if err := bgen.Generate(conf, conf.Package, "./edwards/template", entries...); err != nil {
+		return err
+	}
+
+	h2c, err := newHashToCurveConfig(conf)
+	if err != nil {
+		return err
+	}
+
+	entries = []bavard.Entry{
+		{File: filepath.Join(baseDir, "hash_to_curve.go"), Templates: []string{"hash_to_curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hash_to_curve_test.go"), Templates: []string{"tests/hash_to_curve.go.tmpl"}},
+	}
+
+	return bgen.Generate(h2c, conf.Package, "./edwards/template", entries...)
+}
+
+// hashToCurveConfig selects the map to the curve of RFC 9380.
+type hashToCurveConfig struct {
+	config.TwistedEdwardsCurve
+	MapToCurve string // "ELL2" or "SVDW"
+	Z          int64
+}
+
+// newHashToCurveConfig picks Elligator 2 when its preconditions hold on the Montgomery model
+// K*t² = s³ + J*s² + s, that is J ≠ 0 and (J²-4)/K² = a*d non-square. Otherwise it falls
+// back to SVDW on the short Weierstrass model. Z is chosen as in RFC 9380, appendix H.
+func newHashToCurveConfig(conf config.TwistedEdwardsCurve) (hashToCurveConfig, error) {
+	res := hashToCurveConfig{TwistedEdwardsCurve: conf}
+
+	var p *big.Int
+	for _, c := range config.Curves {
+		if c.Name == conf.Name {
+			p = c.FrInfo.Modulus()
+		}
+	}
+	if p == nil {
+		return res, fmt.Errorf("unknown curve %s", conf.Name)
+	}
+
+	f := field{p}
+	a, d := f.setString(conf.A), f.setString(conf.D)
+
+	if f.add(a, d).Sign() != 0 && !f.isSquare(f.mul(a, d)) {
+		res.MapToCurve = "ELL2"
+		// find_z_ell2
+		for ctr := int64(1); ; ctr++ {
+			for _, z := range []int64{ctr, -ctr} {
+				if !f.isSquare(f.int(z)) {
+					res.Z = z
+					return res, nil
+				}
+			}
+		}
+	}
+
+	res.MapToCurve = "SVDW"
+
+	// K = 4/(a-d), J = 2(a+d)/(a-d)
+	// A = (3 - J²)/(3K²), B = (2J³ - 9J)/(27K³)
+	K := f.div(f.int(4), f.sub(a, d))
+	J := f.div(f.mul(f.int(2), f.add(a, d)), f.sub(a, d))
+	A := f.div(f.sub(f.int(3), f.mul(J, J)), f.mul(f.int(3), f.mul(K, K)))
+	B := f.div(f.sub(f.mul(f.int(2), f.mul(J, f.mul(J, J))), f.mul(f.int(9), J)), f.mul(f.int(27), f.mul(K, f.mul(K, K))))
+
+	g := func(x *big.Int) *big.Int {
+		return f.add(f.mul(x, f.add(f.mul(x, x), A)), B)
+	}
+	h := func(z *big.Int) *big.Int {
+		num := f.add(f.mul(f.int(3), f.mul(z, z)), f.mul(f.int(4), A))
+		return f.sub(f.int(0), f.div(num, f.mul(f.int(4), g(z))))
+	}
+
+	// find_z_svdw
+	for ctr := int64(1); ctr < 1<<16; ctr++ {
+		for _, z := range []int64{ctr, -ctr} {
+			Z := f.int(z)
+			if g(Z).Sign() == 0 || h(Z).Sign() == 0 || !f.isSquare(h(Z)) {
+				continue
+			}
+			if f.isSquare(g(Z)) || f.isSquare(g(f.div(f.sub(f.int(0), Z), f.int(2)))) {
+				res.Z = z
+				return res, nil
+			}
+		}
+	}
+
+	return res, fmt.Errorf("no SVDW constant Z found for %s/%s", conf.Name, conf.Package)
+}
+
+// field is a minimal big.Int prime field helper for the generator
+type field struct {
+	p *big.Int
+}
+
+func (f field) int(x int64) *big.Int {
+	return new(big.Int).Mod(big.NewInt(x), f.p)
+}
+
+func (f field) setString(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid integer " + s)
+	}
+	return x.Mod(x, f.p)
+}
+
+func (f field) add(x, y *big.Int) *big.Int {
+	r := new(big.Int).Add(x, y)
+	return r.Mod(r, f.p)
+}
+
+func (f field) sub(x, y *big.Int) *big.Int {
+	r := new(big.Int).Sub(x, y)
+	return r.Mod(r, f.p)
+}
+
+func (f field) mul(x, y *big.Int) *big.Int {
+	r := new(big.Int).Mul(x, y)
+	return r.Mod(r, f.p)
+}
+
+func (f field) div(x, y *big.Int) *big.Int {
+	return f.mul(x, new(big.Int).ModInverse(y, f.p))
+}
+
+func (f field) isSquare(x *big.Int) bool {
+	return big.Jacobi(x, f.p) != -1
 }
@@ -8,7 +8,23 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Config is the data passed to the fft templates
+type Config struct {
+	config.FieldDependency
+	config.FFT
+	Package string // current package being generated
+
+	// CurvePackagePath is the package of the curve whose encoder serializes the domain;
+	// if empty, the domain is serialized using the canonical encoding of the field elements.
+	CurvePackagePath string
+}
+
+// FF returns the name of the field package
+func (conf Config) FF() string {
+	return conf.FieldPackageName
+}
+
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	conf.Package = "fft"
 
@@ -20,9 +36,21 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "bitreverse_test.go"), Templates: []string{"tests/bitreverse.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixedradix.go"), Templates: []string{"mixedradix.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixedradix_test.go"), Templates: []string{"tests/mixedradix.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "outofcore.go"), Templates: []string{"outofcore.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "outofcore_test.go"), Templates: []string{"tests/outofcore.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
 	}
 
+	if conf.CurvePackagePath != "" {
+		// the FFT of vectors of G1 and G2 points
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "group.go"), Templates: []string{"group.go.tmpl", "imports.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "group_test.go"), Templates: []string{"tests/group.go.tmpl", "imports.go.tmpl"}},
+		)
+	}
+
 	funcs := make(map[string]interface{})
 	funcs["bitReverse"] = func(n, i int64) uint64 {
 		nn := uint64(64 - bits.TrailingZeros64(uint64(n)))
@@ -54,12 +82,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
-	// put the generator in the parent dir (fr)
-	frDir := filepath.Dir(baseDir)
+	// put the generator in the parent dir (the field package)
+	fieldDir := filepath.Dir(baseDir)
 	entries = []bavard.Entry{
-		{File: filepath.Join(frDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
+		{File: filepath.Join(fieldDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
 	}
-	return bgen.GenerateWithOptions(conf, "fr", "./fft/template/", bavardOpts, entries...)
+	return bgen.GenerateWithOptions(conf, conf.FieldPackageName, "./fft/template/", bavardOpts, entries...)
 }
 
 func anyToUint64(x any) uint64 {
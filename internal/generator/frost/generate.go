@@ -0,0 +1,65 @@
+package frost
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// frostConfig describes the prime order group FROST is instantiated over.
+type frostConfig struct {
+	Name          string // name of the curve, e.g. bn254
+	Package       string // name of the generated package
+	CurvePackage  string // import path of the group, relative to ecc/
+	CurveName     string // name of the package of the group
+	Point         string // type of the group elements, e.g. twistededwards.PointAffine
+	Scalar        string // name of the package of the integers modulo the group order
+	ScalarPackage string // import path of the integers modulo the group order, relative to ecc/
+	Edwards       bool   // twisted Edwards companion curve, signatures are eddsa compatible
+	ContextString string // domain separation, cf RFC 9591 section 6
+}
+
+// Generate generates FROST over the short Weierstrass curve conf. Only secp256k1 has a
+// ciphersuite specified in RFC 9591.
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	fc := frostConfig{
+		Name:          conf.Name,
+		Package:       "frost",
+		CurvePackage:  conf.Name,
+		CurveName:     conf.CurvePackage,
+		Point:         conf.CurvePackage + ".G1Affine",
+		Scalar:        "fr",
+		ScalarPackage: conf.Name + "/fr",
+		ContextString: "FROST-" + conf.Name + "-SHA256-v1",
+	}
+	return generate(fc, filepath.Join(baseDir, fc.Package), bgen)
+}
+
+// GenerateEdwards generates FROST over the twisted Edwards companion curve conf.
+func GenerateEdwards(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	fc := frostConfig{
+		Name:          conf.Name,
+		Package:       "frost",
+		CurvePackage:  conf.Name + "/" + conf.Package,
+		CurveName:     conf.Package,
+		Point:         conf.Package + ".PointAffine",
+		Scalar:        "scalar",
+		ScalarPackage: conf.Name + "/" + conf.Package + "/scalar",
+		Edwards:       true,
+		ContextString: "FROST-" + strings.ToUpper(conf.Name+"-"+conf.Package) + "-SHA256-v1",
+	}
+	return generate(fc, filepath.Join(baseDir, fc.Package), bgen)
+}
+
+func generate(conf frostConfig, baseDir string, bgen *bavard.BatchGenerator) error {
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "group.go"), Templates: []string{"group.go.tmpl"}},
+		{File: filepath.Join(baseDir, "frost.go"), Templates: []string{"frost.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dkg.go"), Templates: []string{"dkg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "frost_test.go"), Templates: []string{"frost.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./frost/template", entries...)
+}
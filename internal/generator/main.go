@@ -14,23 +14,29 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+	"github.com/consensys/gnark-crypto/internal/generator/ecfft"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
+	"github.com/consensys/gnark-crypto/internal/generator/frost"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
+	"github.com/consensys/gnark-crypto/internal/generator/pcs"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
 	"github.com/consensys/gnark-crypto/internal/generator/sis"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
+	"github.com/consensys/gnark-crypto/internal/generator/tensorcommitment"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/generator/vss"
+	"github.com/consensys/gnark-crypto/internal/generator/zeromorph"
 )
 
 const (
@@ -69,6 +75,27 @@ func main() {
 			// generate ecdsa
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
+			// generate verifiable secret sharing
+			assertNoError(vss.Generate(conf, filepath.Join(curveDir, "vss"), bgen))
+
+			// generate ecfft on the fields without large 2-adic subgroups
+			for _, f := range []struct {
+				name  string
+				ecfft *config.ECFFT
+			}{{"fp", conf.FpECFFT}, {"fr", conf.FrECFFT}} {
+				if f.ecfft == nil {
+					continue
+				}
+				assertNoError(ecfft.Generate(ecfft.Config{
+					FieldDependency: config.FieldDependency{
+						FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/" + f.name,
+						FieldPackageName: f.name,
+						ElementType:      f.name + ".Element",
+					},
+					ECFFT: *f.ecfft,
+				}, filepath.Join(curveDir, f.name, "ecfft"), bgen))
+			}
+
 			if conf.Equal(config.STARK_CURVE) {
 				return // TODO @yelhousni
 			}
@@ -77,6 +104,8 @@ func main() {
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
 			if conf.Equal(config.SECP256K1) {
+				// generate frost threshold signatures
+				assertNoError(frost.Generate(conf, curveDir, bgen))
 				return
 			}
 
@@ -86,19 +115,38 @@ func main() {
 			// generate pairing tests
 			assertNoError(pairing.Generate(conf, curveDir, bgen))
 
+			frInfo := config.FieldDependency{
+				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+				FieldPackageName: "fr",
+				ElementType:      "fr.Element",
+			}
+
 			// generate fri on fr
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(fri.Generate(frInfo, filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate fft on fr
-			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+			assertNoError(fft.Generate(fft.Config{
+				FieldDependency:  frInfo,
+				FFT:              conf.FFT,
+				CurvePackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name,
+			}, filepath.Join(curveDir, "fr", "fft"), bgen))
 
 			if conf.Equal(config.BN254) || conf.Equal(config.BLS12_377) {
 				assertNoError(sis.Generate(conf, filepath.Join(curveDir, "fr", "sis"), bgen))
 			}
 
+			// generate the tensor commitment on fr
+			assertNoError(tensorcommitment.Generate(conf, filepath.Join(curveDir, "fr", "tensor-commitment"), bgen))
+
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
 
+			// generate the polynomial commitment scheme interface and its kzg and fri adapters
+			assertNoError(pcs.Generate(conf, filepath.Join(curveDir, "pcs"), bgen))
+
+			// generate zeromorph multilinear commitments on the kzg srs
+			assertNoError(zeromorph.Generate(conf, filepath.Join(curveDir, "zeromorph"), bgen))
+
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
@@ -111,17 +159,8 @@ func main() {
 			// generate mimc on fr
 			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
 
-			frInfo := config.FieldDependency{
-				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
-				FieldPackageName: "fr",
-				ElementType:      "fr.Element",
-			}
-
 			// generate polynomial on fr
-			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
-
-			// generate eddsa on companion curves
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, true, bgen))
 
 			// generate sumcheck on fr
 			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
@@ -163,11 +202,20 @@ func main() {
 			defer wg.Done()
 
 			curveDir := filepath.Join(baseDir, "ecc", conf.Name, conf.Package)
+
+			// generate the scalar field (integers modulo the order of the prime subgroup)
+			scalarField, err := field.NewFieldConfig("scalar", "Element", conf.Order, false)
+			assertNoError(err)
+			assertNoError(generator.GenerateFF(scalarField, filepath.Join(curveDir, "scalar")))
+
 			// generate twisted edwards companion curves
 			assertNoError(edwards.Generate(conf, curveDir, bgen))
 
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
+
+			// generate frost threshold signatures on companion curves
+			assertNoError(frost.GenerateEdwards(conf, curveDir, bgen))
 		}(conf)
 
 	}
@@ -177,6 +225,35 @@ func main() {
 		defer wg.Done()
 		assertNoError(test_vector_utils.GenerateRationals(bgen))
 	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// generate fft, fri, polynomial and sumcheck on goldilocks (2-adicity 32)
+		fieldDir := filepath.Join(baseDir, "field", "goldilocks")
+		goldilocks := config.FieldDependency{
+			FieldPackagePath: "github.com/consensys/gnark-crypto/field/goldilocks",
+			FieldPackageName: "goldilocks",
+			ElementType:      "goldilocks.Element",
+		}
+
+		assertNoError(fft.Generate(fft.Config{
+			FieldDependency: goldilocks,
+			FFT: config.FFT{
+				GeneratorFullMultiplicativeGroup: 7,
+				GeneratorMaxTwoAdicSubgroup:      "1753635133440165772",
+				LogTwoOrderMaxTwoAdicSubgroup:    32,
+			},
+		}, filepath.Join(fieldDir, "fft"), bgen))
+		assertNoError(fri.Generate(goldilocks, filepath.Join(fieldDir, "fri"), bgen))
+		assertNoError(polynomial.Generate(goldilocks, filepath.Join(fieldDir, "polynomial"), true, true, bgen))
+		assertNoError(sumcheck.Generate(goldilocks, filepath.Join(fieldDir, "sumcheck"), bgen))
+		assertNoError(test_vector_utils.Generate(test_vector_utils.Config{
+			FieldDependency:             goldilocks,
+			RandomizeMissingHashEntries: false,
+		}, filepath.Join(fieldDir, "test_vector_utils"), bgen))
+	}()
 	wg.Wait()
 
 	// format the whole directory
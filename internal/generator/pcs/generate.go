@@ -0,0 +1,26 @@
+package pcs
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// common interface of the polynomial commitment schemes, and adapters for kzg, fri and
+	// the tensor commitment
+	conf.Package = "pcs"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pcs.go"), Templates: []string{"pcs.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pcs_test.go"), Templates: []string{"pcs.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
+		{File: filepath.Join(baseDir, "tensor.go"), Templates: []string{"tensor.go.tmpl"}},
+		{File: filepath.Join(baseDir, "tensor_test.go"), Templates: []string{"tensor.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./pcs/template/", entries...)
+
+}
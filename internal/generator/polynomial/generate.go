@@ -7,7 +7,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
+func Generate(conf config.FieldDependency, baseDir string, generateTests, withFFT bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -16,11 +16,19 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		{File: filepath.Join(baseDir, "pool.go"), Templates: []string{"pool.go.tmpl"}},
 	}
 
+	if withFFT {
+		// fast multiplication, division and interpolation rely on the fft package of the field
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "univariate.go"), Templates: []string{"univariate.go.tmpl"}})
+	}
+
 	if generateTests {
 		entries = append(entries,
 			bavard.Entry{File: filepath.Join(baseDir, "polynomial_test.go"), Templates: []string{"polynomial.test.go.tmpl"}},
 			bavard.Entry{File: filepath.Join(baseDir, "multilin_test.go"), Templates: []string{"multilin.test.go.tmpl"}},
 		)
+		if withFFT {
+			entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "univariate_test.go"), Templates: []string{"univariate.test.go.tmpl"}})
+		}
 	}
 
 	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
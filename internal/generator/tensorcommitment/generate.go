@@ -0,0 +1,30 @@
+package tensorcommitment
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// tensor commitment, and its multilinear opening proofs
+	conf.Package = "tensorcommitment"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "commitment.go"), Templates: []string{"commitment.go.tmpl"}},
+		{File: filepath.Join(baseDir, "commitment_test.go"), Templates: []string{"commitment.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multilinear.go"), Templates: []string{"multilinear.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multilinear_test.go"), Templates: []string{"multilinear.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+
+	// the ring SIS hash is only generated for some curves
+	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_377) {
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "sis_test.go"), Templates: []string{"sis.test.go.tmpl"}})
+	}
+
+	return bgen.Generate(conf, conf.Package, "./tensorcommitment/template/", entries...)
+
+}
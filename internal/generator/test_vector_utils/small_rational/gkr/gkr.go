@@ -24,6 +24,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils/small_rational/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/parallel"
 	"github.com/consensys/gnark-crypto/utils"
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 	"math/big"
 	"strconv"
 	"sync"
@@ -448,6 +449,13 @@ func WithWorkers(workers *utils.WorkerPool) Option {
 	}
 }
 
+// WithScheduler runs the parallel sections of the protocol through the given scheduler.
+func WithScheduler(s scheduler.Scheduler) Option {
+	return func(options *settings) {
+		options.workers = utils.NewWorkerPoolWithScheduler(s)
+	}
+}
+
 // MemoryRequirements returns an increasing vector of memory allocation sizes required for proving a GKR statement
 func (c Circuit) MemoryRequirements(nbInstances int) []int {
 	res := []int{256, nbInstances, nbInstances * (c.maxGateDegree() + 1)}
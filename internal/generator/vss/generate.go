@@ -0,0 +1,23 @@
+package vss
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// verifiable secret sharing
+	conf.Package = "vss"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vss.go"), Templates: []string{"vss.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vss_test.go"), Templates: []string{"vss.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dkg.go"), Templates: []string{"dkg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dkg_test.go"), Templates: []string{"dkg.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./vss/template/", entries...)
+
+}
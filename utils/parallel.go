@@ -1,45 +1,37 @@
 package utils
 
 import (
-	"runtime"
 	"sync"
+
+	"github.com/consensys/gnark-crypto/utils/scheduler"
 )
 
 // Would normally put this in internal/parallel; but it may be desirable for it to be accessible from gnark
 
 type Task func(start, end int)
 
-type job struct {
-	start, end int
-	task       Task
-	done       *sync.WaitGroup
-}
-
+// WorkerPool runs the submitted tasks through a scheduler.Scheduler.
 type WorkerPool struct {
-	chJobs    chan job
-	nbWorkers int
+	scheduler scheduler.Scheduler
 }
 
+// NewWorkerPool returns a WorkerPool using the default scheduler.
 func NewWorkerPool() *WorkerPool {
-	p := &WorkerPool{}
-	p.nbWorkers = runtime.NumCPU() + 2
-	p.chJobs = make(chan job, 40*p.nbWorkers)
-	for i := 0; i < p.nbWorkers; i++ {
-		go func() {
-			for j := range p.chJobs {
-				j.task(j.start, j.end)
-				j.done.Done()
-			}
-		}()
-	}
-	return p
+	return NewWorkerPoolWithScheduler(scheduler.Default())
 }
 
-// Stop (but does not wait) the pool. It frees the worker.
-func (wp *WorkerPool) Stop() {
-	close(wp.chJobs)
+// NewWorkerPoolWithScheduler returns a WorkerPool running its tasks through s.
+func NewWorkerPoolWithScheduler(s scheduler.Scheduler) *WorkerPool {
+	return &WorkerPool{scheduler: s}
 }
 
+// Stop is a no-op; the goroutines are owned by the scheduler.
+// It is kept for compatibility.
+func (wp *WorkerPool) Stop() {}
+
+// Submit splits [0,n) in blocks of size minBlock and runs work on each of them.
+// Blocks the scheduler has no room for are run by the calling goroutine.
+// The returned WaitGroup is done when all the blocks are processed.
 func (wp *WorkerPool) Submit(n int, work func(int, int), minBlock int) *sync.WaitGroup {
 	var wg sync.WaitGroup
 
@@ -52,12 +44,10 @@ func (wp *WorkerPool) Submit(n int, work func(int, int), minBlock int) *sync.Wai
 			end = n
 		}
 		wg.Add(1)
-		wp.chJobs <- job{
-			task:  work,
-			start: start,
-			end:   end,
-			done:  &wg,
-		}
+		scheduler.Go(wp.scheduler, func() {
+			work(start, end)
+			wg.Done()
+		})
 	}
 
 	return &wg
@@ -0,0 +1,153 @@
+// Package scheduler bounds the number of goroutines spawned by the parallel sections
+// of the library (MSM, FFT, KZG, GKR, SIS, vector operations...).
+//
+// Every goroutine the library spawns for a parallel computation goes through a Scheduler.
+// When the scheduler has no room left, the work is run by the calling goroutine instead;
+// a Scheduler never blocks, so nested parallel sections can't deadlock.
+//
+// By default, all the computations share a Scheduler of runtime.NumCPU() tasks. When
+// several proofs run in the same process, they share that budget instead of each of them
+// spawning runtime.NumCPU() goroutines. The default can be replaced with SetDefault, and
+// most APIs accept a per-call Scheduler through their options.
+package scheduler
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Scheduler decides whether a task may run in a new goroutine.
+type Scheduler interface {
+	// TryGo runs f in a new goroutine and returns true if the scheduler has room for it.
+	// Otherwise, it returns false and f is not run.
+	TryGo(f func()) bool
+
+	// NbTasks returns the maximum number of goroutines the scheduler runs concurrently.
+	NbTasks() int
+}
+
+// New returns a Scheduler running at most nbTasks goroutines concurrently.
+func New(nbTasks int) Scheduler {
+	if nbTasks < 1 {
+		nbTasks = 1
+	}
+	return &tokenScheduler{tokens: make(chan struct{}, nbTasks)}
+}
+
+// tokenScheduler is a counting semaphore; a goroutine holds a token while it runs.
+type tokenScheduler struct {
+	tokens chan struct{}
+}
+
+func (s *tokenScheduler) TryGo(f func()) bool {
+	select {
+	case s.tokens <- struct{}{}:
+		go func() {
+			defer func() { <-s.tokens }()
+			f()
+		}()
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *tokenScheduler) NbTasks() int {
+	return cap(s.tokens)
+}
+
+type holder struct {
+	s Scheduler
+}
+
+var _default atomic.Value
+
+func init() {
+	_default.Store(holder{New(runtime.NumCPU())})
+}
+
+// Default returns the Scheduler used when none is provided.
+func Default() Scheduler {
+	return _default.Load().(holder).s
+}
+
+// SetDefault replaces the Scheduler used when none is provided.
+// Computations already running keep the previous one.
+func SetDefault(s Scheduler) {
+	if s == nil {
+		panic("scheduler: nil Scheduler")
+	}
+	_default.Store(holder{s})
+}
+
+// Go runs f in a new goroutine if s has room for it, or in the calling goroutine otherwise.
+// If s is nil, the default Scheduler is used.
+func Go(s Scheduler, f func()) {
+	if s == nil {
+		s = Default()
+	}
+	if !s.TryGo(f) {
+		f()
+	}
+}
+
+// Execute splits [0, nbIterations) in at most maxTasks intervals and calls work on each of them,
+// through s; it returns when all the calls returned. If maxTasks is not set, s.NbTasks() is used.
+// If s is nil, the default Scheduler is used.
+func Execute(s Scheduler, nbIterations int, work func(start, end int), maxTasks ...int) {
+	if s == nil {
+		s = Default()
+	}
+
+	nbTasks := s.NbTasks()
+	if len(maxTasks) == 1 {
+		nbTasks = maxTasks[0]
+		if nbTasks < 1 {
+			nbTasks = 1
+		} else if nbTasks > 512 {
+			nbTasks = 512
+		}
+	}
+
+	if nbTasks == 1 {
+		// no go routines
+		work(0, nbIterations)
+		return
+	}
+
+	nbIterationsPerCpus := nbIterations / nbTasks
+
+	// more CPUs than tasks: a CPU will work on exactly one iteration
+	if nbIterationsPerCpus < 1 {
+		nbIterationsPerCpus = 1
+		nbTasks = nbIterations
+	}
+
+	var wg sync.WaitGroup
+
+	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
+	extraTasksOffset := 0
+
+	for i := 0; i < nbTasks; i++ {
+		_start := i*nbIterationsPerCpus + extraTasksOffset
+		_end := _start + nbIterationsPerCpus
+		if extraTasks > 0 {
+			_end++
+			extraTasks--
+			extraTasksOffset++
+		}
+		if i == nbTasks-1 {
+			// the calling goroutine would only wait; it processes the last interval instead.
+			work(_start, _end)
+			break
+		}
+		wg.Add(1)
+		Go(s, func() {
+			work(_start, _end)
+			wg.Done()
+		})
+	}
+
+	wg.Wait()
+}
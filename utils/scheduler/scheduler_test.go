@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestExecute(t *testing.T) {
+	for _, nbTasks := range []int{1, 2, 3, 7, 64} {
+		s := New(nbTasks)
+		for _, n := range []int{0, 1, 5, 100, 1001} {
+			visited := make([]int32, n)
+			Execute(s, n, func(start, end int) {
+				for i := start; i < end; i++ {
+					atomic.AddInt32(&visited[i], 1)
+				}
+			})
+			for i := range visited {
+				if visited[i] != 1 {
+					t.Fatalf("nbTasks=%d, n=%d: index %d visited %d times", nbTasks, n, i, visited[i])
+				}
+			}
+		}
+	}
+}
+
+func TestSchedulerBoundsConcurrency(t *testing.T) {
+	const nbTasks = 3
+	s := New(nbTasks)
+
+	var running, maxRunning int32
+	var mu sync.Mutex
+	work := func(start, end int) {
+		r := atomic.AddInt32(&running, 1)
+		mu.Lock()
+		if r > maxRunning {
+			maxRunning = r
+		}
+		mu.Unlock()
+		for i := start; i < end; i++ {
+			// nested parallel sections must not deadlock nor exceed the budget
+			Execute(s, 100, func(int, int) {})
+		}
+		atomic.AddInt32(&running, -1)
+	}
+
+	// run from several goroutines, as several proofs would
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Execute(s, 1000, work, 64)
+		}()
+	}
+	wg.Wait()
+
+	// each caller runs work itself, plus at most nbTasks goroutines
+	if maxRunning > 4+nbTasks {
+		t.Fatalf("%d concurrent tasks, expected at most %d", maxRunning, 4+nbTasks)
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	old := Default()
+	defer SetDefault(old)
+
+	s := New(5)
+	SetDefault(s)
+	if Default() != s {
+		t.Fatal("SetDefault did not replace the default scheduler")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	Go(nil, wg.Done)
+	wg.Wait()
+}